@@ -2,6 +2,7 @@ package app
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"time"
 
@@ -10,6 +11,7 @@ import (
 	"gopkg.in/tomb.v2"
 
 	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
 	"github.com/c13n-io/c13n-go/slog"
 	"github.com/c13n-io/c13n-go/store"
 )
@@ -25,9 +27,28 @@ type App struct {
 
 	bus *gochannel.GoChannel
 
+	// The maximum number of concurrent per-recipient operations.
+	maxParallelism int
+	// Collects the parts of incoming multi-part messages.
+	fragments fragmentBuffer
+	// Whether presence indications are received.
+	presence bool
+	// The default retention policy of discussion messages.
+	retention model.RetentionPolicy
+	// The interval between retention policy sweeps (0 disables sweeping).
+	retentionSweepInterval time.Duration
+	// The directory scheduled backups are written to.
+	backupDir string
+	// The interval between scheduled backups (0 disables scheduled backups).
+	backupInterval time.Duration
+
 	Tomb *tomb.Tomb
 }
 
+// defaultMaxParallelism is the default maximum number
+// of concurrent per-recipient operations.
+const defaultMaxParallelism = 8
+
 // New creates a new app instance.
 func New(lnChat lnchat.LightManager, database store.Database,
 	options ...func(*App) error) (*App, error) {
@@ -36,6 +57,8 @@ func New(lnChat lnchat.LightManager, database store.Database,
 		Log:       slog.NewLogger("app"),
 		LNManager: lnChat,
 		Database:  database,
+
+		maxParallelism: defaultMaxParallelism,
 	}
 
 	for _, option := range options {
@@ -58,6 +81,64 @@ func WithDefaultFeeLimitMsat(defaultLimitMsat int64) func(*App) error {
 	}
 }
 
+// WithMaxParallelism sets the maximum number of per-recipient
+// operations (payments or route queries) performed concurrently
+// when sending or estimating a message for a group discussion.
+func WithMaxParallelism(maxParallelism int) func(*App) error {
+	return func(app *App) error {
+		if maxParallelism < 1 {
+			return fmt.Errorf("invalid maximum parallelism %d", maxParallelism)
+		}
+		app.maxParallelism = maxParallelism
+		return nil
+	}
+}
+
+// WithPresence enables the reception of presence indications,
+// which requires intercepting the HTLCs forwarded by the underlying node.
+// Forwarded HTLCs not carrying presence indications are resumed.
+func WithPresence(enabled bool) func(*App) error {
+	return func(app *App) error {
+		app.presence = enabled
+		return nil
+	}
+}
+
+// WithRetention sets the default retention policy of discussion messages,
+// and enables periodic sweeps redacting the messages exceeding
+// their retention policy, if the sweep interval is positive.
+func WithRetention(policy model.RetentionPolicy,
+	sweepInterval time.Duration) func(*App) error {
+
+	return func(app *App) error {
+		if policy.MaxAge < 0 {
+			return ErrInvalidRetention
+		}
+		if sweepInterval < 0 {
+			return fmt.Errorf("invalid retention sweep interval %v", sweepInterval)
+		}
+		app.retention = policy
+		app.retentionSweepInterval = sweepInterval
+		return nil
+	}
+}
+
+// WithBackups enables scheduled incremental database backups,
+// written to the provided directory, if the interval is positive.
+func WithBackups(dir string, interval time.Duration) func(*App) error {
+	return func(app *App) error {
+		if interval < 0 {
+			return fmt.Errorf("invalid backup interval %v", interval)
+		}
+		if interval > 0 && dir == "" {
+			return fmt.Errorf("backup directory required for scheduled backups")
+		}
+		app.backupDir = dir
+		app.backupInterval = interval
+		return nil
+	}
+}
+
 func subscriptionBackoffFn(n int) time.Duration {
 	startBackoff, maxCeilOffset := 5., 595.
 
@@ -68,20 +149,78 @@ func subscriptionBackoffFn(n int) time.Duration {
 	return time.Duration(int64(nextBackoff)) * time.Second
 }
 
-// Init performs any initializations needed at the logic layer, and also
-// opens a persistent publisher listening for received messages from
-// the Lightning daemon.
-func (app *App) Init(ctx context.Context, infoTimeoutSecs uint) error {
-	var err error
+// runSubscription runs a subscription until the app is requested to terminate,
+// recreating it each time it terminates (with backoff on disconnection).
+// Each subscription resumes after the last index known to the database.
+func (app *App) runSubscription(ctx context.Context, name string,
+	lastIndex func() (uint64, error),
+	subscribe func(context.Context, uint64) error) {
+
+	for failedCount := 0; app.Tomb.Alive(); {
+		// Retrieve last known index
+		lastIdx, err := lastIndex()
+		if err != nil {
+			app.Log.WithError(err).Warnf("could not retrieve last known %s", name)
+			continue
+		}
+
+		err = subscribe(ctx, lastIdx)
+		switch {
+		case err != nil:
+			app.Log.WithError(err).Warnf("%s subscription terminated erroneously", name)
+			// In case of disconnection, increment backoff.
+			if errors.Is(err, lnchat.ErrNetworkUnavailable) {
+				failedCount++
+				break
+			}
+			fallthrough
+		default:
+			failedCount = 0
+		}
+
+		backoffInterval := subscriptionBackoffFn(failedCount)
+		app.Log.Infof("retrying %s subscription after %s "+
+			"(attempt %d)", name, backoffInterval, failedCount+1)
 
-	// Retrieve underlying node identity
+		// Wait for backoff to elapse, while also
+		// listening for normal termination.
+		select {
+		case <-time.After(backoffInterval):
+		case <-app.Tomb.Dying():
+		}
+	}
+	app.Log.Infof("%s subscription terminated", name)
+}
+
+// InitSelfInfo retrieves the identity of the underlying node, which is
+// the only initialization needed by one-off operations (e.g. Resync)
+// that do not require the subscriptions and background tasks of Init.
+func (app *App) InitSelfInfo(ctx context.Context, infoTimeoutSecs uint) error {
 	ctxt, cancel := context.WithTimeout(ctx,
 		time.Duration(infoTimeoutSecs)*time.Second)
 	defer cancel()
-	app.Self, err = app.LNManager.GetSelfInfo(ctxt)
+
+	self, err := app.LNManager.GetSelfInfo(ctxt)
 	if err != nil {
 		return newErrorf(err, "GetSelfInfo")
 	}
+	app.Self = self
+
+	return nil
+}
+
+// Init performs any initializations needed at the logic layer, and also
+// opens a persistent publisher listening for received messages from
+// the Lightning daemon.
+func (app *App) Init(ctx context.Context, infoTimeoutSecs uint) error {
+	if err := app.InitSelfInfo(ctx, infoTimeoutSecs); err != nil {
+		return err
+	}
+
+	// Restore the received parts of incomplete multi-part messages.
+	if err := app.loadFragments(ctx); err != nil {
+		app.Log.WithError(err).Warn("could not restore message parts")
+	}
 
 	// Initialize GoChannel for publishing received messages
 	app.Log.Info("Creating pubsub bus")
@@ -96,45 +235,53 @@ func (app *App) Init(ctx context.Context, infoTimeoutSecs uint) error {
 	var subscriptionCtx context.Context
 	app.Tomb, subscriptionCtx = tomb.WithContext(ctx)
 	app.Tomb.Go(func() error {
-		// Until the app is requested to terminate,
-		// recreate invoice subscription each time it terminates.
-		for failedCount := 0; app.Tomb.Alive(); {
-			// Retrieve last known invoice
-			lastInvoiceSettleIdx, err := app.Database.GetLastInvoiceIndex()
-			if err != nil {
-				app.Log.WithError(err).Warn("could not retrieve last known invoice")
-				continue
-			}
-
-			err = app.subscribeInvoices(subscriptionCtx, lastInvoiceSettleIdx)
-			switch {
-			case err != nil:
-				app.Log.WithError(err).Warn("invoice subscription terminated erroneously")
-				// In case of disconnection, increment backoff.
-				if errors.Is(err, lnchat.ErrNetworkUnavailable) {
-					failedCount++
-					break
-				}
-				fallthrough
-			default:
-				failedCount = 0
-			}
-
-			backoffInterval := subscriptionBackoffFn(failedCount)
-			app.Log.Infof("retrying subscription after %s "+
-				"(attempt %d)", backoffInterval, failedCount+1)
+		app.runSubscription(subscriptionCtx, "invoice",
+			app.Database.GetLastInvoiceIndex, app.subscribeInvoices)
+		return nil
+	})
 
-			// Wait for backoff to elapse, while also
-			// listening for normal termination.
-			select {
-			case <-time.After(backoffInterval):
-			case <-app.Tomb.Dying():
-			}
-		}
-		app.Log.Info("invoice subscription terminated")
+	// Run the outgoing payment subscription as a separate goroutine,
+	// storing messages sent from the underlying node by other means
+	// (e.g. another c13n instance or a different client).
+	app.Tomb.Go(func() error {
+		app.runSubscription(subscriptionCtx, "payment",
+			app.Database.GetLastPaymentIndex, app.subscribePayments)
 		return nil
 	})
 
+	// Run the presence subscription as a separate goroutine,
+	// if enabled, publishing received presence indications.
+	if app.presence {
+		app.Tomb.Go(func() error {
+			noIndex := func() (uint64, error) { return 0, nil }
+			app.runSubscription(subscriptionCtx, "presence",
+				noIndex, app.subscribePresence)
+			return nil
+		})
+	}
+
+	// Run the retention sweeper as a separate goroutine, if enabled,
+	// redacting messages exceeding their retention policy.
+	if app.retentionSweepInterval > 0 {
+		app.Tomb.Go(func() error {
+			app.runRetentionSweeper()
+			return nil
+		})
+	}
+
+	// Run the backup scheduler as a separate goroutine, if enabled.
+	if app.backupInterval > 0 {
+		app.Tomb.Go(func() error {
+			app.runBackupScheduler()
+			return nil
+		})
+	}
+
+	// Resume tracking of in-flight payments of outgoing messages.
+	if err := app.resumeOutbox(); err != nil {
+		app.Log.WithError(err).Warn("could not resume outbox messages")
+	}
+
 	return nil
 }
 
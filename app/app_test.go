@@ -21,6 +21,13 @@ var (
 	defaultTimeout = time.Second
 )
 
+func mustNodeFromString(t *testing.T, address string) lnchat.NodeID {
+	node, err := lnchat.NewNodeFromString(address)
+	require.NoError(t, err)
+
+	return node
+}
+
 // Initial setup for all tests in this package.
 func TestMain(m *testing.M) {
 	f, _ := ioutil.TempFile(os.TempDir(), "output-test_app-*.log")
@@ -81,12 +88,17 @@ func TestAppInitSuccess(t *testing.T) {
 
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockDB.On("Close").Return(nil).Once()
 		mockLNManager.On("Close").Return(nil).Once()
@@ -210,11 +222,16 @@ func TestAppInitSubscriptionIndex(t *testing.T) {
 		},
 	}
 	mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+	mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 	var _, lastReceivedIdx uint64 = 3, 72
 
 	mockDB.On("GetLastInvoiceIndex").Return(
 		lastReceivedIdx, nil).Once()
+	mockDB.On("GetOutboxMessages").Return(nil, nil)
+	mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+	mockLNManager.On("ListPayments", mock.Anything,
+		uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 	mockDB.On("Close").Return(nil).Once()
 
 	mockLNManager.On("Close").Return(nil).Once()
@@ -0,0 +1,114 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// BackupDatabase writes a backup of the database entries changed
+// after the provided version (0 for a full backup).
+// The version a subsequent incremental backup should start from is returned.
+func (app *App) BackupDatabase(_ context.Context, w io.Writer,
+	since uint64) (uint64, error) {
+
+	version, err := app.Database.Backup(w, since)
+
+	return version, newErrorf(err, "Backup")
+}
+
+// backupFileName returns the name of a scheduled backup file.
+// Backup file names sort in creation order, and contain the versions
+// the backup spans, with a full backup starting from version 0.
+func backupFileName(since, version uint64) string {
+	return fmt.Sprintf("c13n-backup-%020d-%020d.bak", since, version)
+}
+
+// lastBackupVersion returns the highest version spanned
+// by the backup files in the provided directory,
+// or 0 if the directory contains no backup files.
+func lastBackupVersion(dir string) (uint64, error) {
+	names, err := filepath.Glob(filepath.Join(dir, "c13n-backup-*-*.bak"))
+	if err != nil {
+		return 0, err
+	}
+
+	var last uint64
+	for _, name := range names {
+		var since, version uint64
+		if _, err := fmt.Sscanf(filepath.Base(name),
+			"c13n-backup-%d-%d.bak", &since, &version); err != nil {
+
+			continue
+		}
+		if version > last {
+			last = version
+		}
+	}
+
+	return last, nil
+}
+
+// runBackupScheduler writes a backup after each backup interval
+// elapses, until the app is requested to terminate.
+// The backups resume from the last backup in the backup directory,
+// starting with a full backup if the directory contains no backups.
+func (app *App) runBackupScheduler() {
+	ticker := time.NewTicker(app.backupInterval)
+	defer ticker.Stop()
+
+	since, err := lastBackupVersion(app.backupDir)
+	if err != nil {
+		app.Log.WithError(err).Warn("could not read backup directory, " +
+			"starting with a full backup")
+	}
+	for {
+		select {
+		case <-ticker.C:
+		case <-app.Tomb.Dying():
+			app.Log.Info("backup scheduler terminated")
+			return
+		}
+
+		version, err := app.scheduledBackup(since)
+		if err != nil {
+			app.Log.WithError(err).Warn("scheduled backup failed")
+			continue
+		}
+		since = version
+	}
+}
+
+// scheduledBackup writes a backup of the database entries changed
+// after the provided version to the backup directory.
+// No backup file is written if no entries changed.
+func (app *App) scheduledBackup(since uint64) (uint64, error) {
+	f, err := ioutil.TempFile(app.backupDir, ".c13n-backup-*")
+	if err != nil {
+		return since, err
+	}
+	defer os.Remove(f.Name())
+
+	version, err := app.Database.Backup(f, since)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil || version == since {
+		return since, err
+	}
+
+	name := filepath.Join(app.backupDir, backupFileName(since, version))
+	if err := os.Rename(f.Name(), name); err != nil {
+		return since, err
+	}
+	app.Log.Infof("database backup written to %s", name)
+
+	return version, nil
+}
@@ -0,0 +1,68 @@
+package app
+
+import (
+	"io"
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestScheduledBackup(t *testing.T) {
+	dir := t.TempDir()
+
+	writeBackup := func(args mock.Arguments) {
+		_, err := args.Get(0).(io.Writer).Write([]byte("backup"))
+		require.NoError(t, err)
+	}
+
+	mockDB := new(dbmock.Database)
+	mockDB.On("Backup", mock.Anything, uint64(0)).
+		Run(writeBackup).Return(uint64(5), nil).Once()
+	mockDB.On("Backup", mock.Anything, uint64(5)).
+		Run(writeBackup).Return(uint64(5), nil).Once()
+
+	app, err := New(new(lnmock.LightManager), mockDB,
+		WithBackups(dir, time.Hour))
+	require.NoError(t, err)
+
+	version, err := app.scheduledBackup(0)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, version)
+
+	// No backup file is written without changes.
+	version, err = app.scheduledBackup(version)
+	require.NoError(t, err)
+	assert.EqualValues(t, 5, version)
+	mockDB.AssertExpectations(t)
+
+	files, err := ioutil.ReadDir(dir)
+	require.NoError(t, err)
+	require.Len(t, files, 1)
+	assert.Equal(t, backupFileName(0, 5), files[0].Name())
+
+	content, err := ioutil.ReadFile(filepath.Join(dir, files[0].Name()))
+	require.NoError(t, err)
+	assert.Equal(t, "backup", string(content))
+
+	// Scheduled backups resume from the last backup file.
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, ".c13n-backup-tmp"), nil, 0600))
+	require.NoError(t, ioutil.WriteFile(filepath.Join(dir, backupFileName(5, 12)), nil, 0600))
+	last, err := lastBackupVersion(dir)
+	require.NoError(t, err)
+	assert.EqualValues(t, 12, last)
+
+	last, err = lastBackupVersion(t.TempDir())
+	require.NoError(t, err)
+	assert.Zero(t, last)
+
+	_, err = New(new(lnmock.LightManager), mockDB, WithBackups("", time.Hour))
+	assert.Error(t, err)
+}
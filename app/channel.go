@@ -2,9 +2,11 @@ package app
 
 import (
 	"context"
+	"errors"
 
 	"github.com/c13n-io/c13n-go/lnchat"
 	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
 )
 
 // OpenChannel creates a channel with the node identified
@@ -26,5 +28,188 @@ func (app *App) OpenChannel(ctx context.Context, address string,
 		return nil, newErrorf(err, "OpenChannel: channel opening failed")
 	}
 
-	return &model.ChannelPoint{*chanPoint}, nil
+	return &model.ChannelPoint{ChannelPoint: *chanPoint}, nil
+}
+
+// ChannelCloseUpdate represents a channel closing update.
+type ChannelCloseUpdate struct {
+	Status *model.ChannelCloseStatus
+	Error  error
+}
+
+// CloseChannel closes the channel identified by the provided channel point.
+// The returned channel receives closing updates and is closed
+// once the closing transaction is confirmed.
+// The provided fee options are ignored if force is set.
+func (app *App) CloseChannel(ctx context.Context, chanPoint model.ChannelPoint,
+	force bool, txOptions model.TxFeeOptions) (<-chan ChannelCloseUpdate, error) {
+
+	updates, err := app.LNManager.CloseChannel(ctx, chanPoint.ChannelPoint,
+		force, lnchat.TxFeeOptions{
+			SatPerVByte:     txOptions.SatPerVByte,
+			TargetConfBlock: txOptions.TargetConfBlock,
+		},
+	)
+	if err != nil {
+		return nil, newErrorf(err, "CloseChannel: channel closing failed")
+	}
+
+	updateCh := make(chan ChannelCloseUpdate)
+
+	go func() {
+		defer close(updateCh)
+
+		for update := range updates {
+			var res ChannelCloseUpdate
+			switch {
+			case update.Err != nil:
+				res.Error = newErrorf(update.Err,
+					"CloseChannel: channel closing update failed")
+			default:
+				res.Status = &model.ChannelCloseStatus{
+					ChannelCloseStatus: *update.Status,
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- res:
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
+
+// ListChannels returns the open channels of the current node.
+func (app *App) ListChannels(ctx context.Context) ([]model.Channel, error) {
+	channels, err := app.LNManager.ListChannels(ctx)
+	if err != nil {
+		return nil, newErrorf(err, "ListChannels")
+	}
+
+	return app.channelsWithRemoteNames(ctx, channels)
+}
+
+// ListPendingChannels returns the channels of the current node
+// that are pending opening or closing.
+func (app *App) ListPendingChannels(ctx context.Context) ([]model.Channel, error) {
+	channels, err := app.LNManager.ListPendingChannels(ctx)
+	if err != nil {
+		return nil, newErrorf(err, "ListPendingChannels")
+	}
+
+	return app.channelsWithRemoteNames(ctx, channels)
+}
+
+// ChannelEventUpdate represents a channel event update.
+type ChannelEventUpdate struct {
+	Event *model.ChannelEvent
+	Error error
+}
+
+// SubscribeChannelEvents returns a channel over which channel events are sent.
+// The subscriber is responsible for draining the channel
+// until it is closed or the context is cancelled.
+func (app *App) SubscribeChannelEvents(ctx context.Context) (<-chan ChannelEventUpdate, error) {
+	events, err := app.LNManager.SubscribeChannelEvents(ctx)
+	if err != nil {
+		return nil, newErrorf(err, "SubscribeChannelEvents")
+	}
+
+	eventCh := make(chan ChannelEventUpdate)
+
+	go func() {
+		defer close(eventCh)
+
+		for update := range events {
+			var res ChannelEventUpdate
+			switch {
+			case update.Err != nil:
+				res.Error = newErrorf(update.Err,
+					"SubscribeChannelEvents: subscription failed")
+			default:
+				res.Event = &model.ChannelEvent{
+					Type: update.Event.Type,
+					ChannelPoint: model.ChannelPoint{
+						ChannelPoint: update.Event.ChannelPoint,
+					},
+				}
+				if update.Event.Channel != nil {
+					channels, err := app.channelsWithRemoteNames(ctx,
+						[]lnchat.LightningChannel{*update.Event.Channel})
+					if err != nil {
+						app.Log.WithError(err).Warn("could not resolve " +
+							"channel remote node name")
+						channels = []model.Channel{{
+							LightningChannel: *update.Event.Channel,
+						}}
+					}
+					res.Event.Channel = &channels[0]
+				}
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case eventCh <- res:
+			}
+		}
+	}()
+
+	return eventCh, nil
+}
+
+// channelsWithRemoteNames resolves the remote node name of each channel,
+// preferring the contact display name over the node alias.
+// Only the remote nodes of the provided channels are looked up.
+func (app *App) channelsWithRemoteNames(ctx context.Context,
+	channels []lnchat.LightningChannel) ([]model.Channel, error) {
+
+	names := make(map[string]string, len(channels))
+	for _, channel := range channels {
+		address := channel.RemoteAddress
+		if _, ok := names[address]; ok {
+			continue
+		}
+
+		name, err := app.remoteNodeName(ctx, address)
+		if err != nil {
+			return nil, err
+		}
+		names[address] = name
+	}
+
+	result := make([]model.Channel, len(channels))
+	for i, channel := range channels {
+		result[i] = model.Channel{
+			LightningChannel: channel,
+			RemoteName:       names[channel.RemoteAddress],
+		}
+	}
+
+	return result, nil
+}
+
+// remoteNodeName returns the contact display name of a node,
+// falling back to its alias if the node is not a contact.
+// Nodes missing from the network graph have an empty name.
+func (app *App) remoteNodeName(ctx context.Context, address string) (string, error) {
+	contact, err := app.Database.GetContact(address)
+	switch {
+	case err == nil:
+		return contact.DisplayName, nil
+	case !errors.Is(err, store.ErrContactNotFound):
+		return "", newErrorf(err, "GetContact")
+	}
+
+	node, err := app.LNManager.GetNodeInfo(ctx, address)
+	if err != nil {
+		app.Log.WithError(err).Debugf("could not retrieve "+
+			"alias of node %s", address)
+		return "", nil
+	}
+
+	return node.Alias, nil
 }
@@ -0,0 +1,252 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestCloseChannel(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	chanPoint := lnchat.ChannelPoint{
+		FundingTxid: "a3c1f2b7e9a8d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1",
+		OutputIndex: 1,
+	}
+	closingTxid := "1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d"
+
+	lnUpdates := []lnchat.ChannelCloseUpdate{
+		{
+			Status: &lnchat.ChannelCloseStatus{
+				ClosingTxid: closingTxid,
+			},
+		},
+		{
+			Status: &lnchat.ChannelCloseStatus{
+				ClosingTxid: closingTxid,
+				Confirmed:   true,
+			},
+		},
+	}
+
+	expectedUpdates := []ChannelCloseUpdate{
+		{
+			Status: &model.ChannelCloseStatus{
+				ChannelCloseStatus: lnchat.ChannelCloseStatus{
+					ClosingTxid: closingTxid,
+				},
+			},
+		},
+		{
+			Status: &model.ChannelCloseStatus{
+				ChannelCloseStatus: lnchat.ChannelCloseStatus{
+					ClosingTxid: closingTxid,
+					Confirmed:   true,
+				},
+			},
+		},
+	}
+
+	txOpts := model.TxFeeOptions{
+		SatPerVByte:     5,
+		TargetConfBlock: 6,
+	}
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		updateCh := make(chan lnchat.ChannelCloseUpdate, len(lnUpdates))
+		for _, update := range lnUpdates {
+			updateCh <- update
+		}
+		close(updateCh)
+
+		mockLNManager.On("CloseChannel", mock.Anything, chanPoint, false,
+			lnchat.TxFeeOptions{
+				SatPerVByte:     txOpts.SatPerVByte,
+				TargetConfBlock: txOpts.TargetConfBlock,
+			},
+		).Return((<-chan lnchat.ChannelCloseUpdate)(updateCh), nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	updates, err := app.CloseChannel(ctxt,
+		model.ChannelPoint{ChannelPoint: chanPoint}, false, txOpts)
+	assert.NoError(t, err)
+
+	var received []ChannelCloseUpdate
+	for update := range updates {
+		received = append(received, update)
+	}
+	assert.Equal(t, expectedUpdates, received)
+}
+
+func TestListChannels(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	nodes := []lnchat.LightningNode{
+		{
+			Alias:   "alice_alias",
+			Address: "111111111111111111111111111111111111111111111111111111111111111111",
+		},
+		{
+			Alias:   "bob_alias",
+			Address: "222222222222222222222222222222222222222222222222222222222222222222",
+		},
+	}
+
+	contacts := []model.Contact{
+		{
+			ID:          1,
+			DisplayName: "bob",
+			Node: model.Node{
+				Alias:   "bob_alias",
+				Address: "222222222222222222222222222222222222222222222222222222222222222222",
+			},
+		},
+	}
+
+	channels := []lnchat.LightningChannel{
+		{
+			ChannelID: 1,
+			ChannelPoint: lnchat.ChannelPoint{
+				FundingTxid: "a3c1f2b7e9a8d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1",
+				OutputIndex: 0,
+			},
+			RemoteAddress:     "111111111111111111111111111111111111111111111111111111111111111111",
+			CapacityMsat:      1000000,
+			LocalBalanceMsat:  600000,
+			RemoteBalanceMsat: 400000,
+			Active:            true,
+		},
+		{
+			ChannelID: 2,
+			ChannelPoint: lnchat.ChannelPoint{
+				FundingTxid: "1c2d3e4f5a6b7c8d9e0f1a2b3c4d5e6f7a8b9c0d1e2f3a4b5c6d7e8f9a0b1c2d",
+				OutputIndex: 1,
+			},
+			RemoteAddress:    "222222222222222222222222222222222222222222222222222222222222222222",
+			CapacityMsat:     2000000,
+			LocalBalanceMsat: 2000000,
+		},
+		{
+			ChannelID: 3,
+			ChannelPoint: lnchat.ChannelPoint{
+				FundingTxid: "b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1a3c1f2b7e9a8d6c5",
+				OutputIndex: 0,
+			},
+			RemoteAddress: "333333333333333333333333333333333333333333333333333333333333333333",
+			CapacityMsat:  3000000,
+			Active:        true,
+		},
+	}
+
+	expected := []model.Channel{
+		{
+			LightningChannel: channels[0],
+			RemoteName:       "alice_alias",
+		},
+		{
+			LightningChannel: channels[1],
+			RemoteName:       "bob",
+		},
+		{
+			LightningChannel: channels[2],
+		},
+	}
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockLNManager.On("ListChannels", mock.Anything).Return(channels, nil).Once()
+		mockDB.On("GetContact", nodes[0].Address).Return(
+			nil, store.ErrContactNotFound).Once()
+		mockLNManager.On("GetNodeInfo", mock.Anything,
+			nodes[0].Address).Return(&nodes[0], nil).Once()
+		mockDB.On("GetContact", contacts[0].Address).Return(
+			&contacts[0], nil).Once()
+		mockDB.On("GetContact", channels[2].RemoteAddress).Return(
+			nil, store.ErrContactNotFound).Once()
+		mockLNManager.On("GetNodeInfo", mock.Anything,
+			channels[2].RemoteAddress).Return(nil, lnchat.ErrUnknown).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	result, err := app.ListChannels(ctxt)
+	assert.NoError(t, err)
+	assert.Equal(t, expected, result)
+}
@@ -85,9 +85,14 @@ func TestAddContact(t *testing.T) {
 
 				// Mock self info
 				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 				mockDB.On("GetLastInvoiceIndex").Return(
 					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates",
 					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -173,9 +178,14 @@ func TestGetContactsSuccess(t *testing.T) {
 	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -253,9 +263,14 @@ func TestRemoveContactByAddress(t *testing.T) {
 
 				// Mock self info
 				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 				mockDB.On("GetLastInvoiceIndex").Return(
 					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates",
 					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -16,14 +16,82 @@ import (
 // was requested to a group discussion.
 var ErrDiscAnonymousMessage = fmt.Errorf("anonymous message in group discussion is disallowed")
 
-// GetDiscussions returns all messages stored in database.
-func (app *App) GetDiscussions(_ context.Context) ([]model.Discussion, error) {
-	discussions, err := app.Database.GetDiscussions(0, 0)
+// ErrAnonymousAnnotation indicates that an anonymous edit,
+// delete request or reaction was requested.
+// Since these apply only if their sender is verified, they cannot be anonymous.
+var ErrAnonymousAnnotation = fmt.Errorf("anonymous edit, delete request or reaction is disallowed")
+
+// GetDiscussions returns the requested range of discussions
+// matching the filter, along with their unread message count, their last message
+// and the contact display names of their participants.
+func (app *App) GetDiscussions(_ context.Context, pageOpts model.PageOptions,
+	order model.DiscussionOrder, filter model.DiscussionFilter) ([]model.DiscussionSummary, error) {
+
+	discussions, err := app.Database.GetDiscussions(pageOpts, order, filter)
 	if err != nil {
 		return nil, newErrorf(err, "GetDiscussions")
 	}
 
-	return discussions, nil
+	contacts, err := app.Database.GetContacts()
+	if err != nil {
+		return nil, newErrorf(err, "GetContacts")
+	}
+	names := make(map[string]string, len(contacts))
+	for _, contact := range contacts {
+		names[contact.Address] = contact.DisplayName
+	}
+
+	summaries := make([]model.DiscussionSummary, len(discussions))
+	for i := range discussions {
+		summary, err := app.summarizeDiscussion(&discussions[i], names)
+		if err != nil {
+			return nil, err
+		}
+		summaries[i] = *summary
+	}
+
+	return summaries, nil
+}
+
+// summarizeDiscussion retrieves the last message of a discussion
+// and resolves its participant names from the provided contact names.
+// The unread message count is stored along with the discussion.
+// The last message of a discussion being removed may be missing.
+func (app *App) summarizeDiscussion(disc *model.Discussion,
+	names map[string]string) (*model.DiscussionSummary, error) {
+
+	var latest *store.MessageAggregate
+	if disc.LatestMessageID != 0 {
+		var err error
+		latest, err = app.Database.GetMessage(disc.LatestMessageID)
+		switch {
+		case errors.Is(err, store.ErrMessageNotFound):
+			latest = nil
+		case err != nil:
+			return nil, newErrorf(err, "GetMessage")
+		}
+	}
+
+	summary := &model.DiscussionSummary{
+		Discussion:       *disc,
+		ParticipantNames: make([]string, len(disc.Participants)),
+	}
+	for i, participant := range disc.Participants {
+		summary.ParticipantNames[i] = names[participant]
+	}
+
+	if latest != nil {
+		// NOTE: Since the discussion is fixed, we can forgo the lookup.
+		retrieveDisc := func(_ []string) (*model.Discussion, error) {
+			return disc, nil
+		}
+		var err error
+		if summary.LastMessage, err = annotatedMessage(*latest, retrieveDisc); err != nil {
+			return nil, err
+		}
+	}
+
+	return summary, nil
 }
 
 // GetDiscussionStatistics retrieves the discussion messages and calculates statistics.
@@ -37,11 +105,14 @@ func (app *App) GetDiscussionStatistics(_ context.Context, id uint64) (
 	}
 
 	var amtSent, amtRcv, amtFees, msgsSent, msgsRcv int64
-	for _, m := range msgAggregates {
+	accumulate := func(m store.MessageAggregate) {
 		switch {
 		case len(m.Payments) == 0:
 			msgsRcv++
 			amtRcv += m.Invoice.AmtPaid.Msat()
+			for _, fragment := range m.Fragments {
+				amtRcv += fragment.AmtPaid.Msat()
+			}
 		case m.Invoice == nil:
 			msgsSent++
 			for _, p := range m.Payments {
@@ -54,8 +125,13 @@ func (app *App) GetDiscussionStatistics(_ context.Context, id uint64) (
 					}
 				}
 			}
-		default:
-			continue
+		}
+	}
+	for _, m := range msgAggregates {
+		accumulate(m)
+		// Edits, delete requests and reactions are paid messages as well.
+		for _, annotation := range m.Annotations {
+			accumulate(annotation)
 		}
 	}
 
@@ -89,17 +165,9 @@ func (app *App) GetDiscussionHistory(ctx context.Context, discID uint64,
 
 	msgs := make([]model.Message, len(msgList))
 	for i, m := range msgList {
-		var msg *model.Message
-		switch {
-		case len(m.Payments) == 0:
-			msg, err = model.NewIncomingMessage(m.RawMessage,
-				m.Invoice, retrieveDisc)
-		case m.Invoice == nil:
-			msg, err = model.NewOutgoingMessage(m.RawMessage,
-				true, m.Payments...)
-		}
+		msg, err := annotatedMessage(m, retrieveDisc)
 		if err != nil {
-			return nil, newErrorf(err, "message unmarshal failed")
+			return nil, err
 		}
 		msgs[i] = *msg
 	}
@@ -107,6 +175,44 @@ func (app *App) GetDiscussionHistory(ctx context.Context, discID uint64,
 	return msgs, nil
 }
 
+// annotatedMessage constructs a message from a stored message aggregate
+// and applies its edits, delete requests and reactions.
+func annotatedMessage(m store.MessageAggregate,
+	retrieveDisc func([]string) (*model.Discussion, error)) (*model.Message, error) {
+
+	msg, err := aggregateToMessage(m, retrieveDisc)
+	if err != nil {
+		return nil, newErrorf(err, "message unmarshal failed")
+	}
+
+	annotations := make([]*model.Message, len(m.Annotations))
+	for j, a := range m.Annotations {
+		if annotations[j], err = aggregateToMessage(a, retrieveDisc); err != nil {
+			return nil, newErrorf(err, "message annotation unmarshal failed")
+		}
+	}
+	msg.ApplyAnnotations(annotations...)
+
+	return msg, nil
+}
+
+// aggregateToMessage constructs a message from a stored message aggregate.
+func aggregateToMessage(m store.MessageAggregate,
+	retrieveDisc func([]string) (*model.Discussion, error)) (*model.Message, error) {
+
+	switch {
+	case len(m.Payments) == 0:
+		return model.NewIncomingMessage(m.RawMessage,
+			m.Invoice, retrieveDisc, m.Fragments...)
+	case m.Invoice == nil:
+		return model.NewOutgoingMessage(m.RawMessage,
+			true, m.Payments...)
+	default:
+		return nil, fmt.Errorf("message associated " +
+			"with both invoice and payments")
+	}
+}
+
 // AddDiscussion adds a discussion to database.
 func (app *App) AddDiscussion(_ context.Context,
 	discussion *model.Discussion) (*model.Discussion, error) {
@@ -121,19 +227,76 @@ func (app *App) AddDiscussion(_ context.Context,
 }
 
 // UpdateDiscussionLastRead updates a discussion's last read message.
-func (app *App) UpdateDiscussionLastRead(_ context.Context, discID, readMsgID uint64) error {
+// If requested, a read receipt is sent to the verified senders
+// of the messages up to the last read one.
+func (app *App) UpdateDiscussionLastRead(_ context.Context,
+	discID, readMsgID uint64, sendReadReceipt bool) error {
+
 	err := app.Database.UpdateDiscussionLastRead(discID, readMsgID)
+	if err != nil || !sendReadReceipt {
+		return newErrorf(err, "UpdateDiscussionLastRead")
+	}
+
+	err = app.sendReadReceipts(discID, readMsgID)
+
+	return newErrorf(err, "UpdateDiscussionLastRead: sendReadReceipts")
+}
+
+// UpdateDiscussionOptions replaces a discussion's options.
+// The options are validated against the discussion participants,
+// and an unset fee limit is replaced by the default one.
+func (app *App) UpdateDiscussionOptions(ctx context.Context,
+	discID uint64, options model.MessageOptions) error {
 
-	return newErrorf(err, "UpdateDiscussionLastRead")
+	disc, err := app.retrieveDiscussion(ctx, discID)
+	if err != nil {
+		return err
+	}
+	if err := validateOptions(disc, options, model.MessageContent{}); err != nil {
+		return newErrorf(err, "UpdateDiscussionOptions")
+	}
+
+	if options.FeeLimitMsat == 0 {
+		options.FeeLimitMsat = DefaultOptions.FeeLimitMsat
+	}
+
+	err = app.Database.UpdateDiscussionOptions(discID, options)
+
+	return newErrorf(err, "UpdateDiscussionOptions")
 }
 
-// RemoveDiscussion removes the discussion matching the passed id from database.
-func (app *App) RemoveDiscussion(_ context.Context, id uint64) error {
+// UpdateDiscussionMetadata replaces a discussion's metadata.
+func (app *App) UpdateDiscussionMetadata(_ context.Context,
+	discID uint64, metadata model.DiscussionMetadata) error {
+
+	err := app.Database.UpdateDiscussionMetadata(discID, metadata)
+
+	return newErrorf(err, "UpdateDiscussionMetadata")
+}
+
+// RemoveDiscussion deletes the discussion matching the passed id.
+// Unless purge is requested, the discussion is soft deleted,
+// hidden from discussion listings until restored.
+// If purge is requested, the discussion and its messages are removed
+// from database, while their invoices and payments are retained.
+func (app *App) RemoveDiscussion(_ context.Context, id uint64, purge bool) error {
+	if !purge {
+		err := app.Database.SoftDeleteDiscussion(id)
+		return newErrorf(err, "SoftDeleteDiscussion")
+	}
+
 	_, err := app.Database.RemoveDiscussion(id)
 
 	return newErrorf(err, "RemoveDiscussion")
 }
 
+// RestoreDiscussion restores a soft deleted discussion.
+func (app *App) RestoreDiscussion(_ context.Context, id uint64) error {
+	err := app.Database.RestoreDiscussion(id)
+
+	return newErrorf(err, "RestoreDiscussion")
+}
+
 func (app *App) retrieveDiscussion(_ context.Context, discussionID uint64) (*model.Discussion, error) {
 	discussion, err := app.Database.GetDiscussion(discussionID)
 
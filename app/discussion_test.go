@@ -7,6 +7,7 @@ import (
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
 
 	"github.com/c13n-io/c13n-go/lnchat"
 	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
@@ -94,9 +95,14 @@ func TestAddDiscussion(t *testing.T) {
 
 				// Mock self info
 				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 				mockDB.On("GetLastInvoiceIndex").Return(
 					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates",
 					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -146,6 +152,7 @@ func TestRemoveDiscussion(t *testing.T) {
 	cases := []struct {
 		name                 string
 		idToRemove           uint64
+		purge                bool
 		removeDiscussionResp *model.Discussion
 		removeDiscussionErr  error
 		expectedErr          error
@@ -153,6 +160,7 @@ func TestRemoveDiscussion(t *testing.T) {
 		{
 			name:       "Success",
 			idToRemove: 1,
+			purge:      true,
 			removeDiscussionResp: &model.Discussion{
 				ID: 41,
 				Participants: []string{
@@ -169,6 +177,7 @@ func TestRemoveDiscussion(t *testing.T) {
 		{
 			name:                 "Not found",
 			idToRemove:           10,
+			purge:                true,
 			removeDiscussionResp: nil,
 			removeDiscussionErr:  store.ErrDiscussionNotFound,
 			expectedErr: Error{
@@ -177,6 +186,157 @@ func TestRemoveDiscussion(t *testing.T) {
 				Err:     store.ErrDiscussionNotFound,
 			},
 		},
+		{
+			name:        "Soft delete",
+			idToRemove:  1,
+			expectedErr: Error{},
+		},
+		{
+			name:                "Soft delete not found",
+			idToRemove:          10,
+			removeDiscussionErr: store.ErrDiscussionNotFound,
+			expectedErr: Error{
+				Kind:    DiscussionNotFound,
+				details: "SoftDeleteDiscussion",
+				Err:     store.ErrDiscussionNotFound,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
+				// Mock self info
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+				mockDB.On("GetLastInvoiceIndex").Return(
+					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates",
+					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				if c.purge {
+					mockDB.On("RemoveDiscussion", c.idToRemove).Return(
+						c.removeDiscussionResp, c.removeDiscussionErr).Once()
+				} else {
+					mockDB.On("SoftDeleteDiscussion", c.idToRemove).Return(
+						c.removeDiscussionErr).Once()
+				}
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+			defer cancel()
+
+			err := app.RemoveDiscussion(ctxt, c.idToRemove, c.purge)
+
+			switch c.expectedErr {
+			case Error{}:
+				assert.NoError(t, err)
+			default:
+				assert.Error(t, err)
+				assert.EqualError(t, err, c.expectedErr.Error())
+			}
+		})
+	}
+}
+
+func TestUpdateDiscussionOptions(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	single := &model.Discussion{
+		ID: 3,
+		Participants: []string{
+			"111111111111111111111111111111111111111111111111111111111111111111",
+		},
+	}
+	group := &model.Discussion{
+		ID: 5,
+		Participants: []string{
+			"111111111111111111111111111111111111111111111111111111111111111111",
+			"222222222222222222222222222222222222222222222222222222222222222222",
+		},
+	}
+
+	cases := []struct {
+		name            string
+		discussion      *model.Discussion
+		options         model.MessageOptions
+		expectedOptions *model.MessageOptions
+		expectedErr     error
+	}{
+		{
+			name:       "Success",
+			discussion: single,
+			options: model.MessageOptions{
+				FeeLimitMsat: 32000,
+				Anonymous:    true,
+			},
+			expectedOptions: &model.MessageOptions{
+				FeeLimitMsat: 32000,
+				Anonymous:    true,
+			},
+			expectedErr: Error{},
+		},
+		{
+			name:       "Default fee limit",
+			discussion: group,
+			options: model.MessageOptions{
+				Encrypted: true,
+			},
+			expectedOptions: &model.MessageOptions{
+				FeeLimitMsat: DefaultOptions.FeeLimitMsat,
+				Encrypted:    true,
+			},
+			expectedErr: Error{},
+		},
+		{
+			name:       "Anonymous group discussion",
+			discussion: group,
+			options: model.MessageOptions{
+				Anonymous: true,
+			},
+			expectedErr: Error{
+				Kind:    InvalidOptions,
+				details: "UpdateDiscussionOptions",
+				Err:     ErrDiscAnonymousMessage,
+			},
+		},
+		{
+			name:       "Anonymous encrypted",
+			discussion: single,
+			options: model.MessageOptions{
+				Anonymous: true,
+				Encrypted: true,
+			},
+			expectedErr: Error{
+				Kind:    InvalidOptions,
+				details: "UpdateDiscussionOptions",
+				Err:     ErrDiscAnonymousEncrypted,
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -184,9 +344,14 @@ func TestRemoveDiscussion(t *testing.T) {
 			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
 				// Mock self info
 				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 				mockDB.On("GetLastInvoiceIndex").Return(
 					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates",
 					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -194,8 +359,12 @@ func TestRemoveDiscussion(t *testing.T) {
 				mockDB.On("Close").Return(nil).Once()
 				mockLNManager.On("Close").Return(nil).Once()
 
-				mockDB.On("RemoveDiscussion", c.idToRemove).Return(
-					c.removeDiscussionResp, c.removeDiscussionErr).Once()
+				mockDB.On("GetDiscussion", c.discussion.ID).Return(
+					c.discussion, nil).Once()
+				if c.expectedOptions != nil {
+					mockDB.On("UpdateDiscussionOptions", c.discussion.ID,
+						*c.expectedOptions).Return(nil).Once()
+				}
 
 				mockStopFunc := func() {}
 
@@ -211,7 +380,7 @@ func TestRemoveDiscussion(t *testing.T) {
 			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 			defer cancel()
 
-			err := app.RemoveDiscussion(ctxt, c.idToRemove)
+			err := app.UpdateDiscussionOptions(ctxt, c.discussion.ID, c.options)
 
 			switch c.expectedErr {
 			case Error{}:
@@ -219,6 +388,7 @@ func TestRemoveDiscussion(t *testing.T) {
 			default:
 				assert.Error(t, err)
 				assert.EqualError(t, err, c.expectedErr.Error())
+				assert.ErrorIs(t, err, c.expectedErr.(Error).Err)
 			}
 		})
 	}
@@ -242,7 +412,7 @@ func TestGetDiscussionStatistics(t *testing.T) {
 				ID:                39,
 				DiscussionID:      discussionID,
 				RawPayload:        []byte("third message payload"),
-				Sender:            selfAddress,
+				Sender:            mustNodeFromString(t, selfAddress),
 				Signature:         []byte(selfAddress),
 				SignatureVerified: true,
 				PaymentIndexes:    []uint64{0},
@@ -271,7 +441,7 @@ func TestGetDiscussionStatistics(t *testing.T) {
 				ID:                 31,
 				DiscussionID:       discussionID,
 				RawPayload:         []byte("second message payload"),
-				Sender:             correspondentAddress,
+				Sender:             mustNodeFromString(t, correspondentAddress),
 				Signature:          []byte(correspondentAddress),
 				SignatureVerified:  true,
 				InvoiceSettleIndex: 1,
@@ -287,7 +457,7 @@ func TestGetDiscussionStatistics(t *testing.T) {
 				ID:                23,
 				DiscussionID:      discussionID,
 				RawPayload:        []byte("first message payload"),
-				Sender:            selfAddress,
+				Sender:            mustNodeFromString(t, selfAddress),
 				Signature:         []byte(selfAddress),
 				SignatureVerified: true,
 				PaymentIndexes:    []uint64{0},
@@ -358,12 +528,17 @@ func TestGetDiscussionStatistics(t *testing.T) {
 
 				// Mock self info
 				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates",
 					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
 
 				mockDB.On("GetLastInvoiceIndex").Return(
 					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 				mockDB.On("GetMessages",
 					discussionID, model.PageOptions{}).Return(
@@ -401,3 +576,123 @@ func TestGetDiscussionStatistics(t *testing.T) {
 	}
 
 }
+
+func TestGetDiscussions(t *testing.T) {
+	selfAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	contactAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+	otherAddress := "222222222222222222222222222222222222222222222222222222222222222222"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: selfAddress,
+		},
+	}
+
+	discussions := []model.Discussion{
+		{
+			ID:              2,
+			Participants:    []string{contactAddress, otherAddress},
+			LastReadID:      3,
+			LastMessageID:   7,
+			LatestMessageID: 7,
+			UnreadCount:     3,
+		},
+		{
+			ID:           5,
+			Participants: []string{otherAddress},
+		},
+	}
+	contacts := []model.Contact{
+		{
+			ID:          1,
+			DisplayName: "contact",
+			Node:        model.Node{Address: contactAddress},
+		},
+	}
+
+	payload, err := model.NewPayload(discussions[0].Participants,
+		"the last message").Encode()
+	require.NoError(t, err)
+	lastMessage := store.MessageAggregate{
+		RawMessage: &model.RawMessage{
+			ID:             7,
+			DiscussionID:   discussions[0].ID,
+			RawPayload:     payload,
+			Sender:         mustNodeFromString(t, selfAddress),
+			PaymentIndexes: []uint64{4},
+		},
+		Payments: []*model.Payment{
+			{
+				PayerAddress: selfAddress,
+				PayeeAddress: contactAddress,
+				Payment: lnchat.Payment{
+					Hash:         "0000000000000000000000000000000000000000000000000000000000000000",
+					Preimage:     "1111111111111111111111111111111111111111111111111111111111111111",
+					Status:       lnchat.PaymentSUCCEEDED,
+					PaymentIndex: 4,
+				},
+			},
+		},
+	}
+
+	pageOpts := model.PageOptions{PageSize: 2}
+	filter := model.DiscussionFilter{Archived: model.FlagUnset}
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager,
+		mockDB *dbmock.Database) (*lnmock.LightManager,
+		*dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		mockDB.On("GetDiscussions", pageOpts,
+			model.DiscussionOrderLastActivity, filter).Return(discussions, nil).Once()
+		mockDB.On("GetContacts").Return(contacts, nil).Once()
+		mockDB.On("GetMessage", discussions[0].LatestMessageID).Return(
+			&lastMessage, nil).Once()
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	summaries, err := app.GetDiscussions(ctxt, pageOpts,
+		model.DiscussionOrderLastActivity, filter)
+	require.NoError(t, err)
+	require.Len(t, summaries, 2)
+
+	assert.Equal(t, discussions[0], summaries[0].Discussion)
+	assert.EqualValues(t, 3, summaries[0].UnreadCount)
+	assert.Equal(t, []string{"contact", ""}, summaries[0].ParticipantNames)
+	require.NotNil(t, summaries[0].LastMessage)
+	assert.Equal(t, lastMessage.RawMessage.ID, summaries[0].LastMessage.ID)
+	assert.Equal(t, "the last message", summaries[0].LastMessage.Payload)
+
+	assert.Equal(t, discussions[1], summaries[1].Discussion)
+	assert.Zero(t, summaries[1].UnreadCount)
+	assert.Equal(t, []string{""}, summaries[1].ParticipantNames)
+	assert.Nil(t, summaries[1].LastMessage)
+}
@@ -0,0 +1,115 @@
+package app
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"fmt"
+
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// ErrDiscAnonymousEncrypted indicates that an anonymous send
+// was requested with payload encryption.
+// The sender address is required by the recipient for decryption.
+var ErrDiscAnonymousEncrypted = fmt.Errorf("anonymous encrypted message is disallowed")
+
+// payloadKeyLabel is used for domain separation of the payload
+// encryption key from the ECDH shared key.
+const payloadKeyLabel = "c13n payload encryption"
+
+// payloadKey derives the symmetric key used for encrypting
+// payloads exchanged with the node with the provided address.
+func (app *App) payloadKey(ctx context.Context, address string) ([]byte, error) {
+	sharedKey, err := app.LNManager.DeriveSharedKey(ctx, address)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not derive shared key")
+	}
+
+	key := sha256.Sum256(append([]byte(payloadKeyLabel), sharedKey...))
+
+	return key[:], nil
+}
+
+// sealPayload encrypts and authenticates a payload with AES-256-GCM.
+// The returned ciphertext is prefixed with the random nonce used.
+func sealPayload(key, payload []byte) ([]byte, error) {
+	aead, err := newPayloadAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonce := make([]byte, aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, errors.Wrap(err, "could not generate nonce")
+	}
+
+	return aead.Seal(nonce, nonce, payload, nil), nil
+}
+
+// openPayload decrypts and authenticates a payload sealed by sealPayload.
+func openPayload(key, sealed []byte) ([]byte, error) {
+	aead, err := newPayloadAEAD(key)
+	if err != nil {
+		return nil, err
+	}
+
+	nonceSize := aead.NonceSize()
+	if len(sealed) < nonceSize+aead.Overhead() {
+		return nil, fmt.Errorf("encrypted payload too short")
+	}
+
+	return aead.Open(nil, sealed[:nonceSize], sealed[nonceSize:], nil)
+}
+
+func newPayloadAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// decryptPayload decrypts a payload exchanged with the node
+// with the provided address.
+func (app *App) decryptPayload(ctx context.Context, sealed []byte, address string) ([]byte, error) {
+	key, err := app.payloadKey(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+
+	return openPayload(key, sealed)
+}
+
+// recipientPayloads returns the wire payload of a raw message
+// for each of the recipients. If encryption is requested,
+// the message payload is encrypted separately for each recipient.
+func (app *App) recipientPayloads(ctx context.Context, rawMsg *model.RawMessage,
+	recipients []string, encrypt bool) (map[string]map[uint64][]byte, error) {
+
+	payloads := make(map[string]map[uint64][]byte, len(recipients))
+	for _, recipient := range recipients {
+		payload := marshalPayload(rawMsg)
+		if encrypt && rawMsg.RawPayload != nil {
+			key, err := app.payloadKey(ctx, recipient)
+			if err != nil {
+				return nil, err
+			}
+			sealed, err := sealPayload(key, rawMsg.RawPayload)
+			if err != nil {
+				return nil, errors.Wrap(err, "could not encrypt payload")
+			}
+
+			delete(payload, PayloadTypeKey)
+			payload[EncryptedPayloadTypeKey] = sealed
+		}
+		payloads[recipient] = payload
+	}
+
+	return payloads, nil
+}
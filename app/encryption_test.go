@@ -0,0 +1,161 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestSealOpenPayload(t *testing.T) {
+	key := bytes.Repeat([]byte{0x01}, 32)
+	otherKey := bytes.Repeat([]byte{0x02}, 32)
+	payload := []byte("a secret message")
+
+	sealed, err := sealPayload(key, payload)
+	require.NoError(t, err)
+	assert.NotContains(t, string(sealed), string(payload))
+
+	cases := []struct {
+		name        string
+		key         []byte
+		sealed      func() []byte
+		expectedErr bool
+	}{
+		{
+			name:   "Success",
+			key:    key,
+			sealed: func() []byte { return sealed },
+		},
+		{
+			name:        "Wrong key",
+			key:         otherKey,
+			sealed:      func() []byte { return sealed },
+			expectedErr: true,
+		},
+		{
+			name: "Tampered ciphertext",
+			key:  key,
+			sealed: func() []byte {
+				tampered := append([]byte(nil), sealed...)
+				tampered[len(tampered)-1] ^= 0xff
+				return tampered
+			},
+			expectedErr: true,
+		},
+		{
+			name:        "Truncated ciphertext",
+			key:         key,
+			sealed:      func() []byte { return sealed[:8] },
+			expectedErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			opened, err := openPayload(c.key, c.sealed())
+			if c.expectedErr {
+				assert.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, payload, opened)
+		})
+	}
+}
+
+func TestRecipientPayloads(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+	otherAddress := "222222222222222222222222222222222222222222222222222222222222222222"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: srcAddress,
+		},
+	}
+
+	sharedKeys := map[string][]byte{
+		destAddress:  bytes.Repeat([]byte{0x01}, 32),
+		otherAddress: bytes.Repeat([]byte{0x02}, 32),
+	}
+
+	rawMsg := &model.RawMessage{
+		RawPayload: []byte("payload"),
+		Sender:     mustNodeFromString(t, srcAddress),
+		Signature:  []byte("signature"),
+	}
+	recipients := []string{destAddress, otherAddress}
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		for address, key := range sharedKeys {
+			mockLNManager.On("DeriveSharedKey", mock.Anything, address).Return(key, nil)
+		}
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	t.Run("Plaintext", func(t *testing.T) {
+		payloads, err := app.recipientPayloads(ctxt, rawMsg, recipients, false)
+		require.NoError(t, err)
+
+		for _, recipient := range recipients {
+			assert.Equal(t, marshalPayload(rawMsg), payloads[recipient])
+		}
+	})
+
+	t.Run("Encrypted", func(t *testing.T) {
+		payloads, err := app.recipientPayloads(ctxt, rawMsg, recipients, true)
+		require.NoError(t, err)
+
+		for _, recipient := range recipients {
+			payload := payloads[recipient]
+			require.NotNil(t, payload)
+			assert.NotContains(t, payload, PayloadTypeKey)
+			assert.Equal(t, rawMsg.Signature, payload[SignatureTypeKey])
+
+			opened, err := app.decryptPayload(ctxt,
+				payload[EncryptedPayloadTypeKey], recipient)
+			require.NoError(t, err)
+			assert.Equal(t, rawMsg.RawPayload, opened)
+		}
+	})
+}
@@ -24,6 +24,9 @@ const (
 	ContactNotFound
 	DiscussionAlreadyExists
 	DiscussionNotFound
+	MessageNotFound
+	InvalidOptions
+	FailedPrecondition
 	UnknownError
 	InternalError
 )
@@ -56,6 +59,18 @@ func kindFromErr(err error) ErrKind {
 		return DiscussionNotFound
 	case errors.Is(err, store.ErrDiscussionAlreadyExists):
 		return DiscussionAlreadyExists
+	case errors.Is(err, store.ErrMessageNotFound):
+		return MessageNotFound
+	case errors.Is(err, ErrDiscAnonymousMessage),
+		errors.Is(err, ErrDiscAnonymousEncrypted),
+		errors.Is(err, ErrInvalidRetention),
+		errors.Is(err, ErrAnonymousAnnotation),
+		errors.Is(err, ErrAnonymousPresence),
+		errors.Is(err, ErrPayloadTooLarge):
+		return InvalidOptions
+	case errors.Is(err, ErrPresenceDisabled),
+		errors.Is(err, store.ErrDiscussionRestored):
+		return FailedPrecondition
 	default:
 		return InternalError
 	}
@@ -0,0 +1,264 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/archive"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+)
+
+// errOrphanCarrier indicates an archived invoice or payment
+// that is not followed by the message it carries.
+var errOrphanCarrier = fmt.Errorf("%w: invoice or payment not followed by message",
+	archive.ErrMalformed)
+
+// ImportSummary contains the results of an archive import.
+type ImportSummary struct {
+	// The number of contacts imported.
+	Contacts uint64
+	// The number of discussions created.
+	Discussions uint64
+	// The number of messages imported.
+	Messages uint64
+	// The number of messages skipped as already present.
+	Duplicates uint64
+}
+
+// ExportDiscussions writes the requested discussions to an archive,
+// along with the contacts of their participants and the invoices
+// and payments carrying their messages, and completes the archive.
+// If no discussion is requested, all contacts and discussions
+// are exported, including soft deleted discussions.
+func (app *App) ExportDiscussions(ctx context.Context,
+	w *archive.Writer, discIDs ...uint64) error {
+
+	var discussions []model.Discussion
+	if len(discIDs) == 0 {
+		for _, deleted := range []bool{false, true} {
+			discs, err := app.Database.GetDiscussions(model.PageOptions{},
+				model.DiscussionOrderID, model.DiscussionFilter{Deleted: deleted})
+			if err != nil {
+				return newErrorf(err, "ExportDiscussions: GetDiscussions")
+			}
+			discussions = append(discussions, discs...)
+		}
+		sort.Slice(discussions, func(i, j int) bool {
+			return discussions[i].ID < discussions[j].ID
+		})
+	}
+	for _, id := range discIDs {
+		disc, err := app.retrieveDiscussion(ctx, id)
+		if err != nil {
+			return err
+		}
+		discussions = append(discussions, *disc)
+	}
+
+	contacts, err := app.Database.GetContacts()
+	if err != nil {
+		return newErrorf(err, "ExportDiscussions: GetContacts")
+	}
+	participants := make(map[string]bool)
+	for _, disc := range discussions {
+		for _, participant := range disc.Participants {
+			participants[participant] = true
+		}
+	}
+	for i := range contacts {
+		if len(discIDs) != 0 && !participants[contacts[i].Address] {
+			continue
+		}
+		if err := w.WriteContact(&contacts[i]); err != nil {
+			return newErrorf(err, "ExportDiscussions")
+		}
+	}
+
+	for i := range discussions {
+		if err := app.exportDiscussion(w, &discussions[i]); err != nil {
+			return err
+		}
+	}
+
+	return newErrorf(w.Close(), "ExportDiscussions")
+}
+
+// exportDiscussion writes a discussion to an archive,
+// followed by its messages in storage order, each preceded
+// by the invoices or payments carrying it.
+func (app *App) exportDiscussion(w *archive.Writer, disc *model.Discussion) error {
+	aggregates, err := app.Database.GetMessages(disc.ID, model.PageOptions{})
+	if err != nil {
+		return newErrorf(err, "ExportDiscussions: GetMessages")
+	}
+
+	var msgs []store.MessageAggregate
+	var flatten func([]store.MessageAggregate)
+	flatten = func(aggregates []store.MessageAggregate) {
+		for _, aggregate := range aggregates {
+			msgs = append(msgs, aggregate)
+			flatten(aggregate.Annotations)
+		}
+	}
+	flatten(aggregates)
+	sort.Slice(msgs, func(i, j int) bool {
+		return msgs[i].RawMessage.ID < msgs[j].RawMessage.ID
+	})
+
+	if err := w.WriteDiscussion(disc); err != nil {
+		return newErrorf(err, "ExportDiscussions")
+	}
+	for _, msg := range msgs {
+		if msg.Invoice != nil {
+			invoices := append([]*model.Invoice{msg.Invoice}, msg.Fragments...)
+			for _, inv := range invoices {
+				if err := w.WriteInvoice(inv); err != nil {
+					return newErrorf(err, "ExportDiscussions")
+				}
+			}
+		}
+		for _, payment := range msg.Payments {
+			if err := w.WritePayment(payment); err != nil {
+				return newErrorf(err, "ExportDiscussions")
+			}
+		}
+		if err := w.WriteMessage(msg.RawMessage); err != nil {
+			return newErrorf(err, "ExportDiscussions")
+		}
+	}
+
+	return nil
+}
+
+// ImportArchive imports the contacts, discussions and messages of an archive.
+// Contacts and discussions already present are retained, while messages
+// whose invoices or payments (by payment hash) are already present
+// are skipped, so an import can be safely repeated.
+// Soft deleted discussions are imported as such, unless already present.
+func (app *App) ImportArchive(ctx context.Context,
+	r *archive.Reader) (*ImportSummary, error) {
+
+	summary := new(ImportSummary)
+
+	// The local ids of the archive discussions.
+	discussions := make(map[uint64]uint64)
+	var deleted []uint64
+
+	var invoices []*model.Invoice
+	var payments []*model.Payment
+	for {
+		if err := ctx.Err(); err != nil {
+			return summary, newErrorf(err, "ImportArchive")
+		}
+
+		record, err := r.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return summary, newErrorf(err, "ImportArchive")
+		}
+
+		switch record.Type {
+		case archive.RecordContact, archive.RecordDiscussion:
+			if len(invoices) != 0 || len(payments) != 0 {
+				return summary, newErrorf(errOrphanCarrier, "ImportArchive")
+			}
+		}
+
+		switch record.Type {
+		case archive.RecordContact:
+			contact := *record.Contact
+			contact.ID = 0
+			_, err := app.Database.AddContact(&contact)
+			switch {
+			case errors.Is(err, store.ErrContactAlreadyExists):
+			case err != nil:
+				return summary, newErrorf(err, "ImportArchive: AddContact")
+			default:
+				summary.Contacts++
+			}
+		case archive.RecordDiscussion:
+			disc, created, err := app.importDiscussion(record.Discussion)
+			if err != nil {
+				return summary, err
+			}
+			discussions[record.Discussion.ID] = disc.ID
+			if created {
+				summary.Discussions++
+				if record.Discussion.Deleted {
+					deleted = append(deleted, disc.ID)
+				}
+			}
+		case archive.RecordInvoice:
+			invoices = append(invoices, record.Invoice)
+		case archive.RecordPayment:
+			payments = append(payments, record.Payment)
+		case archive.RecordMessage:
+			rawMsg := record.Message
+			discID, ok := discussions[rawMsg.DiscussionID]
+			if !ok {
+				return summary, newErrorf(fmt.Errorf("%w: message of unknown "+
+					"discussion", archive.ErrMalformed), "ImportArchive")
+			}
+			rawMsg.DiscussionID = discID
+
+			imported, err := app.Database.ImportMessage(rawMsg, invoices, payments)
+			if err != nil {
+				return summary, newErrorf(err, "ImportArchive: ImportMessage")
+			}
+			if imported {
+				summary.Messages++
+			} else {
+				summary.Duplicates++
+			}
+			invoices, payments = nil, nil
+		}
+	}
+
+	if len(invoices) != 0 || len(payments) != 0 {
+		return summary, newErrorf(errOrphanCarrier, "ImportArchive")
+	}
+
+	// Storing a message restores its discussion,
+	// so deleted discussions are deleted after their messages.
+	for _, id := range deleted {
+		if err := app.Database.SoftDeleteDiscussion(id); err != nil {
+			return summary, newErrorf(err, "ImportArchive: SoftDeleteDiscussion")
+		}
+	}
+
+	return summary, nil
+}
+
+// importDiscussion retrieves the discussion with the participants
+// of an archived discussion, or creates it with the archived
+// options, metadata and retention policy if it doesn't exist.
+func (app *App) importDiscussion(archived *model.Discussion) (*model.Discussion, bool, error) {
+	participants := append([]string(nil), archived.Participants...)
+
+	discussion, err := app.Database.GetDiscussionByParticipants(participants)
+	switch {
+	case err == nil:
+		return discussion, false, nil
+	case !errors.Is(err, store.ErrDiscussionNotFound):
+		return nil, false, newErrorf(err, "ImportArchive: GetDiscussionByParticipants")
+	}
+
+	discussion, err = app.Database.AddDiscussion(&model.Discussion{
+		Participants: participants,
+		Options:      archived.Options,
+		Metadata:     archived.Metadata,
+		Retention:    archived.Retention,
+	})
+	if err != nil {
+		return nil, false, newErrorf(err, "ImportArchive: AddDiscussion")
+	}
+
+	return discussion, true, nil
+}
@@ -0,0 +1,97 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/archive"
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestExportImportDiscussion(t *testing.T) {
+	participant := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	disc := &model.Discussion{
+		ID:            3,
+		Participants:  []string{participant},
+		LastMessageID: 5,
+		Metadata:      model.DiscussionMetadata{Title: "title"},
+		Deleted:       true,
+	}
+	contacts := []model.Contact{
+		{ID: 1, DisplayName: "alice", Node: model.Node{Address: participant}},
+		{ID: 2, DisplayName: "bob", Node: model.Node{Address: "another"}},
+	}
+	inv := &model.Invoice{Invoice: lnchat.Invoice{Hash: "hash", SettleIndex: 4}}
+	payment := &model.Payment{Payment: lnchat.Payment{Hash: "hash", PaymentIndex: 6}}
+	incoming := &model.RawMessage{ID: 5, DiscussionID: 3, InvoiceSettleIndex: 4}
+	reaction := &model.RawMessage{ID: 8, DiscussionID: 3, PaymentIndexes: []uint64{6},
+		TargetID: 5, TargetLinked: true}
+
+	// Export the discussion
+	exportDB := new(dbmock.Database)
+	exportDB.On("GetDiscussion", disc.ID).Return(disc, nil).Once()
+	exportDB.On("GetContacts").Return(contacts, nil).Once()
+	exportDB.On("GetMessages", disc.ID, model.PageOptions{}).Return([]store.MessageAggregate{
+		{
+			RawMessage: incoming,
+			Invoice:    inv,
+			Annotations: []store.MessageAggregate{
+				{RawMessage: reaction, Payments: []*model.Payment{payment}},
+			},
+		},
+	}, nil).Once()
+
+	exporter, err := New(new(lnmock.LightManager), exportDB)
+	require.NoError(t, err)
+
+	buf := new(bytes.Buffer)
+	w, err := archive.NewWriter(buf, nil)
+	require.NoError(t, err)
+	require.NoError(t, exporter.ExportDiscussions(context.Background(), w, disc.ID))
+	exportDB.AssertExpectations(t)
+
+	// Import the archive
+	importDB := new(dbmock.Database)
+	importDB.On("AddContact", &model.Contact{
+		DisplayName: "alice", Node: model.Node{Address: participant},
+	}).Return(&contacts[0], nil).Once()
+	importDB.On("GetDiscussionByParticipants", []string{participant}).
+		Return(nil, store.ErrDiscussionNotFound).Once()
+	importDB.On("AddDiscussion", &model.Discussion{
+		Participants: []string{participant},
+		Metadata:     disc.Metadata,
+	}).Return(&model.Discussion{ID: 7, Participants: []string{participant}}, nil).Once()
+	importDB.On("ImportMessage", mock.MatchedBy(func(raw *model.RawMessage) bool {
+		return raw.DiscussionID == 7 && raw.InvoiceSettleIndex == 4
+	}), []*model.Invoice{inv}, []*model.Payment(nil)).Return(true, nil).Once()
+	importDB.On("ImportMessage", mock.MatchedBy(func(raw *model.RawMessage) bool {
+		return raw.DiscussionID == 7 && len(raw.PaymentIndexes) == 1
+	}), []*model.Invoice(nil), []*model.Payment{payment}).Return(false, nil).Once()
+	importDB.On("SoftDeleteDiscussion", uint64(7)).Return(nil).Once()
+
+	importer, err := New(new(lnmock.LightManager), importDB)
+	require.NoError(t, err)
+
+	r, err := archive.NewReader(buf, nil)
+	require.NoError(t, err)
+	summary, err := importer.ImportArchive(context.Background(), r)
+	require.NoError(t, err)
+	importDB.AssertExpectations(t)
+
+	assert.Equal(t, &ImportSummary{
+		Contacts:    1,
+		Discussions: 1,
+		Messages:    1,
+		Duplicates:  1,
+	}, summary)
+}
@@ -0,0 +1,365 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// ErrPayloadTooLarge indicates that a message payload
+// does not fit in the maximum number of message parts.
+var ErrPayloadTooLarge = fmt.Errorf("message payload too large")
+
+const (
+	// maxHTLCPayloadSize is the maximum encoded size of the message
+	// records carried by a single HTLC (in bytes). The remaining onion
+	// space accommodates the keysend preimage and routes of several hops.
+	maxHTLCPayloadSize = 800
+
+	// maxFragments is the maximum number of parts of a message.
+	maxFragments = 64
+
+	// fragmentAmtMsat is the amount sent with each part
+	// of a multi-part message, other than the first one.
+	fragmentAmtMsat = 1000
+
+	// fragmentTimeout is the time after which the received parts
+	// of an incomplete multi-part message are discarded.
+	fragmentTimeout = time.Hour
+
+	// fragmentHeaderSize is the encoded size of a fragment header.
+	fragmentHeaderSize = 12
+
+	// maxLengthVarIntSize is the maximum size of the length
+	// of a record whose value is smaller than 64KiB.
+	maxLengthVarIntSize = 3
+)
+
+// fragmentHeader identifies a part of a multi-part message.
+type fragmentHeader struct {
+	// The identifier shared by all parts of a message.
+	ID [8]byte
+	// The index of the part.
+	Index uint16
+	// The total number of parts.
+	Total uint16
+}
+
+func (h *fragmentHeader) encode() []byte {
+	b := make([]byte, fragmentHeaderSize)
+	copy(b, h.ID[:])
+	binary.BigEndian.PutUint16(b[8:], h.Index)
+	binary.BigEndian.PutUint16(b[10:], h.Total)
+
+	return b
+}
+
+func decodeFragmentHeader(b []byte) (*fragmentHeader, error) {
+	if len(b) != fragmentHeaderSize {
+		return nil, fmt.Errorf("invalid fragment header length %d", len(b))
+	}
+
+	h := new(fragmentHeader)
+	copy(h.ID[:], b)
+	h.Index = binary.BigEndian.Uint16(b[8:])
+	h.Total = binary.BigEndian.Uint16(b[10:])
+
+	switch {
+	case h.Total == 0 || h.Total > maxFragments:
+		return nil, fmt.Errorf("invalid fragment count %d", h.Total)
+	case h.Index >= h.Total:
+		return nil, fmt.Errorf("fragment index %d out of range", h.Index)
+	}
+
+	return h, nil
+}
+
+// recordSize returns the encoded size of a TLV record.
+func recordSize(typ uint64, length int) int {
+	return int(tlv.VarIntSize(typ)+tlv.VarIntSize(uint64(length))) + length
+}
+
+// payloadRecordsSize returns the encoded size of the records of a wire payload.
+func payloadRecordsSize(payload map[uint64][]byte) int {
+	var size int
+	for typ, value := range payload {
+		size += recordSize(typ, len(value))
+	}
+
+	return size
+}
+
+// payloadBodyKey returns the key of the message body in a wire payload.
+func payloadBodyKey(payload map[uint64][]byte) uint64 {
+	if _, ok := payload[EncryptedPayloadTypeKey]; ok {
+		return EncryptedPayloadTypeKey
+	}
+
+	return PayloadTypeKey
+}
+
+// splitPayload splits a wire payload into the ordered parts
+// sent as separate HTLCs, each carrying a fragment of the message body.
+// Payloads fitting in a single HTLC are returned as is.
+// The records other than the body are carried by the first part.
+func splitPayload(payload map[uint64][]byte) ([]map[uint64][]byte, error) {
+	if payloadRecordsSize(payload) <= maxHTLCPayloadSize {
+		return []map[uint64][]byte{payload}, nil
+	}
+
+	bodyKey := payloadBodyKey(payload)
+	body := payload[bodyKey]
+
+	others := make(map[uint64][]byte)
+	for k, v := range payload {
+		if k != bodyKey {
+			others[k] = v
+		}
+	}
+
+	overhead := recordSize(FragmentTypeKey, fragmentHeaderSize) +
+		int(tlv.VarIntSize(bodyKey)) + maxLengthVarIntSize
+	firstCap := maxHTLCPayloadSize - payloadRecordsSize(others) - overhead
+	restCap := maxHTLCPayloadSize - overhead
+	if firstCap <= 0 {
+		return nil, ErrPayloadTooLarge
+	}
+
+	var chunks [][]byte
+	for capacity := firstCap; len(body) > 0; capacity = restCap {
+		if len(body) < capacity {
+			capacity = len(body)
+		}
+		chunks = append(chunks, body[:capacity])
+		body = body[capacity:]
+	}
+	if len(chunks) > maxFragments {
+		return nil, fmt.Errorf("%w: %d parts required, at most %d allowed",
+			ErrPayloadTooLarge, len(chunks), maxFragments)
+	}
+
+	header := &fragmentHeader{Total: uint16(len(chunks))}
+	if _, err := rand.Read(header.ID[:]); err != nil {
+		return nil, errors.Wrap(err, "could not generate fragment identifier")
+	}
+
+	parts := make([]map[uint64][]byte, len(chunks))
+	for i, chunk := range chunks {
+		part := make(map[uint64][]byte)
+		if i == 0 {
+			for k, v := range others {
+				part[k] = v
+			}
+		}
+		header.Index = uint16(i)
+		part[FragmentTypeKey] = header.encode()
+		part[bodyKey] = chunk
+
+		parts[i] = part
+	}
+
+	return parts, nil
+}
+
+// partAmounts splits the amount of a message between its parts.
+// Every part but the first carries fragmentAmtMsat.
+func partAmounts(amtMsat int64, parts int) ([]int64, error) {
+	if parts > 1 && amtMsat < int64(parts)*fragmentAmtMsat {
+		return nil, fmt.Errorf("amount of %d msat is insufficient "+
+			"for a message of %d parts (at least %d msat required)",
+			amtMsat, parts, int64(parts)*fragmentAmtMsat)
+	}
+
+	amts := make([]int64, parts)
+	amts[0] = amtMsat - int64(parts-1)*fragmentAmtMsat
+	for i := 1; i < parts; i++ {
+		amts[i] = fragmentAmtMsat
+	}
+
+	return amts, nil
+}
+
+// mergeFragments reassembles the wire payload of a multi-part message
+// from its parts, which must be ordered by index.
+func mergeFragments(parts []map[uint64][]byte) (map[uint64][]byte, error) {
+	if len(parts) == 0 {
+		return nil, fmt.Errorf("no message parts provided")
+	}
+
+	bodyKey := payloadBodyKey(parts[0])
+
+	var body bytes.Buffer
+	merged := make(map[uint64][]byte)
+	for i, part := range parts {
+		if payloadBodyKey(part) != bodyKey {
+			return nil, fmt.Errorf("mismatched body of message part %d", i)
+		}
+		for k, v := range part {
+			switch k {
+			case FragmentTypeKey:
+			case bodyKey:
+				body.Write(v)
+			default:
+				merged[k] = v
+			}
+		}
+	}
+	merged[bodyKey] = body.Bytes()
+
+	return merged, nil
+}
+
+// partialMessage contains the received parts of a multi-part message.
+type partialMessage struct {
+	parts    []map[uint64][]byte
+	invoices []*model.Invoice
+	received int
+	created  time.Time
+}
+
+// fragmentBuffer collects the parts of incoming multi-part messages
+// until all of them have been received.
+// The invoices carrying received parts are stored as pending,
+// and the buffer is restored from them on startup.
+type fragmentBuffer struct {
+	mtx     sync.Mutex
+	pending map[[8]byte]*partialMessage
+}
+
+// add records a message part received at the provided time,
+// along with the invoice carrying it.
+// Once all parts of the message have been received, the reassembled
+// wire payload is returned, along with the invoices of all parts.
+func (b *fragmentBuffer) add(header *fragmentHeader, part map[uint64][]byte,
+	inv *model.Invoice, received time.Time) (map[uint64][]byte, []*model.Invoice, error) {
+
+	b.mtx.Lock()
+	defer b.mtx.Unlock()
+
+	if b.pending == nil {
+		b.pending = make(map[[8]byte]*partialMessage)
+	}
+
+	// Discard stale incomplete messages.
+	now := time.Now()
+	for id, msg := range b.pending {
+		if now.Sub(msg.created) > fragmentTimeout {
+			delete(b.pending, id)
+		}
+	}
+
+	msg, ok := b.pending[header.ID]
+	if !ok {
+		msg = &partialMessage{
+			parts:    make([]map[uint64][]byte, header.Total),
+			invoices: make([]*model.Invoice, header.Total),
+			created:  received,
+		}
+		b.pending[header.ID] = msg
+	}
+
+	switch {
+	case len(msg.parts) != int(header.Total):
+		return nil, nil, fmt.Errorf("mismatched fragment count %d, "+
+			"expected %d", header.Total, len(msg.parts))
+	case msg.parts[header.Index] != nil:
+		return nil, nil, fmt.Errorf("duplicate fragment %d", header.Index)
+	}
+
+	msg.parts[header.Index] = part
+	msg.invoices[header.Index] = inv
+	msg.received++
+
+	if msg.received < len(msg.parts) {
+		return nil, nil, nil
+	}
+	delete(b.pending, header.ID)
+
+	merged, err := mergeFragments(msg.parts)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return merged, msg.invoices, nil
+}
+
+// loadFragments restores the received parts of incomplete multi-part
+// messages from their pending invoices.
+// Messages whose parts were all received, but which were not stored
+// (e.g. due to an interruption), are reassembled and stored.
+// Stale parts, as well as parts that cannot be restored,
+// are no longer considered pending.
+func (app *App) loadFragments(ctx context.Context) error {
+	invoices, err := app.Database.GetInvoiceFragments()
+	if err != nil {
+		return err
+	}
+
+	var discarded []uint64
+	for _, inv := range invoices {
+		received := time.Unix(inv.SettleTimeSec, 0)
+		if time.Since(received) > fragmentTimeout {
+			discarded = append(discarded, inv.SettleIndex)
+			continue
+		}
+
+		records := invoiceCustomRecords(&inv.Invoice)
+		header, err := decodeFragmentHeader(records[FragmentTypeKey])
+		if err != nil {
+			discarded = append(discarded, inv.SettleIndex)
+			continue
+		}
+
+		merged, complete, err := app.fragments.add(header, records, inv, received)
+		switch {
+		case err != nil:
+			discarded = append(discarded, inv.SettleIndex)
+		case merged != nil:
+			if err := app.storeReassembledMessage(ctx, merged, complete, inv); err != nil {
+				app.Log.WithError(err).Warn("could not store reassembled message")
+				for _, part := range complete {
+					discarded = append(discarded, part.SettleIndex)
+				}
+			}
+		}
+	}
+
+	if len(discarded) == 0 {
+		return nil
+	}
+
+	return app.Database.RemoveInvoiceFragments(discarded...)
+}
+
+// storeReassembledMessage stores the message carried by the reassembled
+// wire payload of a multi-part message, whose part invoices are stored
+// as pending, and which are no longer pending once it is stored.
+func (app *App) storeReassembledMessage(ctx context.Context, records map[uint64][]byte,
+	invoices []*model.Invoice, last *model.Invoice) error {
+
+	rawMsg, _, err := app.reassembledMessage(ctx, records, invoices, last)
+	if err != nil {
+		return fmt.Errorf("message extraction failed: %w", err)
+	}
+
+	disc, err := app.retrieveOrCreateRawMsgDiscussion(rawMsg)
+	if err != nil {
+		return fmt.Errorf("discussion retrieval failed: %w", err)
+	}
+
+	rawMsg.DiscussionID = disc.ID
+	if err := app.Database.AddRawMessage(rawMsg); err != nil {
+		return fmt.Errorf("message storage failed: %w", err)
+	}
+
+	return nil
+}
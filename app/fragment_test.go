@@ -0,0 +1,383 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestSplitPayload(t *testing.T) {
+	sender := bytes.Repeat([]byte{0x02}, 33)
+	signature := bytes.Repeat([]byte{0x03}, 71)
+
+	cases := []struct {
+		name          string
+		payload       map[uint64][]byte
+		expectedParts int
+		expectedErr   error
+	}{
+		{
+			name: "Small payload",
+			payload: map[uint64][]byte{
+				PayloadTypeKey:   []byte("hello"),
+				SenderTypeKey:    sender,
+				SignatureTypeKey: signature,
+			},
+			expectedParts: 1,
+		},
+		{
+			name: "Large payload",
+			payload: map[uint64][]byte{
+				PayloadTypeKey:   bytes.Repeat([]byte{0x01}, 2000),
+				SenderTypeKey:    sender,
+				SignatureTypeKey: signature,
+			},
+			expectedParts: 3,
+		},
+		{
+			name: "Large encrypted payload",
+			payload: map[uint64][]byte{
+				EncryptedPayloadTypeKey: bytes.Repeat([]byte{0x01}, 1000),
+				SenderTypeKey:           sender,
+				SignatureTypeKey:        signature,
+			},
+			expectedParts: 2,
+		},
+		{
+			name: "Payload too large",
+			payload: map[uint64][]byte{
+				PayloadTypeKey: bytes.Repeat([]byte{0x01},
+					maxFragments*maxHTLCPayloadSize),
+			},
+			expectedErr: ErrPayloadTooLarge,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			parts, err := splitPayload(c.payload)
+			if c.expectedErr != nil {
+				assert.ErrorIs(t, err, c.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, parts, c.expectedParts)
+
+			if c.expectedParts == 1 {
+				assert.Equal(t, c.payload, parts[0])
+				return
+			}
+
+			var id [8]byte
+			for i, part := range parts {
+				assert.LessOrEqual(t, payloadRecordsSize(part), maxHTLCPayloadSize)
+
+				header, err := decodeFragmentHeader(part[FragmentTypeKey])
+				require.NoError(t, err)
+				if i == 0 {
+					id = header.ID
+				}
+				assert.Equal(t, id, header.ID)
+				assert.Equal(t, uint16(i), header.Index)
+				assert.Equal(t, uint16(len(parts)), header.Total)
+			}
+
+			merged, err := mergeFragments(parts)
+			require.NoError(t, err)
+			assert.Equal(t, c.payload, merged)
+		})
+	}
+}
+
+func TestPartAmounts(t *testing.T) {
+	amts, err := partAmounts(1500, 1)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{1500}, amts)
+
+	amts, err = partAmounts(5000, 3)
+	require.NoError(t, err)
+	assert.Equal(t, []int64{3000, 1000, 1000}, amts)
+
+	_, err = partAmounts(2500, 3)
+	assert.Error(t, err)
+}
+
+func TestFragmentBuffer(t *testing.T) {
+	payload := map[uint64][]byte{
+		PayloadTypeKey: bytes.Repeat([]byte{0x01}, 2000),
+		SenderTypeKey:  bytes.Repeat([]byte{0x02}, 33),
+	}
+	parts, err := splitPayload(payload)
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+
+	invoices := make([]*model.Invoice, len(parts))
+	for i := range invoices {
+		invoices[i] = &model.Invoice{
+			Invoice: lnchat.Invoice{SettleIndex: uint64(i + 1)},
+		}
+	}
+
+	var buf fragmentBuffer
+	addPart := func(i int) (map[uint64][]byte, []*model.Invoice, error) {
+		header, err := decodeFragmentHeader(parts[i][FragmentTypeKey])
+		require.NoError(t, err)
+
+		return buf.add(header, parts[i], invoices[i], time.Now())
+	}
+
+	// Parts may be received in any order.
+	merged, invs, err := addPart(2)
+	require.NoError(t, err)
+	assert.Nil(t, merged)
+	assert.Nil(t, invs)
+
+	merged, _, err = addPart(0)
+	require.NoError(t, err)
+	assert.Nil(t, merged)
+
+	_, _, err = addPart(0)
+	assert.Error(t, err)
+
+	merged, invs, err = addPart(1)
+	require.NoError(t, err)
+	assert.Equal(t, payload, merged)
+	assert.Equal(t, invoices, invs)
+	assert.Empty(t, buf.pending)
+}
+
+func TestLoadFragments(t *testing.T) {
+	payload := map[uint64][]byte{
+		PayloadTypeKey: bytes.Repeat([]byte{0x01}, 2000),
+	}
+	parts, err := splitPayload(payload)
+	require.NoError(t, err)
+	require.Len(t, parts, 3)
+
+	createInvoice := func(idx uint64, settled time.Time,
+		records map[uint64][]byte) *model.Invoice {
+
+		return &model.Invoice{
+			Invoice: lnchat.Invoice{
+				SettleIndex:   idx,
+				SettleTimeSec: settled.Unix(),
+				Htlcs: []lnchat.InvoiceHTLC{
+					{
+						State:         lnrpc.InvoiceHTLCState_SETTLED,
+						CustomRecords: records,
+					},
+				},
+			},
+		}
+	}
+
+	selfAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+	srcAddr, err := lnchat.NewNodeFromString(
+		"020000000000000000000000000000000000000000000000000000000000000000")
+	require.NoError(t, err)
+
+	rawPayload, err := model.NewPayload([]string{selfAddress},
+		string(bytes.Repeat([]byte("a"), 1000))).Encode()
+	require.NoError(t, err)
+	signature := []byte("a dummy signature")
+	messageParts, err := splitPayload(map[uint64][]byte{
+		PayloadTypeKey:   rawPayload,
+		SenderTypeKey:    srcAddr.Bytes(),
+		SignatureTypeKey: signature,
+	})
+	require.NoError(t, err)
+	require.Len(t, messageParts, 2)
+
+	now := time.Now()
+	invalidPart := map[uint64][]byte{FragmentTypeKey: []byte("invalid")}
+	invoices := []*model.Invoice{
+		createInvoice(1, now.Add(-2*fragmentTimeout), parts[2]),
+		createInvoice(2, now.Add(-time.Minute), parts[0]),
+		createInvoice(3, now.Add(-time.Minute), invalidPart),
+		createInvoice(4, now.Add(-time.Minute), messageParts[1]),
+		createInvoice(5, now.Add(-time.Minute), messageParts[0]),
+	}
+
+	discussion := &model.Discussion{
+		ID:           5,
+		Participants: []string{srcAddr.String()},
+	}
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	mockDB.On("GetInvoiceFragments").Return(invoices, nil).Once()
+	// Messages whose parts were all received are stored.
+	var storedMsg *model.RawMessage
+	mockLNManager.On("VerifySignatureExtractPubkey", mock.Anything,
+		rawPayload, signature).Return(srcAddr.String(), nil).Once()
+	mockDB.On("GetDiscussionByParticipants",
+		discussion.Participants).Return(discussion, nil).Once()
+	mockDB.On("AddRawMessage", mock.AnythingOfType("*model.RawMessage")).Return(
+		nil).Once().Run(func(args mock.Arguments) {
+		storedMsg = args.Get(0).(*model.RawMessage)
+	})
+	// Stale and invalid parts are discarded.
+	mockDB.On("RemoveInvoiceFragments", uint64(1), uint64(3)).Return(nil).Once()
+
+	app, err := New(mockLNManager, mockDB)
+	require.NoError(t, err)
+	app.Self.Node.Address = selfAddress
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	require.NoError(t, app.loadFragments(ctxt))
+	mockLNManager.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+
+	require.NotNil(t, storedMsg)
+	assert.Equal(t, discussion.ID, storedMsg.DiscussionID)
+	assert.Equal(t, rawPayload, storedMsg.RawPayload)
+	assert.EqualValues(t, 5, storedMsg.InvoiceSettleIndex)
+	assert.Equal(t, []uint64{4}, storedMsg.FragmentSettleIndexes)
+
+	require.Len(t, app.fragments.pending, 1)
+	for _, msg := range app.fragments.pending {
+		assert.Equal(t, 1, msg.received)
+		assert.Equal(t, invoices[1], msg.invoices[0])
+	}
+}
+
+func TestStoreMultiPartInvoices(t *testing.T) {
+	selfAddr, err := lnchat.NewNodeFromString(
+		"111111111111111111111111111111111111111111111111111111111111111111")
+	require.NoError(t, err)
+	srcAddr, err := lnchat.NewNodeFromString(
+		"020000000000000000000000000000000000000000000000000000000000000000")
+	require.NoError(t, err)
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: selfAddr.String(),
+		},
+	}
+
+	message := string(bytes.Repeat([]byte("a"), 1000))
+	rawPayload, err := model.NewPayload(
+		[]string{selfAddr.String()}, message).Encode()
+	require.NoError(t, err)
+	signature := []byte("a dummy signature")
+
+	parts, err := splitPayload(map[uint64][]byte{
+		PayloadTypeKey:   rawPayload,
+		SenderTypeKey:    srcAddr.Bytes(),
+		SignatureTypeKey: signature,
+	})
+	require.NoError(t, err)
+	require.Len(t, parts, 2)
+
+	createdTime := time.Now().Add(-time.Minute).Unix()
+	invoices := make([]*lnchat.Invoice, len(parts))
+	for i, part := range parts {
+		invoices[i] = &lnchat.Invoice{
+			Hash:           "0101010101010101010101010101010101010101010101010101010101010101",
+			Preimage:       bytes.Repeat([]byte{byte(i)}, 32),
+			AmtPaid:        lnchat.NewAmount(1000 * int64(i+1)),
+			CreatedTimeSec: createdTime + int64(i),
+			State:          lnchat.InvoiceSETTLED,
+			SettleIndex:    uint64(10 + i),
+			Htlcs: []lnchat.InvoiceHTLC{
+				{
+					State:         lnrpc.InvoiceHTLCState_SETTLED,
+					CustomRecords: part,
+				},
+			},
+		}
+	}
+
+	discussion := &model.Discussion{
+		ID:           5,
+		Participants: []string{srcAddr.String()},
+		Options:      DefaultOptions,
+	}
+
+	var storedMsg *model.RawMessage
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		// The invoice of the last received part is stored with the message,
+		// while the invoices of other parts are stored as pending.
+		mockDB.On("AddInvoiceFragment", &model.Invoice{
+			CreatorAddress: selfAddr.String(),
+			Invoice:        *invoices[1],
+		}).Return(nil).Once()
+
+		mockLNManager.On("VerifySignatureExtractPubkey", mock.Anything,
+			rawPayload, signature).Return(srcAddr.String(), nil).Once()
+		mockDB.On("GetDiscussionByParticipants",
+			discussion.Participants).Return(discussion, nil).Once()
+		mockDB.On("AddInvoiceMessage", &model.Invoice{
+			CreatorAddress: selfAddr.String(),
+			Invoice:        *invoices[0],
+		}, mock.AnythingOfType("*model.RawMessage")).Return(
+			nil).Once().Run(func(args mock.Arguments) {
+			storedMsg = args.Get(1).(*model.RawMessage)
+		})
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	// The message is stored once its last part is received.
+	msg, _, err := app.storeInvoice(ctxt, invoices[1])
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+
+	msg, _, err = app.storeInvoice(ctxt, invoices[0])
+	require.NoError(t, err)
+	require.NotNil(t, msg)
+
+	require.NotNil(t, storedMsg)
+	assert.Equal(t, rawPayload, storedMsg.RawPayload)
+	assert.Equal(t, invoices[0].SettleIndex, storedMsg.InvoiceSettleIndex)
+	assert.Equal(t, []uint64{invoices[1].SettleIndex}, storedMsg.FragmentSettleIndexes)
+
+	assert.Equal(t, discussion.ID, msg.DiscussionID)
+	assert.Equal(t, message, msg.Payload)
+	assert.Equal(t, int64(3000), msg.AmtMsat)
+	assert.Equal(t, srcAddr.String(), msg.Sender)
+	assert.True(t, msg.SenderVerified)
+	assert.Equal(t, time.Unix(createdTime, 0).UnixNano(), msg.SentTimeNs)
+}
@@ -5,8 +5,11 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/pkg/errors"
+
 	"github.com/c13n-io/c13n-go/lnchat"
 	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
 )
 
 // defaultInvoiceFilter is an invoice update filter,
@@ -56,67 +59,187 @@ func (app *App) subscribeInvoices(ctx context.Context, lastInvoiceIdx uint64) er
 				return fmt.Errorf("invoice update failed: %w", invUpdate.Err)
 			}
 
-			// Store the invoice, regardless of payload being present.
-			invoice := &model.Invoice{
-				CreatorAddress: app.Self.Node.Address,
-				Invoice:        *inv,
-			}
-			if err = app.Database.AddInvoice(invoice); err != nil {
-				app.Log.WithError(err).Error("invoice storage failed")
-			}
-
-			// Extract a raw message, if one exists.
-			rawMsg, err := payloadExtractor(inv,
-				func(msg, sig []byte, sender string) (bool, error) {
-					return app.verifySignature(ctx, msg, sig, sender)
-				},
-			)
-			if err != nil {
-				app.Log.WithError(err).Info("message extraction failed")
-			}
-
-			// If no payload exists, there is nothing more
-			// to be done on this iteration.
-			if rawMsg == nil {
-				continue
-			}
-
-			// Retrieve (or create) the appropriate discussion,
-			// and store the message.
-			var disc *model.Discussion
-			disc, err = app.retrieveOrCreateRawMsgDiscussion(rawMsg)
+			msg, disc, err := app.storeInvoice(ctx, inv)
 			if err != nil {
-				app.Log.WithError(err).Error("discussion retrieval failed")
+				app.Log.WithError(err).Error("invoice storage failed")
 				continue
 			}
-
-			// Store invoice (and raw message, if present).
-			rawMsg.DiscussionID = disc.ID
-			if err := app.Database.AddRawMessage(rawMsg); err != nil {
-				app.Log.WithError(err).Error("message storage failed")
+			// If no (new) message exists, there is nothing
+			// more to be done on this iteration.
+			if msg == nil {
 				continue
 			}
 
 			// Publish the message to the appropriate topic.
-			retrieveDisc := func(_ []string) (*model.Discussion, error) {
-				return disc, nil
-			}
-			msg, err := model.NewIncomingMessage(rawMsg, invoice, retrieveDisc)
-			if err != nil {
-				app.Log.WithError(err).Error("message unmarshalling failed")
-				continue
-			}
-
 			if err := app.publishMessage(msg); err != nil {
 				app.Log.WithError(err).Error("message publish failed")
 				continue
 			}
+
+			app.acknowledgeMessage(disc, msg)
 		}
 	}
 
 	return nil
 }
 
+// storeInvoice stores a settled invoice along with the message
+// or receipt it carries, if any. The stored message is returned
+// along with its discussion (nil if the invoice carries no message
+// or was already stored).
+func (app *App) storeInvoice(ctx context.Context, inv *lnchat.Invoice) (
+	*model.Message, *model.Discussion, error) {
+
+	invoice := &model.Invoice{
+		CreatorAddress: app.Self.Node.Address,
+		Invoice:        *inv,
+	}
+
+	// Invoices carrying a receipt carry no message.
+	if records := invoiceCustomRecords(inv); records != nil {
+		if _, ok := records[ReceiptTypeKey]; ok {
+			if stored, err := app.addInvoice(invoice, false); !stored {
+				return nil, nil, err
+			}
+			if err := app.storeReceipt(ctx, inv, records); err != nil {
+				return nil, nil, fmt.Errorf("receipt storage failed: %w", err)
+			}
+			return nil, nil, nil
+		}
+	}
+
+	// Extract a raw message, if one exists.
+	rawMsg, fragments, err := app.extractInvoiceMessage(ctx, invoice)
+	if err != nil {
+		app.Log.WithError(err).Info("message extraction failed")
+	}
+
+	// If no message exists, store the invoice by itself,
+	// as pending if it carries a part of an incomplete message.
+	if rawMsg == nil {
+		_, isFragment := invoiceCustomRecords(inv)[FragmentTypeKey]
+		_, err := app.addInvoice(invoice, isFragment && err == nil)
+		return nil, nil, err
+	}
+
+	// Retrieve (or create) the appropriate discussion,
+	// and store the message along with the invoice.
+	disc, err := app.retrieveOrCreateRawMsgDiscussion(rawMsg)
+	if err != nil {
+		return nil, nil, fmt.Errorf("discussion retrieval failed: %w", err)
+	}
+
+	rawMsg.DiscussionID = disc.ID
+	err = app.Database.AddInvoiceMessage(invoice, rawMsg)
+	switch {
+	case errors.Is(err, store.ErrDuplicateInvoice):
+		// The invoice (and its message) has already been handled.
+		return nil, nil, nil
+	case err != nil:
+		return nil, nil, fmt.Errorf("message storage failed: %w", err)
+	}
+
+	retrieveDisc := func(_ []string) (*model.Discussion, error) {
+		return disc, nil
+	}
+	msg, err := model.NewIncomingMessage(rawMsg, invoice, retrieveDisc, fragments...)
+	if err != nil {
+		return nil, nil, fmt.Errorf("message unmarshalling failed: %w", err)
+	}
+	msg.Muted = disc.Metadata.Muted
+
+	return msg, disc, nil
+}
+
+// addInvoice stores an invoice carrying no message, returning
+// whether it was stored (false if it has already been stored).
+// Pending invoices carry parts of multi-part messages
+// that have not been reassembled yet.
+func (app *App) addInvoice(invoice *model.Invoice, pending bool) (bool, error) {
+	add := app.Database.AddInvoice
+	if pending {
+		add = app.Database.AddInvoiceFragment
+	}
+
+	err := add(invoice)
+	switch {
+	case errors.Is(err, store.ErrDuplicateInvoice):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("invoice storage failed: %w", err)
+	}
+
+	return true, nil
+}
+
+// extractInvoiceMessage extracts the raw message carried by an invoice.
+// The parts of a multi-part message are collected until all of them
+// have been received, at which point the reassembled message is returned
+// along with the invoices carrying its other parts.
+func (app *App) extractInvoiceMessage(ctx context.Context,
+	invoice *model.Invoice) (*model.RawMessage, []*model.Invoice, error) {
+
+	verifier := func(msg, sig []byte, sender string) (bool, error) {
+		return app.verifySignature(ctx, msg, sig, sender)
+	}
+	decrypter := func(sealed []byte, sender string) ([]byte, error) {
+		return app.decryptPayload(ctx, sealed, sender)
+	}
+
+	inv := &invoice.Invoice
+	customRecords := invoiceCustomRecords(inv)
+	headerBytes, ok := customRecords[FragmentTypeKey]
+	if !ok {
+		rawMsg, err := payloadExtractor(inv, verifier, decrypter)
+		return rawMsg, nil, err
+	}
+
+	header, err := decodeFragmentHeader(headerBytes)
+	if err != nil {
+		return nil, nil, err
+	}
+	records, invoices, err := app.fragments.add(header, customRecords,
+		invoice, time.Now())
+	if err != nil || records == nil {
+		return nil, nil, err
+	}
+
+	return app.reassembledMessage(ctx, records, invoices, invoice)
+}
+
+// reassembledMessage returns the raw message carried by the reassembled
+// wire payload of a multi-part message, along with the invoices
+// carrying its parts other than the last received one.
+func (app *App) reassembledMessage(ctx context.Context, records map[uint64][]byte,
+	invoices []*model.Invoice, last *model.Invoice) (*model.RawMessage, []*model.Invoice, error) {
+
+	verifier := func(msg, sig []byte, sender string) (bool, error) {
+		return app.verifySignature(ctx, msg, sig, sender)
+	}
+	decrypter := func(sealed []byte, sender string) ([]byte, error) {
+		return app.decryptPayload(ctx, sealed, sender)
+	}
+
+	rawMsg, err := unmarshalPayload(records, verifier, decrypter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// The message is associated with the invoice of its last received part.
+	rawMsg.InvoiceSettleIndex = last.SettleIndex
+	var fragments []*model.Invoice
+	for _, fragment := range invoices {
+		if fragment.SettleIndex == last.SettleIndex {
+			continue
+		}
+		fragments = append(fragments, fragment)
+		rawMsg.FragmentSettleIndexes = append(rawMsg.FragmentSettleIndexes,
+			fragment.SettleIndex)
+	}
+
+	return rawMsg, fragments, nil
+}
+
 func (app *App) retrieveOrCreateRawMsgDiscussion(raw *model.RawMessage) (
 	*model.Discussion, error) {
 
@@ -125,6 +248,16 @@ func (app *App) retrieveOrCreateRawMsgDiscussion(raw *model.RawMessage) (
 		return nil, fmt.Errorf("cannot retrieve message participant set: %w", err)
 	}
 
+	return app.retrieveOrCreateDiscussion(&model.Discussion{
+		Participants: app.incomingParticipants(participants, raw.SenderAddress()),
+		Options:      DefaultOptions,
+	})
+}
+
+// incomingParticipants returns the participant set of the discussion
+// an incoming message belongs to, based on the participant set
+// carried by the message and its sender.
+func (app *App) incomingParticipants(participants []string, sender string) []string {
 	// NOTE: Since currently our node address is included
 	// in the participant set of an incoming message,
 	// but not in the participant set of a discussion, remove it.
@@ -147,12 +280,9 @@ func (app *App) retrieveOrCreateRawMsgDiscussion(raw *model.RawMessage) (
 	}
 
 	// If the sender is identified, add them to the participant set.
-	if raw.Sender != "" {
-		trimmedParticipants = append(trimmedParticipants, raw.Sender)
+	if sender != "" {
+		trimmedParticipants = append(trimmedParticipants, sender)
 	}
 
-	return app.retrieveOrCreateDiscussion(&model.Discussion{
-		Participants: trimmedParticipants,
-		Options:      DefaultOptions,
-	})
+	return trimmedParticipants
 }
@@ -38,6 +38,8 @@ func mustExtractRawMessage(t *testing.T, inv *lnchat.Invoice) *model.RawMessage
 	rawMsg, err := payloadExtractor(inv, func(_, _ []byte, _ string) (
 		bool, error) {
 		return true, nil
+	}, func(sealed []byte, _ string) ([]byte, error) {
+		return sealed, nil
 	})
 	require.NoError(t, err)
 
@@ -64,7 +66,7 @@ func TestSubscribeInvoices(t *testing.T) {
 	require.NoError(t, err)
 
 	srcAddr, err := lnchat.NewNodeFromString(
-		"000000000000000000000000000000000000000000000000000000000000000000")
+		"020000000000000000000000000000000000000000000000000000000000000000")
 	require.NoError(t, err)
 
 	selfInfo := lnchat.SelfInfo{
@@ -217,6 +219,55 @@ func TestSubscribeInvoices(t *testing.T) {
 				},
 			},
 		},
+		{
+			name:                "Muted discussion",
+			subscrInvUpdatesErr: nil,
+			invoiceUpdateOps: []invoiceUpdateOp{
+				{
+					data:                     invoiceUpdateList[0],
+					addInvoiceErr:            nil,
+					payloadExists:            true,
+					payloadSigned:            true,
+					verifySigExtractedPubkey: srcAddr.String(),
+					verifySigErr:             nil,
+					rawMsg:                   mustExtractRawMessage(t, invoiceUpdateList[0].Inv),
+					canUnmarshalPayload:      true,
+					discExists:               true,
+					discParticipants:         []string{srcAddr.String()},
+					discussion: &model.Discussion{
+						Participants:  []string{srcAddr.String()},
+						LastReadID:    0,
+						LastMessageID: 33,
+						Options:       DefaultOptions,
+						Metadata:      model.DiscussionMetadata{Muted: true},
+					},
+					discID:                   13,
+					getDiscByParticipantsErr: nil,
+					addDiscussionErr:         nil,
+					addRawMsgErr:             nil,
+					addRawMsgID:              42,
+					message: &MaybeMessage{
+						Message: &model.Message{
+							ID:             42,
+							DiscussionID:   13,
+							Payload:        "test message",
+							AmtMsat:        124,
+							Sender:         srcAddr.String(),
+							Receiver:       selfAddr.String(),
+							SenderVerified: true,
+							SentTimeNs:     time.Unix(invoiceUpdateList[0].Inv.CreatedTimeSec, 0).UnixNano(),
+							ReceivedTimeNs: time.Unix(invoiceUpdateList[0].Inv.SettleTimeSec, 0).UnixNano(),
+							Index:          invoiceUpdateList[0].Inv.SettleIndex,
+							PayReq:         "dummy payment request",
+							SuccessProb:    1.,
+							PreimageHash:   mustMakePreimageHash(t, invoiceUpdateList[0].Inv.Hash),
+							Preimage:       mustMakePreimage(t, invoiceUpdateList[0].Inv.Preimage),
+							Muted:          true,
+						},
+					},
+				},
+			},
+		},
 		{
 			name:                "Subscription terminated",
 			subscrInvUpdatesErr: nil,
@@ -256,7 +307,7 @@ func TestSubscribeInvoices(t *testing.T) {
 			},
 		},
 		{
-			name:                "AddRawMessage error",
+			name:                "AddInvoiceMessage error",
 			subscrInvUpdatesErr: nil,
 			invoiceUpdateOps: []invoiceUpdateOp{
 				{
@@ -279,7 +330,7 @@ func TestSubscribeInvoices(t *testing.T) {
 					discID:                   13,
 					getDiscByParticipantsErr: nil,
 					addDiscussionErr:         nil,
-					addRawMsgErr:             fmt.Errorf("dummy AddRawMessage error"),
+					addRawMsgErr:             fmt.Errorf("dummy AddInvoiceMessage error"),
 					addRawMsgID:              0,
 					message:                  nil,
 				},
@@ -323,9 +374,14 @@ func TestSubscribeInvoices(t *testing.T) {
 
 				// Mock self info
 				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 				mockDB.On("GetLastInvoiceIndex").Return(
 					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 				// Mock invoice update subscription channel
 				invoiceUpdateCh := func() <-chan lnchat.InvoiceUpdate {
@@ -348,11 +404,16 @@ func TestSubscribeInvoices(t *testing.T) {
 					invoiceUpdateCh, c.subscrInvUpdatesErr).Once()
 
 				for _, invUpdate := range c.invoiceUpdateOps {
+					var invModel *model.Invoice
 					if invUpdate.data.Inv != nil {
-						invModel := &model.Invoice{
+						invModel = &model.Invoice{
 							CreatorAddress: selfAddr.String(),
 							Invoice:        *invUpdate.data.Inv,
 						}
+					}
+					// Invoices carrying a message are stored along with it.
+					hasMessage := invUpdate.payloadExists && invUpdate.canUnmarshalPayload
+					if invModel != nil && !hasMessage {
 						mockDB.On("AddInvoice", invModel).Return(
 							invUpdate.addInvoiceErr).Once()
 					}
@@ -387,12 +448,12 @@ func TestSubscribeInvoices(t *testing.T) {
 						rawMsg := invUpdate.rawMsg
 						rawMsg.DiscussionID = invUpdate.discID
 
-						mockDB.On("AddRawMessage", rawMsg).Return(invUpdate.addRawMsgErr).Run(
-							func(args mock.Arguments) {
-								//nolint:errcheck // no need to check cast error in mock install
-								arg := args.Get(0).(*model.RawMessage)
-								arg.ID = invUpdate.addRawMsgID
-							}).Once()
+						mockDB.On("AddInvoiceMessage", invModel, rawMsg).Return(
+							invUpdate.addRawMsgErr).Run(func(args mock.Arguments) {
+							//nolint:errcheck // no need to check cast error in mock install
+							arg := args.Get(1).(*model.RawMessage)
+							arg.ID = invUpdate.addRawMsgID
+						}).Once()
 					}
 				}
 
@@ -0,0 +1,208 @@
+package app
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+)
+
+const (
+	// messageStatusTopic is the topic where message
+	// status updates are published.
+	messageStatusTopic = "message_status"
+)
+
+// publishStatus publishes a message status update.
+// Failures are logged, since status updates are best-effort.
+func (app *App) publishStatus(update *model.MessageStatusUpdate) {
+	if app.bus == nil {
+		return
+	}
+
+	updateBytes, err := json.Marshal(update)
+	if err == nil {
+		err = app.publish(messageStatusTopic, updateBytes)
+	}
+	if err != nil {
+		app.Log.WithError(err).Warnf("could not publish status "+
+			"update for send %d", update.SendID)
+	}
+}
+
+// publishPaymentStatus publishes the status of the payment
+// towards a recipient of an outbox message.
+func (app *App) publishPaymentStatus(outbox *model.OutboxMessage,
+	recipient string, payment *lnchat.Payment) {
+
+	update := &model.MessageStatusUpdate{
+		SendID:       outbox.ID,
+		DiscussionID: outbox.RawMessage.DiscussionID,
+		Recipient:    recipient,
+	}
+	switch payment.Status {
+	case lnchat.PaymentSUCCEEDED:
+		update.Status = model.MessageSUCCEEDED
+	case lnchat.PaymentFAILED:
+		update.Status = model.MessageFAILED
+		update.FailureReason = paymentFailureReason(payment)
+	default:
+		update.Status = model.MessageINFLIGHT
+	}
+
+	app.publishStatus(update)
+}
+
+// publishRecipientFailure publishes the failure of the payment
+// towards a recipient of an outbox message.
+func (app *App) publishRecipientFailure(outbox *model.OutboxMessage,
+	recipient string, reason string) {
+
+	app.publishStatus(&model.MessageStatusUpdate{
+		SendID:        outbox.ID,
+		DiscussionID:  outbox.RawMessage.DiscussionID,
+		Recipient:     recipient,
+		Status:        model.MessageFAILED,
+		FailureReason: reason,
+	})
+}
+
+// paymentFailureReason returns a description of the reason a payment failed,
+// based on the failure of its last failed HTLC attempt, if any.
+func paymentFailureReason(payment *lnchat.Payment) string {
+	for i := len(payment.Htlcs) - 1; i >= 0; i-- {
+		failure := payment.Htlcs[i].Failure
+		if failure == nil {
+			continue
+		}
+
+		return fmt.Sprintf("%s at hop %d", failure.Code, failure.NodeIndex)
+	}
+
+	if payment.FailureReason != lnrpc.PaymentFailureReason_FAILURE_REASON_NONE {
+		return payment.FailureReason.String()
+	}
+
+	return "unknown failure reason"
+}
+
+// MaybeMessageStatus represents a message status update or an error.
+type MaybeMessageStatus struct {
+	Update *model.MessageStatusUpdate
+	Error  error
+}
+
+// GetMessageStatus returns the current status of a send operation.
+// Send operations whose payments are not yet resolved are reported
+// as in-flight, while completed ones are reported with their final
+// status, along with the sent message if it was delivered.
+func (app *App) GetMessageStatus(_ context.Context,
+	sendID uint64) (*model.MessageStatusUpdate, error) {
+
+	outbox, err := app.Database.GetOutboxMessage(sendID)
+	switch {
+	case err == nil:
+		return &model.MessageStatusUpdate{
+			SendID:       sendID,
+			DiscussionID: outbox.RawMessage.DiscussionID,
+			Status:       model.MessageINFLIGHT,
+		}, nil
+	case !errors.Is(err, store.ErrOutboxMessageNotFound):
+		return nil, newErrorf(err, "GetMessageStatus")
+	}
+
+	// Outbox messages are completed atomically with
+	// the storage of their send result.
+	result, err := app.Database.GetSendResult(sendID)
+	if err != nil {
+		return nil, newErrorf(err, "GetMessageStatus")
+	}
+
+	update := &model.MessageStatusUpdate{
+		SendID:        sendID,
+		DiscussionID:  result.DiscussionID,
+		Status:        result.Status,
+		FailureReason: result.FailureReason,
+	}
+	if result.MessageID == 0 {
+		return update, nil
+	}
+
+	stored, err := app.Database.GetMessage(result.MessageID)
+	switch {
+	case errors.Is(err, store.ErrMessageNotFound):
+		// The message was removed after it was sent.
+		return update, nil
+	case err != nil:
+		return nil, newErrorf(err, "GetMessageStatus")
+	}
+	if update.Message, err = model.NewOutgoingMessage(stored.RawMessage,
+		true, stored.Payments...); err != nil {
+
+		return nil, newErrorf(err, "GetMessageStatus: "+
+			"message marshalling failed")
+	}
+
+	return update, nil
+}
+
+// SubscribeMessageStatus returns a channel over which message
+// status updates are sent. If sendID is non-zero, only updates
+// for the corresponding send operation are sent, starting with
+// its current status (if the send operation exists), so that
+// subscribers do not miss updates published before subscribing.
+// The subscriber is responsible for draining the channel
+// once the subscription terminates.
+func (app *App) SubscribeMessageStatus(ctx context.Context,
+	sendID uint64) (<-chan MaybeMessageStatus, error) {
+
+	subCh, err := app.subscribe(ctx, messageStatusTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	updateCh := make(chan MaybeMessageStatus)
+	go func() {
+		defer close(updateCh)
+
+		// The current status is retrieved after subscribing,
+		// so that no subsequent update is missed.
+		if sendID != 0 {
+			current, err := app.GetMessageStatus(ctx, sendID)
+			switch {
+			case errors.Is(err, store.ErrSendResultNotFound):
+				// The send operation does not exist (yet).
+			default:
+				updateCh <- MaybeMessageStatus{
+					Update: current,
+					Error:  err,
+				}
+			}
+		}
+
+		// Forward updates until subscriber exits.
+		for subMsg := range subCh {
+			subMsg.Ack()
+
+			// Unmarshal update data in a fresh variable.
+			update := new(model.MessageStatusUpdate)
+			err := json.Unmarshal(subMsg.Payload, update)
+			if err == nil && sendID != 0 && update.SendID != sendID {
+				continue
+			}
+
+			updateCh <- MaybeMessageStatus{
+				Update: update,
+				Error:  err,
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
@@ -0,0 +1,346 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestPaymentFailureReason(t *testing.T) {
+	cases := []struct {
+		name     string
+		payment  *lnchat.Payment
+		expected string
+	}{
+		{
+			name: "last HTLC failure",
+			payment: &lnchat.Payment{
+				Status: lnchat.PaymentFAILED,
+				Htlcs: []lnchat.HTLCAttempt{
+					{
+						Status: lnrpc.HTLCAttempt_FAILED,
+						Failure: &lnchat.HTLCFailure{
+							Code:      lnrpc.Failure_TEMPORARY_CHANNEL_FAILURE,
+							NodeIndex: 1,
+						},
+					},
+					{
+						Status: lnrpc.HTLCAttempt_FAILED,
+						Failure: &lnchat.HTLCFailure{
+							Code:      lnrpc.Failure_INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS,
+							NodeIndex: 2,
+						},
+					},
+					{
+						Status: lnrpc.HTLCAttempt_FAILED,
+					},
+				},
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_INCORRECT_PAYMENT_DETAILS,
+			},
+			expected: "INCORRECT_OR_UNKNOWN_PAYMENT_DETAILS at hop 2",
+		},
+		{
+			name: "payment failure reason",
+			payment: &lnchat.Payment{
+				Status:        lnchat.PaymentFAILED,
+				FailureReason: lnrpc.PaymentFailureReason_FAILURE_REASON_NO_ROUTE,
+			},
+			expected: "FAILURE_REASON_NO_ROUTE",
+		},
+		{
+			name: "unknown failure",
+			payment: &lnchat.Payment{
+				Status: lnchat.PaymentFAILED,
+			},
+			expected: "unknown failure reason",
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, paymentFailureReason(c.payment))
+		})
+	}
+}
+
+func TestSendPaymentAsync(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: srcAddress,
+		},
+	}
+
+	discussion := &model.Discussion{
+		ID:           1,
+		Participants: []string{destAddress},
+	}
+
+	hash := "1111111111111111111111111111111111111111111111111111111111111111"
+	paymentUpdateList := []lnchat.PaymentUpdate{
+		{
+			Payment: &lnchat.Payment{
+				Hash:   hash,
+				Status: lnchat.PaymentINFLIGHT,
+			},
+		},
+		{
+			Payment: &lnchat.Payment{
+				Hash:   hash,
+				Status: lnchat.PaymentFAILED,
+				Htlcs: []lnchat.HTLCAttempt{
+					{
+						Status: lnrpc.HTLCAttempt_FAILED,
+						Failure: &lnchat.HTLCFailure{
+							Code:      lnrpc.Failure_UNKNOWN_NEXT_PEER,
+							NodeIndex: 1,
+						},
+					},
+				},
+			},
+		},
+	}
+
+	outboxID := uint64(5)
+	// The attempt hash stored in the outbox before sending.
+	var storedHash string
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		mockDB.On("GetDiscussion", discussion.ID).Return(discussion, nil).Once()
+
+		mockLNManager.On("SignMessage", mock.Anything, mock.Anything).Return([]byte("sig"), nil).Once()
+
+		mockDB.On("AddOutboxMessage", mock.AnythingOfType("*model.OutboxMessage")).Return(
+			nil).Once().Run(func(args mock.Arguments) {
+			outbox := args.Get(0).(*model.OutboxMessage)
+			outbox.ID = outboxID
+			storedHash = outbox.Attempts[0].Hash
+		})
+
+		mockDB.On("UpdateOutboxMessage", mock.AnythingOfType("*model.OutboxMessage")).Return(
+			nil)
+
+		paymentUpdates := make(chan lnchat.PaymentUpdate, len(paymentUpdateList))
+		for _, update := range paymentUpdateList {
+			paymentUpdates <- update
+		}
+		close(paymentUpdates)
+
+		mockLNManager.On("SendPayment", mock.Anything, destAddress, lnchat.NewAmount(1000), "",
+			mock.AnythingOfType("*lntypes.Preimage"), mock.Anything, mock.Anything, mock.Anything).Return(
+			(<-chan lnchat.PaymentUpdate)(paymentUpdates), nil).Once().Run(func(args mock.Arguments) {
+			// The payment hash is persisted before the payment is initiated.
+			preimage := args.Get(4).(*lnchat.PreImage)
+			assert.Equal(t, storedHash, preimage.Hash().String())
+		})
+
+		mockDB.On("CompleteOutboxMessage", outboxID, model.SendResult{
+			DiscussionID: discussion.ID,
+			Status:       model.MessageFAILED,
+			FailureReason: "failed to send message: payment to " + destAddress +
+				" failed: UNKNOWN_NEXT_PEER at hop 1",
+		}, (*model.RawMessage)(nil), mock.AnythingOfType("*model.Payment")).Return(
+			nil).Once()
+
+		// The subscription precedes the send operation.
+		mockDB.On("GetOutboxMessage", outboxID).Return(
+			nil, store.ErrOutboxMessageNotFound).Once()
+		mockDB.On("GetSendResult", outboxID).Return(
+			nil, store.ErrSendResultNotFound).Once()
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	updates, err := app.SubscribeMessageStatus(ctxt, outboxID)
+	require.NoError(t, err)
+
+	pending, err := app.SendPaymentAsync(ctxt, model.MessageContent{Body: "hello"}, 1000,
+		discussion.ID, "", model.MessageOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, &model.MessageStatusUpdate{
+		SendID:       outboxID,
+		DiscussionID: discussion.ID,
+		Status:       model.MessagePENDING,
+	}, pending)
+
+	expected := []model.MessageStatusUpdate{
+		*pending,
+		{
+			SendID:       outboxID,
+			DiscussionID: discussion.ID,
+			Recipient:    destAddress,
+			Status:       model.MessageINFLIGHT,
+		},
+		{
+			SendID:        outboxID,
+			DiscussionID:  discussion.ID,
+			Recipient:     destAddress,
+			Status:        model.MessageFAILED,
+			FailureReason: "UNKNOWN_NEXT_PEER at hop 1",
+		},
+		{
+			SendID:       outboxID,
+			DiscussionID: discussion.ID,
+			Status:       model.MessageFAILED,
+			FailureReason: "failed to send message: payment to " + destAddress +
+				" failed: UNKNOWN_NEXT_PEER at hop 1",
+		},
+	}
+
+	var received []model.MessageStatusUpdate
+	for len(received) < len(expected) {
+		select {
+		case update := <-updates:
+			require.NoError(t, update.Error)
+			received = append(received, *update.Update)
+		case <-ctxt.Done():
+			require.FailNow(t, "message status updates not received")
+		}
+	}
+
+	// Status updates are not guaranteed to be received in order.
+	assert.ElementsMatch(t, expected, received)
+}
+
+func TestGetMessageStatus(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	rawMsg := &model.RawMessage{
+		ID:             8,
+		DiscussionID:   1,
+		PaymentIndexes: []uint64{4},
+	}
+	payment := &model.Payment{
+		PayerAddress: srcAddress,
+		PayeeAddress: destAddress,
+		Payment: lnchat.Payment{
+			Hash:         "1111111111111111111111111111111111111111111111111111111111111111",
+			Preimage:     "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			Status:       lnchat.PaymentSUCCEEDED,
+			PaymentIndex: 4,
+		},
+	}
+	sentMsg, err := model.NewOutgoingMessage(rawMsg, true, payment)
+	require.NoError(t, err)
+
+	cases := []struct {
+		name     string
+		outbox   *model.OutboxMessage
+		result   *model.SendResult
+		expected *model.MessageStatusUpdate
+	}{
+		{
+			name: "In outbox",
+			outbox: &model.OutboxMessage{
+				ID:         3,
+				RawMessage: model.RawMessage{DiscussionID: 1},
+			},
+			expected: &model.MessageStatusUpdate{
+				SendID:       3,
+				DiscussionID: 1,
+				Status:       model.MessageINFLIGHT,
+			},
+		},
+		{
+			name: "Failed",
+			result: &model.SendResult{
+				SendID:        3,
+				DiscussionID:  1,
+				Status:        model.MessageFAILED,
+				FailureReason: "failed to send message",
+			},
+			expected: &model.MessageStatusUpdate{
+				SendID:        3,
+				DiscussionID:  1,
+				Status:        model.MessageFAILED,
+				FailureReason: "failed to send message",
+			},
+		},
+		{
+			name: "Succeeded",
+			result: &model.SendResult{
+				SendID:       3,
+				DiscussionID: 1,
+				Status:       model.MessageSUCCEEDED,
+				MessageID:    rawMsg.ID,
+			},
+			expected: &model.MessageStatusUpdate{
+				SendID:       3,
+				DiscussionID: 1,
+				Status:       model.MessageSUCCEEDED,
+				Message:      sentMsg,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockDB := new(dbmock.Database)
+			if c.outbox != nil {
+				mockDB.On("GetOutboxMessage", uint64(3)).Return(c.outbox, nil).Once()
+			} else {
+				mockDB.On("GetOutboxMessage", uint64(3)).Return(
+					nil, store.ErrOutboxMessageNotFound).Once()
+				mockDB.On("GetSendResult", uint64(3)).Return(c.result, nil).Once()
+			}
+			if c.result != nil && c.result.MessageID != 0 {
+				mockDB.On("GetMessage", c.result.MessageID).Return(
+					&store.MessageAggregate{
+						RawMessage: rawMsg,
+						Payments:   []*model.Payment{payment},
+					}, nil).Once()
+			}
+
+			app, err := New(new(lnmock.LightManager), mockDB)
+			require.NoError(t, err)
+
+			update, err := app.GetMessageStatus(context.Background(), 3)
+			require.NoError(t, err)
+			assert.Equal(t, c.expected, update)
+			mockDB.AssertExpectations(t)
+		})
+	}
+}
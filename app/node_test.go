@@ -62,9 +62,14 @@ func TestGetNodesSuccess(t *testing.T) {
 	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -73,6 +78,7 @@ func TestGetNodesSuccess(t *testing.T) {
 		mockLNManager.On("Close").Return(nil).Once()
 
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockLNManager.On("ListNodes", mock.Anything).Return(nodes, nil).Once()
 
@@ -141,9 +147,14 @@ func TestGetNodesByAliasSuccess(t *testing.T) {
 	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -152,6 +163,7 @@ func TestGetNodesByAliasSuccess(t *testing.T) {
 		mockLNManager.On("Close").Return(nil).Once()
 
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockLNManager.On("ListNodes", mock.Anything).Return(nodes, nil).Once()
 
@@ -209,9 +221,14 @@ func TestGetNodesByAliasNoMatch(t *testing.T) {
 	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -220,6 +237,7 @@ func TestGetNodesByAliasNoMatch(t *testing.T) {
 		mockLNManager.On("Close").Return(nil).Once()
 
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockLNManager.On("ListNodes", mock.Anything).Return(nodes, nil).Once()
 
@@ -277,9 +295,14 @@ func TestGetNodesByAddressSuccess(t *testing.T) {
 	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -288,6 +311,7 @@ func TestGetNodesByAddressSuccess(t *testing.T) {
 		mockLNManager.On("Close").Return(nil).Once()
 
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockLNManager.On("ListNodes", mock.Anything).Return(nodes, nil).Once()
 
@@ -352,9 +376,14 @@ func TestGetNodesByAddressNoMatch(t *testing.T) {
 	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -363,6 +392,7 @@ func TestGetNodesByAddressNoMatch(t *testing.T) {
 		mockLNManager.On("Close").Return(nil).Once()
 
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockLNManager.On("ListNodes", mock.Anything).Return(nodes, nil).Once()
 
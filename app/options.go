@@ -16,12 +16,14 @@ var DefaultOptions = model.MessageOptions{
 // If relaxation of the fee limit is not allowed,
 // the fee limit is capped by the initial value of opts.
 // A fee limit of 0 is ignored and does not override a previous value.
+// Encryption, once requested, cannot be disabled by subsequent overrides.
 func overrideOptions(opts model.MessageOptions, allowRelax bool,
 	overrides ...model.MessageOptions) model.MessageOptions {
 
 	res := opts
 	for _, o := range overrides {
 		res.Anonymous = o.Anonymous
+		res.Encrypted = res.Encrypted || o.Encrypted
 
 		relaxFee := o.FeeLimitMsat > opts.FeeLimitMsat
 		switch {
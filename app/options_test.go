@@ -146,6 +146,28 @@ func TestOverrideOptions(t *testing.T) {
 				Anonymous:    false,
 			},
 		},
+		{
+			opts: model.MessageOptions{
+				FeeLimitMsat: 3000,
+				Anonymous:    false,
+			},
+			overrideOpts: []model.MessageOptions{
+				model.MessageOptions{
+					FeeLimitMsat: 0,
+					Encrypted:    true,
+				},
+				model.MessageOptions{
+					FeeLimitMsat: 0,
+					Encrypted:    false,
+				},
+			},
+			allowRelax: false,
+			expected: model.MessageOptions{
+				FeeLimitMsat: 3000,
+				Anonymous:    false,
+				Encrypted:    true,
+			},
+		},
 	}
 
 	for _, c := range cases {
@@ -0,0 +1,224 @@
+package app
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// trackingPaymentFilter is a payment update filter,
+// accepting in-flight and final payment updates.
+func trackingPaymentFilter(p *lnchat.Payment) bool {
+	return p.Status != lnchat.PaymentUNKNOWN
+}
+
+// newOutboxAttempt creates the attempt carrying a message part
+// towards recipient, populating its payment hash before
+// the payment is initiated, so that the payment can be tracked
+// in case of interruption.
+// Spontaneous payments use a preimage generated in advance,
+// while payments to a payment request use the request hash.
+func newOutboxAttempt(recipient string, part int,
+	payRequest *lnchat.PayReq) (*model.OutboxAttempt, error) {
+
+	attempt := &model.OutboxAttempt{
+		Recipient: recipient,
+		Part:      part,
+	}
+	if payRequest != nil {
+		attempt.Hash = payRequest.Hash
+		return attempt, nil
+	}
+
+	preimage, err := lnchat.NewPreimage()
+	if err != nil {
+		return nil, errors.Wrap(err, "could not generate payment preimage")
+	}
+	attempt.Hash = preimage.Hash().String()
+	attempt.Preimage = preimage[:]
+
+	return attempt, nil
+}
+
+// attemptPreimage returns the preimage of an outbox attempt,
+// or nil if the attempt pays a payment request.
+func attemptPreimage(attempt *model.OutboxAttempt) (*lnchat.PreImage, error) {
+	if attempt == nil {
+		return nil, fmt.Errorf("outbox attempt not found")
+	}
+	if attempt.Preimage == nil {
+		return nil, nil
+	}
+
+	preimage, err := lntypes.MakePreimage(attempt.Preimage)
+	if err != nil {
+		return nil, errors.Wrap(err, "invalid outbox attempt preimage")
+	}
+
+	return &preimage, nil
+}
+
+// awaitPayment waits for the final update of a payment carrying
+// a message part towards recipient.
+// If the update channel closes before the payment is resolved,
+// the context error (if any) is returned.
+func (app *App) awaitPayment(ctx context.Context, outbox *model.OutboxMessage,
+	recipient string, part int, updates <-chan lnchat.PaymentUpdate) (*lnchat.Payment, error) {
+
+	inFlightPublished := false
+	for update := range updates {
+		if update.Err != nil {
+			return nil, update.Err
+		}
+
+		payment := update.Payment
+		if !inFlightPublished {
+			inFlightPublished = true
+
+			if part == 0 && payment.Status == lnchat.PaymentINFLIGHT {
+				app.publishPaymentStatus(outbox, recipient, payment)
+			}
+		}
+
+		if defaultPaymentFilter(payment) {
+			return payment, nil
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, fmt.Errorf("payment updates terminated before resolution")
+}
+
+// completeOutboxMessage removes a message from the outbox,
+// storing the provided payments, the send result and,
+// if the message was delivered to any recipient, the raw message
+// associated with the payments delivering it.
+// A message is delivered to a recipient only if every part
+// towards the recipient was delivered.
+// The stored raw message and its payments are returned,
+// or an error describing the send failure (along with
+// the provided errors) if the message was not delivered.
+func (app *App) completeOutboxMessage(outbox *model.OutboxMessage,
+	payments []*model.Payment, errs []error) (*model.RawMessage, []*model.Payment, error) {
+
+	rawMsg := outbox.RawMessage
+
+	// Associate with the message only the payments
+	// of the recipients it was delivered to.
+	delivered := deliveredPayments(outbox, payments)
+	for _, payment := range delivered {
+		rawMsg.WithPaymentIndexes(payment.PaymentIndex)
+	}
+
+	result := model.SendResult{
+		DiscussionID: rawMsg.DiscussionID,
+		Status:       model.MessageSUCCEEDED,
+	}
+	var storedMsg *model.RawMessage
+	var sendErr error
+	switch len(delivered) {
+	case 0:
+		sendErr = sendFailure(payments, errs)
+		result.Status = model.MessageFAILED
+		result.FailureReason = sendErr.Error()
+	default:
+		storedMsg = &rawMsg
+	}
+
+	// Save all payments (irrespective of status).
+	err := app.Database.CompleteOutboxMessage(outbox.ID, result,
+		storedMsg, payments...)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "message storage failed")
+	}
+
+	return storedMsg, delivered, sendErr
+}
+
+// deliveredPayments returns the successful payments towards
+// the recipients to which every part of an outbox message was delivered.
+func deliveredPayments(outbox *model.OutboxMessage,
+	payments []*model.Payment) []*model.Payment {
+
+	parts := make(map[string]int)
+	for _, attempt := range outbox.Attempts {
+		parts[attempt.Recipient]++
+	}
+	succeeded := make(map[string]int)
+	for _, payment := range payments {
+		if payment.Status == lnchat.PaymentSUCCEEDED {
+			succeeded[payment.PayeeAddress]++
+		}
+	}
+
+	var delivered []*model.Payment
+	for _, payment := range payments {
+		recipient := payment.PayeeAddress
+		if payment.Status == lnchat.PaymentSUCCEEDED &&
+			succeeded[recipient] == parts[recipient] {
+
+			delivered = append(delivered, payment)
+		}
+	}
+
+	return delivered
+}
+
+// sendFailure returns the error of a send operation
+// that delivered the message to no recipient.
+func sendFailure(payments []*model.Payment, errs []error) error {
+	for _, payment := range payments {
+		if payment.Status == lnchat.PaymentSUCCEEDED {
+			continue
+		}
+		errs = append(errs, fmt.Errorf("payment to %s failed: %s",
+			payment.PayeeAddress, paymentFailureReason(&payment.Payment)))
+	}
+	if err := newCompositeError(errs); err != nil {
+		return fmt.Errorf("failed to send message: %w", err)
+	}
+
+	return fmt.Errorf("failed to send message")
+}
+
+// resumeOutbox resumes the delivery of messages left in the outbox
+// (e.g. due to a restart), tracking their initiated payments
+// and sending their remaining parts, finalizing them
+// once their payments are resolved.
+func (app *App) resumeOutbox() error {
+	outboxMsgs, err := app.Database.GetOutboxMessages()
+	if err != nil {
+		return err
+	}
+
+	for i := range outboxMsgs {
+		app.resumeOutboxMessage(&outboxMsgs[i])
+	}
+
+	return nil
+}
+
+// resumeOutboxMessage resumes the delivery of an outbox message
+// in the background, finalizing the message once its payments are resolved.
+func (app *App) resumeOutboxMessage(outbox *model.OutboxMessage) {
+	if app.Tomb == nil || !app.Tomb.Alive() {
+		return
+	}
+
+	ctx := app.Tomb.Context(nil)
+	app.Tomb.Go(func() error {
+		if _, err := app.deliver(ctx, newOutgoingSend(outbox, true)); err != nil {
+			app.Log.WithError(err).Warnf("could not deliver "+
+				"outbox message %d", outbox.ID)
+		}
+		return nil
+	})
+}
@@ -0,0 +1,282 @@
+package app
+
+import (
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestResumeOutbox(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddresses := []string{
+		"111111111111111111111111111111111111111111111111111111111111111111",
+		"222222222222222222222222222222222222222222222222222222222222222222",
+		"333333333333333333333333333333333333333333333333333333333333333333",
+	}
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: srcAddress,
+		},
+	}
+
+	// The second part towards the second recipient was never sent.
+	preimage := lntypes.Preimage{1, 2, 3}
+	partPayload := map[uint64][]byte{PayloadTypeKey: []byte("part")}
+
+	hashes := []string{
+		"1111111111111111111111111111111111111111111111111111111111111111",
+		"2222222222222222222222222222222222222222222222222222222222222222",
+		preimage.Hash().String(),
+		"4444444444444444444444444444444444444444444444444444444444444444",
+	}
+
+	outboxMsg := model.OutboxMessage{
+		ID: 3,
+		RawMessage: model.RawMessage{
+			DiscussionID: 1,
+			RawPayload:   []byte("payload"),
+		},
+		AmtMsat: 1000,
+		Attempts: []model.OutboxAttempt{
+			{Recipient: destAddresses[0], Hash: hashes[0]},
+			{Recipient: destAddresses[1], Hash: hashes[1]},
+			{
+				Recipient: destAddresses[1],
+				Part:      1,
+				Hash:      hashes[2],
+				Preimage:  preimage[:],
+				AmtMsat:   10,
+				Payload:   partPayload,
+			},
+			// Never initiated, and stored without its payload.
+			{Recipient: destAddresses[2], Hash: hashes[3]},
+		},
+	}
+
+	trackedPayments := map[string]lnchat.PaymentUpdate{
+		hashes[0]: {
+			Payment: &lnchat.Payment{
+				Hash:         hashes[0],
+				Status:       lnchat.PaymentSUCCEEDED,
+				PaymentIndex: 7,
+			},
+		},
+		hashes[1]: {
+			Payment: &lnchat.Payment{
+				Hash:         hashes[1],
+				Status:       lnchat.PaymentSUCCEEDED,
+				PaymentIndex: 8,
+			},
+		},
+		hashes[2]: {
+			Err: lnchat.ErrPaymentNotFound,
+		},
+		hashes[3]: {
+			Err: lnchat.ErrPaymentNotFound,
+		},
+	}
+	sentPayment := &lnchat.Payment{
+		Hash:         hashes[2],
+		Status:       lnchat.PaymentSUCCEEDED,
+		PaymentIndex: 9,
+	}
+
+	expectedRawMsg := outboxMsg.RawMessage
+	expectedRawMsg.PaymentIndexes = []uint64{7, 8, 9}
+
+	expectedPayments := []interface{}{
+		&model.Payment{
+			PayerAddress: srcAddress,
+			PayeeAddress: destAddresses[0],
+			Payment:      *trackedPayments[hashes[0]].Payment,
+		},
+		&model.Payment{
+			PayerAddress: srcAddress,
+			PayeeAddress: destAddresses[1],
+			Payment:      *trackedPayments[hashes[1]].Payment,
+		},
+		&model.Payment{
+			PayerAddress: srcAddress,
+			PayeeAddress: destAddresses[1],
+			Payment:      *sentPayment,
+		},
+	}
+
+	completed := make(chan struct{})
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		mockDB.On("GetOutboxMessages").Return(
+			[]model.OutboxMessage{outboxMsg}, nil).Once()
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		for hash, update := range trackedPayments {
+			updates := make(chan lnchat.PaymentUpdate, 1)
+			updates <- update
+			close(updates)
+
+			mockLNManager.On("TrackPayment", mock.Anything, hash,
+				mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(
+				(<-chan lnchat.PaymentUpdate)(updates), nil).Once()
+		}
+
+		sentUpdates := make(chan lnchat.PaymentUpdate, 1)
+		sentUpdates <- lnchat.PaymentUpdate{Payment: sentPayment}
+		close(sentUpdates)
+		mockLNManager.On("SendPayment", mock.Anything, destAddresses[1],
+			lnchat.NewAmount(10), "", &preimage, lnchat.PaymentOptions{},
+			partPayload, mock.AnythingOfType("func(*lnchat.Payment) bool")).Return(
+			(<-chan lnchat.PaymentUpdate)(sentUpdates), nil).Once()
+
+		mockDB.On("CompleteOutboxMessage", append([]interface{}{
+			outboxMsg.ID, model.SendResult{
+				DiscussionID: outboxMsg.RawMessage.DiscussionID,
+				Status:       model.MessageSUCCEEDED,
+			}, &expectedRawMsg}, expectedPayments...)...).Return(nil).Once().Run(
+			func(mock.Arguments) {
+				close(completed)
+			})
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	_, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	select {
+	case <-completed:
+	case <-time.After(defaultTimeout):
+		assert.Fail(t, "outbox message was not completed")
+	}
+}
+
+func TestCompleteOutboxMessage(t *testing.T) {
+	destAddresses := []string{
+		"111111111111111111111111111111111111111111111111111111111111111111",
+		"222222222222222222222222222222222222222222222222222222222222222222",
+	}
+
+	payment := func(recipient string, idx uint64,
+		status lnchat.PaymentStatus) *model.Payment {
+
+		return &model.Payment{
+			PayeeAddress: recipient,
+			Payment: lnchat.Payment{
+				Status:       status,
+				PaymentIndex: idx,
+			},
+		}
+	}
+
+	// The message is sent in two parts to each recipient.
+	outboxMsg := &model.OutboxMessage{
+		ID:         3,
+		RawMessage: model.RawMessage{DiscussionID: 1},
+		Attempts: []model.OutboxAttempt{
+			{Recipient: destAddresses[0]},
+			{Recipient: destAddresses[0], Part: 1},
+			{Recipient: destAddresses[1]},
+			{Recipient: destAddresses[1], Part: 1},
+		},
+	}
+
+	cases := []struct {
+		name              string
+		payments          []*model.Payment
+		expectedIndexes   []uint64
+		expectedDelivered int
+	}{
+		{
+			name: "Delivered to some recipients",
+			payments: []*model.Payment{
+				payment(destAddresses[0], 1, lnchat.PaymentSUCCEEDED),
+				payment(destAddresses[0], 2, lnchat.PaymentSUCCEEDED),
+				payment(destAddresses[1], 3, lnchat.PaymentSUCCEEDED),
+				payment(destAddresses[1], 4, lnchat.PaymentFAILED),
+			},
+			expectedIndexes:   []uint64{1, 2},
+			expectedDelivered: 2,
+		},
+		{
+			name: "Only first parts delivered",
+			payments: []*model.Payment{
+				payment(destAddresses[0], 1, lnchat.PaymentSUCCEEDED),
+				payment(destAddresses[1], 2, lnchat.PaymentSUCCEEDED),
+				payment(destAddresses[1], 3, lnchat.PaymentFAILED),
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var expectedMsg *model.RawMessage
+			result := model.SendResult{
+				DiscussionID: outboxMsg.RawMessage.DiscussionID,
+				Status:       model.MessageSUCCEEDED,
+			}
+			switch c.expectedIndexes {
+			case nil:
+				result.Status = model.MessageFAILED
+				result.FailureReason = "failed to send message: payment to " +
+					c.payments[len(c.payments)-1].PayeeAddress +
+					" failed: unknown failure reason"
+			default:
+				expectedMsg = &model.RawMessage{
+					DiscussionID:   outboxMsg.RawMessage.DiscussionID,
+					PaymentIndexes: c.expectedIndexes,
+				}
+			}
+
+			args := []interface{}{outboxMsg.ID, result, expectedMsg}
+			for _, p := range c.payments {
+				args = append(args, p)
+			}
+			mockDB := new(dbmock.Database)
+			mockDB.On("CompleteOutboxMessage", args...).Return(nil).Once()
+
+			app, err := New(new(lnmock.LightManager), mockDB)
+			assert.NoError(t, err)
+
+			rawMsg, delivered, err := app.completeOutboxMessage(outboxMsg,
+				c.payments, nil)
+			assert.Equal(t, expectedMsg, rawMsg)
+			assert.Len(t, delivered, c.expectedDelivered)
+			if c.expectedIndexes == nil {
+				assert.EqualError(t, err, result.FailureReason)
+			} else {
+				assert.NoError(t, err)
+			}
+			mockDB.AssertExpectations(t)
+		})
+	}
+}
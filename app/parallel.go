@@ -0,0 +1,30 @@
+package app
+
+import "sync"
+
+// forEachRecipient calls fn once for each of the provided recipients,
+// running at most app.maxParallelism calls concurrently.
+// It returns after all calls have returned.
+// The index of the recipient is passed to fn, so that results
+// can be collected without additional synchronization.
+func (app *App) forEachRecipient(recipients []string, fn func(int, string)) {
+	limit := app.maxParallelism
+	if limit < 1 {
+		limit = 1
+	}
+
+	sem := make(chan struct{}, limit)
+	var wg sync.WaitGroup
+	for i, recipient := range recipients {
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(i int, recipient string) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			fn(i, recipient)
+		}(i, recipient)
+	}
+	wg.Wait()
+}
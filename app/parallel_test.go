@@ -0,0 +1,74 @@
+package app
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestForEachRecipient(t *testing.T) {
+	cases := []struct {
+		name           string
+		maxParallelism int
+		recipients     int
+	}{
+		{
+			name:           "Sequential",
+			maxParallelism: 1,
+			recipients:     5,
+		},
+		{
+			name:           "Bounded",
+			maxParallelism: 3,
+			recipients:     10,
+		},
+		{
+			name:           "Unbounded",
+			maxParallelism: 20,
+			recipients:     10,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			app, err := New(nil, nil, WithMaxParallelism(c.maxParallelism))
+			require.NoError(t, err)
+
+			recipients := make([]string, c.recipients)
+			for i := range recipients {
+				recipients[i] = fmt.Sprintf("recipient-%d", i)
+			}
+
+			var mtx sync.Mutex
+			var running, maxRunning int
+			results := make([]string, len(recipients))
+			app.forEachRecipient(recipients, func(i int, recipient string) {
+				mtx.Lock()
+				running++
+				if running > maxRunning {
+					maxRunning = running
+				}
+				mtx.Unlock()
+
+				time.Sleep(10 * time.Millisecond)
+				results[i] = recipient
+
+				mtx.Lock()
+				running--
+				mtx.Unlock()
+			})
+
+			assert.Equal(t, recipients, results)
+			assert.LessOrEqual(t, maxRunning, c.maxParallelism)
+		})
+	}
+}
+
+func TestWithMaxParallelism(t *testing.T) {
+	_, err := New(nil, nil, WithMaxParallelism(0))
+	assert.EqualError(t, err, "invalid maximum parallelism 0")
+}
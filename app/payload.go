@@ -21,37 +21,140 @@ const (
 	SenderTypeKey
 	// SignatureTypeKey is the key of the sender signature.
 	SignatureTypeKey
+	// EncryptedPayloadTypeKey is the key of the payload,
+	// when encrypted end-to-end. It replaces PayloadTypeKey.
+	EncryptedPayloadTypeKey
+	// FragmentTypeKey is the key of the fragment header,
+	// present on each part of a multi-part message.
+	FragmentTypeKey
+	// ReceiptTypeKey is the key of a delivery or read receipt.
+	// Payments carrying a receipt carry no payload.
+	ReceiptTypeKey
+	// PresenceTypeKey is the key of a presence indication.
+	// It is carried by HTLCs that are failed by their recipient.
+	PresenceTypeKey
 )
 
 // payloadExtractor extracts a RawMessage from an Invoice.
+// Encrypted payloads are decrypted with payloadDecrypter,
+// which is provided the encrypted payload and the sender address.
 func payloadExtractor(inv *lnchat.Invoice,
 	signatureVerifier func([]byte, []byte, string) (bool, error),
+	payloadDecrypter func([]byte, string) ([]byte, error),
 ) (*model.RawMessage, error) {
-	rawMsg := new(model.RawMessage)
+	customRecords := invoiceCustomRecords(inv)
+	if customRecords == nil {
+		return nil, fmt.Errorf("no payload present on invoice "+
+			"with hash %s", inv.Hash)
+	}
+
+	rawMsg, err := unmarshalPayload(customRecords,
+		signatureVerifier, payloadDecrypter)
+	if err != nil {
+		return nil, err
+	}
 
-	var customRecords map[uint64][]byte
+	rawMsg.InvoiceSettleIndex = inv.SettleIndex
+
+	return rawMsg, nil
+}
+
+// invoiceCustomRecords returns the custom records
+// of the first settled HTLC of an invoice carrying any.
+func invoiceCustomRecords(inv *lnchat.Invoice) map[uint64][]byte {
 	for _, htlc := range inv.Htlcs {
 		if htlc.State == lnrpc.InvoiceHTLCState_SETTLED &&
 			len(htlc.CustomRecords) != 0 {
-			customRecords = htlc.CustomRecords
-			break
+			return htlc.CustomRecords
 		}
 	}
+
+	return nil
+}
+
+// paymentPayloadExtractor extracts a RawMessage from an outgoing Payment.
+// The payload is extracted from the custom records
+// of the final hop of a successful HTLC.
+// Encrypted payloads are decrypted with payloadDecrypter.
+func paymentPayloadExtractor(payment *lnchat.Payment,
+	signatureVerifier func([]byte, []byte, string) (bool, error),
+	payloadDecrypter func([]byte, string) ([]byte, error),
+) (*model.RawMessage, error) {
+	customRecords := paymentCustomRecords(payment)
 	if customRecords == nil {
-		return nil, fmt.Errorf("no payload present on invoice "+
-			"with hash %s", inv.Hash)
+		return nil, fmt.Errorf("no payload present on payment "+
+			"with hash %s", payment.Hash)
 	}
 
-	if payload, ok := customRecords[PayloadTypeKey]; ok {
-		rawMsg.RawPayload = payload
+	rawMsg, err := unmarshalPayload(customRecords,
+		signatureVerifier, payloadDecrypter)
+	if err != nil {
+		return nil, err
 	}
 
+	rawMsg.WithPaymentIndexes(payment.PaymentIndex)
+
+	return rawMsg, nil
+}
+
+// paymentCustomRecords returns the custom records of the final hop
+// of the first successful HTLC of a payment carrying a payload.
+func paymentCustomRecords(payment *lnchat.Payment) map[uint64][]byte {
+	for _, htlc := range payment.Htlcs {
+		hops := htlc.Route.Hops
+		if htlc.Status != lnrpc.HTLCAttempt_SUCCEEDED || len(hops) == 0 {
+			continue
+		}
+		finalHop := hops[len(hops)-1]
+		if hasPayload(finalHop.CustomRecords) {
+			return finalHop.CustomRecords
+		}
+	}
+
+	return nil
+}
+
+// hasPayload returns whether the custom records of an HTLC
+// contain a (possibly encrypted) payload.
+func hasPayload(customRecords map[uint64][]byte) bool {
+	_, plain := customRecords[PayloadTypeKey]
+	_, encrypted := customRecords[EncryptedPayloadTypeKey]
+
+	return plain || encrypted
+}
+
+// unmarshalPayload creates a RawMessage from the wire payload
+// (the custom records of an HTLC), decrypting it if encrypted
+// and verifying its signature.
+func unmarshalPayload(customRecords map[uint64][]byte,
+	signatureVerifier func([]byte, []byte, string) (bool, error),
+	payloadDecrypter func([]byte, string) ([]byte, error),
+) (*model.RawMessage, error) {
+	rawMsg := new(model.RawMessage)
+
 	if sender, ok := customRecords[SenderTypeKey]; ok {
 		senderAddr, err := lnchat.NewNodeFromBytes(sender)
 		if err != nil {
 			return nil, err
 		}
-		rawMsg.Sender = senderAddr.String()
+		rawMsg.Sender = senderAddr
+	}
+
+	if payload, ok := customRecords[PayloadTypeKey]; ok {
+		rawMsg.RawPayload = payload
+	}
+
+	if sealed, ok := customRecords[EncryptedPayloadTypeKey]; ok {
+		if rawMsg.Sender.IsZero() {
+			return nil, fmt.Errorf("cannot decrypt payload " +
+				"without sender address")
+		}
+		payload, err := payloadDecrypter(sealed, rawMsg.SenderAddress())
+		if err != nil {
+			return nil, fmt.Errorf("cannot decrypt message payload: %w", err)
+		}
+		rawMsg.RawPayload = payload
+		rawMsg.Encrypted = true
 	}
 
 	if signature, ok := customRecords[SignatureTypeKey]; ok {
@@ -59,14 +162,16 @@ func payloadExtractor(inv *lnchat.Invoice,
 	}
 
 	switch verified, err := signatureVerifier(rawMsg.RawPayload,
-		rawMsg.Signature, rawMsg.Sender); err {
+		rawMsg.Signature, rawMsg.SenderAddress()); err {
 	case nil:
 		rawMsg.SignatureVerified = verified
 	default:
 		return nil, fmt.Errorf("cannot verify message signature: %w", err)
 	}
 
-	rawMsg.InvoiceSettleIndex = inv.SettleIndex
+	// Malformed payloads are rejected when the message is stored,
+	// so message references are populated only for well-formed ones.
+	_ = rawMsg.WithPayloadReferences()
 
 	return rawMsg, nil
 }
@@ -83,13 +188,7 @@ func marshalPayload(rawMsg *model.RawMessage) map[uint64][]byte {
 	}
 
 	if rawMsg.Signature != nil {
-		// TODO: If the Sender field type is changed,
-		// the following panic statement can be removed.
-		addr, err := lnchat.NewNodeFromString(rawMsg.Sender)
-		if err != nil {
-			panic(fmt.Errorf("Error during sender address encoding: %w", err))
-		}
-		payload[SenderTypeKey] = addr.Bytes()
+		payload[SenderTypeKey] = rawMsg.Sender.Bytes()
 		payload[SignatureTypeKey] = rawMsg.Signature
 	}
 
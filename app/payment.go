@@ -15,13 +15,51 @@ import (
 // SendPayment attempts to send a payment.
 // A payment fulfils the payment request, if one is provided,
 // otherwise it is addressed to the discussion participants.
-// If payload is present, it is sent along with the payment
-// (respecting the provided and discussion options, if applicable).
+// The message content is sent along with the payment
+// (respecting the provided and discussion options, if applicable);
+// besides text messages and replies, it can be an edit or delete request
+// of a previous message of ours, or a reaction to a message.
 func (app *App) SendPayment(ctx context.Context,
-	payload string, amtMsat int64, discID uint64, payReq string,
+	content model.MessageContent, amtMsat int64, discID uint64, payReq string,
 	opts model.MessageOptions) (*model.Message, error) {
 
-	return app.sendPayment(ctx, payload, amtMsat, discID, payReq, opts)
+	return app.sendPayment(ctx, content, amtMsat, discID, payReq, opts)
+}
+
+// SendPaymentAsync is the non-blocking variant of SendPayment.
+// It returns as soon as the message is accepted for sending,
+// with a pending status update identifying the send operation.
+// Subsequent status updates are published to message status subscribers.
+func (app *App) SendPaymentAsync(ctx context.Context,
+	content model.MessageContent, amtMsat int64, discID uint64, payReq string,
+	opts model.MessageOptions) (*model.MessageStatusUpdate, error) {
+
+	if app.Tomb == nil || !app.Tomb.Alive() {
+		return nil, fmt.Errorf("application is not running")
+	}
+
+	send, err := app.prepareSend(ctx, content, amtMsat, discID, payReq, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	pending := &model.MessageStatusUpdate{
+		SendID:       send.outbox.ID,
+		DiscussionID: send.outbox.RawMessage.DiscussionID,
+		Status:       model.MessagePENDING,
+	}
+	app.publishStatus(pending)
+
+	tombCtx := app.Tomb.Context(nil)
+	app.Tomb.Go(func() error {
+		if _, err := app.deliver(tombCtx, send); err != nil {
+			app.Log.WithError(err).Warnf("asynchronous send %d "+
+				"failed", send.outbox.ID)
+		}
+		return nil
+	})
+
+	return pending, nil
 }
 
 // defaultPaymentFilter is a payment update filter,
@@ -31,10 +69,55 @@ func defaultPaymentFilter(p *lnchat.Payment) bool {
 		p.Status == lnchat.PaymentFAILED
 }
 
+// outgoingSend contains everything needed
+// for delivering a message stored in the outbox.
+type outgoingSend struct {
+	outbox     *model.OutboxMessage
+	recipients []string
+	// The ordered attempts carrying the message parts to each recipient.
+	attempts map[string][]*model.OutboxAttempt
+	// Whether delivery is resumed, in which case
+	// some payments may have already been initiated.
+	resumed bool
+}
+
+// newOutgoingSend returns the delivery of an outbox message,
+// to the recipients of its attempts in order.
+func newOutgoingSend(outbox *model.OutboxMessage, resumed bool) *outgoingSend {
+	send := &outgoingSend{
+		outbox:   outbox,
+		attempts: make(map[string][]*model.OutboxAttempt),
+		resumed:  resumed,
+	}
+	for i := range outbox.Attempts {
+		attempt := &outbox.Attempts[i]
+		if _, ok := send.attempts[attempt.Recipient]; !ok {
+			send.recipients = append(send.recipients, attempt.Recipient)
+		}
+		send.attempts[attempt.Recipient] = append(
+			send.attempts[attempt.Recipient], attempt)
+	}
+
+	return send
+}
+
 func (app *App) sendPayment(ctx context.Context,
-	payload string, amtMsat int64, discID uint64, payReq string,
+	content model.MessageContent, amtMsat int64, discID uint64, payReq string,
 	opts model.MessageOptions) (*model.Message, error) {
 
+	send, err := app.prepareSend(ctx, content, amtMsat, discID, payReq, opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return app.deliver(ctx, send)
+}
+
+// prepareSend creates the raw message to be sent and stores it in the outbox.
+func (app *App) prepareSend(ctx context.Context,
+	content model.MessageContent, amtMsat int64, discID uint64, payReq string,
+	opts model.MessageOptions) (*outgoingSend, error) {
+
 	// Exactly one of discussion and payment request must be defined.
 	if payReq != "" && discID != 0 {
 		return nil, fmt.Errorf("exactly one of payment request" +
@@ -73,21 +156,18 @@ func (app *App) sendPayment(ctx context.Context,
 	options := overrideOptions(DefaultOptions, true, discussion.Options, opts)
 	payOpts := options.GetPaymentOptions()
 
-	// Disallow anonymous messages in group discussions
-	if len(discussion.Participants) > 1 && options.Anonymous {
-		return nil, ErrDiscAnonymousMessage
+	if err := validateOptions(discussion, options, content); err != nil {
+		return nil, err
 	}
 
 	// NOTE: Currently, sending a payment means creating a message
 	// even if the payload is empty ("").
 
-	rawMsg, err := app.createRawMessage(ctx, discussion, payload, !options.Anonymous)
+	rawMsg, err := app.createRawMessage(ctx, discussion, content, !options.Anonymous)
 	if err != nil {
 		return nil, err
 	}
 
-	paymentPayload := marshalPayload(rawMsg)
-
 	// Unified logic for payReq and spontaneous payment
 	// (possibly to multiple recipients), due to handling
 	// recipient and payReq compatibility in lnchat.
@@ -99,70 +179,237 @@ func (app *App) sendPayment(ctx context.Context,
 		recipients = []string{payRequest.Destination.String()}
 	}
 
+	payloads, err := app.recipientPayloads(ctx, rawMsg,
+		recipients, options.Encrypted)
+	if err != nil {
+		return nil, err
+	}
+
+	// Persist the message in the outbox before initiating any payment,
+	// so that in-flight payments can be tracked and the remaining
+	// parts can be sent in case of interruption.
+	outbox := &model.OutboxMessage{
+		RawMessage:     *rawMsg,
+		AmtMsat:        amtMsat,
+		PayReq:         payReq,
+		PaymentOptions: payOpts,
+	}
+	outbox.RawMessage.Encrypted = options.Encrypted
+
+	// Split oversized payloads into parts sent as separate payments.
+	for _, recipient := range recipients {
+		payloadParts, err := splitPayload(payloads[recipient])
+		if err != nil {
+			return nil, err
+		}
+		if payReq != "" && len(payloadParts) > 1 {
+			return nil, fmt.Errorf("%w: a multi-part message cannot "+
+				"be sent to a payment request", ErrPayloadTooLarge)
+		}
+		amts, err := partAmounts(amtMsat, len(payloadParts))
+		if err != nil {
+			return nil, err
+		}
+		for part, payload := range payloadParts {
+			attempt, err := newOutboxAttempt(recipient, part, payRequest)
+			if err != nil {
+				return nil, err
+			}
+			attempt.AmtMsat, attempt.Payload = amts[part], payload
+			outbox.Attempts = append(outbox.Attempts, *attempt)
+		}
+	}
+
+	if err := app.Database.AddOutboxMessage(outbox); err != nil {
+		return nil, errors.Wrap(err, "outbox storage failed")
+	}
+
+	return newOutgoingSend(outbox, false), nil
+}
+
+// deliver sends the payments of an outbox message to its recipients,
+// publishing status updates as they are resolved,
+// and stores the message once they are all resolved.
+// Payments to different recipients are sent concurrently.
+func (app *App) deliver(ctx context.Context, send *outgoingSend) (*model.Message, error) {
+	outbox := send.outbox
+
 	// Send payments and retrieve final updates.
+	// Results are collected per recipient, preserving recipient order.
+	results := make([][]*model.Payment, len(send.recipients))
+	resultErrs := make([]error, len(send.recipients))
+	app.forEachRecipient(send.recipients, func(i int, recipient string) {
+		results[i], resultErrs[i] = app.deliverToRecipient(ctx, send, recipient)
+	})
+
+	if ctx.Err() != nil {
+		// The payments may still be resolved,
+		// so keep tracking them in the background.
+		app.resumeOutboxMessage(outbox)
+		return nil, fmt.Errorf("message sending interrupted, "+
+			"payments will be tracked in the background: %w", ctx.Err())
+	}
+
 	var errs []error
 	var payments []*model.Payment
-	for _, recipient := range recipients {
-		paymentUpdates, err := app.LNManager.SendPayment(ctx,
-			recipient, lnchat.NewAmount(amtMsat), payReq, payOpts,
-			paymentPayload, defaultPaymentFilter)
-		if err != nil {
-			errs = append(errs, fmt.Errorf("could not "+
-				"initiate payment to %s: %w", recipient, err))
-			continue
+	for i := range send.recipients {
+		if resultErrs[i] != nil {
+			errs = append(errs, resultErrs[i])
+		}
+		// Payments of partially delivered messages are stored as well.
+		payments = append(payments, results[i]...)
+	}
+
+	return app.finalizeSend(outbox, payments, errs)
+}
+
+// deliverToRecipient sends the parts of an outbox message to a recipient
+// in order, stopping at the first part that is not delivered.
+// The resolved payments are returned, along with an error
+// if the message could not be delivered.
+// On interruption, no error is returned and the caller is responsible
+// for handling the context error.
+func (app *App) deliverToRecipient(ctx context.Context, send *outgoingSend,
+	recipient string) ([]*model.Payment, error) {
+
+	outbox := send.outbox
+	parts := send.attempts[recipient]
+
+	var payments []*model.Payment
+	for i, attempt := range parts {
+		payment, err := app.sendPart(ctx, send, attempt)
+		switch {
+		case ctx.Err() != nil:
+			return payments, nil
+		case err != nil:
+			err = fmt.Errorf("payment error for "+
+				"recipient %s: %w", recipient, err)
+			app.publishRecipientFailure(outbox, recipient, err.Error())
+			return payments, err
 		}
 
-		// NOTE: Waiting for updates can be handled concurrently.
-		update := <-paymentUpdates
+		payments = append(payments, &model.Payment{
+			PayerAddress: app.Self.Node.Address,
+			PayeeAddress: recipient,
+			Payment:      *payment,
+		})
+
 		switch {
-		case update.Err != nil:
-			errs = append(errs, fmt.Errorf("payment error "+
-				"for recipient %s: %w", recipient, update.Err))
-		case update.Payment != nil:
-			payments = append(payments, &model.Payment{
-				PayerAddress: app.Self.Node.Address,
-				PayeeAddress: recipient,
-				Payment:      *update.Payment,
-			})
+		case i == len(parts)-1:
+			app.publishPaymentStatus(outbox, recipient, payment)
+		case payment.Status != lnchat.PaymentSUCCEEDED:
+			err := fmt.Errorf("payment of part %d of %d to %s "+
+				"failed: %s", i+1, len(parts), recipient,
+				paymentFailureReason(payment))
+			app.publishRecipientFailure(outbox, recipient, err.Error())
+			return payments, err
 		}
 	}
 
-	// Associate only successful payments with the message.
-	for _, payment := range payments {
-		if payment.Status == lnchat.PaymentSUCCEEDED {
-			rawMsg.WithPaymentIndexes(payment.PaymentIndex)
+	return payments, nil
+}
+
+// sendPart sends the payment carrying a message part
+// and waits for its final update.
+// When delivery is resumed, the payment is tracked instead,
+// unless it was never initiated.
+func (app *App) sendPart(ctx context.Context, send *outgoingSend,
+	attempt *model.OutboxAttempt) (*lnchat.Payment, error) {
+
+	outbox := send.outbox
+	if send.resumed && attempt.Hash != "" {
+		updates, err := app.LNManager.TrackPayment(ctx,
+			attempt.Hash, trackingPaymentFilter)
+		if err != nil {
+			return nil, err
+		}
+
+		payment, err := app.awaitPayment(ctx, outbox,
+			attempt.Recipient, attempt.Part, updates)
+		if !errors.Is(err, lnchat.ErrPaymentNotFound) {
+			return payment, err
 		}
 	}
 
-	// Save all payments (irrespective of status).
-	if err := app.Database.AddPayments(payments...); err != nil {
-		return nil, errors.Wrap(err, "payment storage failed")
+	// Parts stored by earlier versions cannot be sent.
+	if attempt.Payload == nil {
+		return nil, fmt.Errorf("part %d was never sent", attempt.Part+1)
 	}
 
-	// Store raw message, if it has associated payments
-	if len(rawMsg.PaymentIndexes) <= 0 {
-		if err := newCompositeError(errs); err != nil {
-			return nil, fmt.Errorf("failed to send message: %w", err)
-		}
-		return nil, fmt.Errorf("failed to send message")
+	preimage, err := attemptPreimage(attempt)
+	if err != nil {
+		return nil, err
 	}
 
-	if err := app.Database.AddRawMessage(rawMsg); err != nil {
-		return nil, errors.Wrap(err, "message storage failed")
+	updates, err := app.LNManager.SendPayment(ctx, attempt.Recipient,
+		lnchat.NewAmount(attempt.AmtMsat), outbox.PayReq, preimage,
+		outbox.PaymentOptions, attempt.Payload, trackingPaymentFilter)
+	if err != nil {
+		return nil, fmt.Errorf("could not initiate payment: %w", err)
 	}
 
-	msg, err := model.NewOutgoingMessage(rawMsg, true, payments...)
+	return app.awaitPayment(ctx, outbox, attempt.Recipient, attempt.Part, updates)
+}
+
+// finalizeSend completes an outbox message, publishing
+// the final message status update and returning the sent message.
+func (app *App) finalizeSend(outbox *model.OutboxMessage,
+	payments []*model.Payment, errs []error) (*model.Message, error) {
+
+	msg, err := func() (*model.Message, error) {
+		// Store payments and raw message, if it was delivered.
+		rawMsg, delivered, err := app.completeOutboxMessage(outbox, payments, errs)
+		if err != nil {
+			return nil, err
+		}
+
+		msg, err := model.NewOutgoingMessage(rawMsg, true, delivered...)
+		if err != nil {
+			return nil, errors.Wrap(err, "message marshalling failed")
+		}
+
+		return msg, nil
+	}()
+
+	update := &model.MessageStatusUpdate{
+		SendID:       outbox.ID,
+		DiscussionID: outbox.RawMessage.DiscussionID,
+		Status:       model.MessageSUCCEEDED,
+		Message:      msg,
+	}
 	if err != nil {
-		return nil, errors.Wrap(err, "message marshalling failed")
+		update.Status = model.MessageFAILED
+		update.FailureReason = err.Error()
+	}
+	app.publishStatus(update)
+
+	return msg, err
+}
+
+// validateOptions checks that the message options
+// are compatible with the discussion and the message content.
+func validateOptions(discussion *model.Discussion,
+	options model.MessageOptions, content model.MessageContent) error {
+
+	switch {
+	// Disallow anonymous messages in group discussions
+	case len(discussion.Participants) > 1 && options.Anonymous:
+		return ErrDiscAnonymousMessage
+	// Disallow anonymous encrypted messages
+	case options.Anonymous && options.Encrypted:
+		return ErrDiscAnonymousEncrypted
+	// Disallow anonymous edits, delete requests and reactions
+	case options.Anonymous && content.ContentType.HasTarget():
+		return ErrAnonymousAnnotation
 	}
 
-	return msg, nil
+	return nil
 }
 
 func (app *App) createRawMessage(ctx context.Context, discussion *model.Discussion,
-	payload string, withSig bool) (*model.RawMessage, error) {
+	content model.MessageContent, withSig bool) (*model.RawMessage, error) {
 
-	rawMsg, err := model.NewRawMessage(discussion, payload)
+	rawMsg, err := model.NewRawMessage(discussion, content)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create raw message")
 	}
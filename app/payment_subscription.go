@@ -0,0 +1,452 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+)
+
+// paymentPollInterval is the interval between
+// successive retrievals of outgoing payments.
+var paymentPollInterval = 10 * time.Second
+
+// paymentPageSize is the maximum number of payments
+// retrieved in a single request.
+const paymentPageSize = 100
+
+// outgoingSetTimeout is the time after the first payment
+// of an incomplete multi-part or group message, after which
+// its payments are stored without waiting for the remaining ones,
+// which were most likely never made.
+var outgoingSetTimeout = 10 * time.Minute
+
+// subscribePayments periodically retrieves the outgoing payments
+// of the underlying node after lastPaymentIdx, storing the successful ones
+// along with any messages they carry. This allows messages sent from
+// the same node by other means (another c13n instance, a different client
+// or before a database restore) to appear in discussion history.
+// Retrieval does not advance past the first unresolved payment,
+// so that payments are only processed once they are resolved.
+// The payments of multi-part and group messages may be retrieved
+// over several requests, so storage does not advance past the first
+// payment of a message whose payments were not all retrieved,
+// holding it and the following payments until the message is complete.
+func (app *App) subscribePayments(ctx context.Context, lastPaymentIdx uint64) error {
+	// The retrieved successful payments not yet stored.
+	var held []lnchat.Payment
+	for {
+		payments, err := app.LNManager.ListPayments(ctx,
+			lastPaymentIdx, paymentPageSize)
+		switch {
+		case ctx.Err() != nil:
+			// If the context is finished, terminate
+			return nil
+		case err != nil:
+			return fmt.Errorf("payment retrieval failed: %w", err)
+		}
+
+		resolved := resolvedPayments(payments)
+		if len(resolved) != 0 {
+			lastPaymentIdx = resolved[len(resolved)-1].PaymentIndex
+		}
+
+		held = append(held, succeededPayments(resolved)...)
+		if len(held) != 0 {
+			msgs, stored := app.storeOutgoingPayments(ctx, held, false)
+			for _, msg := range msgs {
+				if err := app.publishMessage(msg); err != nil {
+					app.Log.WithError(err).Error("message publish failed")
+				}
+			}
+			held = append([]lnchat.Payment(nil), held[stored:]...)
+		}
+
+		// If more payments are available, retrieve them immediately,
+		// unless an unresolved payment was encountered.
+		if len(payments) == paymentPageSize && len(resolved) == len(payments) {
+			continue
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(paymentPollInterval):
+		}
+	}
+}
+
+// resolvedPayments returns the payments preceding
+// the first unresolved (in-flight or unknown) payment.
+func resolvedPayments(payments []lnchat.Payment) []lnchat.Payment {
+	for i, payment := range payments {
+		switch payment.Status {
+		case lnchat.PaymentSUCCEEDED, lnchat.PaymentFAILED:
+		default:
+			return payments[:i]
+		}
+	}
+
+	return payments
+}
+
+// succeededPayments returns the successful payments of a payment list.
+func succeededPayments(payments []lnchat.Payment) []lnchat.Payment {
+	succeeded := make([]lnchat.Payment, 0, len(payments))
+	for _, payment := range payments {
+		if payment.Status == lnchat.PaymentSUCCEEDED {
+			succeeded = append(succeeded, payment)
+		}
+	}
+
+	return succeeded
+}
+
+// paymentRecipient returns the address of the recipient of a payment,
+// as the final hop of its first successful HTLC (empty if none exists).
+func paymentRecipient(payment *lnchat.Payment) string {
+	for _, htlc := range payment.Htlcs {
+		hops := htlc.Route.Hops
+		if htlc.Status == lnrpc.HTLCAttempt_SUCCEEDED && len(hops) != 0 {
+			return hops[len(hops)-1].NodeID.String()
+		}
+	}
+
+	return ""
+}
+
+// outgoingPaymentGroup contains the payments of an outgoing message.
+type outgoingPaymentGroup struct {
+	rawMsg   *model.RawMessage
+	payments []*model.Payment
+	// The positions of the payments in the list of processed payments.
+	positions []int
+	// Whether payments of the message may not have been processed yet.
+	incomplete bool
+}
+
+// add adds payments to the group, along with their positions.
+func (g *outgoingPaymentGroup) add(positions []int, payments ...*model.Payment) {
+	g.positions = append(g.positions, positions...)
+	g.payments = append(g.payments, payments...)
+}
+
+// span returns the first and last position of the payments of the group.
+func (g *outgoingPaymentGroup) span() (first, last int) {
+	first, last = g.positions[0], g.positions[0]
+	for _, pos := range g.positions[1:] {
+		if pos < first {
+			first = pos
+		}
+		if pos > last {
+			last = pos
+		}
+	}
+
+	return first, last
+}
+
+// missingRecipients returns whether the group message was not paid
+// to all participants of its discussion (as is the case
+// until all payments of a group message are processed).
+func (g *outgoingPaymentGroup) missingRecipients() bool {
+	if g.rawMsg == nil {
+		return false
+	}
+	_, participants, err := g.rawMsg.UnmarshalPayload()
+	if err != nil {
+		return false
+	}
+
+	paid := make(map[string]struct{}, len(g.payments))
+	for _, payment := range g.payments {
+		paid[payment.PayeeAddress] = struct{}{}
+	}
+	for _, participant := range participants {
+		if _, ok := paid[participant]; !ok {
+			return true
+		}
+	}
+
+	return false
+}
+
+// stale returns whether the first payment of the group
+// was created before the provided time.
+func (g *outgoingPaymentGroup) stale(before time.Time) bool {
+	for _, payment := range g.payments {
+		if time.Unix(0, payment.CreationTimeNs).Before(before) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// outgoingFragments contains the parts of an outgoing multi-part message
+// towards a recipient, along with the payments carrying them.
+type outgoingFragments struct {
+	recipient string
+	parts     []map[uint64][]byte
+	payments  []*model.Payment
+	positions []int
+}
+
+// missing returns whether any part of the message is missing.
+func (f *outgoingFragments) missing() bool {
+	for _, part := range f.parts {
+		if part == nil {
+			return true
+		}
+	}
+
+	return false
+}
+
+// reassemble returns the raw message carried by the parts.
+func (f *outgoingFragments) reassemble(
+	signatureVerifier func([]byte, []byte, string) (bool, error),
+	payloadDecrypter func([]byte, string) ([]byte, error),
+) (*model.RawMessage, error) {
+	for i, part := range f.parts {
+		if part == nil {
+			return nil, fmt.Errorf("message part %d of %d missing",
+				i+1, len(f.parts))
+		}
+	}
+
+	records, err := mergeFragments(f.parts)
+	if err != nil {
+		return nil, err
+	}
+
+	rawMsg, err := unmarshalPayload(records, signatureVerifier, payloadDecrypter)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, payment := range f.payments {
+		rawMsg.WithPaymentIndexes(payment.PaymentIndex)
+	}
+
+	return rawMsg, nil
+}
+
+// storeOutgoingPayments stores outgoing payments not made by the current
+// instance, along with the messages they carry, and returns the messages.
+// Payments carrying the same payload (e.g. a message towards
+// a group discussion) are associated with a single message.
+// Already stored payments are skipped.
+// Unless final is set, the payments are stored up to the first payment
+// of a multi-part or group message whose payments are not all present,
+// unless it is older than outgoingSetTimeout.
+// The number of leading payments processed is returned along with
+// the messages, with the remaining payments left to be stored
+// along with the following payments.
+func (app *App) storeOutgoingPayments(ctx context.Context,
+	payments []lnchat.Payment, final bool) ([]*model.Message, int) {
+
+	// Payments of messages in the outbox are handled on completion.
+	pendingHashes := make(map[string]struct{})
+	outboxMsgs, err := app.Database.GetOutboxMessages()
+	if err != nil {
+		app.Log.WithError(err).Error("outbox retrieval failed")
+		return nil, 0
+	}
+	for _, outbox := range outboxMsgs {
+		for _, attempt := range outbox.Attempts {
+			pendingHashes[attempt.Hash] = struct{}{}
+		}
+	}
+
+	var groups []*outgoingPaymentGroup
+	groupIdx := make(map[string]*outgoingPaymentGroup)
+	addPayments := func(rawMsg *model.RawMessage, incomplete bool,
+		positions []int, payments ...*model.Payment) {
+
+		// Store the payments, even if no payload is present.
+		if rawMsg == nil {
+			group := &outgoingPaymentGroup{incomplete: incomplete}
+			group.add(positions, payments...)
+			groups = append(groups, group)
+			return
+		}
+
+		key := string(rawMsg.RawPayload) + "\x00" + string(rawMsg.Signature)
+		group, ok := groupIdx[key]
+		if !ok {
+			group = &outgoingPaymentGroup{rawMsg: rawMsg}
+			groupIdx[key] = group
+			groups = append(groups, group)
+		} else {
+			group.rawMsg.WithPaymentIndexes(rawMsg.PaymentIndexes...)
+		}
+		group.add(positions, payments...)
+	}
+
+	verifier := func(msg, sig []byte, sender string) (bool, error) {
+		return app.verifySignature(ctx, msg, sig, sender)
+	}
+	// Outgoing payloads are encrypted for the recipient.
+	decrypterFor := func(recipient string) func([]byte, string) ([]byte, error) {
+		return func(sealed []byte, _ string) ([]byte, error) {
+			return app.decryptPayload(ctx, sealed, recipient)
+		}
+	}
+
+	// The parts of multi-part messages, by fragment identifier.
+	var fragmentIDs [][8]byte
+	fragmented := make(map[[8]byte]*outgoingFragments)
+
+	for i := range payments {
+		if _, ok := pendingHashes[payments[i].Hash]; ok {
+			continue
+		}
+
+		recipient := paymentRecipient(&payments[i])
+		payment := &model.Payment{
+			PayerAddress: app.Self.Node.Address,
+			PayeeAddress: recipient,
+			Payment:      payments[i],
+		}
+
+		// Collect the parts of multi-part messages.
+		records := paymentCustomRecords(&payments[i])
+		if headerBytes, ok := records[FragmentTypeKey]; ok {
+			header, err := decodeFragmentHeader(headerBytes)
+			if err != nil {
+				app.Log.WithError(err).Debug("message part extraction failed")
+				addPayments(nil, false, []int{i}, payment)
+				continue
+			}
+
+			fragments, ok := fragmented[header.ID]
+			if !ok {
+				fragments = &outgoingFragments{
+					recipient: recipient,
+					parts:     make([]map[uint64][]byte, header.Total),
+				}
+				fragmented[header.ID] = fragments
+				fragmentIDs = append(fragmentIDs, header.ID)
+			}
+			if int(header.Total) == len(fragments.parts) {
+				fragments.parts[header.Index] = records
+			}
+			fragments.payments = append(fragments.payments, payment)
+			fragments.positions = append(fragments.positions, i)
+			continue
+		}
+
+		// Extract a raw message, if one exists.
+		rawMsg, err := paymentPayloadExtractor(&payments[i],
+			verifier, decrypterFor(recipient))
+		if err != nil {
+			app.Log.WithError(err).Debug("message extraction failed")
+		}
+
+		addPayments(rawMsg, false, []int{i}, payment)
+	}
+
+	// Reassemble multi-part messages whose parts are all present.
+	// The payments of incomplete messages are stored without a message.
+	for _, id := range fragmentIDs {
+		fragments := fragmented[id]
+
+		rawMsg, err := fragments.reassemble(verifier,
+			decrypterFor(fragments.recipient))
+		if err != nil {
+			app.Log.WithError(err).Debug("message reassembly failed")
+		}
+
+		addPayments(rawMsg, fragments.missing(),
+			fragments.positions, fragments.payments...)
+	}
+
+	// Hold the payments from the first payment of an incomplete message,
+	// along with any message with payments after it.
+	stored := len(payments)
+	if !final {
+		staleBefore := time.Now().Add(-outgoingSetTimeout)
+		for _, group := range groups {
+			group.incomplete = (group.incomplete || group.missingRecipients()) &&
+				!group.stale(staleBefore)
+		}
+		for held := true; held; {
+			held = false
+			for _, group := range groups {
+				first, last := group.span()
+				if first < stored && (group.incomplete || last >= stored) {
+					stored, held = first, true
+				}
+			}
+		}
+	}
+
+	var msgs []*model.Message
+	for _, group := range groups {
+		if _, last := group.span(); last >= stored {
+			continue
+		}
+
+		msg, err := app.storeOutgoingPaymentGroup(group)
+		switch {
+		case err != nil:
+			app.Log.WithError(err).Error("outgoing payment storage failed")
+		case msg != nil:
+			msgs = append(msgs, msg)
+		}
+	}
+
+	return msgs, stored
+}
+
+// storeOutgoingPaymentGroup stores the payments of an outgoing message
+// and the message itself, if any. The stored message is returned
+// (nil if the payments carry no message or were already stored).
+func (app *App) storeOutgoingPaymentGroup(group *outgoingPaymentGroup) (*model.Message, error) {
+	err := app.Database.AddPayments(group.payments...)
+	switch {
+	case errors.Is(err, store.ErrDuplicatePayment):
+		return nil, nil
+	case err != nil:
+		return nil, fmt.Errorf("payment storage failed: %w", err)
+	}
+
+	rawMsg := group.rawMsg
+	if rawMsg == nil {
+		return nil, nil
+	}
+
+	// The participant set of an outgoing message
+	// matches that of its discussion.
+	_, participants, err := rawMsg.UnmarshalPayload()
+	if err != nil {
+		return nil, fmt.Errorf("cannot retrieve message participant set: %w", err)
+	}
+
+	disc, err := app.retrieveOrCreateDiscussion(&model.Discussion{
+		Participants: participants,
+		Options:      DefaultOptions,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("discussion retrieval failed: %w", err)
+	}
+
+	rawMsg.DiscussionID = disc.ID
+	if err := app.Database.AddRawMessage(rawMsg); err != nil {
+		return nil, fmt.Errorf("message storage failed: %w", err)
+	}
+
+	msg, err := model.NewOutgoingMessage(rawMsg, true, group.payments...)
+	if err != nil {
+		return nil, fmt.Errorf("message unmarshalling failed: %w", err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,332 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestStoreOutgoingPayments(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+	otherAddress := "222222222222222222222222222222222222222222222222222222222222222222"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: srcAddress,
+		},
+	}
+
+	discussion := &model.Discussion{
+		ID:           3,
+		Participants: []string{destAddress, otherAddress},
+		Options:      DefaultOptions,
+	}
+
+	rawPayload := mustJSONMarshalMessage(t, discussion.Participants, "hello")
+
+	createPayment := func(idx uint64, hash, recipient string,
+		payload map[uint64][]byte) lnchat.Payment {
+
+		node, err := lnchat.NewNodeFromString(recipient)
+		require.NoError(t, err)
+
+		return lnchat.Payment{
+			Hash:           hash,
+			Value:          lnchat.NewAmount(1000),
+			CreationTimeNs: time.Now().UnixNano(),
+			Status:         lnchat.PaymentSUCCEEDED,
+			PaymentIndex:   idx,
+			Htlcs: []lnchat.HTLCAttempt{
+				{
+					Status: lnrpc.HTLCAttempt_SUCCEEDED,
+					Route: lnchat.Route{
+						Amt: lnchat.NewAmount(1000),
+						Hops: []lnchat.RouteHop{
+							{
+								NodeID:        node,
+								AmtToForward:  lnchat.NewAmount(1000),
+								CustomRecords: payload,
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	msgPayload := map[uint64][]byte{PayloadTypeKey: rawPayload}
+	payments := []lnchat.Payment{
+		createPayment(1, "01", destAddress, msgPayload),
+		createPayment(2, "02", otherAddress, msgPayload),
+		createPayment(3, "03", destAddress, msgPayload),
+		createPayment(4, "04", destAddress, nil),
+	}
+
+	toModel := func(p lnchat.Payment, recipient string) *model.Payment {
+		return &model.Payment{
+			PayerAddress: srcAddress,
+			PayeeAddress: recipient,
+			Payment:      p,
+		}
+	}
+
+	cases := []struct {
+		name            string
+		addPaymentsErr  error
+		expectedMessage bool
+	}{
+		{
+			name:            "Success",
+			addPaymentsErr:  nil,
+			expectedMessage: true,
+		},
+		{
+			name:            "Already stored payments",
+			addPaymentsErr:  store.ErrDuplicatePayment,
+			expectedMessage: false,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			var storedMsg *model.RawMessage
+
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+				*lnmock.LightManager, *dbmock.Database, func()) {
+
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+				mockDB.On("GetLastInvoiceIndex").Return(
+					uint64(1), nil).Once()
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates",
+					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+				// The third payment belongs to a message in the outbox.
+				mockDB.On("GetOutboxMessages").Return(nil, nil).Once()
+				mockDB.On("GetOutboxMessages").Return([]model.OutboxMessage{
+					{
+						ID: 1,
+						Attempts: []model.OutboxAttempt{
+							{Recipient: destAddress, Hash: "03"},
+						},
+					},
+				}, nil).Once()
+
+				mockLNManager.On("VerifySignatureExtractPubkey", mock.Anything,
+					rawPayload, []byte(nil)).Return("", nil)
+
+				mockDB.On("AddPayments",
+					toModel(payments[0], destAddress),
+					toModel(payments[1], otherAddress)).Return(c.addPaymentsErr).Once()
+				mockDB.On("AddPayments",
+					toModel(payments[3], destAddress)).Return(c.addPaymentsErr).Once()
+
+				if c.expectedMessage {
+					mockDB.On("GetDiscussionByParticipants",
+						discussion.Participants).Return(discussion, nil).Once()
+					mockDB.On("AddRawMessage", mock.AnythingOfType("*model.RawMessage")).Return(
+						nil).Once().Run(func(args mock.Arguments) {
+						storedMsg = args.Get(0).(*model.RawMessage)
+					})
+				}
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+			defer cancel()
+
+			msgs, stored := app.storeOutgoingPayments(ctxt, payments, false)
+			assert.Equal(t, len(payments), stored)
+
+			if !c.expectedMessage {
+				assert.Nil(t, storedMsg)
+				assert.Empty(t, msgs)
+				return
+			}
+
+			require.NotNil(t, storedMsg)
+			assert.Equal(t, discussion.ID, storedMsg.DiscussionID)
+			assert.Equal(t, rawPayload, storedMsg.RawPayload)
+			assert.Equal(t, []uint64{1, 2}, storedMsg.PaymentIndexes)
+
+			require.Len(t, msgs, 1)
+			assert.Equal(t, discussion.ID, msgs[0].DiscussionID)
+			assert.Equal(t, "hello", msgs[0].Payload)
+			assert.Equal(t, int64(2000), msgs[0].AmtMsat)
+		})
+	}
+}
+
+func TestStoreOutgoingPaymentsHeld(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+	otherAddress := "222222222222222222222222222222222222222222222222222222222222222222"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: srcAddress,
+		},
+	}
+
+	rawPayload := mustJSONMarshalMessage(t,
+		[]string{destAddress, otherAddress}, "hello")
+	msgPayload := map[uint64][]byte{PayloadTypeKey: rawPayload}
+
+	createPayment := func(idx uint64, recipient string,
+		payload map[uint64][]byte, created time.Time) lnchat.Payment {
+
+		node, err := lnchat.NewNodeFromString(recipient)
+		require.NoError(t, err)
+
+		return lnchat.Payment{
+			Hash:           fmt.Sprintf("%064x", idx),
+			Preimage:       fmt.Sprintf("%064x", idx),
+			Value:          lnchat.NewAmount(1000),
+			CreationTimeNs: created.UnixNano(),
+			Status:         lnchat.PaymentSUCCEEDED,
+			PaymentIndex:   idx,
+			Htlcs: []lnchat.HTLCAttempt{
+				{
+					Status: lnrpc.HTLCAttempt_SUCCEEDED,
+					Route: lnchat.Route{
+						Hops: []lnchat.RouteHop{
+							{NodeID: node, CustomRecords: payload},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+	mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+	mockLNManager.On("VerifySignatureExtractPubkey", mock.Anything,
+		mock.Anything, []byte(nil)).Return("", nil)
+	mockDB.On("GetOutboxMessages").Return(nil, nil)
+
+	app, err := New(mockLNManager, mockDB)
+	require.NoError(t, err)
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	require.NoError(t, app.InitSelfInfo(ctxt, 1))
+
+	// The payments of a group message not paid to all participants
+	// are held, along with the following payments.
+	now := time.Now()
+	payments := []lnchat.Payment{
+		createPayment(1, destAddress, nil, now),
+		createPayment(2, destAddress, msgPayload, now),
+		createPayment(3, destAddress, nil, now),
+	}
+	mockDB.On("AddPayments", mock.MatchedBy(func(p *model.Payment) bool {
+		return p.PaymentIndex == 1
+	})).Return(nil).Once()
+
+	msgs, stored := app.storeOutgoingPayments(ctxt, payments, false)
+	assert.Empty(t, msgs)
+	assert.Equal(t, 1, stored)
+	mockDB.AssertExpectations(t)
+
+	// Held payments are stored once the message is complete.
+	payments = append(payments[stored:],
+		createPayment(4, otherAddress, msgPayload, now))
+	mockDB.On("AddPayments", mock.MatchedBy(func(p *model.Payment) bool {
+		return p.PaymentIndex == 2
+	}), mock.MatchedBy(func(p *model.Payment) bool {
+		return p.PaymentIndex == 4
+	})).Return(nil).Once()
+	mockDB.On("AddPayments", mock.MatchedBy(func(p *model.Payment) bool {
+		return p.PaymentIndex == 3
+	})).Return(nil).Once()
+	mockDB.On("GetDiscussionByParticipants",
+		[]string{destAddress, otherAddress}).Return(&model.Discussion{
+		ID:           3,
+		Participants: []string{destAddress, otherAddress},
+	}, nil).Once()
+	mockDB.On("AddRawMessage", mock.AnythingOfType("*model.RawMessage")).Return(nil).Once()
+
+	msgs, stored = app.storeOutgoingPayments(ctxt, payments, false)
+	require.Len(t, msgs, 1)
+	assert.Equal(t, "hello", msgs[0].Payload)
+	assert.Equal(t, 3, stored)
+	mockDB.AssertExpectations(t)
+
+	// Incomplete messages are stored once stale, or if final.
+	stale := createPayment(5, destAddress, msgPayload,
+		now.Add(-outgoingSetTimeout-time.Minute))
+	recent := createPayment(6, otherAddress, msgPayload, now)
+	recent.Htlcs[0].Route.Hops[0].CustomRecords = map[uint64][]byte{
+		PayloadTypeKey: mustJSONMarshalMessage(t,
+			[]string{destAddress, otherAddress}, "again"),
+	}
+	for i, payment := range []lnchat.Payment{stale, recent} {
+		payment := payment
+		mockDB.On("AddPayments", mock.MatchedBy(func(p *model.Payment) bool {
+			return p.PaymentIndex == payment.PaymentIndex
+		})).Return(nil).Once()
+		mockDB.On("GetDiscussionByParticipants",
+			[]string{destAddress, otherAddress}).Return(&model.Discussion{
+			ID:           3,
+			Participants: []string{destAddress, otherAddress},
+		}, nil).Once()
+		mockDB.On("AddRawMessage", mock.AnythingOfType("*model.RawMessage")).Return(nil).Once()
+
+		final := i == 1
+		msgs, stored = app.storeOutgoingPayments(ctxt, []lnchat.Payment{payment}, final)
+		assert.Len(t, msgs, 1)
+		assert.Equal(t, 1, stored)
+	}
+	mockDB.AssertExpectations(t)
+}
+
+func TestResolvedPayments(t *testing.T) {
+	payment := func(idx uint64, status lnchat.PaymentStatus) lnchat.Payment {
+		return lnchat.Payment{PaymentIndex: idx, Status: status}
+	}
+
+	payments := []lnchat.Payment{
+		payment(1, lnchat.PaymentSUCCEEDED),
+		payment(2, lnchat.PaymentFAILED),
+		payment(3, lnchat.PaymentINFLIGHT),
+		payment(4, lnchat.PaymentSUCCEEDED),
+	}
+
+	assert.Equal(t, payments[:2], resolvedPayments(payments))
+	assert.Equal(t, payments[:2], resolvedPayments(payments[:2]))
+	assert.Empty(t, resolvedPayments(payments[2:]))
+	assert.Empty(t, resolvedPayments(nil))
+}
@@ -223,9 +223,14 @@ func TestSendPayment(t *testing.T) {
 
 				// Mock self info
 				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 				mockDB.On("GetLastInvoiceIndex").Return(
 					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates",
 					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -264,17 +269,21 @@ func TestSendPayment(t *testing.T) {
 						return ch
 					}()
 
-					mockLNManager.On("SendPayment", mock.Anything, recipient, lnchat.NewAmount(c.amt), c.payReq, lnchat.PaymentOptions{
+					mockLNManager.On("SendPayment", mock.Anything, recipient, lnchat.NewAmount(c.amt), c.payReq, mock.Anything, lnchat.PaymentOptions{
 						FeeLimitMsat:   3000,
 						FinalCltvDelta: 20,
 						TimeoutSecs:    30,
 					}, mock.Anything, mock.Anything).Return(paymentUpdates, nil).Once()
 				}
 
-				mockDB.On("AddPayments", mock.AnythingOfType("*model.Payment")).Return(
+				mockDB.On("AddOutboxMessage", mock.AnythingOfType("*model.OutboxMessage")).Return(
 					nil).Once()
 
-				mockDB.On("AddRawMessage", mock.AnythingOfType("*model.RawMessage")).Return(
+				mockDB.On("UpdateOutboxMessage", mock.AnythingOfType("*model.OutboxMessage")).Return(
+					nil)
+
+				mockDB.On("CompleteOutboxMessage", mock.Anything, mock.AnythingOfType("model.SendResult"),
+					mock.AnythingOfType("*model.RawMessage"), mock.AnythingOfType("*model.Payment")).Return(
 					nil).Once()
 
 				mockDB.On("Close").Return(nil).Once()
@@ -302,13 +311,16 @@ func TestSendPayment(t *testing.T) {
 			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 			defer cancel()
 
-			msg, err := app.SendPayment(ctxt, c.payload, c.amt, c.discID, c.payReq, opts)
+			msg, err := app.SendPayment(ctxt, model.MessageContent{Body: c.payload}, c.amt, c.discID, c.payReq, opts)
 
 			if c.expectedErr != nil {
 				assert.Nil(t, msg)
 				assert.EqualError(t, err, c.expectedErr.Error())
 			} else {
 				assert.NoError(t, err)
+				// The message identifier is randomly generated.
+				assert.NotEmpty(t, msg.MessageID)
+				msg.MessageID = ""
 				assert.Equal(t, c.expectedMessage, msg)
 			}
 		})
@@ -0,0 +1,290 @@
+package app
+
+import (
+	"context"
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+)
+
+// ErrAnonymousPresence indicates that a presence indication
+// was requested on an anonymous discussion.
+var ErrAnonymousPresence = fmt.Errorf("presence indication on anonymous discussion is disallowed")
+
+// ErrPresenceDisabled indicates that presence indications are disabled.
+var ErrPresenceDisabled = fmt.Errorf("presence indications are disabled")
+
+const (
+	// presenceTopic is the topic where presence updates are published.
+	presenceTopic = "presence"
+
+	// presenceMaxAge is the maximum difference between
+	// the sending and receiving time of a presence indication,
+	// beyond which the indication is discarded.
+	presenceMaxAge = 30 * time.Second
+
+	// presenceHeaderSize is the encoded size of a presence indication,
+	// excluding its participant set.
+	presenceHeaderSize = 9
+
+	// participantAddressSize is the encoded size of a participant address.
+	participantAddressSize = 33
+)
+
+// presence represents a presence indication, as carried over the wire.
+type presence struct {
+	// Whether the sender is typing.
+	Typing bool
+	// The time the indication was sent (in nanoseconds since Unix Epoch).
+	TimeNs int64
+	// The participant set of the discussion, as seen by the sender.
+	Participants []string
+}
+
+func (p *presence) encode() ([]byte, error) {
+	b := make([]byte, presenceHeaderSize,
+		presenceHeaderSize+len(p.Participants)*participantAddressSize)
+	if p.Typing {
+		b[0] = 1
+	}
+	binary.BigEndian.PutUint64(b[1:], uint64(p.TimeNs))
+
+	for _, participant := range p.Participants {
+		addr, err := lnchat.NewNodeFromString(participant)
+		if err != nil {
+			return nil, err
+		}
+		b = append(b, addr.Bytes()...)
+	}
+
+	return b, nil
+}
+
+func decodePresence(b []byte) (*presence, error) {
+	if len(b) < presenceHeaderSize ||
+		(len(b)-presenceHeaderSize)%participantAddressSize != 0 {
+
+		return nil, fmt.Errorf("invalid presence length %d", len(b))
+	}
+
+	p := &presence{
+		Typing: b[0] == 1,
+		TimeNs: int64(binary.BigEndian.Uint64(b[1:])),
+	}
+	for rest := b[presenceHeaderSize:]; len(rest) > 0; rest = rest[participantAddressSize:] {
+		addr, err := lnchat.NewNodeFromBytes(rest[:participantAddressSize])
+		if err != nil {
+			return nil, err
+		}
+		p.Participants = append(p.Participants, addr.String())
+	}
+
+	return p, nil
+}
+
+// SetTyping sends a typing indication to the participants of a discussion.
+// Indications are carried by HTLCs that their recipients fail,
+// so no funds are transferred. Since indications are not stored,
+// clients are expected to repeat them while the user is typing.
+func (app *App) SetTyping(ctx context.Context, discID uint64, typing bool) error {
+	discussion, err := app.retrieveDiscussion(ctx, discID)
+	if err != nil {
+		return err
+	}
+	if discussion.Options.Anonymous {
+		return ErrAnonymousPresence
+	}
+
+	record, err := (&presence{
+		Typing:       typing,
+		TimeNs:       time.Now().UnixNano(),
+		Participants: discussion.Participants,
+	}).encode()
+	if err != nil {
+		return errors.Wrap(err, "could not encode presence indication")
+	}
+	sig, err := app.LNManager.SignMessage(ctx, record)
+	if err != nil {
+		return newErrorf(err, "SetTyping: SignMessage")
+	}
+	self, err := lnchat.NewNodeFromString(app.Self.Node.Address)
+	if err != nil {
+		return errors.Wrap(err, "could not encode sender address")
+	}
+
+	payload := map[uint64][]byte{
+		PresenceTypeKey:  record,
+		SenderTypeKey:    self.Bytes(),
+		SignatureTypeKey: sig,
+	}
+	payOpts := overrideOptions(DefaultOptions, true,
+		discussion.Options).GetPaymentOptions()
+
+	errs := make([]error, len(discussion.Participants))
+	app.forEachRecipient(discussion.Participants, func(i int, recipient string) {
+		err := app.LNManager.SendSignal(ctx, recipient, payOpts, payload)
+		if err != nil {
+			errs[i] = fmt.Errorf("presence indication to %s "+
+				"failed: %w", recipient, err)
+		}
+	})
+
+	var failed []error
+	for _, err := range errs {
+		if err != nil {
+			failed = append(failed, err)
+		}
+	}
+
+	return newCompositeError(failed)
+}
+
+// isPresenceSignal returns whether the custom records
+// of an intercepted HTLC carry a presence indication.
+func isPresenceSignal(customRecords map[uint64][]byte) bool {
+	_, ok := customRecords[PresenceTypeKey]
+
+	return ok
+}
+
+func (app *App) subscribePresence(ctx context.Context, _ uint64) error {
+	signals, err := app.LNManager.SubscribeSignals(ctx, isPresenceSignal)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-signals:
+			if !ok {
+				return fmt.Errorf("subscription channel closed")
+			}
+			if update.Err != nil {
+				return fmt.Errorf("signal update failed: %w", update.Err)
+			}
+
+			presence, err := app.receivePresence(ctx, update.Signal)
+			if err != nil {
+				app.Log.WithError(err).Debug("presence indication discarded")
+				continue
+			}
+
+			if err := app.publishPresence(presence); err != nil {
+				app.Log.WithError(err).Error("presence publish failed")
+			}
+		}
+	}
+}
+
+// receivePresence extracts the presence update carried by a signal.
+// Only recent indications from verified senders
+// on existing discussions are accepted.
+func (app *App) receivePresence(ctx context.Context,
+	signal *lnchat.Signal) (*model.PresenceUpdate, error) {
+
+	records := signal.CustomRecords
+	p, err := decodePresence(records[PresenceTypeKey])
+	if err != nil {
+		return nil, err
+	}
+
+	sentTime := time.Unix(0, p.TimeNs)
+	if age := time.Since(sentTime); age > presenceMaxAge || age < -presenceMaxAge {
+		return nil, fmt.Errorf("stale presence indication sent at %v", sentTime)
+	}
+
+	senderBytes, ok := records[SenderTypeKey]
+	if !ok {
+		return nil, fmt.Errorf("presence sender missing")
+	}
+	senderAddr, err := lnchat.NewNodeFromBytes(senderBytes)
+	if err != nil {
+		return nil, err
+	}
+	sender := senderAddr.String()
+
+	verified, err := app.verifySignature(ctx, records[PresenceTypeKey],
+		records[SignatureTypeKey], sender)
+	switch {
+	case err != nil:
+		return nil, fmt.Errorf("cannot verify presence signature: %w", err)
+	case !verified:
+		return nil, fmt.Errorf("presence signature not verified")
+	}
+
+	discussion, err := app.Database.GetDiscussionByParticipants(
+		app.incomingParticipants(p.Participants, sender))
+	switch {
+	case errors.Is(err, store.ErrDiscussionNotFound):
+		return nil, fmt.Errorf("presence indication for unknown discussion")
+	case err != nil:
+		return nil, err
+	}
+
+	return &model.PresenceUpdate{
+		DiscussionID: discussion.ID,
+		Sender:       sender,
+		Typing:       p.Typing,
+		SentTimeNs:   p.TimeNs,
+	}, nil
+}
+
+func (app *App) publishPresence(update *model.PresenceUpdate) error {
+	updateBytes, err := json.Marshal(update)
+	if err != nil {
+		return err
+	}
+
+	return app.publish(presenceTopic, updateBytes)
+}
+
+// MaybePresence represents a presence update or an error.
+type MaybePresence struct {
+	Update *model.PresenceUpdate
+	Error  error
+}
+
+// SubscribePresence returns a channel over which presence updates
+// received from discussion participants are sent.
+// The subscriber is responsible for draining the channel
+// once the subscription terminates.
+func (app *App) SubscribePresence(ctx context.Context) (<-chan MaybePresence, error) {
+	if !app.presence {
+		return nil, ErrPresenceDisabled
+	}
+
+	subCh, err := app.subscribe(ctx, presenceTopic)
+	if err != nil {
+		return nil, err
+	}
+
+	updateCh := make(chan MaybePresence)
+	go func() {
+		defer close(updateCh)
+
+		// Forward updates until subscriber exits.
+		for subMsg := range subCh {
+			subMsg.Ack()
+
+			// Unmarshal update data in a fresh variable.
+			update := new(model.PresenceUpdate)
+			err := json.Unmarshal(subMsg.Payload, update)
+
+			updateCh <- MaybePresence{
+				Update: update,
+				Error:  err,
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
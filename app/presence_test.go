@@ -0,0 +1,165 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestPresenceEncodeDecode(t *testing.T) {
+	p := &presence{
+		Typing: true,
+		TimeNs: time.Now().UnixNano(),
+		Participants: []string{
+			"000000000000000000000000000000000000000000000000000000000000000000",
+			"111111111111111111111111111111111111111111111111111111111111111111",
+		},
+	}
+
+	b, err := p.encode()
+	require.NoError(t, err)
+	assert.Len(t, b, presenceHeaderSize+2*participantAddressSize)
+
+	decoded, err := decodePresence(b)
+	require.NoError(t, err)
+	assert.Equal(t, p, decoded)
+
+	_, err = decodePresence(b[:len(b)-1])
+	assert.Error(t, err)
+
+	_, err = (&presence{Participants: []string{"invalid"}}).encode()
+	assert.Error(t, err)
+}
+
+func TestPresence(t *testing.T) {
+	selfAddr := "111111111111111111111111111111111111111111111111111111111111111111"
+	srcAddr := "000000000000000000000000000000000000000000000000000000000000000000"
+	otherAddr := "222222222222222222222222222222222222222222222222222222222222222222"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: selfAddr,
+		},
+	}
+
+	discussion := &model.Discussion{
+		ID:           3,
+		Participants: []string{srcAddr, otherAddr},
+		Options:      DefaultOptions,
+	}
+	anonymousDiscussion := &model.Discussion{
+		ID:           4,
+		Participants: []string{srcAddr},
+		Options:      DefaultOptions,
+	}
+	anonymousDiscussion.Options.Anonymous = true
+
+	signature := []byte("a dummy signature")
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		mockDB.On("GetDiscussion", discussion.ID).Return(discussion, nil).Once()
+		mockDB.On("GetDiscussion", anonymousDiscussion.ID).Return(
+			anonymousDiscussion, nil).Once()
+		mockLNManager.On("SignMessage", mock.Anything, mock.Anything).Return(
+			signature, nil).Once()
+		mockLNManager.On("SendSignal", mock.Anything, srcAddr, mock.Anything,
+			mock.Anything).Return(nil).Once()
+		mockLNManager.On("SendSignal", mock.Anything, otherAddr, mock.Anything,
+			mock.Anything).Return(fmt.Errorf("dummy SendSignal error")).Once()
+
+		mockLNManager.On("VerifySignatureExtractPubkey", mock.Anything,
+			mock.Anything, signature).Return(srcAddr, nil).Once()
+		mockDB.On("GetDiscussionByParticipants",
+			[]string{otherAddr, srcAddr}).Return(discussion, nil).Once()
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	t.Run("SetTyping", func(t *testing.T) {
+		err := app.SetTyping(ctxt, discussion.ID, true)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), otherAddr)
+		assert.NotContains(t, err.Error(), srcAddr)
+
+		err = app.SetTyping(ctxt, anonymousDiscussion.ID, true)
+		assert.ErrorIs(t, err, ErrAnonymousPresence)
+	})
+
+	t.Run("Receive", func(t *testing.T) {
+		signalFor := func(p *presence) *lnchat.Signal {
+			record, err := p.encode()
+			require.NoError(t, err)
+			src, err := lnchat.NewNodeFromString(srcAddr)
+			require.NoError(t, err)
+
+			return &lnchat.Signal{
+				CustomRecords: map[uint64][]byte{
+					PresenceTypeKey:  record,
+					SenderTypeKey:    src.Bytes(),
+					SignatureTypeKey: signature,
+				},
+			}
+		}
+
+		sentTimeNs := time.Now().UnixNano()
+		update, err := app.receivePresence(ctxt, signalFor(&presence{
+			Typing:       true,
+			TimeNs:       sentTimeNs,
+			Participants: []string{selfAddr, otherAddr},
+		}))
+		require.NoError(t, err)
+		assert.Equal(t, &model.PresenceUpdate{
+			DiscussionID: discussion.ID,
+			Sender:       srcAddr,
+			Typing:       true,
+			SentTimeNs:   sentTimeNs,
+		}, update)
+
+		// Stale indications are discarded.
+		_, err = app.receivePresence(ctxt, signalFor(&presence{
+			TimeNs:       time.Now().Add(-2 * presenceMaxAge).UnixNano(),
+			Participants: []string{selfAddr, otherAddr},
+		}))
+		assert.Error(t, err)
+	})
+}
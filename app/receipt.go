@@ -0,0 +1,234 @@
+package app
+
+import (
+	"context"
+	"encoding/hex"
+	"fmt"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/pkg/errors"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+const (
+	// receiptAmtMsat is the amount sent along with a receipt.
+	receiptAmtMsat = 1000
+
+	// receiptSize is the encoded size of a receipt.
+	receiptSize = 1 + lntypes.HashSize
+
+	// readReceiptLookback is the maximum number of messages
+	// searched for senders when sending read receipts.
+	readReceiptLookback = 50
+)
+
+// encodeReceipt encodes a receipt of the provided type
+// for the message carried by the payment with the provided hash.
+func encodeReceipt(typ model.ReceiptType, paymentHash []byte) ([]byte, error) {
+	if len(paymentHash) != lntypes.HashSize {
+		return nil, fmt.Errorf("invalid payment hash length %d",
+			len(paymentHash))
+	}
+
+	b := make([]byte, receiptSize)
+	b[0] = byte(typ)
+	copy(b[1:], paymentHash)
+
+	return b, nil
+}
+
+// decodeReceipt decodes a receipt, returning its type
+// and the (hex-encoded) payment hash of the acknowledged message.
+func decodeReceipt(b []byte) (model.ReceiptType, string, error) {
+	if len(b) != receiptSize {
+		return 0, "", fmt.Errorf("invalid receipt length %d", len(b))
+	}
+
+	typ := model.ReceiptType(b[0])
+	switch typ {
+	case model.ReceiptDELIVERED, model.ReceiptREAD:
+	default:
+		return 0, "", fmt.Errorf("unknown receipt type %d", typ)
+	}
+
+	return typ, hex.EncodeToString(b[1:]), nil
+}
+
+// sendReceipt sends a signed receipt of the provided type to the sender
+// of the message carried by the payment with the provided hash.
+func (app *App) sendReceipt(ctx context.Context, typ model.ReceiptType,
+	recipient string, paymentHash []byte) error {
+
+	receipt, err := encodeReceipt(typ, paymentHash)
+	if err != nil {
+		return err
+	}
+
+	sig, err := app.LNManager.SignMessage(ctx, receipt)
+	if err != nil {
+		return errors.Wrap(err, "could not sign receipt")
+	}
+	self, err := lnchat.NewNodeFromString(app.Self.Node.Address)
+	if err != nil {
+		return errors.Wrap(err, "could not encode sender address")
+	}
+
+	payload := map[uint64][]byte{
+		ReceiptTypeKey:   receipt,
+		SenderTypeKey:    self.Bytes(),
+		SignatureTypeKey: sig,
+	}
+	payOpts := DefaultOptions.GetPaymentOptions()
+
+	updates, err := app.LNManager.SendPayment(ctx, recipient,
+		lnchat.NewAmount(receiptAmtMsat), "", nil, payOpts,
+		payload, defaultPaymentFilter)
+	if err != nil {
+		return errors.Wrap(err, "could not initiate receipt payment")
+	}
+
+	for update := range updates {
+		switch {
+		case update.Err != nil:
+			return update.Err
+		case update.Payment.Status == lnchat.PaymentFAILED:
+			return fmt.Errorf("receipt payment failed: %s",
+				paymentFailureReason(update.Payment))
+		case update.Payment.Status == lnchat.PaymentSUCCEEDED:
+			return nil
+		}
+	}
+
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	return fmt.Errorf("payment updates terminated before resolution")
+}
+
+// sendReceiptAsync sends a receipt in the background.
+// Failures are logged, since the receipt is not retried.
+func (app *App) sendReceiptAsync(typ model.ReceiptType,
+	recipient string, paymentHash []byte) {
+
+	if app.Tomb == nil || !app.Tomb.Alive() {
+		return
+	}
+
+	tombCtx := app.Tomb.Context(nil)
+	app.Tomb.Go(func() error {
+		if err := app.sendReceipt(tombCtx, typ,
+			recipient, paymentHash); err != nil {
+
+			app.Log.WithError(err).Warnf("could not send "+
+				"receipt to %s", recipient)
+		}
+		return nil
+	})
+}
+
+// coversReceipt returns whether the amount paid over a received message
+// covers the amount sent along with a receipt, so that senders
+// cannot drain the node by sending messages of lower amount.
+func coversReceipt(amtMsat int64) bool {
+	return amtMsat >= receiptAmtMsat
+}
+
+// acknowledgeMessage sends a delivery receipt for a received message,
+// if receipts are enabled for its discussion.
+// Receipts are sent only to verified message senders,
+// for messages paying at least the receipt amount.
+func (app *App) acknowledgeMessage(disc *model.Discussion, msg *model.Message) {
+	if !disc.Options.Receipts || msg.Sender == "" || !msg.SenderVerified ||
+		!coversReceipt(msg.AmtMsat) {
+
+		return
+	}
+
+	app.sendReceiptAsync(model.ReceiptDELIVERED, msg.Sender, msg.PreimageHash)
+}
+
+// storeReceipt records the receipt carried by an invoice,
+// provided that its signature is verified.
+func (app *App) storeReceipt(ctx context.Context, inv *lnchat.Invoice,
+	customRecords map[uint64][]byte) error {
+
+	typ, paymentHash, err := decodeReceipt(customRecords[ReceiptTypeKey])
+	if err != nil {
+		return err
+	}
+
+	senderBytes, ok := customRecords[SenderTypeKey]
+	if !ok {
+		return fmt.Errorf("receipt sender missing")
+	}
+	senderAddr, err := lnchat.NewNodeFromBytes(senderBytes)
+	if err != nil {
+		return err
+	}
+	sender := senderAddr.String()
+
+	verified, err := app.verifySignature(ctx, customRecords[ReceiptTypeKey],
+		customRecords[SignatureTypeKey], sender)
+	switch {
+	case err != nil:
+		return fmt.Errorf("cannot verify receipt signature: %w", err)
+	case !verified:
+		return fmt.Errorf("receipt signature not verified")
+	}
+
+	return app.Database.AddReceipt(&model.Receipt{
+		Type:        typ,
+		PaymentHash: paymentHash,
+		Sender:      sender,
+		TimeNs:      time.Unix(inv.SettleTimeSec, 0).UnixNano(),
+	})
+}
+
+// sendReadReceipts sends a read receipt for the latest message read
+// from each verified sender, considering the messages of a discussion
+// up to (and including) the message with the provided id.
+// Messages paying less than the receipt amount are not acknowledged.
+func (app *App) sendReadReceipts(discID, readMsgID uint64) error {
+	pageOpts := model.PageOptions{
+		LastID:   readMsgID,
+		PageSize: readReceiptLookback,
+		Reverse:  true,
+	}
+	// Reverse pagination without an anchor retrieves the latest messages,
+	// while no messages precede the first one.
+	if readMsgID == 0 {
+		pageOpts = model.PageOptions{PageSize: 1}
+	}
+	aggregates, err := app.Database.GetMessages(discID, pageOpts)
+	if err != nil {
+		return err
+	}
+
+	acknowledged := make(map[string]struct{})
+	for i := len(aggregates) - 1; i >= 0; i-- {
+		raw, inv := aggregates[i].RawMessage, aggregates[i].Invoice
+		// Only incoming messages from verified senders are acknowledged.
+		sender := raw.SenderAddress()
+		if inv == nil || sender == "" || !raw.SignatureVerified ||
+			!coversReceipt(raw.AmtMsat) {
+
+			continue
+		}
+		if _, ok := acknowledged[sender]; ok {
+			continue
+		}
+		acknowledged[sender] = struct{}{}
+
+		paymentHash, err := hex.DecodeString(inv.Hash)
+		if err != nil {
+			return err
+		}
+		app.sendReceiptAsync(model.ReceiptREAD, sender, paymentHash)
+	}
+
+	return nil
+}
@@ -0,0 +1,210 @@
+package app
+
+import (
+	"bytes"
+	"context"
+	"encoding/hex"
+	"testing"
+	"time"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"gopkg.in/tomb.v2"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestEncodeDecodeReceipt(t *testing.T) {
+	paymentHash := bytes.Repeat([]byte{0x01}, 32)
+
+	receipt, err := encodeReceipt(model.ReceiptREAD, paymentHash)
+	require.NoError(t, err)
+	assert.Len(t, receipt, receiptSize)
+
+	typ, hash, err := decodeReceipt(receipt)
+	require.NoError(t, err)
+	assert.Equal(t, model.ReceiptREAD, typ)
+	assert.Equal(t, hex.EncodeToString(paymentHash), hash)
+
+	_, err = encodeReceipt(model.ReceiptDELIVERED, paymentHash[1:])
+	assert.Error(t, err)
+
+	_, _, err = decodeReceipt(receipt[1:])
+	assert.Error(t, err)
+
+	receipt[0] = 0x42
+	_, _, err = decodeReceipt(receipt)
+	assert.Error(t, err)
+}
+
+func TestStoreReceiptInvoice(t *testing.T) {
+	selfAddr, err := lnchat.NewNodeFromString(
+		"111111111111111111111111111111111111111111111111111111111111111111")
+	require.NoError(t, err)
+	srcAddr, err := lnchat.NewNodeFromString(
+		"000000000000000000000000000000000000000000000000000000000000000000")
+	require.NoError(t, err)
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: selfAddr.String(),
+		},
+	}
+
+	paymentHash := bytes.Repeat([]byte{0x01}, 32)
+	receipt, err := encodeReceipt(model.ReceiptDELIVERED, paymentHash)
+	require.NoError(t, err)
+	signature := []byte("a dummy signature")
+
+	settleTime := time.Now().Unix()
+	inv := &lnchat.Invoice{
+		Hash:          "0202020202020202020202020202020202020202020202020202020202020202",
+		AmtPaid:       lnchat.NewAmount(receiptAmtMsat),
+		SettleTimeSec: settleTime,
+		State:         lnchat.InvoiceSETTLED,
+		SettleIndex:   7,
+		Htlcs: []lnchat.InvoiceHTLC{
+			{
+				State: lnrpc.InvoiceHTLCState_SETTLED,
+				CustomRecords: map[uint64][]byte{
+					ReceiptTypeKey:   receipt,
+					SenderTypeKey:    srcAddr.Bytes(),
+					SignatureTypeKey: signature,
+				},
+			},
+		},
+	}
+
+	mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (
+		*lnmock.LightManager, *dbmock.Database, func()) {
+
+		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+		mockDB.On("GetLastInvoiceIndex").Return(
+			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+		mockLNManager.On("SubscribeInvoiceUpdates",
+			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+		mockDB.On("AddInvoice", &model.Invoice{
+			CreatorAddress: selfAddr.String(),
+			Invoice:        *inv,
+		}).Return(nil).Once()
+		mockLNManager.On("VerifySignatureExtractPubkey", mock.Anything,
+			receipt, signature).Return(srcAddr.String(), nil).Once()
+		mockDB.On("AddReceipt", &model.Receipt{
+			Type:        model.ReceiptDELIVERED,
+			PaymentHash: hex.EncodeToString(paymentHash),
+			Sender:      srcAddr.String(),
+			TimeNs:      time.Unix(settleTime, 0).UnixNano(),
+		}).Return(nil).Once()
+
+		mockDB.On("Close").Return(nil).Once()
+		mockLNManager.On("Close").Return(nil).Once()
+
+		mockStopFunc := func() {}
+
+		return mockLNManager, mockDB, mockStopFunc
+	}
+
+	app, appTestStartFunc, appTestStopFunc :=
+		createInitializedApp(t, mockInstaller)
+
+	appTestStartFunc()
+	defer appTestStopFunc()
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	// Invoices carrying a receipt carry no message.
+	msg, disc, err := app.storeInvoice(ctxt, inv)
+	require.NoError(t, err)
+	assert.Nil(t, msg)
+	assert.Nil(t, disc)
+}
+
+func TestSendReadReceipts(t *testing.T) {
+	selfAddr := "111111111111111111111111111111111111111111111111111111111111111111"
+	senders := []string{
+		"020000000000000000000000000000000000000000000000000000000000000000",
+		"030000000000000000000000000000000000000000000000000000000000000000",
+	}
+
+	createIncoming := func(id uint64, sender string,
+		amtMsat int64, hash string) store.MessageAggregate {
+
+		senderAddr, err := lnchat.NewNodeFromString(sender)
+		require.NoError(t, err)
+
+		return store.MessageAggregate{
+			RawMessage: &model.RawMessage{
+				ID:                id,
+				Sender:            senderAddr,
+				SignatureVerified: true,
+				AmtMsat:           amtMsat,
+			},
+			Invoice: &model.Invoice{
+				Invoice: lnchat.Invoice{Hash: hash},
+			},
+		}
+	}
+
+	coveredHash := bytes.Repeat([]byte{0x01}, 32)
+	aggregates := []store.MessageAggregate{
+		createIncoming(1, senders[0], receiptAmtMsat,
+			hex.EncodeToString(coveredHash)),
+		// Messages paying less than a receipt are not acknowledged.
+		createIncoming(2, senders[1], receiptAmtMsat-1,
+			"0202020202020202020202020202020202020202020202020202020202020202"),
+	}
+
+	mockDB := new(dbmock.Database)
+	mockDB.On("GetMessages", uint64(3), model.PageOptions{
+		LastID:   2,
+		PageSize: readReceiptLookback,
+		Reverse:  true,
+	}).Return(aggregates, nil).Once()
+
+	receipt, err := encodeReceipt(model.ReceiptREAD, coveredHash)
+	require.NoError(t, err)
+
+	updates := make(chan lnchat.PaymentUpdate, 1)
+	updates <- lnchat.PaymentUpdate{
+		Payment: &lnchat.Payment{Status: lnchat.PaymentSUCCEEDED},
+	}
+	close(updates)
+
+	mockLNManager := new(lnmock.LightManager)
+	mockLNManager.On("SignMessage", mock.Anything,
+		receipt).Return([]byte("a dummy signature"), nil).Once()
+	mockLNManager.On("SendPayment", mock.Anything, senders[0],
+		lnchat.NewAmount(receiptAmtMsat), "", (*lntypes.Preimage)(nil),
+		mock.Anything, mock.Anything, mock.Anything).Return(
+		(<-chan lnchat.PaymentUpdate)(updates), nil).Once()
+
+	app, err := New(mockLNManager, mockDB)
+	require.NoError(t, err)
+	app.Self.Node.Address = selfAddr
+	app.Tomb, _ = tomb.WithContext(context.Background())
+
+	require.NoError(t, app.sendReadReceipts(3, 2))
+
+	app.Tomb.Kill(nil)
+	require.NoError(t, app.Tomb.Wait())
+
+	mockDB.AssertExpectations(t)
+	mockLNManager.AssertExpectations(t)
+}
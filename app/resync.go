@@ -0,0 +1,87 @@
+package app
+
+import (
+	"context"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// resyncPageSize is the maximum number of invoices or payments
+// retrieved in a single request during a resync.
+const resyncPageSize = 100
+
+// ResyncSummary contains the results of a history resync.
+type ResyncSummary struct {
+	// The number of settled invoices examined.
+	Invoices uint64
+	// The number of completed payments examined.
+	Payments uint64
+	// The number of messages restored.
+	Messages uint64
+}
+
+// Resync rebuilds the message history from the underlying node,
+// by walking its settled invoices and completed payments
+// and storing any messages they carry, creating discussions as needed.
+// Invoices and payments already present in the database are skipped,
+// so a resync can be safely repeated.
+func (app *App) Resync(ctx context.Context) (*ResyncSummary, error) {
+	summary := new(ResyncSummary)
+
+	// Walk the invoices of the node.
+	for offset := uint64(0); ; {
+		invoices, err := app.LNManager.ListInvoices(ctx, offset, resyncPageSize)
+		if err != nil {
+			return nil, newErrorf(err, "Resync: ListInvoices")
+		}
+
+		for i := range invoices {
+			inv := &invoices[i]
+			if !defaultInvoiceFilter(inv) {
+				continue
+			}
+			summary.Invoices++
+
+			msg, _, err := app.storeInvoice(ctx, inv)
+			switch {
+			case err != nil:
+				app.Log.WithError(err).Warnf("could not restore "+
+					"invoice with hash %s", inv.Hash)
+			case msg != nil:
+				summary.Messages++
+			}
+		}
+
+		if len(invoices) < resyncPageSize {
+			break
+		}
+		offset = invoices[len(invoices)-1].AddIndex
+	}
+
+	// Walk the payments of the node.
+	// The payments of multi-part and group messages may span pages,
+	// so the payments of all pages are stored together.
+	var succeeded []lnchat.Payment
+	for offset := uint64(0); ; {
+		payments, err := app.LNManager.ListPayments(ctx, offset, resyncPageSize)
+		if err != nil {
+			return nil, newErrorf(err, "Resync: ListPayments")
+		}
+
+		// Incomplete payments carry no delivered message.
+		succeeded = append(succeeded, succeededPayments(payments)...)
+
+		if len(payments) < resyncPageSize {
+			break
+		}
+		offset = payments[len(payments)-1].PaymentIndex
+	}
+	summary.Payments = uint64(len(succeeded))
+	msgs, _ := app.storeOutgoingPayments(ctx, succeeded, true)
+	summary.Messages += uint64(len(msgs))
+
+	app.Log.Infof("resync completed: %d invoices, %d payments, "+
+		"%d messages restored", summary.Invoices, summary.Payments, summary.Messages)
+
+	return summary, nil
+}
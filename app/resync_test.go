@@ -0,0 +1,121 @@
+package app
+
+import (
+	"context"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestResync(t *testing.T) {
+	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
+	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: srcAddress,
+		},
+	}
+
+	discussion := &model.Discussion{
+		ID:           7,
+		Participants: []string{destAddress},
+		Options:      DefaultOptions,
+	}
+
+	rawPayload := mustJSONMarshalMessage(t,
+		[]string{srcAddress, destAddress}, "hello")
+
+	invoices := []lnchat.Invoice{
+		{
+			Hash:        "0101010101010101010101010101010101010101010101010101010101010101",
+			Preimage:    make([]byte, 32),
+			Value:       lnchat.NewAmount(1000),
+			AmtPaid:     lnchat.NewAmount(1000),
+			State:       lnchat.InvoiceSETTLED,
+			AddIndex:    1,
+			SettleIndex: 1,
+			Htlcs: []lnchat.InvoiceHTLC{
+				{
+					Amount: lnchat.NewAmount(1000),
+					State:  lnrpc.InvoiceHTLCState_SETTLED,
+					CustomRecords: map[uint64][]byte{
+						PayloadTypeKey: rawPayload,
+					},
+				},
+			},
+		},
+		{
+			Hash:     "02",
+			Value:    lnchat.NewAmount(2000),
+			State:    lnchat.InvoiceOPEN,
+			AddIndex: 2,
+		},
+	}
+
+	payments := []lnchat.Payment{
+		{
+			Hash:         "03",
+			Value:        lnchat.NewAmount(1000),
+			Status:       lnchat.PaymentSUCCEEDED,
+			PaymentIndex: 1,
+		},
+	}
+
+	mockLNManager, mockDB := new(lnmock.LightManager), new(dbmock.Database)
+
+	// A resync only requires the node identity,
+	// without any subscriptions being started.
+	mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+
+	mockLNManager.On("ListInvoices", mock.Anything,
+		uint64(0), uint64(resyncPageSize)).Return(invoices, nil).Once()
+	mockLNManager.On("ListPayments", mock.Anything,
+		uint64(0), uint64(resyncPageSize)).Return(payments, nil).Once()
+
+	// Only the settled invoice is stored.
+	mockLNManager.On("VerifySignatureExtractPubkey", mock.Anything,
+		rawPayload, []byte(nil)).Return("", nil).Once()
+	mockDB.On("GetDiscussionByParticipants",
+		discussion.Participants).Return(discussion, nil).Once()
+	mockDB.On("AddInvoiceMessage", &model.Invoice{
+		CreatorAddress: srcAddress,
+		Invoice:        invoices[0],
+	}, mock.AnythingOfType("*model.RawMessage")).Return(nil).Once()
+
+	// The payment has already been stored.
+	mockDB.On("GetOutboxMessages").Return(nil, nil).Once()
+	mockDB.On("AddPayments", &model.Payment{
+		PayerAddress: srcAddress,
+		Payment:      payments[0],
+	}).Return(store.ErrDuplicatePayment).Once()
+
+	app, err := New(mockLNManager, mockDB)
+	require.NoError(t, err)
+
+	ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+	defer cancel()
+
+	require.NoError(t, app.InitSelfInfo(ctxt, 1))
+
+	summary, err := app.Resync(ctxt)
+	require.NoError(t, err)
+	assert.Equal(t, &ResyncSummary{
+		Invoices: 1,
+		Payments: 1,
+		Messages: 1,
+	}, summary)
+
+	mockLNManager.AssertExpectations(t)
+	mockDB.AssertExpectations(t)
+}
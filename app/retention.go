@@ -0,0 +1,66 @@
+package app
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// ErrInvalidRetention indicates a retention policy with a negative maximum age.
+var ErrInvalidRetention = fmt.Errorf("negative retention age is disallowed")
+
+// DeleteMessage deletes a message locally, by redacting its payload
+// along with the payloads of the edits, delete requests and reactions
+// targeting it. The associated invoice or payments are retained,
+// so that the message still accounts for the discussion balance.
+// No delete request is sent to the discussion participants.
+func (app *App) DeleteMessage(_ context.Context, msgID uint64) error {
+	err := app.Database.RedactMessage(msgID)
+
+	return newErrorf(err, "RedactMessage")
+}
+
+// UpdateDiscussionRetention replaces a discussion's retention policy.
+// The limits set by the policy override those of the default policy.
+func (app *App) UpdateDiscussionRetention(_ context.Context,
+	discID uint64, policy model.RetentionPolicy) error {
+
+	if policy.MaxAge < 0 {
+		return newErrorf(ErrInvalidRetention, "UpdateDiscussionRetention")
+	}
+
+	err := app.Database.UpdateDiscussionRetention(discID, policy)
+
+	return newErrorf(err, "UpdateDiscussionRetention")
+}
+
+// runRetentionSweeper applies the retention policies on startup
+// and periodically thereafter, until the app is requested to terminate.
+func (app *App) runRetentionSweeper() {
+	ticker := time.NewTicker(app.retentionSweepInterval)
+	defer ticker.Stop()
+
+	for {
+		app.sweepRetention()
+
+		select {
+		case <-ticker.C:
+		case <-app.Tomb.Dying():
+			app.Log.Info("retention sweeper terminated")
+			return
+		}
+	}
+}
+
+// sweepRetention redacts the messages exceeding their retention policy.
+func (app *App) sweepRetention() {
+	count, err := app.Database.ApplyRetention(app.retention, time.Now())
+	if err != nil {
+		app.Log.WithError(err).Warn("retention sweep failed")
+	}
+	if count != 0 {
+		app.Log.Infof("redacted %d messages exceeding their retention policy", count)
+	}
+}
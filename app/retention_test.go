@@ -0,0 +1,118 @@
+package app
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	lnmock "github.com/c13n-io/c13n-go/lnchat/mocks"
+	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/store"
+	dbmock "github.com/c13n-io/c13n-go/store/mocks"
+)
+
+func TestDeleteMessage(t *testing.T) {
+	selfInfo := lnchat.SelfInfo{
+		Node: lnchat.LightningNode{
+			Alias:   "my_node",
+			Address: "000000000000000000000000000000000000000000000000000000000000000000",
+		},
+	}
+
+	cases := []struct {
+		name        string
+		msgID       uint64
+		redactErr   error
+		expectedErr error
+	}{
+		{
+			name:        "Success",
+			msgID:       3,
+			expectedErr: Error{},
+		},
+		{
+			name:      "Not found",
+			msgID:     42,
+			redactErr: store.ErrMessageNotFound,
+			expectedErr: Error{
+				Kind:    MessageNotFound,
+				details: "RedactMessage",
+				Err:     store.ErrMessageNotFound,
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			mockInstaller := func(mockLNManager *lnmock.LightManager, mockDB *dbmock.Database) (*lnmock.LightManager, *dbmock.Database, func()) {
+				// Mock self info
+				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
+
+				mockDB.On("GetLastInvoiceIndex").Return(
+					uint64(1), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
+
+				mockLNManager.On("SubscribeInvoiceUpdates",
+					mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
+
+				mockDB.On("Close").Return(nil).Once()
+				mockLNManager.On("Close").Return(nil).Once()
+
+				mockDB.On("RedactMessage", c.msgID).Return(c.redactErr).Once()
+
+				mockStopFunc := func() {}
+
+				return mockLNManager, mockDB, mockStopFunc
+			}
+
+			app, appTestStartFunc, appTestStopFunc :=
+				createInitializedApp(t, mockInstaller)
+
+			appTestStartFunc()
+			defer appTestStopFunc()
+
+			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
+			defer cancel()
+
+			err := app.DeleteMessage(ctxt, c.msgID)
+
+			switch c.expectedErr {
+			case Error{}:
+				assert.NoError(t, err)
+			default:
+				assert.EqualError(t, err, c.expectedErr.Error())
+				assert.ErrorIs(t, err, c.expectedErr.(Error).Err)
+			}
+		})
+	}
+}
+
+func TestSweepRetention(t *testing.T) {
+	policy := model.RetentionPolicy{
+		MaxAge:   24 * time.Hour,
+		MaxCount: 100,
+	}
+
+	mockDB := new(dbmock.Database)
+	mockDB.On("ApplyRetention", policy, mock.AnythingOfType("time.Time")).
+		Return(uint64(2), nil).Once()
+
+	app, err := New(new(lnmock.LightManager), mockDB,
+		WithRetention(policy, time.Hour))
+	assert.NoError(t, err)
+
+	app.sweepRetention()
+	mockDB.AssertExpectations(t)
+
+	_, err = New(new(lnmock.LightManager), mockDB,
+		WithRetention(model.RetentionPolicy{MaxAge: -time.Hour}, time.Hour))
+	assert.ErrorIs(t, err, ErrInvalidRetention)
+}
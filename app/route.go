@@ -16,8 +16,12 @@ import (
 // sending a payment (or message) to a discussion.
 // If the discussion contains multiple participants,
 // one route for each participant is calculated and the fees are cumulative.
+// Oversized payloads are split into multiple parts, as when sending,
+// with one route calculated for each part.
+// The encoded payload size and the onion space remaining on the
+// most constrained route are reported along with the estimate.
 func (app *App) EstimatePayment(ctx context.Context,
-	payload string, amtMsat int64, discID uint64,
+	content model.MessageContent, amtMsat int64, discID uint64,
 	opts model.MessageOptions) (*model.Message, error) {
 
 	// Retrieve the requested discussion.
@@ -29,33 +33,74 @@ func (app *App) EstimatePayment(ctx context.Context,
 	options := overrideOptions(DefaultOptions, true, discussion.Options, opts)
 	payOpts := options.GetPaymentOptions()
 
-	// Disallow anonymous messages in group discussions.
-	if len(discussion.Participants) > 1 && options.Anonymous {
-		return nil, ErrDiscAnonymousMessage
+	if err := validateOptions(discussion, options, content); err != nil {
+		return nil, err
 	}
 
 	// Create a raw message.
-	rawMsg, err := app.createRawMessage(ctx, discussion, payload, !options.Anonymous)
+	rawMsg, err := app.createRawMessage(ctx, discussion, content, !options.Anonymous)
 	if err != nil {
 		return nil, err
 	}
 
-	paymentPayload := marshalPayload(rawMsg)
+	// Query routes concurrently, collecting results per recipient.
+	recipients := discussion.Participants
+	payloads, err := app.recipientPayloads(ctx, rawMsg,
+		recipients, options.Encrypted)
+	if err != nil {
+		return nil, err
+	}
+	rawMsg.Encrypted = options.Encrypted
+
+	// Oversized payloads are sent in multiple parts,
+	// each requiring a separate route.
+	var payloadSize, parts int
+	recipientParts := make(map[string][]map[uint64][]byte, len(recipients))
+	recipientAmts := make(map[string][]int64, len(recipients))
+	for _, recipient := range recipients {
+		payloadParts, err := splitPayload(payloads[recipient])
+		if err != nil {
+			return nil, err
+		}
+		amts, err := partAmounts(amtMsat, len(payloadParts))
+		if err != nil {
+			return nil, err
+		}
+		recipientParts[recipient], recipientAmts[recipient] = payloadParts, amts
+
+		if size := payloadRecordsSize(payloads[recipient]); size > payloadSize {
+			payloadSize = size
+		}
+		if len(payloadParts) > parts {
+			parts = len(payloadParts)
+		}
+	}
+
+	routes := make([][]*lnchat.Route, len(recipients))
+	probs := make([]float64, len(recipients))
+	routeErrs := make([]error, len(recipients))
+	app.forEachRecipient(recipients, func(i int, recipient string) {
+		probs[i] = 1.
+		for j, payload := range recipientParts[recipient] {
+			route, prob, err := app.LNManager.GetRoute(ctx, recipient,
+				lnchat.NewAmount(recipientAmts[recipient][j]), payOpts, payload)
+			if err != nil {
+				routes[i], probs[i], routeErrs[i] = nil, prob, err
+				return
+			}
+			routes[i] = append(routes[i], route)
+			probs[i] *= prob
+		}
+	})
 
 	var totalProb = 1.
-	routes := make(map[string]lnchat.Route)
 	var errs []error
-	for _, recipient := range discussion.Participants {
-		route, prob, err := app.LNManager.GetRoute(ctx, recipient,
-			lnchat.NewAmount(amtMsat), payOpts, paymentPayload)
-		switch err {
-		case nil:
-			routes[recipient] = *route
-		default:
+	for i, recipient := range recipients {
+		if err := routeErrs[i]; err != nil {
 			errs = append(errs, fmt.Errorf("could not "+
 				"find route to %s: %w", recipient, err))
 		}
-		totalProb *= prob
+		totalProb *= probs[i]
 	}
 
 	compositeErr := newCompositeError(errs)
@@ -63,22 +108,30 @@ func (app *App) EstimatePayment(ctx context.Context,
 	// This is a slight abuse of the model
 	preimage, hash := lntypes.Preimage{}.String(), lntypes.ZeroHash.String()
 	var payments []*model.Payment
-	for recipient, route := range routes {
+	remainingPayloadSize := lnchat.MaxOnionPayloadSize
+	for i, recipientRoutes := range routes {
+		if len(recipientRoutes) == 0 {
+			continue
+		}
+
 		payment := &model.Payment{
 			PayerAddress: app.Self.Node.Address,
-			PayeeAddress: recipient,
+			PayeeAddress: recipients[i],
 			Payment: lnchat.Payment{
 				Preimage: preimage,
 				Hash:     hash,
 				Value:    lnchat.NewAmount(amtMsat),
-				Htlcs: []lnchat.HTLCAttempt{
-					{
-						Status: lnrpc.HTLCAttempt_SUCCEEDED,
-						Route:  route,
-					},
-				},
 			},
 		}
+		for _, route := range recipientRoutes {
+			payment.Htlcs = append(payment.Htlcs, lnchat.HTLCAttempt{
+				Status: lnrpc.HTLCAttempt_SUCCEEDED,
+				Route:  *route,
+			})
+			if remaining := route.RemainingPayloadSize(); remaining < remainingPayloadSize {
+				remainingPayloadSize = remaining
+			}
+		}
 		payments = append(payments, payment)
 		rawMsg.WithPaymentIndexes(payment.PaymentIndex)
 	}
@@ -95,6 +148,9 @@ func (app *App) EstimatePayment(ctx context.Context,
 	}
 
 	msg.SuccessProb = totalProb
+	msg.PayloadSize = payloadSize
+	msg.RemainingPayloadSize = remainingPayloadSize
+	msg.Parts = parts
 
 	return msg, nil
 }
@@ -3,6 +3,7 @@ package app
 import (
 	"context"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/lightningnetwork/lnd/lntypes"
@@ -20,23 +21,51 @@ func payOptsWithFeeLimit(feeLimit int64) lnchat.PaymentOptions {
 	return DefaultOptions.WithFeeLimit(feeLimit).GetPaymentOptions()
 }
 
-func mustCreatePayload(t *testing.T, participants []string,
-	payload, sender string, signature []byte) map[uint64][]byte {
-
-	rawPayload := mustJSONMarshalMessage(t, participants, payload)
+// payloadMatcher matches raw payloads carrying a message
+// with the provided participant set and body.
+func payloadMatcher(participants []string, payload string) interface{} {
+	return mock.MatchedBy(func(data []byte) bool {
+		return payloadMatches(data, participants, payload)
+	})
+}
 
-	raw := &model.RawMessage{
-		RawPayload: rawPayload,
-		Sender:     sender,
-		Signature:  signature,
+func payloadMatches(data []byte, participants []string, payload string) bool {
+	decoded, err := model.DecodePayload(data)
+	if err != nil {
+		return false
 	}
 
-	return marshalPayload(raw)
+	return reflect.DeepEqual(decoded.Participants, participants) &&
+		decoded.Message == payload
+}
+
+// wirePayloadMatcher matches wire payloads carrying a message with the
+// provided participant set and body, along with the provided signature.
+func wirePayloadMatcher(t *testing.T, participants []string,
+	payload, sender string, signature []byte) interface{} {
+
+	expected := marshalPayload(&model.RawMessage{
+		Sender:    mustNodeFromString(t, sender),
+		Signature: signature,
+	})
+
+	return mock.MatchedBy(func(p map[uint64][]byte) bool {
+		rest := make(map[uint64][]byte, len(p))
+		for k, v := range p {
+			if k != PayloadTypeKey {
+				rest[k] = v
+			}
+		}
+
+		return payloadMatches(p[PayloadTypeKey], participants, payload) &&
+			reflect.DeepEqual(rest, expected)
+	})
 }
 
 func TestEstimatePayment(t *testing.T) {
 	srcAddress := "000000000000000000000000000000000000000000000000000000000000000000"
 	destAddress := "111111111111111111111111111111111111111111111111111111111111111111"
+	otherAddress := "222222222222222222222222222222222222222222222222222222222222222222"
 
 	selfInfo := lnchat.SelfInfo{
 		Node: lnchat.LightningNode{
@@ -52,7 +81,7 @@ func TestEstimatePayment(t *testing.T) {
 		recipient     string
 		amt           int64
 		payOpts       lnchat.PaymentOptions
-		payload       map[uint64][]byte
+		payload       interface{}
 		expectedRoute *lnchat.Route
 		expectedProb  float64
 		expectedErr   error
@@ -75,6 +104,14 @@ func TestEstimatePayment(t *testing.T) {
 			},
 			Options: DefaultOptions,
 		},
+		model.Discussion{
+			ID: 43,
+			Participants: []string{
+				destAddress,
+				otherAddress,
+			},
+			Options: DefaultOptions,
+		},
 	}
 
 	cases := []struct {
@@ -106,7 +143,7 @@ func TestEstimatePayment(t *testing.T) {
 					recipient: discussions[0].Participants[0],
 					amt:       1023,
 					payOpts:   payOptsWithFeeLimit(3200),
-					payload: mustCreatePayload(t, discussions[0].Participants,
+					payload: wirePayloadMatcher(t, discussions[0].Participants,
 						testPayload, srcAddress, []byte("dummy signature")),
 					expectedRoute: &lnchat.Route{
 						TimeLock: 321,
@@ -150,6 +187,88 @@ func TestEstimatePayment(t *testing.T) {
 				PreimageHash: zeroHash[:],
 				Preimage:     zeroPreimage,
 				SuccessProb:  .75,
+				// The payload, sender and signature records.
+				PayloadSize: 79 + 39 + 21,
+				// A single-hop route without custom records.
+				RemainingPayloadSize: lnchat.MaxOnionPayloadSize - 41,
+				Parts:                1,
+			},
+			expectedErr: nil,
+		},
+		{
+			name:             "Partial GetRoute failure in group discussion",
+			discID:           discussions[1].ID,
+			amt:              1023,
+			payload:          testPayload,
+			opts:             defaultTestOpts,
+			discussion:       &discussions[1],
+			getDiscussionErr: nil,
+			signature:        []byte("dummy signature"),
+			signMessageErr:   nil,
+			getRouteCalls: []getRouteCall{
+				{
+					recipient: destAddress,
+					amt:       1023,
+					payOpts:   payOptsWithFeeLimit(3200),
+					payload: wirePayloadMatcher(t, discussions[1].Participants,
+						testPayload, srcAddress, []byte("dummy signature")),
+					expectedRoute: &lnchat.Route{
+						TimeLock: 321,
+						Amt:      lnchat.NewAmount(1023),
+						Hops: []lnchat.RouteHop{
+							{
+								ChannelID:    0x01,
+								NodeID:       destNode,
+								AmtToForward: lnchat.NewAmount(1023),
+								Expiry:       333,
+							},
+						},
+					},
+					expectedProb: .75,
+					expectedErr:  nil,
+				},
+				{
+					recipient: otherAddress,
+					amt:       1023,
+					payOpts:   payOptsWithFeeLimit(3200),
+					payload: wirePayloadMatcher(t, discussions[1].Participants,
+						testPayload, srcAddress, []byte("dummy signature")),
+					expectedRoute: nil,
+					expectedProb:  .0,
+					expectedErr:   fmt.Errorf("some error"),
+				},
+			},
+			expectedMessage: &model.Message{
+				DiscussionID:   discussions[1].ID,
+				Payload:        testPayload,
+				AmtMsat:        1023,
+				Sender:         srcAddress,
+				Receiver:       destAddress,
+				SenderVerified: true,
+				TotalFeesMsat:  0,
+				Routes: []model.Route{
+					model.Route{
+						TotalTimeLock: 321,
+						RouteAmtMsat:  1023,
+						RouteFeesMsat: 0,
+						RouteHops: []model.Hop{
+							model.Hop{
+								ChanID:           0x01,
+								HopAddress:       destNode.String(),
+								AmtToForwardMsat: 1023,
+								FeeMsat:          0,
+							},
+						},
+					},
+				},
+				PreimageHash: zeroHash[:],
+				Preimage:     zeroPreimage,
+				SuccessProb:  0,
+				// The payload (including two participants),
+				// sender and signature records.
+				PayloadSize:          112 + 39 + 21,
+				RemainingPayloadSize: lnchat.MaxOnionPayloadSize - 41,
+				Parts:                1,
 			},
 			expectedErr: nil,
 		},
@@ -192,7 +311,7 @@ func TestEstimatePayment(t *testing.T) {
 					recipient: discussions[0].Participants[0],
 					amt:       103,
 					payOpts:   payOptsWithFeeLimit(3200),
-					payload: mustCreatePayload(t, discussions[0].Participants,
+					payload: wirePayloadMatcher(t, discussions[0].Participants,
 						"test should fail to find route", srcAddress, []byte("dummy signature")),
 					expectedRoute: nil,
 					expectedProb:  .0,
@@ -211,8 +330,13 @@ func TestEstimatePayment(t *testing.T) {
 				*lnmock.LightManager, *dbmock.Database, func()) {
 
 				mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+				mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 				mockDB.On("GetLastInvoiceIndex").Return(uint64(0), nil).Once()
+				mockDB.On("GetOutboxMessages").Return(nil, nil)
+				mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+				mockLNManager.On("ListPayments", mock.Anything,
+					uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 				mockLNManager.On("SubscribeInvoiceUpdates", mock.Anything, uint64(0),
 					mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -222,9 +346,8 @@ func TestEstimatePayment(t *testing.T) {
 
 				if c.getDiscussionErr == nil {
 					if !c.opts.Anonymous {
-						marshalledPayload := mustJSONMarshalMessage(t,
-							c.discussion.Participants, c.payload)
-						mockLNManager.On("SignMessage", mock.Anything, marshalledPayload).Return(
+						mockLNManager.On("SignMessage", mock.Anything, payloadMatcher(
+							c.discussion.Participants, c.payload)).Return(
 							c.signature, c.signMessageErr).Once()
 					}
 
@@ -253,11 +376,14 @@ func TestEstimatePayment(t *testing.T) {
 			ctxt, cancel := context.WithTimeout(context.Background(), defaultTimeout)
 			defer cancel()
 
-			msg, err := app.EstimatePayment(ctxt, c.payload, c.amt, c.discID, c.opts)
+			msg, err := app.EstimatePayment(ctxt, model.MessageContent{Body: c.payload}, c.amt, c.discID, c.opts)
 
 			switch c.expectedErr {
 			case nil:
 				msg.SentTimeNs, msg.ReceivedTimeNs = 0, 0
+				// The message identifier is randomly generated.
+				assert.NotEmpty(t, msg.MessageID)
+				msg.MessageID = ""
 
 				assert.NoError(t, err)
 				assert.Equal(t, c.expectedMessage, msg)
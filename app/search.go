@@ -0,0 +1,55 @@
+package app
+
+import (
+	"context"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// SearchMessages returns the requested range of messages matching a search query,
+// along with the ranges of their payload matching the query terms.
+// Deleted messages and messages of deleted discussions are omitted
+// from the results, while the query terms are matched against
+// the edited payload of messages.
+func (app *App) SearchMessages(ctx context.Context, query model.SearchQuery,
+	pageOpts model.PageOptions) ([]model.SearchResult, error) {
+
+	msgList, err := app.Database.SearchMessages(query, pageOpts)
+	if err != nil {
+		return nil, newErrorf(err, "SearchMessages")
+	}
+
+	discussions := make(map[uint64]*model.Discussion)
+	discussionFor := func(discID uint64) func([]string) (*model.Discussion, error) {
+		return func(_ []string) (*model.Discussion, error) {
+			if disc, ok := discussions[discID]; ok {
+				return disc, nil
+			}
+			disc, err := app.retrieveDiscussion(ctx, discID)
+			if err != nil {
+				return nil, err
+			}
+			discussions[discID] = disc
+
+			return disc, nil
+		}
+	}
+
+	terms := model.SearchTerms(query.Text)
+
+	results := make([]model.SearchResult, 0, len(msgList))
+	for _, m := range msgList {
+		retrieveDisc := discussionFor(m.RawMessage.DiscussionID)
+		msg, err := annotatedMessage(m, retrieveDisc)
+		if err != nil {
+			return nil, err
+		}
+
+		results = append(results, model.SearchResult{
+			Message:    *msg,
+			Highlights: model.SearchHighlights(msg.Payload, terms),
+		})
+	}
+
+	return results, nil
+}
@@ -32,9 +32,14 @@ func TestGetSelfInfoSuccess(t *testing.T) {
 
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
@@ -43,6 +48,7 @@ func TestGetSelfInfoSuccess(t *testing.T) {
 		mockLNManager.On("Close").Return(nil).Once()
 
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockStopFunc := func() {}
 
@@ -106,9 +112,14 @@ func TestGetSelfBalanceSuccess(t *testing.T) {
 
 		// Mock self info
 		mockLNManager.On("GetSelfInfo", mock.Anything).Return(selfInfo, nil).Once()
+		mockDB.On("GetInvoiceFragments").Return(nil, nil)
 
 		mockDB.On("GetLastInvoiceIndex").Return(
 			uint64(1), nil).Once()
+		mockDB.On("GetOutboxMessages").Return(nil, nil)
+		mockDB.On("GetLastPaymentIndex").Return(uint64(0), nil)
+		mockLNManager.On("ListPayments", mock.Anything,
+			uint64(0), uint64(paymentPageSize)).Return(nil, nil)
 
 		mockLNManager.On("SubscribeInvoiceUpdates",
 			mock.Anything, uint64(1), mock.AnythingOfType("func(*lnchat.Invoice) bool")).Return(nil, nil)
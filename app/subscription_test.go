@@ -8,4 +8,5 @@ import (
 
 func TestTopicConsts(t *testing.T) {
 	assert.EqualValues(t, "message", messageTopic)
+	assert.EqualValues(t, "message_status", messageStatusTopic)
 }
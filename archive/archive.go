@@ -0,0 +1,155 @@
+// Package archive implements the format used for exporting and importing
+// discussions along with their message history.
+//
+// An archive is a sequence of JSON objects, one per line (JSON lines).
+// Each object is a record, whose "type" field determines
+// the single record field that is populated:
+//
+//	{"type":"header","header":{"version":1,"created":"...","encryption":""}}
+//	{"type":"contact","contact":{...}}
+//	{"type":"discussion","discussion":{...}}
+//	{"type":"invoice","invoice":{...}}
+//	{"type":"payment","payment":{...}}
+//	{"type":"message","message":{...}}
+//	{"type":"end","end":{"count":5}}
+//
+// The header is always the first record of an archive and the end record
+// always the last one. The end record contains the number of records
+// between them, so that truncated archives can be detected.
+//
+// The contact records precede the discussion records.
+// Each discussion record is followed by its message records, in storage
+// order, with each message record preceded by the records of the invoices
+// or payments carrying the message. The contact, discussion, invoice,
+// payment and message records contain the stored representation
+// of the respective model types, with messages referring
+// to their discussion by id.
+//
+// If the header encryption field is set to "aes-gcm", every record following
+// the header is sealed with AES-GCM under a symmetric key of 16, 24 or 32 bytes
+// and is stored as a sealed record:
+//
+//	{"type":"sealed","sealed":"<base64>"}
+//
+// The sealed value contains the random nonce used, followed by the ciphertext
+// of the JSON encoded record. The sequence number of the record
+// (starting at 1 for the record following the header) is authenticated
+// as additional data, so that reordered or removed records are detected.
+package archive
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"encoding/binary"
+	"fmt"
+	"time"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// Version is the archive format version.
+const Version = 1
+
+// EncryptionAESGCM identifies archives sealed with AES-GCM.
+const EncryptionAESGCM = "aes-gcm"
+
+var (
+	// ErrUnsupportedVersion is returned in case
+	// the archive format version is not supported.
+	ErrUnsupportedVersion = fmt.Errorf("unsupported archive version")
+	// ErrKeyRequired is returned in case an encrypted archive
+	// is read without providing a key.
+	ErrKeyRequired = fmt.Errorf("archive is encrypted, key required")
+	// ErrMalformed is returned in case the archive
+	// is truncated or its records are malformed.
+	ErrMalformed = fmt.Errorf("malformed archive")
+)
+
+// RecordType represents the type of an archive record.
+type RecordType string
+
+const (
+	// RecordHeader is the type of the archive header record.
+	RecordHeader RecordType = "header"
+	// RecordContact is the type of contact records.
+	RecordContact RecordType = "contact"
+	// RecordDiscussion is the type of discussion records.
+	RecordDiscussion RecordType = "discussion"
+	// RecordInvoice is the type of invoice records.
+	RecordInvoice RecordType = "invoice"
+	// RecordPayment is the type of payment records.
+	RecordPayment RecordType = "payment"
+	// RecordMessage is the type of raw message records.
+	RecordMessage RecordType = "message"
+	// RecordEnd is the type of the archive end record.
+	RecordEnd RecordType = "end"
+	// recordSealed is the type of encrypted records.
+	recordSealed RecordType = "sealed"
+)
+
+// Header describes an archive.
+type Header struct {
+	// The archive format version.
+	Version int `json:"version"`
+	// The archive creation time.
+	Created time.Time `json:"created"`
+	// The encryption scheme of the archive records (if any).
+	Encryption string `json:"encryption,omitempty"`
+}
+
+// End marks the end of an archive.
+type End struct {
+	// The number of records between the header and the end record.
+	Count uint64 `json:"count"`
+}
+
+// Record represents an archive record.
+// Only the field corresponding to the record type is populated.
+type Record struct {
+	Type       RecordType        `json:"type"`
+	Header     *Header           `json:"header,omitempty"`
+	Contact    *model.Contact    `json:"contact,omitempty"`
+	Discussion *model.Discussion `json:"discussion,omitempty"`
+	Invoice    *model.Invoice    `json:"invoice,omitempty"`
+	Payment    *model.Payment    `json:"payment,omitempty"`
+	Message    *model.RawMessage `json:"message,omitempty"`
+	End        *End              `json:"end,omitempty"`
+	Sealed     []byte            `json:"sealed,omitempty"`
+}
+
+// populated returns whether the field corresponding
+// to the record type is populated.
+func (r *Record) populated() bool {
+	switch r.Type {
+	case RecordContact:
+		return r.Contact != nil
+	case RecordDiscussion:
+		return r.Discussion != nil
+	case RecordInvoice:
+		return r.Invoice != nil
+	case RecordPayment:
+		return r.Payment != nil
+	case RecordMessage:
+		return r.Message != nil
+	}
+	return false
+}
+
+// newAEAD creates the AES-GCM cipher used for sealing archive records.
+func newAEAD(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// sequenceData returns the additional data
+// authenticated along with a sealed record.
+func sequenceData(seq uint64) []byte {
+	b := make([]byte, 8)
+	binary.BigEndian.PutUint64(b, seq)
+
+	return b
+}
@@ -0,0 +1,129 @@
+package archive
+
+import (
+	"bytes"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func writeArchive(t *testing.T, key []byte) []byte {
+	buf := new(bytes.Buffer)
+
+	w, err := NewWriter(buf, key)
+	require.NoError(t, err)
+
+	require.NoError(t, w.WriteContact(&model.Contact{
+		ID:          1,
+		DisplayName: "alice",
+		Node:        model.Node{Address: "000000000000000000000000000000000000000000000000000000000000000000"},
+	}))
+	require.NoError(t, w.WriteDiscussion(&model.Discussion{
+		ID:           2,
+		Participants: []string{"000000000000000000000000000000000000000000000000000000000000000000"},
+		Metadata:     model.DiscussionMetadata{Title: "title"},
+	}))
+	require.NoError(t, w.WriteInvoice(&model.Invoice{
+		Invoice: lnchat.Invoice{Hash: "hash", SettleIndex: 3},
+	}))
+	require.NoError(t, w.WriteMessage(&model.RawMessage{
+		ID:                 4,
+		DiscussionID:       2,
+		RawPayload:         []byte("payload"),
+		InvoiceSettleIndex: 3,
+		Timestamp:          time.Unix(10, 0).UTC(),
+	}))
+	require.NoError(t, w.Close())
+
+	return buf.Bytes()
+}
+
+func readArchive(t *testing.T, data, key []byte) ([]*Record, error) {
+	r, err := NewReader(bytes.NewReader(data), key)
+	if err != nil {
+		return nil, err
+	}
+	assert.Equal(t, Version, r.Header().Version)
+
+	var records []*Record
+	for {
+		record, err := r.Next()
+		if err == io.EOF {
+			return records, nil
+		}
+		if err != nil {
+			return records, err
+		}
+		records = append(records, record)
+	}
+}
+
+func TestArchiveRoundTrip(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 32)
+
+	for name, key := range map[string][]byte{
+		"Plain":     nil,
+		"Encrypted": key,
+	} {
+		t.Run(name, func(t *testing.T) {
+			data := writeArchive(t, key)
+			assert.Len(t, strings.Split(strings.TrimSpace(string(data)), "\n"), 6)
+
+			records, err := readArchive(t, data, key)
+			require.NoError(t, err)
+			require.Len(t, records, 4)
+
+			assert.Equal(t, RecordContact, records[0].Type)
+			assert.Equal(t, "alice", records[0].Contact.DisplayName)
+			assert.Equal(t, RecordDiscussion, records[1].Type)
+			assert.Equal(t, "title", records[1].Discussion.Metadata.Title)
+			assert.Equal(t, RecordInvoice, records[2].Type)
+			assert.EqualValues(t, 3, records[2].Invoice.SettleIndex)
+			assert.Equal(t, RecordMessage, records[3].Type)
+			assert.Equal(t, []byte("payload"), records[3].Message.RawPayload)
+			assert.True(t, records[3].Message.Timestamp.Equal(time.Unix(10, 0)))
+
+			if key != nil {
+				assert.NotContains(t, string(data), "alice")
+			}
+		})
+	}
+}
+
+func TestArchiveMalformed(t *testing.T) {
+	key := bytes.Repeat([]byte{7}, 16)
+
+	plain := writeArchive(t, nil)
+	encrypted := writeArchive(t, key)
+	lines := func(data []byte) []string {
+		return strings.SplitAfter(string(data), "\n")
+	}
+
+	// Truncated archives are detected.
+	truncated := lines(plain)
+	_, err := readArchive(t, []byte(strings.Join(truncated[:len(truncated)-2], "")), nil)
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	// Removed encrypted records are detected.
+	removed := lines(encrypted)
+	removed = append(removed[:1], removed[2:]...)
+	_, err = readArchive(t, []byte(strings.Join(removed, "")), key)
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	// Encrypted archives require the correct key.
+	_, err = readArchive(t, encrypted, nil)
+	assert.ErrorIs(t, err, ErrKeyRequired)
+	_, err = readArchive(t, encrypted, bytes.Repeat([]byte{8}, 16))
+	assert.ErrorIs(t, err, ErrMalformed)
+
+	// Unsupported versions are rejected.
+	_, err = readArchive(t, []byte(`{"type":"header","header":{"version":2}}`), nil)
+	assert.ErrorIs(t, err, ErrUnsupportedVersion)
+}
@@ -0,0 +1,132 @@
+package archive
+
+import (
+	"crypto/cipher"
+	"encoding/json"
+	"fmt"
+	"io"
+)
+
+// Reader reads records from an archive.
+type Reader struct {
+	dec    *json.Decoder
+	aead   cipher.AEAD
+	seq    uint64
+	done   bool
+	header Header
+}
+
+// NewReader creates an archive reader and reads the archive header.
+// The key is required only for reading encrypted archives.
+func NewReader(r io.Reader, key []byte) (*Reader, error) {
+	ar := &Reader{
+		dec: json.NewDecoder(r),
+	}
+
+	record := new(Record)
+	if err := ar.dec.Decode(record); err != nil {
+		return nil, fmt.Errorf("%w: could not read header: %v", ErrMalformed, err)
+	}
+	if record.Type != RecordHeader || record.Header == nil {
+		return nil, fmt.Errorf("%w: missing header", ErrMalformed)
+	}
+	ar.header = *record.Header
+
+	if ar.header.Version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrUnsupportedVersion, ar.header.Version)
+	}
+
+	switch ar.header.Encryption {
+	case "":
+	case EncryptionAESGCM:
+		if key == nil {
+			return nil, ErrKeyRequired
+		}
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid archive key: %w", err)
+		}
+		ar.aead = aead
+	default:
+		return nil, fmt.Errorf("unsupported archive encryption %q",
+			ar.header.Encryption)
+	}
+
+	return ar, nil
+}
+
+// Header returns the archive header.
+func (r *Reader) Header() Header {
+	return r.header
+}
+
+// Next returns the next archive record.
+// After the end record is read, io.EOF is returned.
+func (r *Reader) Next() (*Record, error) {
+	if r.done {
+		return nil, io.EOF
+	}
+
+	record := new(Record)
+	switch err := r.dec.Decode(record); {
+	case err == io.EOF:
+		return nil, fmt.Errorf("%w: missing end record", ErrMalformed)
+	case err != nil:
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	r.seq++
+
+	if r.aead != nil {
+		var err error
+		if record, err = r.open(record); err != nil {
+			return nil, err
+		}
+	}
+
+	switch record.Type {
+	case RecordEnd:
+		if record.End == nil || record.End.Count != r.seq-1 {
+			return nil, fmt.Errorf("%w: record count mismatch", ErrMalformed)
+		}
+		r.done = true
+		return nil, io.EOF
+	case RecordContact, RecordDiscussion, RecordInvoice,
+		RecordPayment, RecordMessage:
+
+		if !record.populated() {
+			return nil, fmt.Errorf("%w: empty %s record", ErrMalformed, record.Type)
+		}
+		return record, nil
+	default:
+		return nil, fmt.Errorf("%w: unexpected record type %q",
+			ErrMalformed, record.Type)
+	}
+}
+
+// open decrypts and authenticates a sealed record.
+func (r *Reader) open(record *Record) (*Record, error) {
+	if record.Type != recordSealed {
+		return nil, fmt.Errorf("%w: unsealed record in encrypted archive",
+			ErrMalformed)
+	}
+
+	nonceSize := r.aead.NonceSize()
+	if len(record.Sealed) < nonceSize+r.aead.Overhead() {
+		return nil, fmt.Errorf("%w: sealed record too short", ErrMalformed)
+	}
+	plaintext, err := r.aead.Open(nil, record.Sealed[:nonceSize],
+		record.Sealed[nonceSize:], sequenceData(r.seq))
+	if err != nil {
+		return nil, fmt.Errorf("%w: could not open record: %v", ErrMalformed, err)
+	}
+
+	opened := new(Record)
+	if err := json.Unmarshal(plaintext, opened); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrMalformed, err)
+	}
+	if opened.Type == recordSealed {
+		return nil, fmt.Errorf("%w: nested sealed record", ErrMalformed)
+	}
+
+	return opened, nil
+}
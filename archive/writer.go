@@ -0,0 +1,97 @@
+package archive
+
+import (
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// Writer writes records to an archive.
+type Writer struct {
+	enc  *json.Encoder
+	aead cipher.AEAD
+	seq  uint64
+}
+
+// NewWriter creates an archive writer and writes the archive header.
+// If a key is provided, the archive records are sealed with it.
+func NewWriter(w io.Writer, key []byte) (*Writer, error) {
+	aw := &Writer{
+		enc: json.NewEncoder(w),
+	}
+
+	header := &Header{
+		Version: Version,
+		Created: time.Now().UTC(),
+	}
+	if key != nil {
+		aead, err := newAEAD(key)
+		if err != nil {
+			return nil, fmt.Errorf("invalid archive key: %w", err)
+		}
+		aw.aead = aead
+		header.Encryption = EncryptionAESGCM
+	}
+
+	if err := aw.enc.Encode(&Record{Type: RecordHeader, Header: header}); err != nil {
+		return nil, err
+	}
+
+	return aw, nil
+}
+
+// WriteContact writes a contact record.
+func (w *Writer) WriteContact(contact *model.Contact) error {
+	return w.write(&Record{Type: RecordContact, Contact: contact})
+}
+
+// WriteDiscussion writes a discussion record.
+func (w *Writer) WriteDiscussion(discussion *model.Discussion) error {
+	return w.write(&Record{Type: RecordDiscussion, Discussion: discussion})
+}
+
+// WriteInvoice writes an invoice record.
+func (w *Writer) WriteInvoice(invoice *model.Invoice) error {
+	return w.write(&Record{Type: RecordInvoice, Invoice: invoice})
+}
+
+// WritePayment writes a payment record.
+func (w *Writer) WritePayment(payment *model.Payment) error {
+	return w.write(&Record{Type: RecordPayment, Payment: payment})
+}
+
+// WriteMessage writes a raw message record.
+func (w *Writer) WriteMessage(rawMsg *model.RawMessage) error {
+	return w.write(&Record{Type: RecordMessage, Message: rawMsg})
+}
+
+// Close writes the archive end record.
+// It does not close the underlying writer.
+func (w *Writer) Close() error {
+	return w.write(&Record{Type: RecordEnd, End: &End{Count: w.seq}})
+}
+
+func (w *Writer) write(record *Record) error {
+	w.seq++
+	if w.aead == nil {
+		return w.enc.Encode(record)
+	}
+
+	plaintext, err := json.Marshal(record)
+	if err != nil {
+		return err
+	}
+
+	nonce := make([]byte, w.aead.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return fmt.Errorf("could not generate nonce: %w", err)
+	}
+	sealed := w.aead.Seal(nonce, nonce, plaintext, sequenceData(w.seq))
+
+	return w.enc.Encode(&Record{Type: recordSealed, Sealed: sealed})
+}
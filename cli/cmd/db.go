@@ -0,0 +1,185 @@
+package cmd
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/c13n-io/c13n-go/store"
+)
+
+var dbCmd = &cobra.Command{
+	Use:   "db",
+	Short: "Database maintenance",
+}
+
+var dbRestoreCmd = &cobra.Command{
+	Use:   "restore <backup>...",
+	Short: "Restore the database from backups",
+	Long: "Restore the database from backups.\n\n" +
+		"Loads backups created by AdminService.BackupDatabase or by scheduled\n" +
+		"backups into the configured database, in the order provided:\n" +
+		"a full backup followed by the incremental backups created after it.\n" +
+		"Each backup is verified to have been created from a database with\n" +
+		"the configured encryption key before it is loaded.\n" +
+		"Backups of earlier schema versions are migrated once loaded.\n" +
+		"The database must not be in use by a running server.",
+	Args: cobra.MinimumNArgs(1),
+	RunE: Restore,
+}
+
+var migrateDryRun bool
+
+var dbMigrateCmd = &cobra.Command{
+	Use:   "migrate",
+	Short: "Apply pending database schema migrations",
+	Long: "Apply pending database schema migrations.\n\n" +
+		"Pending migrations are also applied whenever the server starts.\n" +
+		"A full backup of the database is written to the migration backup\n" +
+		"directory before any migration is applied.\n" +
+		"With --dry-run, the migrations are applied to an in-memory copy\n" +
+		"of the database, leaving the database unchanged.\n" +
+		"The database must not be in use by a running server.",
+	Args: cobra.NoArgs,
+	RunE: Migrate,
+}
+
+var (
+	newKeyPath    string
+	newKeyCommand string
+)
+
+var dbRotateKeyCmd = &cobra.Command{
+	Use:   "rotate-key",
+	Short: "Re-encrypt the database under a new encryption key",
+	Long: "Re-encrypt the database under a new encryption key.\n\n" +
+		"Verifies the configured encryption key and re-encrypts the database\n" +
+		"under the new key, which must be configured afterwards.\n" +
+		"Existing backups remain encrypted under the previous key.\n" +
+		"The database must not be in use by a running server.",
+	Args: cobra.NoArgs,
+	RunE: RotateKey,
+}
+
+func init() {
+	dbMigrateCmd.Flags().BoolVar(&migrateDryRun, "dry-run", false,
+		"Verify the pending migrations without modifying the database")
+
+	rotateFlags := dbRotateKeyCmd.Flags()
+	rotateFlags.StringVar(&newKeyPath, "new-key-path", "",
+		"Path of the new database encryption key file (16, 24 or 32 bytes)")
+	rotateFlags.StringVar(&newKeyCommand, "new-key-command", "",
+		"Command printing the new database encryption key to its standard output")
+
+	dbCmd.AddCommand(dbRestoreCmd)
+	dbCmd.AddCommand(dbMigrateCmd)
+	dbCmd.AddCommand(dbRotateKeyCmd)
+	rootCmd.AddCommand(dbCmd)
+}
+
+// Restore loads the provided backups into the database.
+func Restore(_ *cobra.Command, args []string) error {
+	if err := initLogLevel(); err != nil {
+		return err
+	}
+
+	var backups []io.Reader
+	for _, path := range args {
+		f, err := os.Open(path)
+		if err != nil {
+			logger.WithError(err).Errorf("Could not open backup %s", path)
+			return err
+		}
+		defer f.Close()
+		backups = append(backups, bufio.NewReader(f))
+	}
+
+	// The backups are loaded as the database is opened,
+	// before migrations are applied and indexes are built.
+	db, err := openDatabase(store.WithRestore(backups...))
+	if err != nil {
+		logger.WithError(err).Error("Could not restore backups")
+		return err
+	}
+	if err := db.Close(); err != nil {
+		logger.WithError(err).Error("Database close failed")
+		return err
+	}
+
+	for _, path := range args {
+		fmt.Printf("Restored backup %s\n", path)
+	}
+
+	return nil
+}
+
+// RotateKey re-encrypts the database under a new encryption key.
+func RotateKey(_ *cobra.Command, _ []string) error {
+	if err := initLogLevel(); err != nil {
+		return err
+	}
+
+	if viper.GetString("database.backend") == "sqlite" {
+		return fmt.Errorf("sqlite databases are not encrypted; " +
+			"there is no encryption key to rotate")
+	}
+	if newKeyPath == "" && newKeyCommand == "" {
+		return fmt.Errorf("one of --new-key-path or --new-key-command is required")
+	}
+
+	oldKey, err := readDatabaseKey(viper.GetString("database.key_path"),
+		viper.GetString("database.key_command"))
+	if err != nil {
+		return err
+	}
+	newKey, err := readDatabaseKey(newKeyPath, newKeyCommand)
+	if err != nil {
+		return err
+	}
+
+	if err := store.RotateEncryptionKey(viper.GetString("database.db_path"),
+		oldKey, newKey); err != nil {
+
+		logger.WithError(err).Error("Could not rotate database encryption key")
+		return err
+	}
+
+	fmt.Println("Database encryption key rotated; " +
+		"update the database key configuration before restarting")
+
+	return nil
+}
+
+// Migrate applies the pending database schema migrations.
+func Migrate(_ *cobra.Command, _ []string) error {
+	if err := initLogLevel(); err != nil {
+		return err
+	}
+
+	var options []func(store.Database)
+	if migrateDryRun {
+		options = append(options, store.WithMigrationDryRun())
+	}
+
+	db, err := openDatabase(options...)
+	switch {
+	case errors.Is(err, store.ErrMigrationDryRun):
+		fmt.Println("Dry run completed; the database was not modified")
+		return nil
+	case err != nil:
+		return err
+	}
+
+	if err := db.Close(); err != nil {
+		logger.WithError(err).Error("Database close failed")
+		return err
+	}
+	fmt.Println("Database schema is up to date")
+
+	return nil
+}
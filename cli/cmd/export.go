@@ -0,0 +1,122 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/c13n-io/c13n-go/archive"
+)
+
+var (
+	exportOutput      string
+	exportDiscussions []uint
+	archiveKeyPath    string
+)
+
+var exportCmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export discussions and their message history to an archive",
+	Long: "Export discussions and their message history to an archive.\n\n" +
+		"Writes the contacts, discussions and messages of the database,\n" +
+		"along with the invoices and payments carrying the messages,\n" +
+		"to a JSON lines archive, optionally encrypted with a key file.\n" +
+		"The database must not be in use by a running server;\n" +
+		"use DiscussionService.ExportDiscussion over RPC instead.",
+	Args: cobra.NoArgs,
+	RunE: Export,
+}
+
+func init() {
+	exportFlags := exportCmd.Flags()
+	exportFlags.StringVarP(&exportOutput, "output", "o", "-",
+		"Path of the archive file to write (- for standard output)")
+	exportFlags.UintSliceVar(&exportDiscussions, "discussion", nil,
+		"Id of a discussion to export (repeatable, all discussions if unset)")
+	exportFlags.StringVar(&archiveKeyPath, "archive-key-path", "",
+		"Path of the archive encryption key file (16, 24 or 32 bytes)")
+
+	rootCmd.AddCommand(exportCmd)
+}
+
+// Export writes the requested discussions of the database to an archive.
+func Export(_ *cobra.Command, _ []string) error {
+	if err := initLogLevel(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := readArchiveKey()
+	if err != nil {
+		return err
+	}
+
+	var out io.Writer = os.Stdout
+	var file *os.File
+	if exportOutput != "-" {
+		file, err = os.OpenFile(exportOutput, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+		if err != nil {
+			logger.WithError(err).Error("Could not create archive file")
+			return err
+		}
+		defer file.Close()
+		out = file
+	}
+
+	application, err := newApplication()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := application.Cleanup(); err != nil {
+			logger.WithError(err).Error("Error generated during cleanup")
+		}
+	}()
+
+	w, err := archive.NewWriter(out, key)
+	if err != nil {
+		logger.WithError(err).Error("Could not create archive")
+		return err
+	}
+	discIDs := make([]uint64, len(exportDiscussions))
+	for i, id := range exportDiscussions {
+		discIDs[i] = uint64(id)
+	}
+	if err := application.ExportDiscussions(ctx, w, discIDs...); err != nil {
+
+		logger.WithError(err).Error("Export failed")
+		return err
+	}
+
+	if file != nil {
+		if err := file.Sync(); err != nil {
+			logger.WithError(err).Error("Could not write archive file")
+			return err
+		}
+	}
+
+	fmt.Fprintln(os.Stderr, "Export completed")
+
+	return nil
+}
+
+// readArchiveKey reads the archive encryption key, if configured.
+func readArchiveKey() ([]byte, error) {
+	if archiveKeyPath == "" {
+		return nil, nil
+	}
+
+	key, err := ioutil.ReadFile(archiveKeyPath)
+	if err != nil {
+		logger.WithError(err).Error("Could not read archive encryption key file")
+		return nil, err
+	}
+
+	return key, nil
+}
@@ -0,0 +1,82 @@
+package cmd
+
+import (
+	"bufio"
+	"context"
+	"fmt"
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/c13n-io/c13n-go/archive"
+)
+
+var importCmd = &cobra.Command{
+	Use:   "import <archive>",
+	Short: "Import discussions and their message history from an archive",
+	Long: "Import discussions and their message history from an archive.\n\n" +
+		"Reads an archive created by the export subcommand or by\n" +
+		"DiscussionService.ExportDiscussion, storing its contacts, discussions\n" +
+		"and messages. Invoices and payments are deduplicated by payment hash;\n" +
+		"messages whose invoices or payments are already present are skipped,\n" +
+		"so an import can be safely repeated.",
+	Args: cobra.ExactArgs(1),
+	RunE: Import,
+}
+
+func init() {
+	importCmd.Flags().StringVar(&archiveKeyPath, "archive-key-path", "",
+		"Path of the archive encryption key file (required for encrypted archives)")
+
+	rootCmd.AddCommand(importCmd)
+}
+
+// Import stores the contents of an archive in the database.
+func Import(_ *cobra.Command, args []string) error {
+	if err := initLogLevel(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	key, err := readArchiveKey()
+	if err != nil {
+		return err
+	}
+
+	f, err := os.Open(args[0])
+	if err != nil {
+		logger.WithError(err).Error("Could not open archive file")
+		return err
+	}
+	defer f.Close()
+
+	r, err := archive.NewReader(bufio.NewReader(f), key)
+	if err != nil {
+		logger.WithError(err).Error("Could not read archive")
+		return err
+	}
+
+	application, err := newApplication()
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := application.Cleanup(); err != nil {
+			logger.WithError(err).Error("Error generated during cleanup")
+		}
+	}()
+
+	summary, err := application.ImportArchive(ctx, r)
+	if err != nil {
+		logger.WithError(err).Error("Import failed")
+		return err
+	}
+
+	fmt.Printf("Import completed: %d contacts, %d discussions, "+
+		"%d messages imported, %d duplicate messages skipped\n",
+		summary.Contacts, summary.Discussions, summary.Messages, summary.Duplicates)
+
+	return nil
+}
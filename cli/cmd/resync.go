@@ -0,0 +1,60 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+)
+
+var resyncCmd = &cobra.Command{
+	Use:   "resync",
+	Short: "Rebuild message history from the Lightning daemon",
+	Long: "Rebuild message history from the Lightning daemon.\n\n" +
+		"Walks the settled invoices and completed payments of the node,\n" +
+		"restoring any messages they carry and recreating their discussions.\n" +
+		"Invoices and payments already present in the database are skipped.",
+	RunE: Resync,
+}
+
+func init() {
+	rootCmd.AddCommand(resyncCmd)
+}
+
+// Resync rebuilds the message history of the database from the Lightning daemon.
+func Resync(_ *cobra.Command, _ []string) error {
+	if err := initLogLevel(); err != nil {
+		return err
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	application, err := newApplication()
+	if err != nil {
+		return err
+	}
+
+	// Only the node identity is needed, so no subscriptions
+	// or background tasks are started.
+	if err := application.InitSelfInfo(ctx, 15); err != nil {
+		logger.WithError(err).Error("Could not initialize application")
+		return err
+	}
+	defer func() {
+		if err := application.Cleanup(); err != nil {
+			logger.WithError(err).Error("Error generated during cleanup")
+		}
+	}()
+
+	summary, err := application.Resync(ctx)
+	if err != nil {
+		logger.WithError(err).Error("Resync failed")
+		return err
+	}
+
+	fmt.Printf("Resync completed: %d invoices, %d payments examined, "+
+		"%d messages restored\n", summary.Invoices, summary.Payments, summary.Messages)
+
+	return nil
+}
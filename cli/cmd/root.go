@@ -1,6 +1,8 @@
 package cmd
 
 import (
+	"time"
+
 	homedir "github.com/mitchellh/go-homedir"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -47,6 +49,34 @@ func initCliFlags() {
 		"Default fee limit for discussions in millisatoshi")
 	_ = viper.BindPFlag("app.default_fee_limit_msat",
 		rootFlags.Lookup("default-fee-limit-msat"))
+	rootFlags.Int("max-parallelism", 8,
+		"Maximum number of concurrent payments or route queries for group discussions")
+	_ = viper.BindPFlag("app.max_parallelism",
+		rootFlags.Lookup("max-parallelism"))
+	rootFlags.Bool("presence", false,
+		"Receive presence indications by intercepting forwarded HTLCs")
+	_ = viper.BindPFlag("app.presence",
+		rootFlags.Lookup("presence"))
+	rootFlags.Duration("retention-max-age", 0,
+		"Default maximum age of retained messages (0 for no limit)")
+	_ = viper.BindPFlag("app.retention.max_age",
+		rootFlags.Lookup("retention-max-age"))
+	rootFlags.Uint64("retention-max-count", 0,
+		"Default maximum number of retained messages per discussion (0 for no limit)")
+	_ = viper.BindPFlag("app.retention.max_count",
+		rootFlags.Lookup("retention-max-count"))
+	rootFlags.Duration("retention-sweep-interval", time.Hour,
+		"Interval between redactions of messages exceeding their retention policy (0 to disable)")
+	_ = viper.BindPFlag("app.retention.sweep_interval",
+		rootFlags.Lookup("retention-sweep-interval"))
+	rootFlags.String("backup-dir", "",
+		"Directory scheduled database backups are written to")
+	_ = viper.BindPFlag("app.backup.dir",
+		rootFlags.Lookup("backup-dir"))
+	rootFlags.Duration("backup-interval", 0,
+		"Interval between scheduled incremental database backups (0 to disable)")
+	_ = viper.BindPFlag("app.backup.interval",
+		rootFlags.Lookup("backup-interval"))
 
 	// RPC flags
 	rootFlags.String("server-address", "localhost:9999",
@@ -94,12 +124,23 @@ func initCliFlags() {
 	_ = viper.BindPFlag("lnd.macaroon_ip", rootFlags.Lookup("lnd-macaroon-ip"))
 
 	// DB flags
+	rootFlags.String("db-backend", "badger",
+		"Database storage backend (badger or sqlite)")
+	_ = viper.BindPFlag("database.backend", rootFlags.Lookup("db-backend"))
 	rootFlags.String("db-path", "c13n.db",
-		"Path of the database directory")
+		"Path of the database directory (badger) or file (sqlite)")
 	_ = viper.BindPFlag("database.db_path", rootFlags.Lookup("db-path"))
 	rootFlags.String("db-key-path", "",
 		"Database encryption key of fixed length(16, 24 or 32 bytes)")
 	_ = viper.BindPFlag("database.key_path", rootFlags.Lookup("db-key-path"))
+	rootFlags.String("db-key-command", "",
+		"Command printing the database encryption key to its standard output")
+	_ = viper.BindPFlag("database.key_command", rootFlags.Lookup("db-key-command"))
+	rootFlags.String("db-migration-backup-dir", "",
+		"Directory database backups are written to before migrations"+
+			" (defaults to the parent directory of the database)")
+	_ = viper.BindPFlag("database.migration_backup_dir",
+		rootFlags.Lookup("db-migration-backup-dir"))
 }
 
 // initConfig reads in config file and env variables if set.
@@ -1,10 +1,15 @@
 package cmd
 
 import (
+	"bytes"
 	"context"
+	"errors"
+	"fmt"
 	"io/ioutil"
 	"os"
+	"os/exec"
 	"os/signal"
+	"path/filepath"
 	"syscall"
 	"time"
 
@@ -15,6 +20,7 @@ import (
 	"github.com/c13n-io/c13n-go/app"
 	"github.com/c13n-io/c13n-go/lnchat"
 	"github.com/c13n-io/c13n-go/lnchat/lnconnect"
+	"github.com/c13n-io/c13n-go/model"
 	"github.com/c13n-io/c13n-go/rpc"
 	"github.com/c13n-io/c13n-go/slog"
 	"github.com/c13n-io/c13n-go/store"
@@ -32,6 +38,66 @@ func initLogger() {
 
 // Run initializes the configuration and starts the application.
 func Run(_ *cobra.Command, _ []string) error {
+	if err := initLogLevel(); err != nil {
+		return err
+	}
+
+	ctxb := context.Background()
+	globalCtx, globalCancel := context.WithCancel(ctxb)
+	defer globalCancel()
+
+	application, err := newApplication()
+	if err != nil {
+		return err
+	}
+
+	if err := application.Init(globalCtx, 15); err != nil {
+		logger.WithError(err).Error("Could not initialize application")
+		return err
+	}
+
+	// Initialize server
+	var srvOpts []func(*rpc.Server) error
+	if viper.IsSet("server.tls.cert_path") && viper.IsSet("server.tls.key_path") {
+		srvOpts = append(srvOpts, rpc.WithTLS(
+			viper.GetString("server.tls.cert_path"),
+			viper.GetString("server.tls.key_path"),
+		))
+	}
+	if viper.IsSet("server.user") && viper.IsSet("server.pass") {
+		srvOpts = append(srvOpts, rpc.WithBasicAuth(
+			viper.GetString("server.user"),
+			viper.GetString("server.pass"),
+		))
+	}
+	srvAddress := viper.GetString("server.address")
+	server, err = rpc.New(srvAddress, application, srvOpts...)
+	if err != nil {
+		logger.WithError(err).Error("Could not initialize server")
+		return err
+	}
+
+	// Shutdown on interrupt
+	terminationCh := make(chan interface{})
+	go waitForTermination(terminationCh,
+		time.Duration(viper.GetInt("server.graceful_shutdown_timeout"))*time.Second)
+
+	logger.Infof("Starting server on %s", srvAddress)
+
+	// Run server
+	if err := server.Serve(server.Listener); err != nil {
+		logger.WithError(err).Error("Fatal server error during Serve")
+		return err
+	}
+
+	<-terminationCh
+
+	logger.Info("THE END")
+	return nil
+}
+
+// initLogLevel sets the configured log level.
+func initLogLevel() error {
 	// Set the default log level
 	logLevel := viper.GetString("log_level")
 	if err := slog.SetLogLevel(logLevel); err != nil {
@@ -42,28 +108,101 @@ func Run(_ *cobra.Command, _ []string) error {
 	// Recreate cmd logger after the log level initialization
 	logger = slog.NewLogger("cmd")
 
-	// Open database encryption file
-	dbMasterKey, err := ioutil.ReadFile(viper.GetString("database.key_path"))
+	return nil
+}
+
+// readDatabaseKey reads a database encryption key, either from the output
+// of the provided key command (if set) or from the provided key file.
+// A single trailing newline is stripped from the key command output.
+func readDatabaseKey(keyPath, keyCommand string) ([]byte, error) {
+	var key []byte
+	switch {
+	case keyPath != "" && keyCommand != "":
+		err := fmt.Errorf("only one of database key path and key command can be set")
+		logger.WithError(err).Error("Could not read database encryption key")
+		return nil, err
+	case keyCommand != "":
+		cmd := exec.Command("sh", "-c", keyCommand)
+		cmd.Stderr = os.Stderr
+		output, err := cmd.Output()
+		if err != nil {
+			logger.WithError(err).Error("Database encryption key command failed")
+			return nil, err
+		}
+		output = bytes.TrimSuffix(output, []byte("\n"))
+		key = bytes.TrimSuffix(output, []byte("\r"))
+	default:
+		var err error
+		key, err = ioutil.ReadFile(keyPath)
+		if err != nil {
+			logger.WithError(err).Error("Could not read database encryption key file")
+			return nil, err
+		}
+	}
+
+	if keyLen := len(key); keyLen != 16 && keyLen != 24 && keyLen != 32 {
+		err := fmt.Errorf("database encryption key of %d bytes not "+
+			"of standard size (16, 24, 32 bytes)", keyLen)
+		logger.WithError(err).Error("Invalid database encryption key")
+		return nil, err
+	}
+
+	return key, nil
+}
+
+// openDatabase opens the database of the configured backend, applying
+// any pending migrations after a backup to the migration backup directory
+// (the parent directory of the database, if unset).
+func openDatabase(options ...func(store.Database)) (store.Database, error) {
+	dbMasterKey, err := readDatabaseKey(viper.GetString("database.key_path"),
+		viper.GetString("database.key_command"))
 	if err != nil {
-		logger.WithError(err).Error("Could not read database encryption key file")
-		return err
+		return nil, err
 	}
 
-	dbKeyLen := len(dbMasterKey)
-	if dbKeyLen != 16 && dbKeyLen != 32 && dbKeyLen != 64 {
-		logger.WithError(err).Error("Database encryption key not of standard size (16,32,64 bytes)")
-		return err
+	dbPath := viper.GetString("database.db_path")
+	backupDir := viper.GetString("database.migration_backup_dir")
+	if backupDir == "" {
+		backupDir = filepath.Dir(filepath.Clean(dbPath))
 	}
 
+	options = append([]func(store.Database){
+		store.WithMigrationBackupDir(backupDir),
+	}, options...)
+
 	// Initialize database
-	db, err := store.New(viper.GetString("database.db_path"), store.WithBadgerOption(
-		func(o badger.Options) badger.Options {
-			return o.WithEncryptionKey(dbMasterKey).WithIndexCacheSize(1 << 20)
-		}),
-	)
-	if err != nil {
+	var db store.Database
+	switch backend := viper.GetString("database.backend"); backend {
+	case "", "badger":
+		db, err = store.New(dbPath, append(options,
+			store.WithBadgerOption(func(o badger.Options) badger.Options {
+				return o.WithEncryptionKey(dbMasterKey).WithIndexCacheSize(1 << 20)
+			}),
+		)...)
+	case "sqlite":
+		db, err = store.NewSQLite(dbPath, append(options,
+			store.WithBackupEncryptionKey(dbMasterKey),
+		)...)
+	default:
+		err = fmt.Errorf("unknown database backend %q", backend)
+	}
+	switch {
+	case errors.Is(err, store.ErrMigrationDryRun):
+		return nil, err
+	case err != nil:
 		logger.WithError(err).Error("Could not create database")
-		return err
+		return nil, err
+	}
+
+	return db, nil
+}
+
+// newApplication creates an application instance
+// with the configured database and Lightning daemon.
+func newApplication() (*app.App, error) {
+	db, err := openDatabase()
+	if err != nil {
+		return nil, err
 	}
 
 	// Initialize chat service
@@ -89,19 +228,15 @@ func Run(_ *cobra.Command, _ []string) error {
 	}
 	if err != nil {
 		logger.WithError(err).Error("Could not create credentials")
-		return err
+		return nil, err
 	}
 
 	lnchatMgr, err := lnchat.New(creds)
 	if err != nil {
 		logger.WithError(err).Error("Could not initialize lnchat service")
-		return err
+		return nil, err
 	}
 
-	ctxb := context.Background()
-	globalCtx, globalCancel := context.WithCancel(ctxb)
-	defer globalCancel()
-
 	// Initialize application
 	var appOpts []func(*app.App) error
 
@@ -109,55 +244,27 @@ func Run(_ *cobra.Command, _ []string) error {
 	if defaultFeeLimitMsat != 0 {
 		appOpts = append(appOpts, app.WithDefaultFeeLimitMsat(defaultFeeLimitMsat))
 	}
-	application, err := app.New(lnchatMgr, db, appOpts...)
-	if err != nil {
-		logger.WithError(err).Error("Could not create application")
-		return err
+	if maxParallelism := viper.GetInt("app.max_parallelism"); maxParallelism != 0 {
+		appOpts = append(appOpts, app.WithMaxParallelism(maxParallelism))
 	}
-
-	if err := application.Init(globalCtx, 15); err != nil {
-		logger.WithError(err).Error("Could not initialize application")
-		return err
+	if viper.GetBool("app.presence") {
+		appOpts = append(appOpts, app.WithPresence(true))
 	}
-
-	// Initialize server
-	var srvOpts []func(*rpc.Server) error
-	if viper.IsSet("server.tls.cert_path") && viper.IsSet("server.tls.key_path") {
-		srvOpts = append(srvOpts, rpc.WithTLS(
-			viper.GetString("server.tls.cert_path"),
-			viper.GetString("server.tls.key_path"),
-		))
-	}
-	if viper.IsSet("server.user") && viper.IsSet("server.pass") {
-		srvOpts = append(srvOpts, rpc.WithBasicAuth(
-			viper.GetString("server.user"),
-			viper.GetString("server.pass"),
-		))
+	retention := model.RetentionPolicy{
+		MaxAge:   viper.GetDuration("app.retention.max_age"),
+		MaxCount: viper.GetUint64("app.retention.max_count"),
 	}
-	srvAddress := viper.GetString("server.address")
-	server, err = rpc.New(srvAddress, application, srvOpts...)
+	appOpts = append(appOpts, app.WithRetention(retention,
+		viper.GetDuration("app.retention.sweep_interval")))
+	appOpts = append(appOpts, app.WithBackups(viper.GetString("app.backup.dir"),
+		viper.GetDuration("app.backup.interval")))
+	application, err := app.New(lnchatMgr, db, appOpts...)
 	if err != nil {
-		logger.WithError(err).Error("Could not initialize server")
-		return err
-	}
-
-	// Shutdown on interrupt
-	terminationCh := make(chan interface{})
-	go waitForTermination(terminationCh,
-		time.Duration(viper.GetInt("server.graceful_shutdown_timeout"))*time.Second)
-
-	logger.Infof("Starting server on %s", srvAddress)
-
-	// Run server
-	if err := server.Serve(server.Listener); err != nil {
-		logger.WithError(err).Error("Fatal server error during Serve")
-		return err
+		logger.WithError(err).Error("Could not create application")
+		return nil, err
 	}
 
-	<-terminationCh
-
-	logger.Info("THE END")
-	return nil
+	return application, nil
 }
 
 func waitForTermination(terminationCh chan<- interface{}, gracePeriodTimeout time.Duration) {
@@ -0,0 +1,31 @@
+package cmd
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestReadDatabaseKey(t *testing.T) {
+	keyPath := filepath.Join(t.TempDir(), "db.key")
+	require.NoError(t, ioutil.WriteFile(keyPath,
+		[]byte("012345678901234567890123"), 0600))
+
+	key, err := readDatabaseKey(keyPath, "")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("012345678901234567890123"), key)
+
+	key, err = readDatabaseKey("", "echo 0123456789012345")
+	require.NoError(t, err)
+	assert.Equal(t, []byte("0123456789012345"), key)
+
+	_, err = readDatabaseKey("", "printf 0123456789")
+	assert.Error(t, err)
+	_, err = readDatabaseKey("", "exit 1")
+	assert.Error(t, err)
+	_, err = readDatabaseKey(keyPath, "echo 0123456789012345")
+	assert.Error(t, err)
+}
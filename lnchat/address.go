@@ -1,6 +1,10 @@
 package lnchat
 
-import "github.com/lightningnetwork/lnd/routing/route"
+import (
+	"encoding/json"
+
+	"github.com/lightningnetwork/lnd/routing/route"
+)
 
 // NodeID represents the identifier for a node (a.k.a. its public key).
 type NodeID struct {
@@ -39,3 +43,47 @@ func (n NodeID) Bytes() []byte {
 
 	return b
 }
+
+// IsZero returns whether the node identifier is unset.
+func (n NodeID) IsZero() bool {
+	return n == NodeID{}
+}
+
+// MarshalJSON encodes the node identifier as a hex-encoded string,
+// which is empty if the identifier is unset.
+func (n NodeID) MarshalJSON() ([]byte, error) {
+	if n.IsZero() {
+		return json.Marshal("")
+	}
+
+	return json.Marshal(n.String())
+}
+
+// UnmarshalJSON decodes a node identifier encoded by MarshalJSON.
+// The structure encoding of node identifiers is also accepted.
+func (n *NodeID) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		var legacy struct {
+			Vertex route.Vertex
+		}
+		if legacyErr := json.Unmarshal(data, &legacy); legacyErr != nil {
+			return err
+		}
+		*n = NodeID{legacy.Vertex}
+		return nil
+	}
+
+	if s == "" {
+		*n = NodeID{}
+		return nil
+	}
+
+	node, err := NewNodeFromString(s)
+	if err != nil {
+		return err
+	}
+	*n = node
+
+	return nil
+}
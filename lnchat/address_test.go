@@ -0,0 +1,39 @@
+package lnchat
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNodeIDJSON(t *testing.T) {
+	const address = "02b5a8213a52feee44ecb735bc22ba5e354c0a3ea53e4ad7e42f2b3d9b1a27f3e2"
+
+	node, err := NewNodeFromString(address)
+	require.NoError(t, err)
+
+	data, err := json.Marshal(node)
+	require.NoError(t, err)
+	assert.JSONEq(t, `"`+address+`"`, string(data))
+
+	var decoded NodeID
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.Equal(t, node, decoded)
+
+	// Unset node identifiers are encoded as empty strings.
+	data, err = json.Marshal(NodeID{})
+	require.NoError(t, err)
+	assert.JSONEq(t, `""`, string(data))
+	require.NoError(t, json.Unmarshal(data, &decoded))
+	assert.True(t, decoded.IsZero())
+
+	// The structure encoding is accepted.
+	legacy, err := json.Marshal(struct{ Vertex [33]byte }{node.Vertex})
+	require.NoError(t, err)
+	require.NoError(t, json.Unmarshal(legacy, &decoded))
+	assert.Equal(t, node, decoded)
+
+	assert.Error(t, json.Unmarshal([]byte(`"invalid"`), &decoded))
+}
@@ -0,0 +1,246 @@
+package lnchat
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/btcsuite/btcd/chaincfg/chainhash"
+	"github.com/lightningnetwork/lnd/lnrpc"
+)
+
+// LightningChannel represents a Lightning network channel
+// between the current node and a remote node.
+type LightningChannel struct {
+	// The ID of the Lightning channel (short channel id).
+	// Pending channels do not have an ID yet.
+	ChannelID uint64
+	// The funding transaction output of the channel.
+	ChannelPoint ChannelPoint
+	// The Lightning address of the remote endpoint (node) of the channel.
+	RemoteAddress string
+	// Capacity is the channel capacity (in millisatoshi).
+	CapacityMsat int64
+	// The balance of the current node in the channel (in millisatoshi).
+	LocalBalanceMsat int64
+	// The balance of the remote node in the channel (in millisatoshi).
+	RemoteBalanceMsat int64
+	// Whether the channel is active (can be used for payments).
+	Active bool
+	// The state of the channel.
+	State ChannelState
+}
+
+// ChannelState represents the state of a channel.
+type ChannelState int32
+
+const (
+	// ChannelOPEN denotes an open channel.
+	ChannelOPEN ChannelState = iota
+	// ChannelPENDINGOPEN denotes a channel whose funding
+	// transaction has not been confirmed yet.
+	ChannelPENDINGOPEN
+	// ChannelPENDINGCLOSE denotes a channel whose closing
+	// transaction has been published but not confirmed yet.
+	ChannelPENDINGCLOSE
+	// ChannelCLOSED denotes a closed channel.
+	ChannelCLOSED
+)
+
+// ChannelEventType represents the type of a channel event.
+type ChannelEventType int32
+
+const (
+	// ChannelEventOPEN signifies that a channel has been opened.
+	ChannelEventOPEN ChannelEventType = iota
+	// ChannelEventPENDINGOPEN signifies that the funding transaction
+	// of a channel has been published.
+	ChannelEventPENDINGOPEN
+	// ChannelEventCLOSED signifies that a channel has been closed.
+	ChannelEventCLOSED
+	// ChannelEventACTIVE signifies that a channel became active.
+	ChannelEventACTIVE
+	// ChannelEventINACTIVE signifies that a channel became inactive.
+	ChannelEventINACTIVE
+	// ChannelEventFULLYRESOLVED signifies that all outputs
+	// of a closed channel have been resolved.
+	ChannelEventFULLYRESOLVED
+)
+
+// ChannelEvent represents an event pertaining to a channel.
+type ChannelEvent struct {
+	// The type of the event.
+	Type ChannelEventType
+	// The funding transaction output of the channel.
+	ChannelPoint ChannelPoint
+	// The channel details, only present for open and closed channel events.
+	Channel *LightningChannel
+}
+
+// parseChannelPoint creates a ChannelPoint from its
+// string representation (funding_txid:output_index).
+func parseChannelPoint(chanPoint string) (ChannelPoint, error) {
+	parts := strings.Split(chanPoint, ":")
+	if len(parts) != 2 {
+		return ChannelPoint{}, fmt.Errorf("invalid channel point %q", chanPoint)
+	}
+
+	txid, err := chainhash.NewHashFromStr(parts[0])
+	if err != nil {
+		return ChannelPoint{}, err
+	}
+	outputIndex, err := strconv.ParseUint(parts[1], 10, 32)
+	if err != nil {
+		return ChannelPoint{}, err
+	}
+
+	return ChannelPoint{
+		FundingTxid: txid.String(),
+		OutputIndex: uint32(outputIndex),
+	}, nil
+}
+
+// unmarshalChannelPoint creates a ChannelPoint from an lnrpc.ChannelPoint.
+func unmarshalChannelPoint(chanPoint *lnrpc.ChannelPoint) (ChannelPoint, error) {
+	if chanPoint == nil {
+		return ChannelPoint{}, fmt.Errorf("cannot unmarshal nil channel point")
+	}
+
+	var txid *chainhash.Hash
+	var err error
+	switch chanPoint.GetFundingTxid().(type) {
+	case *lnrpc.ChannelPoint_FundingTxidBytes:
+		txid, err = chainhash.NewHash(chanPoint.GetFundingTxidBytes())
+	case *lnrpc.ChannelPoint_FundingTxidStr:
+		txid, err = chainhash.NewHashFromStr(chanPoint.GetFundingTxidStr())
+	default:
+		err = fmt.Errorf("unknown funding transaction type")
+	}
+	if err != nil {
+		return ChannelPoint{}, err
+	}
+
+	return ChannelPoint{
+		FundingTxid: txid.String(),
+		OutputIndex: chanPoint.GetOutputIndex(),
+	}, nil
+}
+
+// unmarshalChannel creates an lnchat.LightningChannel
+// from an (open) lnrpc.Channel.
+func unmarshalChannel(c *lnrpc.Channel) (*LightningChannel, error) {
+	if c == nil {
+		return nil, fmt.Errorf("cannot unmarshal nil channel")
+	}
+
+	chanPoint, err := parseChannelPoint(c.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LightningChannel{
+		ChannelID:         c.ChanId,
+		ChannelPoint:      chanPoint,
+		RemoteAddress:     c.RemotePubkey,
+		CapacityMsat:      c.Capacity * 1000,
+		LocalBalanceMsat:  c.LocalBalance * 1000,
+		RemoteBalanceMsat: c.RemoteBalance * 1000,
+		Active:            c.Active,
+		State:             ChannelOPEN,
+	}, nil
+}
+
+// unmarshalPendingChannel creates an lnchat.LightningChannel
+// from an lnrpc pending channel.
+func unmarshalPendingChannel(c *lnrpc.PendingChannelsResponse_PendingChannel,
+	state ChannelState) (*LightningChannel, error) {
+
+	if c == nil {
+		return nil, fmt.Errorf("cannot unmarshal nil pending channel")
+	}
+
+	chanPoint, err := parseChannelPoint(c.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LightningChannel{
+		ChannelPoint:      chanPoint,
+		RemoteAddress:     c.RemoteNodePub,
+		CapacityMsat:      c.Capacity * 1000,
+		LocalBalanceMsat:  c.LocalBalance * 1000,
+		RemoteBalanceMsat: c.RemoteBalance * 1000,
+		State:             state,
+	}, nil
+}
+
+// unmarshalClosedChannel creates an lnchat.LightningChannel
+// from an lnrpc.ChannelCloseSummary.
+func unmarshalClosedChannel(c *lnrpc.ChannelCloseSummary) (*LightningChannel, error) {
+	if c == nil {
+		return nil, fmt.Errorf("cannot unmarshal nil channel close summary")
+	}
+
+	chanPoint, err := parseChannelPoint(c.ChannelPoint)
+	if err != nil {
+		return nil, err
+	}
+
+	return &LightningChannel{
+		ChannelID:        c.ChanId,
+		ChannelPoint:     chanPoint,
+		RemoteAddress:    c.RemotePubkey,
+		CapacityMsat:     c.Capacity * 1000,
+		LocalBalanceMsat: c.SettledBalance * 1000,
+		State:            ChannelCLOSED,
+	}, nil
+}
+
+// unmarshalChannelEvent creates an lnchat.ChannelEvent
+// from an lnrpc.ChannelEventUpdate.
+func unmarshalChannelEvent(e *lnrpc.ChannelEventUpdate) (*ChannelEvent, error) {
+	if e == nil {
+		return nil, fmt.Errorf("cannot unmarshal nil channel event")
+	}
+
+	var event ChannelEvent
+	var err error
+	switch e.Channel.(type) {
+	case *lnrpc.ChannelEventUpdate_OpenChannel:
+		event.Type = ChannelEventOPEN
+		event.Channel, err = unmarshalChannel(e.GetOpenChannel())
+	case *lnrpc.ChannelEventUpdate_ClosedChannel:
+		event.Type = ChannelEventCLOSED
+		event.Channel, err = unmarshalClosedChannel(e.GetClosedChannel())
+	case *lnrpc.ChannelEventUpdate_ActiveChannel:
+		event.Type = ChannelEventACTIVE
+		event.ChannelPoint, err = unmarshalChannelPoint(e.GetActiveChannel())
+	case *lnrpc.ChannelEventUpdate_InactiveChannel:
+		event.Type = ChannelEventINACTIVE
+		event.ChannelPoint, err = unmarshalChannelPoint(e.GetInactiveChannel())
+	case *lnrpc.ChannelEventUpdate_FullyResolvedChannel:
+		event.Type = ChannelEventFULLYRESOLVED
+		event.ChannelPoint, err = unmarshalChannelPoint(e.GetFullyResolvedChannel())
+	case *lnrpc.ChannelEventUpdate_PendingOpenChannel:
+		event.Type = ChannelEventPENDINGOPEN
+		var txid *chainhash.Hash
+		txid, err = chainhash.NewHash(e.GetPendingOpenChannel().GetTxid())
+		if err == nil {
+			event.ChannelPoint = ChannelPoint{
+				FundingTxid: txid.String(),
+				OutputIndex: e.GetPendingOpenChannel().GetOutputIndex(),
+			}
+		}
+	default:
+		err = fmt.Errorf("unknown channel event type")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if event.Channel != nil {
+		event.ChannelPoint = event.Channel.ChannelPoint
+	}
+
+	return &event, nil
+}
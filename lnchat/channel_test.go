@@ -0,0 +1,52 @@
+package lnchat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseChannelPoint(t *testing.T) {
+	cases := []struct {
+		name      string
+		chanPoint string
+		expected  ChannelPoint
+		expectErr bool
+	}{
+		{
+			name:      "Parse success",
+			chanPoint: "a3c1f2b7e9a8d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1:3",
+			expected: ChannelPoint{
+				FundingTxid: "a3c1f2b7e9a8d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1",
+				OutputIndex: 3,
+			},
+		},
+		{
+			name:      "Missing output index",
+			chanPoint: "a3c1f2b7e9a8d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1",
+			expectErr: true,
+		},
+		{
+			name:      "Invalid funding transaction",
+			chanPoint: "xyz:0",
+			expectErr: true,
+		},
+		{
+			name:      "Invalid output index",
+			chanPoint: "a3c1f2b7e9a8d6c5b4a3f2e1d0c9b8a7f6e5d4c3b2a1f0e9d8c7b6a5f4e3d2c1:-1",
+			expectErr: true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			chanPoint, err := parseChannelPoint(c.chanPoint)
+			if c.expectErr {
+				assert.Error(t, err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, c.expected, chanPoint)
+		})
+	}
+}
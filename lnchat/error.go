@@ -18,6 +18,9 @@ var (
 	// ErrInsufficientBalance is returned when a payment fails
 	// due to insufficient balance.
 	ErrInsufficientBalance = fmt.Errorf("Insufficient balance")
+	// ErrPaymentNotFound is returned when a tracked payment
+	// is not known to the Lightning daemon.
+	ErrPaymentNotFound = fmt.Errorf("Payment not found")
 
 	// ErrCancelled is returned when a grpc call returns
 	// with code Canceled.
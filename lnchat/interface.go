@@ -10,30 +10,48 @@ import (
 type LightManager interface {
 	GetSelfInfo(ctx context.Context) (SelfInfo, error)
 	ListNodes(ctx context.Context) ([]LightningNode, error)
+	GetNodeInfo(ctx context.Context, address string) (*LightningNode, error)
 	GetSelfBalance(ctx context.Context) (*SelfBalance, error)
 
 	ConnectNode(ctx context.Context, address string, hostport string) error
 	OpenChannel(ctx context.Context, address string,
 		private bool, amtMsat, pushAmtMsat uint64,
 		minOpenConfirmations int32, txOpts TxFeeOptions) (*ChannelPoint, error)
+	CloseChannel(ctx context.Context, chanPoint ChannelPoint,
+		force bool, txOpts TxFeeOptions) (<-chan ChannelCloseUpdate, error)
+	ListChannels(ctx context.Context) ([]LightningChannel, error)
+	ListPendingChannels(ctx context.Context) ([]LightningChannel, error)
+	SubscribeChannelEvents(ctx context.Context) (<-chan ChannelEventUpdate, error)
 
 	VerifySignatureExtractPubkey(ctx context.Context, message, signature []byte) (string, error)
 	SignMessage(ctx context.Context, message []byte) ([]byte, error)
+	DeriveSharedKey(ctx context.Context, address string) ([]byte, error)
 
 	SubscribeInvoiceUpdates(ctx context.Context, startIdx uint64,
 		filter InvoiceUpdateFilter) (<-chan InvoiceUpdate, error)
 	SendPayment(ctx context.Context, recipient string, amt Amount, payReq string,
-		payOpts PaymentOptions, payload map[uint64][]byte,
+		preimage *PreImage, payOpts PaymentOptions, payload map[uint64][]byte,
 		filter PaymentUpdateFilter) (<-chan PaymentUpdate, error)
+	TrackPayment(ctx context.Context, hash string,
+		filter PaymentUpdateFilter) (<-chan PaymentUpdate, error)
+	ListPayments(ctx context.Context, startIdx uint64,
+		maxPayments uint64) ([]Payment, error)
 
 	DecodePayReq(ctx context.Context, payReq string) (*PayReq, error)
 	CreateInvoice(ctx context.Context, memo string, amt Amount,
 		expiry int64, privateHints bool) (*Invoice, error)
 	LookupInvoice(ctx context.Context, payHash string) (*Invoice, error)
+	ListInvoices(ctx context.Context, startIdx uint64,
+		maxInvoices uint64) ([]Invoice, error)
 
 	GetRoute(ctx context.Context, recipient string, amt Amount,
 		payOpts PaymentOptions, payload map[uint64][]byte) (
 		route *Route, prob float64, err error)
 
+	SendSignal(ctx context.Context, recipient string,
+		payOpts PaymentOptions, payload map[uint64][]byte) error
+	SubscribeSignals(ctx context.Context,
+		filter SignalFilter) (<-chan SignalUpdate, error)
+
 	Close() error
 }
@@ -7,9 +7,12 @@ import (
 	"github.com/btcsuite/btcd/chaincfg/chainhash"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/signrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/pkg/errors"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 
 	"github.com/c13n-io/c13n-go/lnchat/lnconnect"
 )
@@ -22,6 +25,7 @@ type manager struct {
 
 	lnClient    lnrpc.LightningClient
 	routeClient routerrpc.RouterClient
+	signClient  signrpc.SignerClient
 	creds       lnconnect.Credentials
 
 	self SelfInfo
@@ -55,6 +59,7 @@ func New(creds lnconnect.Credentials, options ...func(LightManager) error) (Ligh
 	mgr.conn = conn
 	mgr.lnClient = lnrpc.NewLightningClient(conn)
 	mgr.routeClient = routerrpc.NewRouterClient(conn)
+	mgr.signClient = signrpc.NewSignerClient(conn)
 
 	// Get self info during initialization
 	ctx := context.Background()
@@ -174,6 +179,28 @@ func (m *manager) ListNodes(ctx context.Context) ([]LightningNode, error) {
 	return nodes, nil
 }
 
+// GetNodeInfo returns the node identified by the provided Lightning address,
+// as visible from the underlying lightning daemon.
+func (m *manager) GetNodeInfo(ctx context.Context, address string) (*LightningNode, error) {
+	req := &lnrpc.NodeInfoRequest{
+		PubKey:          address,
+		IncludeChannels: false,
+	}
+
+	info, err := m.lnClient.GetNodeInfo(ctx, req)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	return &LightningNode{
+		Alias:   info.GetNode().GetAlias(),
+		Address: info.GetNode().GetPubKey(),
+	}, nil
+}
+
 // ConnectNode creates a peer connection with a node
 // if one does not already exist.
 func (m *manager) ConnectNode(ctx context.Context, pubkey string, hostport string) error {
@@ -280,13 +307,24 @@ func (m *manager) OpenChannel(ctx context.Context, address string,
 	}
 }
 
-// CloseChannel closes the specified channel.
+// ChannelCloseUpdate represents a channel closing update,
+// as returned by CloseChannel.
+type ChannelCloseUpdate struct {
+	Status *ChannelCloseStatus
+	Err    error
+}
+
+// CloseChannel attempts to close the specified channel,
+// returning a channel over which closing updates are received.
+// If force is set, the channel is closed unilaterally
+// and the provided fee options are ignored.
+// The update channel is closed after the closing transaction confirms.
 func (m *manager) CloseChannel(ctx context.Context, chanPoint ChannelPoint,
-	force bool, txOpts TxFeeOptions) (string, error) {
+	force bool, txOpts TxFeeOptions) (<-chan ChannelCloseUpdate, error) {
 
 	fundingTxid, err := chainhash.NewHashFromStr(chanPoint.FundingTxid)
 	if err != nil {
-		return "", errors.Wrap(err, "could not decode funding transaction")
+		return nil, errors.Wrap(err, "could not decode funding transaction")
 	}
 
 	closeChanReq := &lnrpc.CloseChannelRequest{
@@ -296,40 +334,202 @@ func (m *manager) CloseChannel(ctx context.Context, chanPoint ChannelPoint,
 			},
 			OutputIndex: chanPoint.OutputIndex,
 		},
-		TargetConf: int32(txOpts.TargetConfBlock),
-		Force:      force,
+		Force: force,
 	}
+	// Fee options are only applicable to cooperative closes.
+	if !force {
+		closeChanReq.TargetConf = int32(txOpts.TargetConfBlock)
+		closeChanReq.SatPerVbyte = txOpts.SatPerVByte
+	}
+
 	closeStatusStream, err := m.lnClient.CloseChannel(ctx, closeChanReq)
 	if err != nil {
 		if terr := translateCommonRPCErrors(err); terr != err {
-			return "", terr
+			return nil, terr
 		}
-		return "", errors.Wrap(interceptRPCError(err, ErrUnknown),
+		return nil, errors.Wrap(interceptRPCError(err, ErrUnknown),
 			"channel closing failed")
 	}
-	for {
-		update, err := closeStatusStream.Recv()
+
+	// Check for status updates and return them
+	// to the returned channel asynchronously.
+	updateCh := make(chan ChannelCloseUpdate)
+
+	go func() {
+		defer close(updateCh)
+
+		for {
+			update, err := closeStatusStream.Recv()
+			if err == io.EOF {
+				return
+			}
+
+			status, err := func() (*ChannelCloseStatus, error) {
+				if err != nil {
+					if terr := translateCommonRPCErrors(err); terr != err {
+						return nil, terr
+					}
+					return nil, errors.Wrap(err,
+						"failed to receive channel status update")
+				}
+
+				var txid []byte
+				var confirmed bool
+				switch update.Update.(type) {
+				case *lnrpc.CloseStatusUpdate_ClosePending:
+					txid = update.GetClosePending().GetTxid()
+				case *lnrpc.CloseStatusUpdate_ChanClose:
+					txid = update.GetChanClose().GetClosingTxid()
+					confirmed = true
+				default:
+					return nil, errors.New("unknown channel status update")
+				}
+
+				closingTxid, err := chainhash.NewHash(txid)
+				if err != nil {
+					return nil, errors.Wrap(err,
+						"could not encode closing transaction")
+				}
+
+				return &ChannelCloseStatus{
+					ClosingTxid: closingTxid.String(),
+					Confirmed:   confirmed,
+				}, nil
+			}()
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- ChannelCloseUpdate{status, err}:
+			}
+
+			if err != nil || status.Confirmed {
+				return
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
+
+// ListChannels returns the open channels of the current node.
+func (m *manager) ListChannels(ctx context.Context) ([]LightningChannel, error) {
+	resp, err := m.lnClient.ListChannels(ctx, &lnrpc.ListChannelsRequest{})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	channels := make([]LightningChannel, len(resp.GetChannels()))
+	for i, c := range resp.GetChannels() {
+		channel, err := unmarshalChannel(c)
 		if err != nil {
-			return "", errors.Wrap(err, "failed to receive channel status update")
+			return nil, errors.Wrap(err, "could not unmarshal channel")
 		}
+		channels[i] = *channel
+	}
+
+	return channels, nil
+}
+
+// ListPendingChannels returns the channels of the current node
+// that are pending opening or closing.
+func (m *manager) ListPendingChannels(ctx context.Context) ([]LightningChannel, error) {
+	resp, err := m.lnClient.PendingChannels(ctx, &lnrpc.PendingChannelsRequest{})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	type pendingChannel struct {
+		channel *lnrpc.PendingChannelsResponse_PendingChannel
+		state   ChannelState
+	}
+
+	var pending []pendingChannel
+	for _, c := range resp.GetPendingOpenChannels() {
+		pending = append(pending, pendingChannel{c.GetChannel(), ChannelPENDINGOPEN})
+	}
+	for _, c := range resp.GetWaitingCloseChannels() {
+		pending = append(pending, pendingChannel{c.GetChannel(), ChannelPENDINGCLOSE})
+	}
+	for _, c := range resp.GetPendingClosingChannels() {
+		pending = append(pending, pendingChannel{c.GetChannel(), ChannelPENDINGCLOSE})
+	}
+	for _, c := range resp.GetPendingForceClosingChannels() {
+		pending = append(pending, pendingChannel{c.GetChannel(), ChannelPENDINGCLOSE})
+	}
+
+	channels := make([]LightningChannel, len(pending))
+	for i, p := range pending {
+		channel, err := unmarshalPendingChannel(p.channel, p.state)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal pending channel")
+		}
+		channels[i] = *channel
+	}
+
+	return channels, nil
+}
+
+// ChannelEventUpdate represents a channel event update,
+// as returned by SubscribeChannelEvents.
+type ChannelEventUpdate struct {
+	Event *ChannelEvent
+	Err   error
+}
+
+// SubscribeChannelEvents subscribes to channel events
+// (channels being opened, closed, activated or deactivated).
+// The returned channel is closed when the subscription terminates.
+func (m *manager) SubscribeChannelEvents(ctx context.Context) (<-chan ChannelEventUpdate, error) {
+	eventStream, err := m.lnClient.SubscribeChannelEvents(ctx,
+		&lnrpc.ChannelEventSubscription{})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	updateCh := make(chan ChannelEventUpdate)
+
+	go func() {
+		defer close(updateCh)
+
+		for {
+			rpcEvent, err := eventStream.Recv()
+			if err == io.EOF {
+				return
+			}
+
+			event, err := func() (*ChannelEvent, error) {
+				if err != nil {
+					if terr := translateCommonRPCErrors(err); terr != err {
+						return nil, terr
+					}
+					return nil, interceptRPCError(err, ErrUnknown)
+				}
+				return unmarshalChannelEvent(rpcEvent)
+			}()
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- ChannelEventUpdate{event, err}:
+			}
 
-		switch update.Update.(type) {
-		case *lnrpc.CloseStatusUpdate_ClosePending:
-			continue
-		case *lnrpc.CloseStatusUpdate_ChanClose:
-			chanClosingTxid, err := chainhash.NewHash(
-				update.GetChanClose().GetClosingTxid(),
-			)
 			if err != nil {
-				return "", errors.Wrap(err, "could not encode closing transaction")
+				return
 			}
-			return chanClosingTxid.String(), nil
-		default:
-			return "", errors.New("unknown channel close update type")
 		}
-	}
+	}()
 
-	return "", nil
+	return updateCh, nil
 }
 
 func (m *manager) signMessage(ctx context.Context, msg []byte) ([]byte, error) {
@@ -378,6 +578,29 @@ func (m *manager) VerifySignatureExtractPubkey(ctx context.Context, message, sig
 	return m.verifyMessage(ctx, message, signature)
 }
 
+// DeriveSharedKey performs an ECDH key derivation between the node's
+// identity key and the identity key of the node with the provided address,
+// and returns the sha256 hash of the resulting shared secret.
+// The same key is derived by the remote node for the current node's address.
+func (m *manager) DeriveSharedKey(ctx context.Context, address string) ([]byte, error) {
+	node, err := NewNodeFromString(address)
+	if err != nil {
+		return nil, withCause(newError(ErrInvalidAddress), err)
+	}
+
+	resp, err := m.signClient.DeriveSharedKey(ctx, &signrpc.SharedKeyRequest{
+		EphemeralPubkey: node.Bytes(),
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	return resp.GetSharedKey(), nil
+}
+
 // GetRoute queries the underlying daemon for a route that can accomodate
 // a payment of amount to recipient, respecting the provided payment options.
 // If a route was found, it is returned along with a probability of success
@@ -441,9 +664,13 @@ type PaymentUpdate struct {
 // SendPayment attempts to send a payment to a receiver,
 // returning a channel over which payment updates are received.
 // The update channel is closed when the payment succeeds.
+// The preimage of a spontaneous payment can be provided,
+// so that the caller knows the payment hash before the payment
+// is initiated; if nil, a random preimage is generated.
+// A preimage cannot be provided when paying a payment request.
 func (m *manager) SendPayment(ctx context.Context,
 	recipient string, amount Amount, payReq string,
-	payOpts PaymentOptions, payload map[uint64][]byte,
+	preimage *PreImage, payOpts PaymentOptions, payload map[uint64][]byte,
 	filter PaymentUpdateFilter) (<-chan PaymentUpdate, error) {
 
 	// Validate request, destination and amount.
@@ -497,9 +724,14 @@ func (m *manager) SendPayment(ctx context.Context,
 	if err != nil {
 		return nil, err
 	}
+	if payReq != "" && preimage != nil {
+		return nil, errors.New("a preimage cannot be specified " +
+			"when paying a payment request")
+	}
 
 	// Create and send payment.
-	req, err := createSendPaymentRequest(dest, amtMsat, payReq, payload, payOpts)
+	req, err := createSendPaymentRequest(dest, amtMsat, payReq,
+		preimage, payload, payOpts)
 	if err != nil {
 		return nil, errors.Wrap(err, "could not create request for payment")
 	}
@@ -512,6 +744,77 @@ func (m *manager) SendPayment(ctx context.Context,
 		return nil, interceptRPCError(err, ErrUnknown)
 	}
 
+	return forwardPaymentUpdates(ctx, paymentUpdateStream, filter), nil
+}
+
+// TrackPayment returns a channel over which updates
+// for the payment identified by the provided hash are received.
+// If the payment does not exist, ErrPaymentNotFound is returned
+// over the update channel.
+func (m *manager) TrackPayment(ctx context.Context, hash string,
+	filter PaymentUpdateFilter) (<-chan PaymentUpdate, error) {
+
+	paymentHash, err := lntypes.MakeHashFromStr(hash)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode payment hash")
+	}
+
+	paymentUpdateStream, err := m.routeClient.TrackPaymentV2(ctx,
+		&routerrpc.TrackPaymentRequest{
+			PaymentHash: paymentHash[:],
+		},
+	)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	return forwardPaymentUpdates(ctx, paymentUpdateStream, filter), nil
+}
+
+// ListPayments returns up to maxPayments payments of the current node,
+// with payment index greater than startIdx, in ascending payment index order.
+// Incomplete (in-flight and failed) payments are included, so that callers
+// can tell which payments are not yet resolved.
+func (m *manager) ListPayments(ctx context.Context, startIdx uint64,
+	maxPayments uint64) ([]Payment, error) {
+
+	resp, err := m.lnClient.ListPayments(ctx, &lnrpc.ListPaymentsRequest{
+		IndexOffset:       startIdx,
+		MaxPayments:       maxPayments,
+		IncludeIncomplete: true,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	payments := make([]Payment, len(resp.GetPayments()))
+	for i, p := range resp.GetPayments() {
+		payment, err := unmarshalPayment(p)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal payment")
+		}
+		payments[i] = *payment
+	}
+
+	return payments, nil
+}
+
+// paymentUpdateReceiver is a stream of lnrpc payment updates.
+type paymentUpdateReceiver interface {
+	Recv() (*lnrpc.Payment, error)
+}
+
+// forwardPaymentUpdates receives payment updates from a stream
+// and forwards those passing the filter to the returned channel.
+func forwardPaymentUpdates(ctx context.Context, stream paymentUpdateReceiver,
+	filter PaymentUpdateFilter) <-chan PaymentUpdate {
+
 	// Check for status updates and return them
 	// to the returned channel asynchronously.
 	updateCh := make(chan PaymentUpdate)
@@ -520,10 +823,14 @@ func (m *manager) SendPayment(ctx context.Context,
 		defer close(updateCh)
 
 		for {
-			rpcPaymentUpdate, err := paymentUpdateStream.Recv()
+			rpcPaymentUpdate, err := stream.Recv()
 			switch {
 			case err == io.EOF:
 				return
+			case status.Code(err) == codes.NotFound:
+				updateCh <- PaymentUpdate{nil, withCause(
+					newError(ErrPaymentNotFound), err)}
+				return
 			case err != nil:
 				updateCh <- PaymentUpdate{nil, err}
 				return
@@ -546,7 +853,7 @@ func (m *manager) SendPayment(ctx context.Context,
 		}
 	}()
 
-	return updateCh, nil
+	return updateCh
 }
 
 // InvoiceUpdateFilter allows filtering of invoice updates of interest
@@ -670,3 +977,31 @@ func (m *manager) lookupInvoice(ctx context.Context, hash []byte) (*Invoice, err
 
 	return unmarshalInvoice(inv)
 }
+
+// ListInvoices returns up to maxInvoices invoices of the current node,
+// with add index greater than startIdx, in ascending add index order.
+func (m *manager) ListInvoices(ctx context.Context, startIdx uint64,
+	maxInvoices uint64) ([]Invoice, error) {
+
+	resp, err := m.lnClient.ListInvoices(ctx, &lnrpc.ListInvoiceRequest{
+		IndexOffset:    startIdx,
+		NumMaxInvoices: maxInvoices,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	invoices := make([]Invoice, len(resp.GetInvoices()))
+	for i, inv := range resp.GetInvoices() {
+		invoice, err := unmarshalInvoice(inv)
+		if err != nil {
+			return nil, errors.Wrap(err, "could not unmarshal invoice")
+		}
+		invoices[i] = *invoice
+	}
+
+	return invoices, nil
+}
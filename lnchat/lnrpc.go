@@ -39,6 +39,11 @@ func signatureBytesToStr(sig []byte) string {
 	return zbase32.EncodeToString(sig)
 }
 
+// NewPreimage generates a random payment preimage.
+func NewPreimage() (PreImage, error) {
+	return generatePreimage()
+}
+
 func generatePreimage() (lntypes.Preimage, error) {
 	var preimage lntypes.Preimage
 	if _, err := rand.Read(preimage[:]); err != nil {
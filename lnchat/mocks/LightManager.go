@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	lnchat "github.com/c13n-io/c13n-go/lnchat"
+
+	lntypes "github.com/lightningnetwork/lnd/lntypes"
 )
 
 // LightManager is an autogenerated mock type for the LightManager type
@@ -29,6 +31,29 @@ func (_m *LightManager) Close() error {
 	return r0
 }
 
+// CloseChannel provides a mock function with given fields: ctx, chanPoint, force, txOpts
+func (_m *LightManager) CloseChannel(ctx context.Context, chanPoint lnchat.ChannelPoint, force bool, txOpts lnchat.TxFeeOptions) (<-chan lnchat.ChannelCloseUpdate, error) {
+	ret := _m.Called(ctx, chanPoint, force, txOpts)
+
+	var r0 <-chan lnchat.ChannelCloseUpdate
+	if rf, ok := ret.Get(0).(func(context.Context, lnchat.ChannelPoint, bool, lnchat.TxFeeOptions) <-chan lnchat.ChannelCloseUpdate); ok {
+		r0 = rf(ctx, chanPoint, force, txOpts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.ChannelCloseUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, lnchat.ChannelPoint, bool, lnchat.TxFeeOptions) error); ok {
+		r1 = rf(ctx, chanPoint, force, txOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ConnectNode provides a mock function with given fields: ctx, address, hostport
 func (_m *LightManager) ConnectNode(ctx context.Context, address string, hostport string) error {
 	ret := _m.Called(ctx, address, hostport)
@@ -89,6 +114,52 @@ func (_m *LightManager) DecodePayReq(ctx context.Context, payReq string) (*lncha
 	return r0, r1
 }
 
+// DeriveSharedKey provides a mock function with given fields: ctx, address
+func (_m *LightManager) DeriveSharedKey(ctx context.Context, address string) ([]byte, error) {
+	ret := _m.Called(ctx, address)
+
+	var r0 []byte
+	if rf, ok := ret.Get(0).(func(context.Context, string) []byte); ok {
+		r0 = rf(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]byte)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetNodeInfo provides a mock function with given fields: ctx, address
+func (_m *LightManager) GetNodeInfo(ctx context.Context, address string) (*lnchat.LightningNode, error) {
+	ret := _m.Called(ctx, address)
+
+	var r0 *lnchat.LightningNode
+	if rf, ok := ret.Get(0).(func(context.Context, string) *lnchat.LightningNode); ok {
+		r0 = rf(ctx, address)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*lnchat.LightningNode)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, address)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetRoute provides a mock function with given fields: ctx, recipient, amt, payOpts, payload
 func (_m *LightManager) GetRoute(ctx context.Context, recipient string, amt lnchat.Amount, payOpts lnchat.PaymentOptions, payload map[uint64][]byte) (*lnchat.Route, float64, error) {
 	ret := _m.Called(ctx, recipient, amt, payOpts, payload)
@@ -163,6 +234,52 @@ func (_m *LightManager) GetSelfInfo(ctx context.Context) (lnchat.SelfInfo, error
 	return r0, r1
 }
 
+// ListChannels provides a mock function with given fields: ctx
+func (_m *LightManager) ListChannels(ctx context.Context) ([]lnchat.LightningChannel, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []lnchat.LightningChannel
+	if rf, ok := ret.Get(0).(func(context.Context) []lnchat.LightningChannel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.LightningChannel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListInvoices provides a mock function with given fields: ctx, startIdx, maxInvoices
+func (_m *LightManager) ListInvoices(ctx context.Context, startIdx uint64, maxInvoices uint64) ([]lnchat.Invoice, error) {
+	ret := _m.Called(ctx, startIdx, maxInvoices)
+
+	var r0 []lnchat.Invoice
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []lnchat.Invoice); ok {
+		r0 = rf(ctx, startIdx, maxInvoices)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.Invoice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, startIdx, maxInvoices)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListNodes provides a mock function with given fields: ctx
 func (_m *LightManager) ListNodes(ctx context.Context) ([]lnchat.LightningNode, error) {
 	ret := _m.Called(ctx)
@@ -186,6 +303,52 @@ func (_m *LightManager) ListNodes(ctx context.Context) ([]lnchat.LightningNode,
 	return r0, r1
 }
 
+// ListPayments provides a mock function with given fields: ctx, startIdx, maxPayments
+func (_m *LightManager) ListPayments(ctx context.Context, startIdx uint64, maxPayments uint64) ([]lnchat.Payment, error) {
+	ret := _m.Called(ctx, startIdx, maxPayments)
+
+	var r0 []lnchat.Payment
+	if rf, ok := ret.Get(0).(func(context.Context, uint64, uint64) []lnchat.Payment); ok {
+		r0 = rf(ctx, startIdx, maxPayments)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.Payment)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, uint64, uint64) error); ok {
+		r1 = rf(ctx, startIdx, maxPayments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListPendingChannels provides a mock function with given fields: ctx
+func (_m *LightManager) ListPendingChannels(ctx context.Context) ([]lnchat.LightningChannel, error) {
+	ret := _m.Called(ctx)
+
+	var r0 []lnchat.LightningChannel
+	if rf, ok := ret.Get(0).(func(context.Context) []lnchat.LightningChannel); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]lnchat.LightningChannel)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // LookupInvoice provides a mock function with given fields: ctx, payHash
 func (_m *LightManager) LookupInvoice(ctx context.Context, payHash string) (*lnchat.Invoice, error) {
 	ret := _m.Called(ctx, payHash)
@@ -233,12 +396,12 @@ func (_m *LightManager) OpenChannel(ctx context.Context, address string, private
 }
 
 // SendPayment provides a mock function with given fields: ctx, recipient, amt, payReq, payOpts, payload, filter
-func (_m *LightManager) SendPayment(ctx context.Context, recipient string, amt lnchat.Amount, payReq string, payOpts lnchat.PaymentOptions, payload map[uint64][]byte, filter func(*lnchat.Payment) bool) (<-chan lnchat.PaymentUpdate, error) {
-	ret := _m.Called(ctx, recipient, amt, payReq, payOpts, payload, filter)
+func (_m *LightManager) SendPayment(ctx context.Context, recipient string, amt lnchat.Amount, payReq string, preimage *lntypes.Preimage, payOpts lnchat.PaymentOptions, payload map[uint64][]byte, filter func(*lnchat.Payment) bool) (<-chan lnchat.PaymentUpdate, error) {
+	ret := _m.Called(ctx, recipient, amt, payReq, preimage, payOpts, payload, filter)
 
 	var r0 <-chan lnchat.PaymentUpdate
-	if rf, ok := ret.Get(0).(func(context.Context, string, lnchat.Amount, string, lnchat.PaymentOptions, map[uint64][]byte, func(*lnchat.Payment) bool) <-chan lnchat.PaymentUpdate); ok {
-		r0 = rf(ctx, recipient, amt, payReq, payOpts, payload, filter)
+	if rf, ok := ret.Get(0).(func(context.Context, string, lnchat.Amount, string, *lntypes.Preimage, lnchat.PaymentOptions, map[uint64][]byte, func(*lnchat.Payment) bool) <-chan lnchat.PaymentUpdate); ok {
+		r0 = rf(ctx, recipient, amt, payReq, preimage, payOpts, payload, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).(<-chan lnchat.PaymentUpdate)
@@ -246,8 +409,8 @@ func (_m *LightManager) SendPayment(ctx context.Context, recipient string, amt l
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, string, lnchat.Amount, string, lnchat.PaymentOptions, map[uint64][]byte, func(*lnchat.Payment) bool) error); ok {
-		r1 = rf(ctx, recipient, amt, payReq, payOpts, payload, filter)
+	if rf, ok := ret.Get(1).(func(context.Context, string, lnchat.Amount, string, *lntypes.Preimage, lnchat.PaymentOptions, map[uint64][]byte, func(*lnchat.Payment) bool) error); ok {
+		r1 = rf(ctx, recipient, amt, payReq, preimage, payOpts, payload, filter)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -255,6 +418,20 @@ func (_m *LightManager) SendPayment(ctx context.Context, recipient string, amt l
 	return r0, r1
 }
 
+// SendSignal provides a mock function with given fields: ctx, recipient, payOpts, payload
+func (_m *LightManager) SendSignal(ctx context.Context, recipient string, payOpts lnchat.PaymentOptions, payload map[uint64][]byte) error {
+	ret := _m.Called(ctx, recipient, payOpts, payload)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, string, lnchat.PaymentOptions, map[uint64][]byte) error); ok {
+		r0 = rf(ctx, recipient, payOpts, payload)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SignMessage provides a mock function with given fields: ctx, message
 func (_m *LightManager) SignMessage(ctx context.Context, message []byte) ([]byte, error) {
 	ret := _m.Called(ctx, message)
@@ -278,6 +455,29 @@ func (_m *LightManager) SignMessage(ctx context.Context, message []byte) ([]byte
 	return r0, r1
 }
 
+// SubscribeChannelEvents provides a mock function with given fields: ctx
+func (_m *LightManager) SubscribeChannelEvents(ctx context.Context) (<-chan lnchat.ChannelEventUpdate, error) {
+	ret := _m.Called(ctx)
+
+	var r0 <-chan lnchat.ChannelEventUpdate
+	if rf, ok := ret.Get(0).(func(context.Context) <-chan lnchat.ChannelEventUpdate); ok {
+		r0 = rf(ctx)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.ChannelEventUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context) error); ok {
+		r1 = rf(ctx)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SubscribeInvoiceUpdates provides a mock function with given fields: ctx, startIdx, filter
 func (_m *LightManager) SubscribeInvoiceUpdates(ctx context.Context, startIdx uint64, filter func(*lnchat.Invoice) bool) (<-chan lnchat.InvoiceUpdate, error) {
 	ret := _m.Called(ctx, startIdx, filter)
@@ -301,6 +501,52 @@ func (_m *LightManager) SubscribeInvoiceUpdates(ctx context.Context, startIdx ui
 	return r0, r1
 }
 
+// SubscribeSignals provides a mock function with given fields: ctx, filter
+func (_m *LightManager) SubscribeSignals(ctx context.Context, filter func(map[uint64][]byte) bool) (<-chan lnchat.SignalUpdate, error) {
+	ret := _m.Called(ctx, filter)
+
+	var r0 <-chan lnchat.SignalUpdate
+	if rf, ok := ret.Get(0).(func(context.Context, func(map[uint64][]byte) bool) <-chan lnchat.SignalUpdate); ok {
+		r0 = rf(ctx, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.SignalUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, func(map[uint64][]byte) bool) error); ok {
+		r1 = rf(ctx, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// TrackPayment provides a mock function with given fields: ctx, hash, filter
+func (_m *LightManager) TrackPayment(ctx context.Context, hash string, filter func(*lnchat.Payment) bool) (<-chan lnchat.PaymentUpdate, error) {
+	ret := _m.Called(ctx, hash, filter)
+
+	var r0 <-chan lnchat.PaymentUpdate
+	if rf, ok := ret.Get(0).(func(context.Context, string, func(*lnchat.Payment) bool) <-chan lnchat.PaymentUpdate); ok {
+		r0 = rf(ctx, hash, filter)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(<-chan lnchat.PaymentUpdate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string, func(*lnchat.Payment) bool) error); ok {
+		r1 = rf(ctx, hash, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // VerifySignatureExtractPubkey provides a mock function with given fields: ctx, message, signature
 func (_m *LightManager) VerifySignatureExtractPubkey(ctx context.Context, message []byte, signature []byte) (string, error) {
 	ret := _m.Called(ctx, message, signature)
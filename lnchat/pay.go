@@ -24,8 +24,8 @@ func copyCustomRecords(customRecords map[uint64][]byte,
 }
 
 func createSendPaymentRequest(dest []byte, amtMsat int64, payReq string,
-	customRecords map[uint64][]byte, options PaymentOptions) (
-	*routerrpc.SendPaymentRequest, error) {
+	keysendPreimage *PreImage, customRecords map[uint64][]byte,
+	options PaymentOptions) (*routerrpc.SendPaymentRequest, error) {
 
 	var finalCltvDelta int32
 	var preimage, paymentHash []byte
@@ -33,9 +33,15 @@ func createSendPaymentRequest(dest []byte, amtMsat int64, payReq string,
 	// If a payment request is not provided, the preimage must
 	// be set in the custom records (spontaneous payment).
 	if payReq == "" {
-		preimg, err := generatePreimage()
-		if err != nil {
-			return nil, err
+		var preimg PreImage
+		switch keysendPreimage {
+		case nil:
+			var err error
+			if preimg, err = generatePreimage(); err != nil {
+				return nil, err
+			}
+		default:
+			preimg = *keysendPreimage
 		}
 		hash := preimg.Hash()
 
@@ -79,6 +85,7 @@ func unmarshalPayment(p *lnrpc.Payment) (*Payment, error) {
 		PaymentRequest: p.PaymentRequest,
 		CreationTimeNs: p.CreationTimeNs,
 		PaymentIndex:   p.PaymentIndex,
+		FailureReason:  p.FailureReason,
 	}
 
 	switch p.Status {
@@ -1,6 +1,15 @@
 package lnchat
 
-import "github.com/lightningnetwork/lnd/lnrpc"
+import (
+	sphinx "github.com/lightningnetwork/lightning-onion"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnwire"
+	"github.com/lightningnetwork/lnd/routing/route"
+)
+
+// MaxOnionPayloadSize is the space available to the hop payloads
+// of a route in the onion packet of an HTLC (in bytes).
+const MaxOnionPayloadSize = sphinx.MaxPayloadSize
 
 // Amount represents an amount on the Lightning network.
 type Amount int64
@@ -98,6 +107,8 @@ type Payment struct {
 	PaymentIndex uint64
 	// The HTLC attempts made to settle the payment.
 	Htlcs []HTLCAttempt
+	// The reason of payment failure (if failed).
+	FailureReason lnrpc.PaymentFailureReason
 }
 
 // PaymentStatus represents the status of a payment.
@@ -160,6 +171,36 @@ type RouteHop struct {
 	CustomRecords map[uint64][]byte
 }
 
+// PayloadSize returns the space occupied by the hop payloads of the route
+// (including any custom records) in the onion packet of an HTLC.
+func (r *Route) PayloadSize() int {
+	var size uint64
+	for i, hop := range r.Hops {
+		// The final hop payload does not reference an outgoing channel.
+		var nextChanID uint64
+		if i+1 < len(r.Hops) {
+			nextChanID = r.Hops[i+1].ChannelID
+		}
+
+		h := &route.Hop{
+			PubKeyBytes:      hop.NodeID.Vertex,
+			ChannelID:        hop.ChannelID,
+			OutgoingTimeLock: hop.Expiry,
+			AmtToForward:     lnwire.MilliSatoshi(hop.AmtToForward.Msat()),
+			CustomRecords:    hop.CustomRecords,
+		}
+		size += h.PayloadSize(nextChanID)
+	}
+
+	return int(size)
+}
+
+// RemainingPayloadSize returns the space left in the onion packet
+// of an HTLC sent over the route (negative if the route does not fit).
+func (r *Route) RemainingPayloadSize() int {
+	return MaxOnionPayloadSize - r.PayloadSize()
+}
+
 // HTLCFailure represents a detailed reason for an HTLC failure.
 type HTLCFailure struct {
 	// The failure code (as defined in BOLT #4, Section "Failure Messages").
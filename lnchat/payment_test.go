@@ -0,0 +1,34 @@
+package lnchat
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRoutePayloadSize(t *testing.T) {
+	route := &Route{
+		Hops: []RouteHop{
+			{
+				ChannelID:    1,
+				AmtToForward: NewAmount(2000),
+				Expiry:       100,
+			},
+			{
+				ChannelID:    2,
+				AmtToForward: NewAmount(1000),
+				Expiry:       60,
+				CustomRecords: map[uint64][]byte{
+					65536: make([]byte, 10),
+				},
+			},
+		},
+	}
+
+	// Intermediate hop: amount (4), timelock (3) and next channel (10),
+	// plus the payload length (1) and HMAC (32).
+	// Final hop: amount (4), timelock (3) and custom record (5+1+10),
+	// plus the payload length (1) and HMAC (32).
+	assert.Equal(t, 50+56, route.PayloadSize())
+	assert.Equal(t, MaxOnionPayloadSize-106, route.RemainingPayloadSize())
+}
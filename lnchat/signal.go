@@ -0,0 +1,225 @@
+package lnchat
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+
+	"github.com/btcsuite/btcd/btcec"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/lightningnetwork/lnd/lntypes"
+)
+
+const (
+	// signalAmtMsat is the amount of the HTLC carrying a signal.
+	// Since the HTLC is always failed, no funds are transferred.
+	signalAmtMsat = 1000
+
+	// signalChanID is the (non-existent) channel over which
+	// the recipient of a signal is requested to forward its HTLC.
+	signalChanID = 1
+
+	// signalBufferSize is the number of received signals
+	// buffered for the subscriber.
+	signalBufferSize = 64
+)
+
+// ErrSignalNotDelivered is returned when a signal
+// could not be delivered to its recipient.
+var ErrSignalNotDelivered = fmt.Errorf("Signal not delivered")
+
+// SignalFilter allows filtering the intercepted HTLCs that carry signals,
+// based on their custom records.
+type SignalFilter = func(customRecords map[uint64][]byte) bool
+
+// Signal represents a signal received over an intercepted HTLC.
+type Signal struct {
+	// The payment hash of the HTLC carrying the signal.
+	Hash string
+	// The custom records carried by the HTLC.
+	CustomRecords map[uint64][]byte
+}
+
+// SignalUpdate represents a signal update,
+// as returned by SubscribeSignals.
+type SignalUpdate struct {
+	Signal *Signal
+	Err    error
+}
+
+// SendSignal sends the provided payload to recipient over an HTLC
+// that is never settled. The HTLC uses a random payment hash and
+// requests the recipient to forward it one hop further,
+// so that the recipient can intercept it and fail it.
+// No funds are transferred, while a failure originating
+// at the recipient confirms that the signal was delivered.
+func (m *manager) SendSignal(ctx context.Context, recipient string,
+	payOpts PaymentOptions, payload map[uint64][]byte) error {
+
+	var feeLimit *lnrpc.FeeLimit
+	if payOpts.FeeLimitMsat != 0 {
+		feeLimit = &lnrpc.FeeLimit{
+			Limit: &lnrpc.FeeLimit_FixedMsat{
+				FixedMsat: payOpts.FeeLimitMsat,
+			},
+		}
+	}
+
+	routes, err := m.lnClient.QueryRoutes(ctx, &lnrpc.QueryRoutesRequest{
+		PubKey:         recipient,
+		AmtMsat:        signalAmtMsat,
+		FinalCltvDelta: payOpts.FinalCltvDelta,
+		DestFeatures: []lnrpc.FeatureBit{
+			lnrpc.FeatureBit_TLV_ONION_OPT,
+		},
+		DestCustomRecords: payload,
+		UseMissionControl: true,
+		FeeLimit:          feeLimit,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return interceptRPCError(err, ErrUnknown)
+	}
+	if len(routes.GetRoutes()) == 0 {
+		return ErrNoRouteFound
+	}
+
+	route, err := signalRoute(routes.GetRoutes()[0])
+	if err != nil {
+		return err
+	}
+
+	var hash lntypes.Hash
+	if _, err := rand.Read(hash[:]); err != nil {
+		return err
+	}
+
+	attempt, err := m.routeClient.SendToRouteV2(ctx, &routerrpc.SendToRouteRequest{
+		PaymentHash: hash[:],
+		Route:       route,
+	})
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return terr
+		}
+		return interceptRPCError(err, ErrUnknown)
+	}
+
+	// The recipient is the next to last hop of the route.
+	failure := attempt.GetFailure()
+	if failure == nil ||
+		int(failure.GetFailureSourceIndex()) != len(route.Hops)-1 {
+
+		return newErrorf(ErrSignalNotDelivered,
+			"signal to %s not delivered", recipient)
+	}
+
+	return nil
+}
+
+// signalRoute extends a route to a signal recipient
+// by a hop to a random node over a non-existent channel.
+func signalRoute(route *lnrpc.Route) (*lnrpc.Route, error) {
+	if len(route.Hops) == 0 {
+		return nil, fmt.Errorf("cannot extend empty route")
+	}
+
+	key, err := btcec.NewPrivateKey(btcec.S256())
+	if err != nil {
+		return nil, err
+	}
+
+	last := route.Hops[len(route.Hops)-1]
+	route.Hops = append(route.Hops, &lnrpc.Hop{
+		ChanId:           signalChanID,
+		PubKey:           hex.EncodeToString(key.PubKey().SerializeCompressed()),
+		AmtToForwardMsat: last.AmtToForwardMsat,
+		Expiry:           last.Expiry,
+		TlvPayload:       true,
+	})
+
+	return route, nil
+}
+
+// SubscribeSignals intercepts the HTLCs forwarded by the underlying node
+// and returns a channel over which the signals they carry are received.
+// Intercepted HTLCs accepted by filter are failed and their signals
+// returned, while all other HTLCs are resumed.
+// Since HTLC forwarding waits for the interceptor, signals are buffered
+// and dropped while the buffer is full, instead of waiting for the subscriber.
+// NOTE: The daemon allows a single interceptor at a time,
+// and held HTLCs are resumed if the subscription terminates.
+func (m *manager) SubscribeSignals(ctx context.Context,
+	filter SignalFilter) (<-chan SignalUpdate, error) {
+
+	stream, err := m.routeClient.HtlcInterceptor(ctx)
+	if err != nil {
+		if terr := translateCommonRPCErrors(err); terr != err {
+			return nil, terr
+		}
+		return nil, interceptRPCError(err, ErrUnknown)
+	}
+
+	updateCh := make(chan SignalUpdate, signalBufferSize)
+
+	go func() {
+		defer close(updateCh)
+
+		for {
+			htlc, err := stream.Recv()
+			if err != nil {
+				sendSignalErr(ctx, updateCh, err)
+				return
+			}
+
+			action := routerrpc.ResolveHoldForwardAction_RESUME
+			isSignal := filter(htlc.CustomRecords)
+			if isSignal {
+				action = routerrpc.ResolveHoldForwardAction_FAIL
+			}
+
+			if err := stream.Send(&routerrpc.ForwardHtlcInterceptResponse{
+				IncomingCircuitKey: htlc.IncomingCircuitKey,
+				Action:             action,
+			}); err != nil {
+				sendSignalErr(ctx, updateCh, err)
+				return
+			}
+
+			if !isSignal {
+				continue
+			}
+
+			hash, err := lntypes.MakeHash(htlc.PaymentHash)
+			if err != nil {
+				continue
+			}
+			signal := &Signal{
+				Hash:          hash.String(),
+				CustomRecords: htlc.CustomRecords,
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case updateCh <- SignalUpdate{signal, nil}:
+			default:
+			}
+		}
+	}()
+
+	return updateCh, nil
+}
+
+// sendSignalErr returns a subscription error to the subscriber,
+// unless the subscription is cancelled.
+func sendSignalErr(ctx context.Context, updateCh chan<- SignalUpdate, err error) {
+	select {
+	case <-ctx.Done():
+	case updateCh <- SignalUpdate{nil, err}:
+	}
+}
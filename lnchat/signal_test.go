@@ -0,0 +1,143 @@
+package lnchat
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/lightningnetwork/lnd/lnrpc/routerrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+)
+
+func TestSignalRoute(t *testing.T) {
+	recipientHop := &lnrpc.Hop{
+		ChanId:           2,
+		PubKey:           "020000000000000000000000000000000000000000000000000000000000000001",
+		AmtToForwardMsat: signalAmtMsat,
+		Expiry:           120,
+		TlvPayload:       true,
+		CustomRecords: map[uint64][]byte{
+			65537: []byte("signal"),
+		},
+	}
+	route := &lnrpc.Route{
+		TotalAmtMsat: signalAmtMsat + 1,
+		Hops: []*lnrpc.Hop{
+			{
+				ChanId:           1,
+				AmtToForwardMsat: signalAmtMsat,
+				FeeMsat:          1,
+				Expiry:           120,
+			},
+			recipientHop,
+		},
+	}
+
+	extended, err := signalRoute(route)
+	require.NoError(t, err)
+	require.Len(t, extended.Hops, 3)
+
+	// The signal is carried by the recipient hop,
+	// which is requested to forward it at no fee.
+	assert.Equal(t, recipientHop, extended.Hops[1])
+	last := extended.Hops[2]
+	assert.Equal(t, uint64(signalChanID), last.ChanId)
+	assert.Equal(t, recipientHop.AmtToForwardMsat, last.AmtToForwardMsat)
+	assert.Equal(t, recipientHop.Expiry, last.Expiry)
+	assert.Empty(t, last.CustomRecords)
+
+	_, err = NewNodeFromString(last.PubKey)
+	assert.NoError(t, err)
+	assert.Equal(t, int64(signalAmtMsat+1), extended.TotalAmtMsat)
+
+	_, err = signalRoute(&lnrpc.Route{})
+	assert.Error(t, err)
+}
+
+type interceptorStream struct {
+	routerrpc.Router_HtlcInterceptorClient
+
+	htlcs     []*routerrpc.ForwardHtlcInterceptRequest
+	responses []*routerrpc.ForwardHtlcInterceptResponse
+	drained   chan struct{}
+}
+
+func (s *interceptorStream) Recv() (*routerrpc.ForwardHtlcInterceptRequest, error) {
+	if len(s.htlcs) == 0 {
+		close(s.drained)
+		return nil, fmt.Errorf("stream closed")
+	}
+	htlc := s.htlcs[0]
+	s.htlcs = s.htlcs[1:]
+
+	return htlc, nil
+}
+
+func (s *interceptorStream) Send(resp *routerrpc.ForwardHtlcInterceptResponse) error {
+	s.responses = append(s.responses, resp)
+
+	return nil
+}
+
+type interceptorRouter struct {
+	routerrpc.RouterClient
+
+	stream *interceptorStream
+}
+
+func (r *interceptorRouter) HtlcInterceptor(_ context.Context,
+	_ ...grpc.CallOption) (routerrpc.Router_HtlcInterceptorClient, error) {
+
+	return r.stream, nil
+}
+
+func TestSubscribeSignals(t *testing.T) {
+	const signalCount = signalBufferSize + 10
+
+	stream := &interceptorStream{drained: make(chan struct{})}
+	for i := 0; i < signalCount; i++ {
+		hash := make([]byte, 32)
+		hash[0] = byte(i)
+		stream.htlcs = append(stream.htlcs, &routerrpc.ForwardHtlcInterceptRequest{
+			IncomingCircuitKey: &routerrpc.CircuitKey{HtlcId: uint64(i)},
+			PaymentHash:        hash,
+			CustomRecords: map[uint64][]byte{
+				65537: []byte("signal"),
+			},
+		})
+	}
+
+	m := &manager{routeClient: &interceptorRouter{stream: stream}}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	updates, err := m.SubscribeSignals(ctx, func(map[uint64][]byte) bool {
+		return true
+	})
+	require.NoError(t, err)
+
+	// HTLCs are resolved irrespective of whether signals are received,
+	// with signals exceeding the buffer being dropped.
+	<-stream.drained
+	require.Len(t, stream.responses, signalCount)
+	for i, resp := range stream.responses {
+		assert.Equal(t, uint64(i), resp.IncomingCircuitKey.HtlcId)
+		assert.Equal(t, routerrpc.ResolveHoldForwardAction_FAIL, resp.Action)
+	}
+
+	var signals []*Signal
+	var subErr error
+	for update := range updates {
+		if update.Err != nil {
+			subErr = update.Err
+			continue
+		}
+		signals = append(signals, update.Signal)
+	}
+	assert.Error(t, subErr)
+	assert.Len(t, signals, signalBufferSize)
+}
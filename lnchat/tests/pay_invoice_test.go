@@ -136,7 +136,7 @@ func testSendPaymentSubscribeInvoiceUpdates(net *lntest.NetworkHarness, t *harne
 			}
 
 			paymentUpdates, err := mgrAlice.SendPayment(ctxb,
-				c.recipient, c.amt, payReq,
+				c.recipient, c.amt, payReq, nil,
 				lnchat.PaymentOptions{TimeoutSecs: 30},
 				c.payload, paymentFilter)
 
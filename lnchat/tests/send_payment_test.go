@@ -198,7 +198,7 @@ func testSendPayment(net *lntest.NetworkHarness, t *harnessTest) {
 			}
 
 			paymentUpdates, err := mgrAlice.SendPayment(ctxb,
-				c.recipient, c.amt, payReq,
+				c.recipient, c.amt, payReq, nil,
 				lnchat.PaymentOptions{TimeoutSecs: 30},
 				c.payload, defaultPaymentFilter)
 
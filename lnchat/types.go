@@ -69,16 +69,12 @@ type ChannelPoint struct {
 	OutputIndex uint32
 }
 
-// LightningChannel represents a Lightning network channel between two nodes.
-type LightningChannel struct {
-	// The ID of the Lightning channel.
-	ChannelID uint64
-	// The Lightning address of the first endpoint (node) of the channel.
-	Node1Address string
-	// The Lightning address of the second endpoint of the channel.
-	Node2Address string
-	// Capacity is the channel capacity (in millisatoshi).
-	CapacityMsat int64
+// ChannelCloseStatus represents the status of a channel closing.
+type ChannelCloseStatus struct {
+	// The closing transaction ID (hex-encoded and byte-reversed).
+	ClosingTxid string
+	// Whether the closing transaction has been confirmed.
+	Confirmed bool
 }
 
 // PaymentOptions contains the payment details for sending a message.
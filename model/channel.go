@@ -7,3 +7,26 @@ import "github.com/c13n-io/c13n-go/lnchat"
 type ChannelPoint struct {
 	lnchat.ChannelPoint
 }
+
+// ChannelCloseStatus describes the state of a channel closing.
+type ChannelCloseStatus struct {
+	lnchat.ChannelCloseStatus
+}
+
+// Channel represents a channel of the current node with a remote node.
+type Channel struct {
+	lnchat.LightningChannel
+	// The name of the remote node, which is the contact display name
+	// if the remote node is a contact, or its alias otherwise.
+	RemoteName string
+}
+
+// ChannelEvent represents an event pertaining to a channel.
+type ChannelEvent struct {
+	// The type of the event.
+	Type lnchat.ChannelEventType
+	// The funding transaction output of the channel.
+	ChannelPoint ChannelPoint
+	// The channel details, only present for open and closed channel events.
+	Channel *Channel
+}
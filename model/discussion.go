@@ -4,17 +4,110 @@ import (
 	"fmt"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/timshannon/badgerhold/v4"
 )
 
 // Discussion is the discussion model for c13n.
 type Discussion struct {
-	ID            uint64         `json:"id" badgerhold:"key"`
-	Participants  []string       `json:"participants"`
-	LastReadID    uint64         `json:"last_read_message_id"`
-	LastMessageID uint64         `json:"last_message_id"`
-	Options       MessageOptions `json:"options"`
+	ID            uint64             `json:"id" badgerhold:"key"`
+	Participants  []string           `json:"participants"`
+	LastReadID    uint64             `json:"last_read_message_id"`
+	LastMessageID uint64             `json:"last_message_id"`
+	Options       MessageOptions     `json:"options"`
+	Metadata      DiscussionMetadata `json:"metadata"`
+	// The id of the latest standalone message of the discussion
+	// in timestamp order (zero for discussions without messages).
+	LatestMessageID uint64 `json:"latest_message_id"`
+	// The retention policy of the discussion messages,
+	// overriding the default retention policy.
+	Retention RetentionPolicy `json:"retention"`
+	// The storage time of the last discussion message
+	// (zero for discussions without messages).
+	LastActivity time.Time `json:"last_activity"`
+	// The number of received standalone messages
+	// stored after the last read message.
+	UnreadCount uint64 `json:"unread_count"`
+	// Whether the discussion is (soft) deleted.
+	// Deleted discussions are hidden from discussion listings
+	// until restored, or until a new discussion message is stored.
+	Deleted bool `json:"deleted"`
+}
+
+// DiscussionMetadata represents the user-assigned metadata of a discussion.
+type DiscussionMetadata struct {
+	// The discussion title.
+	Title string `json:"title"`
+	// Whether the discussion is muted.
+	Muted bool `json:"muted"`
+	// Whether the discussion is archived.
+	Archived bool `json:"archived"`
+	// Whether the discussion is pinned.
+	Pinned bool `json:"pinned"`
+}
+
+// DiscussionOrder represents the order of a discussion listing.
+type DiscussionOrder int
+
+const (
+	// DiscussionOrderID orders discussions by ascending id.
+	DiscussionOrderID DiscussionOrder = iota
+	// DiscussionOrderLastActivity orders discussions by descending
+	// time of their last message, placing pinned discussions first
+	// and discussions without messages last.
+	DiscussionOrderLastActivity
+)
+
+// FlagFilter represents a filter on a discussion flag.
+type FlagFilter int
+
+const (
+	// FlagAny matches discussions regardless of the flag.
+	FlagAny FlagFilter = iota
+	// FlagSet matches discussions with the flag set.
+	FlagSet
+	// FlagUnset matches discussions with the flag unset.
+	FlagUnset
+)
+
+// Matches returns whether a flag value satisfies the filter.
+func (f FlagFilter) Matches(flag bool) bool {
+	switch f {
+	case FlagSet:
+		return flag
+	case FlagUnset:
+		return !flag
+	default:
+		return true
+	}
+}
+
+// DiscussionFilter represents a filter on discussion metadata.
+type DiscussionFilter struct {
+	// Archived filters discussions by their archived flag.
+	Archived FlagFilter
+	// Pinned filters discussions by their pinned flag.
+	Pinned FlagFilter
+	// Deleted selects deleted discussions instead of active ones.
+	Deleted bool
+}
+
+// Matches returns whether a discussion satisfies the filter.
+func (f DiscussionFilter) Matches(disc *Discussion) bool {
+	return disc.Deleted == f.Deleted &&
+		f.Archived.Matches(disc.Metadata.Archived) &&
+		f.Pinned.Matches(disc.Metadata.Pinned)
+}
+
+// DiscussionSummary represents a discussion along with its last message.
+type DiscussionSummary struct {
+	Discussion
+	// The last standalone message of the discussion (if any).
+	LastMessage *Message
+	// The contact display names of the participants, in participant order.
+	// Participants not stored as contacts have an empty display name.
+	ParticipantNames []string
 }
 
 // Type satisfies badgerhold.Storer interface.
@@ -15,6 +15,10 @@ type Invoice struct {
 	// Since only the invoice creator has access to the Invoice,
 	// the CreatorAddress is the Lightning address of the underlying node.
 	CreatorAddress string
+	// Whether the invoice was imported from an archive.
+	// Imported invoices are stored by payment hash,
+	// outside the settle index keyspace of the node.
+	Imported bool
 	// The embedded invoice.
 	lnchat.Invoice
 }
@@ -39,6 +43,11 @@ func (i *Invoice) Indexes() map[string]badgerhold.Index {
 			return nil, fmt.Errorf("InvoiceSettleIndex: expected Invoice, got %T", value)
 		}
 
+		// Imported invoices are not indexed.
+		if inv.Imported {
+			return nil, nil
+		}
+
 		// Return the invoice SettleIndex.
 		b := make([]byte, 8)
 		binary.BigEndian.PutUint64(b, inv.SettleIndex)
@@ -57,6 +66,10 @@ func (i *Invoice) Indexes() map[string]badgerhold.Index {
 			return nil, fmt.Errorf("InvoicePreimageIndex: expected Invoice, got %T", value)
 		}
 
+		if inv.Imported {
+			return nil, nil
+		}
+
 		b := make([]byte, len(inv.Preimage))
 		copy(b, inv.Preimage)
 
@@ -74,3 +87,12 @@ func (i *Invoice) Indexes() map[string]badgerhold.Index {
 		},
 	}
 }
+
+// InvoiceFragment marks a stored invoice as carrying a part
+// of an incoming multi-part message that has not been reassembled yet,
+// so that received parts are collected across restarts.
+// Only invoices of the node are marked, never imported ones.
+type InvoiceFragment struct {
+	// The settle index of the invoice carrying the part.
+	SettleIndex uint64 `badgerhold:"key"`
+}
@@ -44,6 +44,8 @@ type Message struct {
 	Receiver string `json:"receiver"`
 	// Whether the sender was verified via signature.
 	SenderVerified bool `json:"sender_verified"`
+	// Whether the message payload was transmitted end-to-end encrypted.
+	Encrypted bool `json:"encrypted"`
 	// The time the message was sent (in nanoseconds since Unix Epoch).
 	SentTimeNs int64 `json:"sent_time_ns"`
 	// The time the message was received (in nanoseconds since Unix Epoch).
@@ -63,4 +65,138 @@ type Message struct {
 	PayReq string `json:"pay_req"`
 	// Arrival success probability.
 	SuccessProb float64
+	// The encoded size of the message payload (in bytes).
+	PayloadSize int
+	// The onion space remaining on the most constrained route
+	// of the message, after accounting for its payload (in bytes).
+	RemainingPayloadSize int
+	// The number of parts the message is sent in.
+	Parts int
+	// The sender-assigned message identifier (if any).
+	MessageID string `json:"message_id"`
+	// The content type of the message.
+	ContentType ContentType `json:"content_type"`
+	// The sender-assigned identifier of the message replied to (if any).
+	ReplyTo string `json:"reply_to"`
+	// The id of the message replied to (valid only if ReplyToLinked is set).
+	ReplyToID uint64 `json:"reply_to_id"`
+	// Whether the message replied to is present in the discussion.
+	ReplyToLinked bool `json:"reply_to_linked"`
+	// The sender-assigned identifier of the message targeted
+	// by an edit, delete request or reaction (if any).
+	Target string `json:"target"`
+	// The id of the targeted message (valid only if TargetLinked is set).
+	TargetID uint64 `json:"target_id"`
+	// Whether the targeted message is present in the discussion.
+	TargetLinked bool `json:"target_linked"`
+	// The time the last applied edit was sent
+	// (in nanoseconds since Unix Epoch), if the message was edited.
+	EditedTimeNs int64 `json:"edited_time_ns"`
+	// Whether the sender requested the deletion of the message.
+	Deleted bool `json:"deleted"`
+	// The aggregated reactions to the message.
+	Reactions []Reaction `json:"reactions"`
+	// The time the message was delivered to all its recipients,
+	// as acknowledged by their delivery receipts
+	// (in nanoseconds since Unix Epoch). Only valid for sent messages.
+	DeliveredTimeNs int64 `json:"delivered_time_ns"`
+	// The time the message was read by all its recipients,
+	// as acknowledged by their read receipts
+	// (in nanoseconds since Unix Epoch). Only valid for sent messages.
+	ReadTimeNs int64 `json:"read_time_ns"`
+	// Whether the message body was redacted locally,
+	// following a deletion or the expiry of the message.
+	Redacted bool `json:"redacted"`
+	// Whether the discussion of the message was muted when it was received.
+	// Only valid for messages received over a message subscription.
+	Muted bool `json:"muted"`
+}
+
+// MessageContent represents the contents of an outgoing message.
+type MessageContent struct {
+	// The content type of the message.
+	ContentType ContentType
+	// The message body.
+	Body string
+	// The sender-assigned identifier of the message replied to
+	// (text messages only).
+	ReplyTo string
+	// The sender-assigned identifier of the message targeted
+	// by an edit, delete request or reaction.
+	Target string
+}
+
+// Content returns the contents of the message.
+func (m *Message) Content() MessageContent {
+	return MessageContent{
+		ContentType: m.ContentType,
+		Body:        m.Payload,
+		ReplyTo:     m.ReplyTo,
+		Target:      m.Target,
+	}
+}
+
+// Reaction represents the reactions of the same kind to a message.
+type Reaction struct {
+	// The reaction (e.g. an emoji).
+	Reaction string `json:"reaction"`
+	// The addresses of the reacting nodes.
+	Senders []string `json:"senders"`
+}
+
+// ApplyAnnotations applies the edits, delete requests and reactions
+// targeting the message, which must be provided in the order they were stored.
+// Edits and delete requests are applied only if sent by the verified
+// sender of the message, and reactions are accounted for only if their
+// sender is verified, in which case the latest reaction of each sender
+// replaces the previous ones. Redacted reactions are disregarded.
+func (m *Message) ApplyAnnotations(annotations ...*Message) {
+	var senders []string
+	latest := make(map[string]string)
+	for _, a := range annotations {
+		if m.MessageID == "" || a.Target != m.MessageID ||
+			a.Sender == "" || !a.SenderVerified {
+			continue
+		}
+
+		fromSender := m.SenderVerified && a.Sender == m.Sender
+		switch a.ContentType {
+		case ContentTypeEdit:
+			if fromSender && !m.Deleted {
+				m.Payload = a.Payload
+				m.EditedTimeNs = a.SentTimeNs
+				m.Redacted = a.Redacted
+			}
+		case ContentTypeDeleteRequest:
+			if fromSender {
+				m.Payload = ""
+				m.Deleted = true
+			}
+		case ContentTypeReaction:
+			// The reaction of a redacted message is unknown.
+			if a.Redacted {
+				continue
+			}
+			if _, ok := latest[a.Sender]; !ok {
+				senders = append(senders, a.Sender)
+			}
+			latest[a.Sender] = a.Payload
+		}
+	}
+
+	m.Reactions = nil
+	reactionIdx := make(map[string]int)
+	for _, sender := range senders {
+		reaction := latest[sender]
+		if reaction == "" {
+			continue
+		}
+		idx, ok := reactionIdx[reaction]
+		if !ok {
+			idx = len(m.Reactions)
+			reactionIdx[reaction] = idx
+			m.Reactions = append(m.Reactions, Reaction{Reaction: reaction})
+		}
+		m.Reactions[idx].Senders = append(m.Reactions[idx].Senders, sender)
+	}
 }
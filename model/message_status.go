@@ -0,0 +1,51 @@
+package model
+
+// MessageStatus represents the delivery status of an outgoing message.
+type MessageStatus int32
+
+const (
+	// MessagePENDING signifies that a message has been accepted
+	// for sending, but no payment has been initiated yet.
+	MessagePENDING MessageStatus = iota
+	// MessageINFLIGHT signifies that a payment has been initiated
+	// but not resolved yet.
+	MessageINFLIGHT
+	// MessageSUCCEEDED signifies that a message was delivered.
+	MessageSUCCEEDED
+	// MessageFAILED signifies that a message could not be delivered.
+	MessageFAILED
+)
+
+// MessageStatusUpdate represents a delivery status transition
+// of an outgoing message, or of its payment towards one of its recipients.
+type MessageStatusUpdate struct {
+	// The id of the send operation (the message outbox id).
+	SendID uint64 `json:"send_id"`
+	// The id of the discussion the message is sent to.
+	DiscussionID uint64 `json:"discussion_id"`
+	// The recipient the update refers to.
+	// If empty, the update refers to the message as a whole.
+	Recipient string `json:"recipient"`
+	// The delivery status.
+	Status MessageStatus `json:"status"`
+	// The reason of delivery failure, if failed.
+	FailureReason string `json:"failure_reason"`
+	// The sent message, present only in a successful
+	// update referring to the message as a whole.
+	Message *Message `json:"message"`
+}
+
+// SendResult represents the final status of a send operation,
+// retained once its outbox message is completed.
+type SendResult struct {
+	// The id of the send operation (the message outbox id).
+	SendID uint64 `badgerhold:"key"`
+	// The id of the discussion the message was sent to.
+	DiscussionID uint64
+	// The final delivery status.
+	Status MessageStatus
+	// The reason of delivery failure, if failed.
+	FailureReason string
+	// The id of the stored message (0 if no payment succeeded).
+	MessageID uint64
+}
@@ -0,0 +1,135 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestApplyAnnotations(t *testing.T) {
+	const (
+		sender = "020000000000000000000000000000000000000000000000000000000000000001"
+		alice  = "030000000000000000000000000000000000000000000000000000000000000002"
+		bob    = "030000000000000000000000000000000000000000000000000000000000000003"
+		msgID  = "6f1f6b9e-4a6c-4d5e-9a53-4b1d2c3e4f50"
+	)
+
+	annotation := func(contentType ContentType, from, payload string,
+		sentTimeNs int64) *Message {
+
+		return &Message{
+			ContentType:    contentType,
+			Sender:         from,
+			SenderVerified: true,
+			Payload:        payload,
+			Target:         msgID,
+			SentTimeNs:     sentTimeNs,
+		}
+	}
+
+	cases := []struct {
+		name              string
+		annotations       []*Message
+		expectedPayload   string
+		expectedEdited    int64
+		expectedDeleted   bool
+		expectedRedacted  bool
+		expectedReactions []Reaction
+	}{
+		{
+			name: "Edits by sender",
+			annotations: []*Message{
+				annotation(ContentTypeEdit, sender, "first edit", 10),
+				annotation(ContentTypeEdit, sender, "second edit", 20),
+			},
+			expectedPayload: "second edit",
+			expectedEdited:  20,
+		},
+		{
+			name: "Edit and delete request by other node",
+			annotations: []*Message{
+				annotation(ContentTypeEdit, alice, "not my message", 10),
+				annotation(ContentTypeDeleteRequest, alice, "", 20),
+			},
+			expectedPayload: "original",
+		},
+		{
+			name: "Delete request by sender",
+			annotations: []*Message{
+				annotation(ContentTypeEdit, sender, "edited", 10),
+				annotation(ContentTypeDeleteRequest, sender, "", 20),
+				annotation(ContentTypeEdit, sender, "edited again", 30),
+			},
+			expectedEdited:  10,
+			expectedDeleted: true,
+		},
+		{
+			name: "Reactions",
+			annotations: []*Message{
+				annotation(ContentTypeReaction, alice, "👍", 10),
+				annotation(ContentTypeReaction, bob, "🎉", 20),
+				annotation(ContentTypeReaction, sender, "🎉", 30),
+				annotation(ContentTypeReaction, bob, "👍", 40),
+				{
+					ContentType: ContentTypeReaction,
+					Sender:      alice,
+					Payload:     "unverified",
+					Target:      msgID,
+				},
+			},
+			expectedPayload: "original",
+			expectedReactions: []Reaction{
+				{Reaction: "👍", Senders: []string{alice, bob}},
+				{Reaction: "🎉", Senders: []string{sender}},
+			},
+		},
+		{
+			name: "Retracted reaction",
+			annotations: []*Message{
+				annotation(ContentTypeReaction, alice, "👍", 10),
+				annotation(ContentTypeReaction, alice, "", 20),
+			},
+			expectedPayload: "original",
+		},
+		{
+			name: "Redacted annotations",
+			annotations: []*Message{
+				annotation(ContentTypeReaction, alice, "👍", 10),
+				func() *Message {
+					a := annotation(ContentTypeReaction, alice, "", 20)
+					a.Redacted = true
+					return a
+				}(),
+				func() *Message {
+					a := annotation(ContentTypeEdit, sender, "", 30)
+					a.Redacted = true
+					return a
+				}(),
+			},
+			expectedEdited:   30,
+			expectedRedacted: true,
+			expectedReactions: []Reaction{
+				{Reaction: "👍", Senders: []string{alice}},
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			msg := &Message{
+				MessageID:      msgID,
+				Sender:         sender,
+				SenderVerified: true,
+				Payload:        "original",
+			}
+
+			msg.ApplyAnnotations(c.annotations...)
+
+			assert.Equal(t, c.expectedPayload, msg.Payload)
+			assert.Equal(t, c.expectedEdited, msg.EditedTimeNs)
+			assert.Equal(t, c.expectedDeleted, msg.Deleted)
+			assert.Equal(t, c.expectedRedacted, msg.Redacted)
+			assert.Equal(t, c.expectedReactions, msg.Reactions)
+		})
+	}
+}
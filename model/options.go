@@ -14,6 +14,11 @@ type MessageOptions struct {
 	FeeLimitMsat int64 `json:"fee_limit_msat"`
 	// Whether to include the sender address in the message.
 	Anonymous bool `json:"anonymous"`
+	// Whether to encrypt the message payload end-to-end.
+	Encrypted bool `json:"encrypted"`
+	// Whether to send delivery receipts for received messages.
+	// It only applies to discussion options.
+	Receipts bool `json:"receipts"`
 }
 
 // WithFeeLimit sets the fee limit option.
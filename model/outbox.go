@@ -0,0 +1,72 @@
+package model
+
+import (
+	"time"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+)
+
+// OutboxMessage represents an outgoing message whose payments
+// have been initiated but not yet resolved.
+// It allows resuming tracking of in-flight payments after a restart.
+type OutboxMessage struct {
+	// The outbox entry id (store index).
+	ID uint64 `badgerhold:"key"`
+	// The raw message being sent.
+	RawMessage RawMessage
+	// The amount sent to each recipient (in millisatoshi).
+	AmtMsat int64
+	// The payment request being paid, if any.
+	PayReq string
+	// The options of the payments carrying the message.
+	PaymentOptions lnchat.PaymentOptions
+	// The payment attempts, one for each recipient
+	// and part of the message.
+	Attempts []OutboxAttempt
+	// The time the message was added to the outbox.
+	CreatedAt time.Time
+}
+
+// OutboxAttempt represents a payment towards a single recipient
+// of an outgoing message.
+type OutboxAttempt struct {
+	// The Lightning address of the recipient.
+	Recipient string
+	// The index of the message part carried by the payment.
+	Part int
+	// The payment hash, populated before the payment is initiated.
+	Hash string
+	// The preimage of a spontaneous payment (unset for payment requests).
+	Preimage []byte
+	// The amount sent along with the part (in millisatoshi).
+	AmtMsat int64
+	// The payload carrying the part, persisted so that parts
+	// not yet sent can be sent when delivery is resumed.
+	Payload map[uint64][]byte
+}
+
+// Attempt returns the attempt carrying the provided message part
+// towards the provided recipient, or nil if no such attempt exists.
+func (o *OutboxMessage) Attempt(recipient string, part int) *OutboxAttempt {
+	for i := range o.Attempts {
+		if o.Attempts[i].Recipient == recipient && o.Attempts[i].Part == part {
+			return &o.Attempts[i]
+		}
+	}
+
+	return nil
+}
+
+// WithAttemptHash records the payment hash for the attempt
+// carrying the provided message part towards the provided recipient.
+func (o *OutboxMessage) WithAttemptHash(recipient string, part int, hash string) {
+	if attempt := o.Attempt(recipient, part); attempt != nil {
+		attempt.Hash = hash
+		return
+	}
+	o.Attempts = append(o.Attempts, OutboxAttempt{
+		Recipient: recipient,
+		Part:      part,
+		Hash:      hash,
+	})
+}
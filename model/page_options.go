@@ -1,5 +1,17 @@
 package model
 
+// MessageDirection represents the direction of a message.
+type MessageDirection int
+
+const (
+	// DirectionAny matches both sent and received messages.
+	DirectionAny MessageDirection = iota
+	// DirectionSent matches sent (outgoing) messages.
+	DirectionSent
+	// DirectionReceived matches received (incoming) messages.
+	DirectionReceived
+)
+
 // PageOptions represents pagination options.
 type PageOptions struct {
 	// LastID represents the id of the element to return.
@@ -12,4 +24,27 @@ type PageOptions struct {
 	// Reverse represents that the requested range
 	// retrieve elements from LastID going backwards.
 	Reverse bool
+
+	// The following options filter the elements of the range
+	// and are only meaningful for messages.
+	// Unset (zero) fields do not restrict the range.
+
+	// FromTimeNs is the earliest time a message was stored
+	// (in nanoseconds since Unix Epoch).
+	FromTimeNs int64
+	// ToTimeNs is the latest time a message was stored
+	// (in nanoseconds since Unix Epoch).
+	ToTimeNs int64
+	// MinAmtMsat is the minimum message amount (in millisatoshi).
+	MinAmtMsat int64
+	// MaxAmtMsat is the maximum message amount (in millisatoshi).
+	MaxAmtMsat int64
+	// Direction is the direction of the messages.
+	Direction MessageDirection
+}
+
+// Latest returns whether the options request the last elements,
+// in the absence of an anchor element.
+func (o PageOptions) Latest() bool {
+	return o.Reverse && o.LastID == 0
 }
@@ -0,0 +1,310 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+
+	"github.com/google/uuid"
+	"github.com/lightningnetwork/lnd/routing/route"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/pkg/errors"
+)
+
+// PayloadVersion is the current version of the message payload format.
+// Version 0 corresponds to legacy JSON-encoded payloads.
+const PayloadVersion uint8 = 1
+
+// ContentType denotes how the message body of a payload is interpreted.
+type ContentType uint8
+
+const (
+	// ContentTypeText denotes a plain text message,
+	// optionally replying to another message.
+	ContentTypeText ContentType = iota
+	// ContentTypeEdit denotes a replacement of the body
+	// of a previous message of the sender.
+	ContentTypeEdit
+	// ContentTypeDeleteRequest denotes a request for the deletion
+	// of a previous message of the sender.
+	ContentTypeDeleteRequest
+	// ContentTypeReaction denotes a reaction to a message.
+	// A reaction with an empty body retracts a previous reaction.
+	ContentTypeReaction
+)
+
+// String returns the name of the content type.
+func (t ContentType) String() string {
+	switch t {
+	case ContentTypeText:
+		return "text"
+	case ContentTypeEdit:
+		return "edit"
+	case ContentTypeDeleteRequest:
+		return "delete request"
+	case ContentTypeReaction:
+		return "reaction"
+	default:
+		return fmt.Sprintf("unknown(%d)", uint8(t))
+	}
+}
+
+// HasTarget returns whether messages of the content type
+// refer to a target message, instead of being standalone messages.
+func (t ContentType) HasTarget() bool {
+	return t == ContentTypeEdit || t == ContentTypeDeleteRequest ||
+		t == ContentTypeReaction
+}
+
+// The TLV record types of a message payload.
+// Following the Lightning convention, even types must be understood
+// by the decoder while unknown odd types are ignored,
+// which allows new optional fields to be introduced.
+// A reply reference is optional, since a reply is displayable
+// as a plain message, while a target reference is not.
+const (
+	payloadVersionType      tlv.Type = 0
+	payloadContentTypeType  tlv.Type = 2
+	payloadMessageIDType    tlv.Type = 4
+	payloadParticipantsType tlv.Type = 6
+	payloadMessageType      tlv.Type = 8
+	payloadTargetType       tlv.Type = 10
+	payloadReplyToType      tlv.Type = 11
+)
+
+// ErrUnknownRequiredRecord indicates that a payload
+// contains an unknown even record type.
+var ErrUnknownRequiredRecord = fmt.Errorf("unknown required payload record")
+
+// ErrUnknownContentType indicates that a payload
+// has an unknown content type.
+var ErrUnknownContentType = fmt.Errorf("unknown payload content type")
+
+// Payload represents the contents of a message, as exchanged over the network.
+type Payload struct {
+	// The payload format version.
+	Version uint8
+	// The type of the message body.
+	ContentType ContentType
+	// The sender-chosen message identifier.
+	// It is unset for legacy payloads.
+	MessageID uuid.UUID
+	// The participant set of the discussion, excluding the sender.
+	Participants []string
+	// The message body.
+	Message string
+	// The identifier of the message replied to (text messages only).
+	ReplyTo uuid.UUID
+	// The identifier of the message targeted by an edit,
+	// delete request or reaction.
+	Target uuid.UUID
+}
+
+// NewPayload creates a text message payload of the current version
+// with a random message identifier.
+func NewPayload(participants []string, message string) *Payload {
+	return &Payload{
+		Version:      PayloadVersion,
+		ContentType:  ContentTypeText,
+		MessageID:    uuid.New(),
+		Participants: participants,
+		Message:      message,
+	}
+}
+
+// legacyPayload is the JSON-encoded payload format used before versioning.
+type legacyPayload struct {
+	Participants []string `json:"participants"`
+	Message      string   `json:"message"`
+}
+
+// Validate checks that the payload content type is known
+// and that the message references it carries are consistent with it.
+func (p *Payload) Validate() error {
+	switch p.ContentType {
+	case ContentTypeText:
+		if p.Target != uuid.Nil {
+			return fmt.Errorf("text message cannot have a target")
+		}
+	case ContentTypeEdit, ContentTypeDeleteRequest, ContentTypeReaction:
+		if p.Target == uuid.Nil {
+			return fmt.Errorf("%v message requires a target", p.ContentType)
+		}
+		if p.ReplyTo != uuid.Nil {
+			return fmt.Errorf("%v message cannot be a reply", p.ContentType)
+		}
+		if p.ContentType == ContentTypeDeleteRequest && p.Message != "" {
+			return fmt.Errorf("delete request cannot have a body")
+		}
+	default:
+		return fmt.Errorf("%w %d", ErrUnknownContentType, p.ContentType)
+	}
+
+	return nil
+}
+
+// Encode returns the TLV encoding of the payload.
+// Participant addresses are encoded as 33-byte public keys.
+func (p *Payload) Encode() ([]byte, error) {
+	if err := p.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid payload")
+	}
+
+	participants := make([]byte, 0, len(p.Participants)*route.VertexSize)
+	for _, participant := range p.Participants {
+		v, err := route.NewVertexFromStr(participant)
+		if err != nil {
+			return nil, errors.Wrapf(err,
+				"invalid participant address %q", participant)
+		}
+		participants = append(participants, v[:]...)
+	}
+
+	var (
+		version     = p.Version
+		contentType = uint8(p.ContentType)
+		messageID   = p.MessageID[:]
+		message     = []byte(p.Message)
+		target      = p.Target[:]
+		replyTo     = p.ReplyTo[:]
+	)
+
+	records := []tlv.Record{
+		tlv.MakePrimitiveRecord(payloadVersionType, &version),
+		tlv.MakePrimitiveRecord(payloadContentTypeType, &contentType),
+	}
+	if p.MessageID != uuid.Nil {
+		records = append(records,
+			tlv.MakePrimitiveRecord(payloadMessageIDType, &messageID))
+	}
+	if len(participants) != 0 {
+		records = append(records,
+			tlv.MakePrimitiveRecord(payloadParticipantsType, &participants))
+	}
+	if len(message) != 0 {
+		records = append(records,
+			tlv.MakePrimitiveRecord(payloadMessageType, &message))
+	}
+	if p.Target != uuid.Nil {
+		records = append(records,
+			tlv.MakePrimitiveRecord(payloadTargetType, &target))
+	}
+	if p.ReplyTo != uuid.Nil {
+		records = append(records,
+			tlv.MakePrimitiveRecord(payloadReplyToType, &replyTo))
+	}
+
+	stream, err := tlv.NewStream(records...)
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	if err := stream.Encode(&buf); err != nil {
+		return nil, err
+	}
+
+	return buf.Bytes(), nil
+}
+
+// DecodePayload decodes a message payload.
+// Both TLV-encoded and legacy JSON-encoded payloads are supported.
+func DecodePayload(data []byte) (*Payload, error) {
+	// TLV payloads start with the version record type (0),
+	// while legacy payloads are JSON objects.
+	if len(data) != 0 && data[0] == '{' {
+		return decodeLegacyPayload(data)
+	}
+
+	var (
+		version      uint8
+		contentType  uint8
+		messageID    []byte
+		participants []byte
+		message      []byte
+		target       []byte
+		replyTo      []byte
+	)
+
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(payloadVersionType, &version),
+		tlv.MakePrimitiveRecord(payloadContentTypeType, &contentType),
+		tlv.MakePrimitiveRecord(payloadMessageIDType, &messageID),
+		tlv.MakePrimitiveRecord(payloadParticipantsType, &participants),
+		tlv.MakePrimitiveRecord(payloadMessageType, &message),
+		tlv.MakePrimitiveRecord(payloadTargetType, &target),
+		tlv.MakePrimitiveRecord(payloadReplyToType, &replyTo),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	parsedTypes, err := stream.DecodeWithParsedTypes(bytes.NewReader(data))
+	if err != nil {
+		return nil, errors.Wrap(err, "could not decode payload")
+	}
+
+	if _, ok := parsedTypes[payloadVersionType]; !ok {
+		return nil, fmt.Errorf("payload version missing")
+	}
+	for typ, val := range parsedTypes {
+		// Known records are reported with a nil value.
+		if val != nil && typ%2 == 0 {
+			return nil, fmt.Errorf("%w: type %d", ErrUnknownRequiredRecord, typ)
+		}
+	}
+
+	p := &Payload{
+		Version:     version,
+		ContentType: ContentType(contentType),
+		Message:     string(message),
+	}
+
+	if len(messageID) != 0 {
+		if p.MessageID, err = uuid.FromBytes(messageID); err != nil {
+			return nil, errors.Wrap(err, "invalid message identifier")
+		}
+	}
+	if len(target) != 0 {
+		if p.Target, err = uuid.FromBytes(target); err != nil {
+			return nil, errors.Wrap(err, "invalid target message identifier")
+		}
+	}
+	if len(replyTo) != 0 {
+		if p.ReplyTo, err = uuid.FromBytes(replyTo); err != nil {
+			return nil, errors.Wrap(err, "invalid replied message identifier")
+		}
+	}
+
+	if len(participants)%route.VertexSize != 0 {
+		return nil, fmt.Errorf("invalid participant set length %d",
+			len(participants))
+	}
+	for i := 0; i < len(participants); i += route.VertexSize {
+		v, err := route.NewVertexFromBytes(participants[i : i+route.VertexSize])
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid participant address")
+		}
+		p.Participants = append(p.Participants, v.String())
+	}
+
+	if err := p.Validate(); err != nil {
+		return nil, errors.Wrap(err, "invalid payload")
+	}
+
+	return p, nil
+}
+
+func decodeLegacyPayload(data []byte) (*Payload, error) {
+	legacy := new(legacyPayload)
+	if err := json.Unmarshal(data, legacy); err != nil {
+		return nil, err
+	}
+
+	return &Payload{
+		Version:      0,
+		ContentType:  ContentTypeText,
+		Participants: legacy.Participants,
+		Message:      legacy.Message,
+	}, nil
+}
@@ -0,0 +1,286 @@
+package model
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/lightningnetwork/lnd/tlv"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestPayloadEncodeDecode(t *testing.T) {
+	participants := []string{
+		"020000000000000000000000000000000000000000000000000000000000000001",
+		"030000000000000000000000000000000000000000000000000000000000000002",
+	}
+
+	cases := []struct {
+		name    string
+		payload *Payload
+	}{
+		{
+			name:    "Text message",
+			payload: NewPayload(participants, "hello there"),
+		},
+		{
+			name:    "Empty message",
+			payload: NewPayload(participants, ""),
+		},
+		{
+			name: "Reply",
+			payload: func() *Payload {
+				p := NewPayload(participants, "a reply")
+				p.ReplyTo = uuid.New()
+				return p
+			}(),
+		},
+		{
+			name: "Edit",
+			payload: func() *Payload {
+				p := NewPayload(participants, "edited text")
+				p.ContentType, p.Target = ContentTypeEdit, uuid.New()
+				return p
+			}(),
+		},
+		{
+			name: "Delete request",
+			payload: func() *Payload {
+				p := NewPayload(participants, "")
+				p.ContentType, p.Target = ContentTypeDeleteRequest, uuid.New()
+				return p
+			}(),
+		},
+		{
+			name: "Reaction",
+			payload: func() *Payload {
+				p := NewPayload(participants, "👍")
+				p.ContentType, p.Target = ContentTypeReaction, uuid.New()
+				return p
+			}(),
+		},
+		{
+			name: "No participants or identifier",
+			payload: &Payload{
+				Version:     PayloadVersion,
+				ContentType: ContentTypeText,
+				Message:     "hello",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			data, err := c.payload.Encode()
+			require.NoError(t, err)
+
+			decoded, err := DecodePayload(data)
+			require.NoError(t, err)
+			assert.Equal(t, c.payload, decoded)
+		})
+	}
+}
+
+func TestPayloadEncodingSize(t *testing.T) {
+	participants := []string{
+		"020000000000000000000000000000000000000000000000000000000000000001",
+		"030000000000000000000000000000000000000000000000000000000000000002",
+	}
+
+	data, err := NewPayload(participants, "hello").Encode()
+	require.NoError(t, err)
+
+	legacy, err := json.Marshal(legacyPayload{
+		Participants: participants,
+		Message:      "hello",
+	})
+	require.NoError(t, err)
+
+	assert.Less(t, len(data), len(legacy))
+}
+
+func TestPayloadEncodeInvalidParticipant(t *testing.T) {
+	_, err := NewPayload([]string{"invalid"}, "hello").Encode()
+	assert.Error(t, err)
+}
+
+func TestDecodeLegacyPayload(t *testing.T) {
+	participants := []string{
+		"020000000000000000000000000000000000000000000000000000000000000001",
+	}
+
+	data, err := json.Marshal(legacyPayload{
+		Participants: participants,
+		Message:      "legacy message",
+	})
+	require.NoError(t, err)
+
+	decoded, err := DecodePayload(data)
+	require.NoError(t, err)
+	assert.Equal(t, &Payload{
+		Version:      0,
+		ContentType:  ContentTypeText,
+		Participants: participants,
+		Message:      "legacy message",
+	}, decoded)
+
+	raw := &RawMessage{RawPayload: data}
+	msg, ps, err := raw.UnmarshalPayload()
+	require.NoError(t, err)
+	assert.Equal(t, "legacy message", msg)
+	assert.Equal(t, participants, ps)
+}
+
+func TestDecodePayloadUnknownRecords(t *testing.T) {
+	encodeWith := func(t *testing.T, extra tlv.Type) []byte {
+		version, message := PayloadVersion, []byte("hello")
+		value := []byte{0x01}
+
+		stream, err := tlv.NewStream(
+			tlv.MakePrimitiveRecord(payloadVersionType, &version),
+			tlv.MakePrimitiveRecord(payloadMessageType, &message),
+			tlv.MakePrimitiveRecord(extra, &value),
+		)
+		require.NoError(t, err)
+
+		var buf bytes.Buffer
+		require.NoError(t, stream.Encode(&buf))
+
+		return buf.Bytes()
+	}
+
+	t.Run("Unknown odd record", func(t *testing.T) {
+		decoded, err := DecodePayload(encodeWith(t, 101))
+		require.NoError(t, err)
+		assert.Equal(t, "hello", decoded.Message)
+	})
+
+	t.Run("Unknown even record", func(t *testing.T) {
+		_, err := DecodePayload(encodeWith(t, 100))
+		assert.True(t, errors.Is(err, ErrUnknownRequiredRecord))
+	})
+}
+
+func TestDecodePayloadMissingVersion(t *testing.T) {
+	message := []byte("hello")
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(payloadMessageType, &message),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, stream.Encode(&buf))
+
+	_, err = DecodePayload(buf.Bytes())
+	assert.Error(t, err)
+}
+
+func TestPayloadValidate(t *testing.T) {
+	cases := []struct {
+		name        string
+		contentType ContentType
+		message     string
+		replyTo     uuid.UUID
+		target      uuid.UUID
+		valid       bool
+	}{
+		{
+			name:        "Text with target",
+			contentType: ContentTypeText,
+			target:      uuid.New(),
+		},
+		{
+			name:        "Edit without target",
+			contentType: ContentTypeEdit,
+			message:     "edited",
+		},
+		{
+			name:        "Reaction as reply",
+			contentType: ContentTypeReaction,
+			message:     "👍",
+			replyTo:     uuid.New(),
+			target:      uuid.New(),
+		},
+		{
+			name:        "Delete request with body",
+			contentType: ContentTypeDeleteRequest,
+			message:     "body",
+			target:      uuid.New(),
+		},
+		{
+			name:        "Unknown content type",
+			contentType: ContentType(42),
+		},
+		{
+			name:        "Reaction retraction",
+			contentType: ContentTypeReaction,
+			target:      uuid.New(),
+			valid:       true,
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			p := NewPayload(nil, c.message)
+			p.ContentType, p.ReplyTo, p.Target = c.contentType, c.replyTo, c.target
+
+			_, err := p.Encode()
+			switch c.valid {
+			case true:
+				assert.NoError(t, err)
+			default:
+				assert.Error(t, err)
+			}
+		})
+	}
+
+	// Payloads of unknown content type are rejected on decoding.
+	version, contentType := PayloadVersion, uint8(42)
+	stream, err := tlv.NewStream(
+		tlv.MakePrimitiveRecord(payloadVersionType, &version),
+		tlv.MakePrimitiveRecord(payloadContentTypeType, &contentType),
+	)
+	require.NoError(t, err)
+
+	var buf bytes.Buffer
+	require.NoError(t, stream.Encode(&buf))
+
+	_, err = DecodePayload(buf.Bytes())
+	assert.True(t, errors.Is(err, ErrUnknownContentType))
+}
+
+func TestNewRawMessageReferences(t *testing.T) {
+	discussion := &Discussion{
+		ID: 3,
+		Participants: []string{
+			"020000000000000000000000000000000000000000000000000000000000000001",
+		},
+	}
+	target := uuid.New().String()
+
+	raw, err := NewRawMessage(discussion, MessageContent{
+		ContentType: ContentTypeReaction,
+		Body:        "👍",
+		Target:      target,
+	})
+	require.NoError(t, err)
+	assert.NotEmpty(t, raw.MessageID)
+	assert.Equal(t, ContentTypeReaction, raw.ContentType)
+	assert.Equal(t, target, raw.Target)
+	assert.Empty(t, raw.ReplyTo)
+
+	decoded := &RawMessage{RawPayload: raw.RawPayload}
+	require.NoError(t, decoded.WithPayloadReferences())
+	assert.Equal(t, raw.MessageID, decoded.MessageID)
+	assert.Equal(t, raw.ContentType, decoded.ContentType)
+	assert.Equal(t, raw.Target, decoded.Target)
+
+	_, err = NewRawMessage(discussion, MessageContent{
+		Body:    "a reply",
+		ReplyTo: "not a message identifier",
+	})
+	assert.Error(t, err)
+}
@@ -16,6 +16,10 @@ type Payment struct {
 	PayerAddress string
 	// The Lightning address of the payee.
 	PayeeAddress string
+	// Whether the payment was imported from an archive.
+	// Imported payments are stored by payment hash,
+	// outside the payment index keyspace of the node.
+	Imported bool
 	// The embedded payment.
 	lnchat.Payment
 }
@@ -40,6 +44,11 @@ func (p *Payment) Indexes() map[string]badgerhold.Index {
 			return nil, fmt.Errorf("PaymentIndex: expected Payment, got %T", value)
 		}
 
+		// Imported payments are not indexed.
+		if p.Imported {
+			return nil, nil
+		}
+
 		// Return the PaymentIndex.
 		b := make([]byte, 8)
 		binary.BigEndian.PutUint64(b, p.PaymentIndex)
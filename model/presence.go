@@ -0,0 +1,14 @@
+package model
+
+// PresenceUpdate represents a presence indication
+// sent by a discussion participant.
+type PresenceUpdate struct {
+	// The id of the discussion the indication refers to.
+	DiscussionID uint64 `json:"discussion_id"`
+	// The address of the participant sending the indication.
+	Sender string `json:"sender"`
+	// Whether the participant is typing.
+	Typing bool `json:"typing"`
+	// The time the indication was sent (in nanoseconds since Unix Epoch).
+	SentTimeNs int64 `json:"sent_time_ns"`
+}
@@ -1,11 +1,11 @@
 package model
 
 import (
-	"encoding/json"
 	"fmt"
 	"math"
 	"time"
 
+	"github.com/google/uuid"
 	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/lightningnetwork/lnd/lntypes"
 	"github.com/pkg/errors"
@@ -24,69 +24,182 @@ type RawMessage struct {
 	DiscussionID uint64 `badgerholdIndex:"DiscIdx"`
 	// The raw message payload.
 	RawPayload []byte
-	// The Lightning address of the sender.
-	// TODO: Replace Sender field type with lnchat.NodeID.
-	Sender string
+	// The Lightning address of the sender (unset if unknown).
+	Sender lnchat.NodeID
 	// The message signature.
 	Signature []byte
 	// Whether the sender was the one that signed the payload.
 	SignatureVerified bool
+	// Whether the payload was transmitted encrypted.
+	Encrypted bool
+	// The sender-assigned message identifier (if any).
+	MessageID string
+	// The content type of the message.
+	ContentType ContentType
+	// The sender-assigned identifier of the message replied to (if any).
+	ReplyTo string
+	// The sender-assigned identifier of the message targeted
+	// by an edit, delete request or reaction (if any).
+	Target string
+	// The id of the message replied to (valid only if ReplyToLinked is set).
+	ReplyToID uint64
+	// Whether the message replied to is present in the discussion.
+	ReplyToLinked bool
+	// The id of the targeted message (valid only if TargetLinked is set).
+	TargetID uint64
+	// Whether the targeted message is present in the discussion.
+	TargetLinked bool
 	// The SettleIndex of the invoice associated
 	// with the message (incoming).
 	InvoiceSettleIndex uint64
+	// The SettleIndexes of the invoices carrying the other parts
+	// of a multi-part message (incoming).
+	FragmentSettleIndexes []uint64
 	// The PaymentIndexes of the payments
 	// used to transport the message (outgoing).
 	PaymentIndexes []uint64
+	// The payment hashes of the invoices (in InvoiceSettleIndex and
+	// FragmentSettleIndexes order) or payments (in PaymentIndexes order)
+	// carrying an imported message, which are stored by payment hash.
+	ImportedHashes []string
+	// The receipts sent by the recipients of the message (outgoing).
+	Receipts []RecipientReceipts
+	// The amount paid over the message (in millisatoshi),
+	// populated when the message is stored.
+	AmtMsat int64
 	// The timestamp of the message.
 	// It  is an internal field and does not correspond
 	// to the sent or received time of the message.
 	Timestamp time.Time
+	// Whether the message body was redacted from the payload,
+	// following a local deletion or the expiry of the message.
+	Redacted bool
 }
 
-type compositePayload struct {
-	Participants []string `json:"participants"`
-	Message      string   `json:"message"`
+// Redact removes the message body from the raw message payload,
+// along with the signature over the payload.
+// The payload participants and message references are retained,
+// unless the payload cannot be decoded, in which case it is dropped.
+func (raw *RawMessage) Redact() {
+	raw.Redacted = true
+	raw.Signature = nil
+
+	payload, err := raw.DecodePayload()
+	if err != nil {
+		raw.RawPayload = nil
+		return
+	}
+	payload.Message = ""
+	if raw.RawPayload, err = payload.Encode(); err != nil {
+		raw.RawPayload = nil
+	}
+}
+
+// DecodePayload decodes the raw message payload.
+// A raw message without payload results in an empty text payload.
+func (raw *RawMessage) DecodePayload() (*Payload, error) {
+	if raw.RawPayload == nil {
+		return &Payload{ContentType: ContentTypeText}, nil
+	}
+
+	return DecodePayload(raw.RawPayload)
 }
 
-// UnmarshalPayload returns the message participants.
+// UnmarshalPayload returns the message and its participants.
 func (raw *RawMessage) UnmarshalPayload() (string, []string, error) {
-	msg := &compositePayload{}
-	if raw.RawPayload != nil {
-		if err := json.Unmarshal(raw.RawPayload, msg); err != nil {
-			return "", nil, err
-		}
+	payload, err := raw.DecodePayload()
+	if err != nil {
+		return "", nil, err
 	}
 
-	return msg.Message, msg.Participants, nil
+	return payload.Message, payload.Participants, nil
 }
 
-// NewRawMessage constructs a raw message from a discussion and payload.
-func NewRawMessage(discussion *Discussion, payload string) (*RawMessage, error) {
+// NewRawMessage constructs a raw message from a discussion and message content.
+func NewRawMessage(discussion *Discussion, content MessageContent) (*RawMessage, error) {
 	if discussion == nil {
 		return nil, fmt.Errorf("cannot create raw message for empty discussion")
 	}
 
-	rawMsg := new(RawMessage)
+	payload := NewPayload(discussion.Participants, content.Body)
+	payload.ContentType = content.ContentType
 
-	data, err := json.Marshal(compositePayload{
-		Participants: discussion.Participants,
-		Message:      payload,
-	})
+	var err error
+	if payload.ReplyTo, err = parseMessageID(content.ReplyTo); err != nil {
+		return nil, errors.Wrap(err, "invalid replied message identifier")
+	}
+	if payload.Target, err = parseMessageID(content.Target); err != nil {
+		return nil, errors.Wrap(err, "invalid target message identifier")
+	}
+
+	data, err := payload.Encode()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not marshal payload")
 	}
 
-	rawMsg.RawPayload = data
-	rawMsg.DiscussionID = discussion.ID
+	rawMsg := &RawMessage{
+		RawPayload:   data,
+		DiscussionID: discussion.ID,
+	}
+	rawMsg.withPayloadReferences(payload)
 
 	return rawMsg, nil
 }
 
+// WithPayloadReferences populates the message identifier, content type
+// and message references of the raw message from its payload.
+func (raw *RawMessage) WithPayloadReferences() error {
+	payload, err := raw.DecodePayload()
+	if err != nil {
+		return err
+	}
+	raw.withPayloadReferences(payload)
+
+	return nil
+}
+
+func (raw *RawMessage) withPayloadReferences(payload *Payload) {
+	raw.MessageID = formatMessageID(payload.MessageID)
+	raw.ContentType = payload.ContentType
+	raw.ReplyTo = formatMessageID(payload.ReplyTo)
+	raw.Target = formatMessageID(payload.Target)
+}
+
+// parseMessageID parses a message identifier,
+// with the empty string denoting an unset identifier.
+func parseMessageID(id string) (uuid.UUID, error) {
+	if id == "" {
+		return uuid.Nil, nil
+	}
+
+	return uuid.Parse(id)
+}
+
+// formatMessageID formats a message identifier,
+// with an unset identifier formatted as the empty string.
+func formatMessageID(id uuid.UUID) string {
+	if id == uuid.Nil {
+		return ""
+	}
+
+	return id.String()
+}
+
 // WithTimestamp adds the provided timestamp to the raw message.
 func (raw *RawMessage) WithTimestamp(ts time.Time) {
 	raw.Timestamp = ts
 }
 
+// SenderAddress returns the Lightning address of the message sender,
+// or an empty string if the sender is unknown.
+func (raw *RawMessage) SenderAddress() string {
+	if raw.Sender.IsZero() {
+		return ""
+	}
+
+	return raw.Sender.String()
+}
+
 // WithSignature adds the provided sender and signature to the raw message.
 func (raw *RawMessage) WithSignature(sender string, signature []byte) error {
 	if sender == "" || signature == nil {
@@ -94,11 +207,12 @@ func (raw *RawMessage) WithSignature(sender string, signature []byte) error {
 			" are required for payload signing")
 	}
 
-	if _, err := lnchat.NewNodeFromString(sender); err != nil {
+	node, err := lnchat.NewNodeFromString(sender)
+	if err != nil {
 		return fmt.Errorf("invalid sender address: %w", err)
 	}
 
-	raw.Sender = sender
+	raw.Sender = node
 	raw.Signature = signature
 	raw.SignatureVerified = (len(signature) != 0)
 
@@ -110,6 +224,12 @@ func (raw *RawMessage) WithPaymentIndexes(paymentIdxs ...uint64) {
 	raw.PaymentIndexes = append(raw.PaymentIndexes, paymentIdxs...)
 }
 
+// Imported returns whether the message was imported from an archive,
+// in which case its invoices or payments are stored by payment hash.
+func (raw *RawMessage) Imported() bool {
+	return len(raw.ImportedHashes) != 0
+}
+
 func (raw *RawMessage) containsPaymentIndex(paymentIdx uint64) bool {
 	for _, idx := range raw.PaymentIndexes {
 		if idx == paymentIdx {
@@ -121,8 +241,11 @@ func (raw *RawMessage) containsPaymentIndex(paymentIdx uint64) bool {
 }
 
 // NewIncomingMessage constructs a Message from a RawMessage and Invoice.
+// The invoices carrying the other parts of a multi-part message
+// are provided as fragments.
 func NewIncomingMessage(rawMsg *RawMessage, inv *Invoice,
-	discussionRetriever func([]string) (*Discussion, error)) (*Message, error) {
+	discussionRetriever func([]string) (*Discussion, error),
+	fragments ...*Invoice) (*Message, error) {
 
 	if rawMsg == nil || inv == nil {
 		return nil, fmt.Errorf("cannot construct message: " +
@@ -133,6 +256,23 @@ func NewIncomingMessage(rawMsg *RawMessage, inv *Invoice,
 			"provided invoice does not correspond to raw message")
 	}
 
+	if len(fragments) != len(rawMsg.FragmentSettleIndexes) {
+		return nil, fmt.Errorf("cannot construct message: " +
+			"provided fragments do not correspond to raw message")
+	}
+	amtMsat, createdTimeSec := inv.AmtPaid.Msat(), inv.CreatedTimeSec
+	for i, fragment := range fragments {
+		if fragment == nil ||
+			fragment.SettleIndex != rawMsg.FragmentSettleIndexes[i] {
+			return nil, fmt.Errorf("cannot construct message: " +
+				"provided fragments do not correspond to raw message")
+		}
+		amtMsat += fragment.AmtPaid.Msat()
+		if fragment.CreatedTimeSec < createdTimeSec {
+			createdTimeSec = fragment.CreatedTimeSec
+		}
+	}
+
 	preimageHash, err := preimageHashBytes(inv.Hash)
 	if err != nil {
 		return nil, err
@@ -143,7 +283,7 @@ func NewIncomingMessage(rawMsg *RawMessage, inv *Invoice,
 	}
 
 	// Unmarshal the message payload.
-	payload, participants, err := rawMsg.UnmarshalPayload()
+	payload, err := rawMsg.DecodePayload()
 	if err != nil {
 		return nil, fmt.Errorf("cannot unmarshal payload: %w", err)
 	}
@@ -151,9 +291,9 @@ func NewIncomingMessage(rawMsg *RawMessage, inv *Invoice,
 	// NOTE: Since the sender address is not included
 	// in the embedded participant set, in order to
 	// retrieve the discussion it must be included in the participant set.
-	fullParticipantSet := participants
-	if rawMsg.Sender != "" {
-		fullParticipantSet = append(fullParticipantSet, rawMsg.Sender)
+	fullParticipantSet := payload.Participants
+	if sender := rawMsg.SenderAddress(); sender != "" {
+		fullParticipantSet = append(fullParticipantSet, sender)
 	}
 
 	// Retrieve the discussion id and verify the message signature.
@@ -162,22 +302,38 @@ func NewIncomingMessage(rawMsg *RawMessage, inv *Invoice,
 		return nil, fmt.Errorf("cannot retrieve discussion ID: %w", err)
 	}
 
-	return &Message{
+	msg := &Message{
 		ID:             rawMsg.ID,
 		DiscussionID:   disc.ID,
-		Payload:        payload,
-		AmtMsat:        inv.AmtPaid.Msat(),
-		Sender:         rawMsg.Sender,
+		Payload:        payload.Message,
+		AmtMsat:        amtMsat,
+		Sender:         rawMsg.SenderAddress(),
 		Receiver:       inv.CreatorAddress,
 		SenderVerified: rawMsg.SignatureVerified,
-		SentTimeNs:     time.Unix(inv.CreatedTimeSec, 0).UnixNano(),
+		Encrypted:      rawMsg.Encrypted,
+		SentTimeNs:     time.Unix(createdTimeSec, 0).UnixNano(),
 		ReceivedTimeNs: time.Unix(inv.SettleTimeSec, 0).UnixNano(),
 		Index:          inv.SettleIndex,
 		PreimageHash:   preimageHash,
 		Preimage:       preimage,
 		PayReq:         inv.PaymentRequest,
 		SuccessProb:    1.,
-	}, nil
+	}
+	msg.withReferences(rawMsg, payload)
+
+	return msg, nil
+}
+
+// withReferences populates the message identifier, content type
+// and message references of a message from its raw message and payload.
+func (m *Message) withReferences(raw *RawMessage, payload *Payload) {
+	m.MessageID = formatMessageID(payload.MessageID)
+	m.ContentType = payload.ContentType
+	m.ReplyTo = formatMessageID(payload.ReplyTo)
+	m.ReplyToID, m.ReplyToLinked = raw.ReplyToID, raw.ReplyToLinked
+	m.Target = formatMessageID(payload.Target)
+	m.TargetID, m.TargetLinked = raw.TargetID, raw.TargetLinked
+	m.Redacted = raw.Redacted
 }
 
 func preimageHashBytes(hash string) ([]byte, error) {
@@ -231,7 +387,7 @@ func NewOutgoingMessage(rawMsg *RawMessage, onlySuccessfulPayments bool,
 }
 
 func newOutgoingMessage(rawMsg *RawMessage, payments ...*Payment) (*Message, error) {
-	payload, _, err := rawMsg.UnmarshalPayload()
+	payload, err := rawMsg.DecodePayload()
 	if err != nil {
 		return nil, errors.Wrap(err, "could not unmarshal raw message")
 	}
@@ -265,6 +421,17 @@ func newOutgoingMessage(rawMsg *RawMessage, payments ...*Payment) (*Message, err
 
 	}
 
+	// The message is acknowledged once all its recipients have sent a receipt.
+	var recipients []string
+	seen := make(map[string]struct{}, len(payments))
+	for _, payment := range payments {
+		if _, ok := seen[payment.PayeeAddress]; !ok {
+			seen[payment.PayeeAddress] = struct{}{}
+			recipients = append(recipients, payment.PayeeAddress)
+		}
+	}
+	deliveredNs, readNs := rawMsg.receiptTimes(recipients)
+
 	// These only make sense being populated in single-payment messages.
 	var (
 		recipient, payReq string
@@ -286,23 +453,29 @@ func newOutgoingMessage(rawMsg *RawMessage, payments ...*Payment) (*Message, err
 		}
 	}
 
-	return &Message{
-		ID:             rawMsg.ID,
-		DiscussionID:   rawMsg.DiscussionID,
-		Payload:        payload,
-		AmtMsat:        amt,
-		Sender:         sender,
-		Receiver:       recipient,
-		SenderVerified: rawMsg.SignatureVerified,
-		SentTimeNs:     startTimeNs,
-		ReceivedTimeNs: endTimeNs,
-		Index:          paymentIdx,
-		TotalFeesMsat:  fees,
-		Routes:         routes,
-		PreimageHash:   preimageHash,
-		Preimage:       preimage,
-		PayReq:         payReq,
-	}, nil
+	msg := &Message{
+		ID:              rawMsg.ID,
+		DiscussionID:    rawMsg.DiscussionID,
+		Payload:         payload.Message,
+		AmtMsat:         amt,
+		Sender:          sender,
+		Receiver:        recipient,
+		SenderVerified:  rawMsg.SignatureVerified,
+		Encrypted:       rawMsg.Encrypted,
+		SentTimeNs:      startTimeNs,
+		ReceivedTimeNs:  endTimeNs,
+		Index:           paymentIdx,
+		TotalFeesMsat:   fees,
+		Routes:          routes,
+		PreimageHash:    preimageHash,
+		Preimage:        preimage,
+		PayReq:          payReq,
+		DeliveredTimeNs: deliveredNs,
+		ReadTimeNs:      readNs,
+	}
+	msg.withReferences(rawMsg, payload)
+
+	return msg, nil
 }
 
 func newRoute(route lnchat.Route) Route {
@@ -0,0 +1,107 @@
+package model
+
+// ReceiptType denotes the kind of a message receipt.
+type ReceiptType uint8
+
+const (
+	// ReceiptDELIVERED denotes a delivery receipt,
+	// sent by a recipient when a message is received.
+	ReceiptDELIVERED ReceiptType = iota
+	// ReceiptREAD denotes a read receipt, sent by a recipient
+	// when a message (and every previous one) is read.
+	ReceiptREAD
+)
+
+// Receipt represents a receipt sent by a recipient of an outgoing message.
+type Receipt struct {
+	// The receipt type.
+	Type ReceiptType
+	// The payment hash of the acknowledged message (hex-encoded).
+	PaymentHash string
+	// The address of the recipient sending the receipt.
+	Sender string
+	// The time the receipt was received (in nanoseconds since Unix Epoch).
+	TimeNs int64
+}
+
+// RecipientReceipts represents the receipts
+// sent by a recipient of an outgoing message.
+type RecipientReceipts struct {
+	// The address of the recipient.
+	Recipient string
+	// The time the message was delivered to the recipient
+	// (in nanoseconds since Unix Epoch), if acknowledged.
+	DeliveredTimeNs int64
+	// The time the message was read by the recipient
+	// (in nanoseconds since Unix Epoch), if acknowledged.
+	ReadTimeNs int64
+}
+
+// WithReceipt records a receipt of the message by one of its recipients.
+// Only the first receipt of each type is recorded,
+// and a read receipt implies delivery of the message.
+// It returns whether the message receipts were updated.
+func (raw *RawMessage) WithReceipt(receipt *Receipt) bool {
+	var rr *RecipientReceipts
+	for i := range raw.Receipts {
+		if raw.Receipts[i].Recipient == receipt.Sender {
+			rr = &raw.Receipts[i]
+			break
+		}
+	}
+	if rr == nil {
+		raw.Receipts = append(raw.Receipts, RecipientReceipts{
+			Recipient: receipt.Sender,
+		})
+		rr = &raw.Receipts[len(raw.Receipts)-1]
+	}
+
+	updated := false
+	if rr.DeliveredTimeNs == 0 {
+		rr.DeliveredTimeNs = receipt.TimeNs
+		updated = true
+	}
+	if receipt.Type == ReceiptREAD && rr.ReadTimeNs == 0 {
+		rr.ReadTimeNs = receipt.TimeNs
+		updated = true
+	}
+
+	return updated
+}
+
+// receiptTimes returns the times an outgoing message was delivered to
+// and read by all of the provided recipients, which are 0 until
+// every recipient has acknowledged the message.
+func (raw *RawMessage) receiptTimes(recipients []string) (deliveredNs, readNs int64) {
+	if len(recipients) == 0 {
+		return 0, 0
+	}
+
+	receipts := make(map[string]RecipientReceipts, len(raw.Receipts))
+	for _, rr := range raw.Receipts {
+		receipts[rr.Recipient] = rr
+	}
+
+	allRead := true
+	for _, recipient := range recipients {
+		rr := receipts[recipient]
+		// A read receipt implies delivery.
+		if rr.DeliveredTimeNs == 0 {
+			return 0, 0
+		}
+		if rr.DeliveredTimeNs > deliveredNs {
+			deliveredNs = rr.DeliveredTimeNs
+		}
+		switch {
+		case rr.ReadTimeNs == 0:
+			allRead = false
+		case rr.ReadTimeNs > readNs:
+			readNs = rr.ReadTimeNs
+		}
+	}
+	if !allRead {
+		readNs = 0
+	}
+
+	return deliveredNs, readNs
+}
@@ -0,0 +1,49 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRawMessageReceipts(t *testing.T) {
+	recipients := []string{"alice", "bob"}
+	raw := new(RawMessage)
+
+	delivered, read := raw.receiptTimes(recipients)
+	assert.Zero(t, delivered)
+	assert.Zero(t, read)
+
+	assert.True(t, raw.WithReceipt(&Receipt{
+		Type: ReceiptDELIVERED, Sender: "alice", TimeNs: 10,
+	}))
+	// Only the first receipt of each type is recorded.
+	assert.False(t, raw.WithReceipt(&Receipt{
+		Type: ReceiptDELIVERED, Sender: "alice", TimeNs: 15,
+	}))
+
+	// Delivery is reported once all recipients have acknowledged the message.
+	delivered, read = raw.receiptTimes(recipients)
+	assert.Zero(t, delivered)
+	assert.Zero(t, read)
+
+	// A read receipt implies delivery.
+	assert.True(t, raw.WithReceipt(&Receipt{
+		Type: ReceiptREAD, Sender: "bob", TimeNs: 20,
+	}))
+	delivered, read = raw.receiptTimes(recipients)
+	assert.Equal(t, int64(20), delivered)
+	assert.Zero(t, read)
+
+	assert.True(t, raw.WithReceipt(&Receipt{
+		Type: ReceiptREAD, Sender: "alice", TimeNs: 30,
+	}))
+	delivered, read = raw.receiptTimes(recipients)
+	assert.Equal(t, int64(20), delivered)
+	assert.Equal(t, int64(30), read)
+
+	assert.Equal(t, []RecipientReceipts{
+		{Recipient: "alice", DeliveredTimeNs: 10, ReadTimeNs: 30},
+		{Recipient: "bob", DeliveredTimeNs: 20, ReadTimeNs: 20},
+	}, raw.Receipts)
+}
@@ -0,0 +1,32 @@
+package model
+
+import "time"
+
+// RetentionPolicy represents a local message retention policy.
+// Messages exceeding the policy limits are redacted.
+type RetentionPolicy struct {
+	// The maximum age of retained messages.
+	// 0 represents no limit.
+	MaxAge time.Duration `json:"max_age"`
+	// The maximum number of retained standalone messages per discussion.
+	// 0 represents no limit.
+	MaxCount uint64 `json:"max_count"`
+}
+
+// IsZero returns whether the policy sets no limits.
+func (p RetentionPolicy) IsZero() bool {
+	return p.MaxAge == 0 && p.MaxCount == 0
+}
+
+// Override returns the policy with its limits
+// replaced by the limits set in the provided policy.
+func (p RetentionPolicy) Override(o RetentionPolicy) RetentionPolicy {
+	if o.MaxAge != 0 {
+		p.MaxAge = o.MaxAge
+	}
+	if o.MaxCount != 0 {
+		p.MaxCount = o.MaxCount
+	}
+
+	return p
+}
@@ -0,0 +1,104 @@
+package model
+
+import (
+	"strings"
+	"unicode"
+)
+
+// SearchQuery represents a message search query.
+// Unset (zero) fields do not restrict the results.
+type SearchQuery struct {
+	// The text to search for. Messages must contain all of its terms,
+	// where a term matches any word of the message it is a prefix of.
+	Text string
+	// The discussions to search in.
+	DiscussionIDs []uint64
+	// The address of the message sender.
+	Sender string
+	// The earliest time a message was stored (in nanoseconds since Unix Epoch).
+	FromTimeNs int64
+	// The latest time a message was stored (in nanoseconds since Unix Epoch).
+	ToTimeNs int64
+	// The minimum message amount (in millisatoshi).
+	MinAmtMsat int64
+	// The maximum message amount (in millisatoshi).
+	MaxAmtMsat int64
+}
+
+// TextRange represents a range of a text,
+// in Unicode code points (runes).
+type TextRange struct {
+	// The offset of the first rune of the range.
+	Start int
+	// The offset past the last rune of the range.
+	End int
+}
+
+// SearchResult represents a message matching a search query.
+type SearchResult struct {
+	// The matching message.
+	Message Message
+	// The ranges of the message payload matching the query terms.
+	Highlights []TextRange
+}
+
+// isWordRune returns whether a rune is part of a word.
+func isWordRune(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsNumber(r)
+}
+
+// SearchTerms returns the distinct normalized terms of a text,
+// as used for indexing message payloads and parsing search queries.
+func SearchTerms(text string) []string {
+	fields := strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !isWordRune(r)
+	})
+
+	var terms []string
+	seen := make(map[string]struct{}, len(fields))
+	for _, f := range fields {
+		if _, ok := seen[f]; ok {
+			continue
+		}
+		seen[f] = struct{}{}
+		terms = append(terms, f)
+	}
+
+	return terms
+}
+
+// SearchHighlights returns the ranges of the words of a text
+// matched by any of the provided (normalized) search terms.
+func SearchHighlights(text string, terms []string) []TextRange {
+	var highlights []TextRange
+
+	match := func(word []rune, start int) {
+		w := strings.ToLower(string(word))
+		for _, term := range terms {
+			if strings.HasPrefix(w, term) {
+				highlights = append(highlights, TextRange{
+					Start: start,
+					End:   start + len(word),
+				})
+				return
+			}
+		}
+	}
+
+	runes := []rune(text)
+	start := -1
+	for i, r := range runes {
+		switch {
+		case isWordRune(r) && start < 0:
+			start = i
+		case !isWordRune(r) && start >= 0:
+			match(runes[start:i], start)
+			start = -1
+		}
+	}
+	if start >= 0 {
+		match(runes[start:], start)
+	}
+
+	return highlights
+}
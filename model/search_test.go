@@ -0,0 +1,29 @@
+package model
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSearchTerms(t *testing.T) {
+	assert.Equal(t, []string{"hello", "wörld", "42"},
+		SearchTerms("Hello, WÖRLD! hello... 42"))
+	assert.Empty(t, SearchTerms(" ,.!? "))
+}
+
+func TestSearchHighlights(t *testing.T) {
+	text := "Héllo, wörld! Say hello to the world."
+
+	highlights := SearchHighlights(text, SearchTerms("wor hé"))
+	assert.Equal(t, []TextRange{
+		{Start: 0, End: 5},
+		{Start: 31, End: 36},
+	}, highlights)
+
+	assert.Equal(t, []TextRange{
+		{Start: 7, End: 12},
+	}, SearchHighlights(text, SearchTerms("wörld")))
+
+	assert.Empty(t, SearchHighlights(text, SearchTerms("orld")))
+}
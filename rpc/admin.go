@@ -0,0 +1,78 @@
+package rpc
+
+import (
+	"context"
+
+	"github.com/c13n-io/c13n-go/app"
+	pb "github.com/c13n-io/c13n-go/rpc/services"
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+type adminServiceServer struct {
+	Log *slog.Logger
+
+	App *app.App
+
+	pb.UnimplementedAdminServiceServer
+}
+
+func (s *adminServiceServer) logError(err error) error {
+	if err != nil {
+		s.Log.Errorf("%+v", err)
+	}
+	return err
+}
+
+// Interface implementation
+
+// Resync rebuilds the message history from the underlying node.
+func (s *adminServiceServer) Resync(ctx context.Context, _ *pb.ResyncRequest) (*pb.ResyncResponse, error) {
+	summary, err := s.App.Resync(ctx)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.ResyncResponse{
+		Invoices: summary.Invoices,
+		Payments: summary.Payments,
+		Messages: summary.Messages,
+	}, nil
+}
+
+// BackupDatabase sends a backup of the database entries changed
+// after the requested version over the provided stream.
+func (s *adminServiceServer) BackupDatabase(req *pb.BackupDatabaseRequest, srv pb.AdminService_BackupDatabaseServer) error {
+	version, err := s.App.BackupDatabase(srv.Context(),
+		backupStreamWriter{srv: srv}, req.GetSinceVersion())
+	if err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+	if err := srv.Send(&pb.BackupDatabaseResponse{Version: version}); err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+	return nil
+}
+
+// backupStreamWriter sends the backup chunks written to it
+// over a BackupDatabase stream.
+type backupStreamWriter struct {
+	srv pb.AdminService_BackupDatabaseServer
+}
+
+func (w backupStreamWriter) Write(chunk []byte) (int, error) {
+	if err := w.srv.Send(&pb.BackupDatabaseResponse{Chunk: chunk}); err != nil {
+		return 0, err
+	}
+
+	return len(chunk), nil
+}
+
+// NewAdminServiceServer initializes a new admin service.
+func NewAdminServiceServer(app *app.App) pb.AdminServiceServer {
+	return &adminServiceServer{
+		Log: slog.NewLogger("admin-service"),
+		App: app,
+	}
+}
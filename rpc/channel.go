@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/c13n-io/c13n-go/app"
+	"github.com/c13n-io/c13n-go/lnchat"
 	"github.com/c13n-io/c13n-go/model"
 	pb "github.com/c13n-io/c13n-go/rpc/services"
 	"github.com/c13n-io/c13n-go/slog"
@@ -48,6 +49,114 @@ func (s *channelServiceServer) OpenChannel(ctx context.Context,
 	}, nil
 }
 
+// CloseChannel closes a channel and streams
+// the channel closing updates.
+func (s *channelServiceServer) CloseChannel(req *pb.CloseChannelRequest,
+	srv pb.ChannelService_CloseChannelServer) error {
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	chanPoint := model.ChannelPoint{
+		ChannelPoint: lnchat.ChannelPoint{
+			FundingTxid: req.GetFundingTxid(),
+			OutputIndex: req.GetOutputIndex(),
+		},
+	}
+	updates, err := s.App.CloseChannel(ctx, chanPoint, req.GetForce(),
+		model.TxFeeOptions{
+			SatPerVByte:     req.GetSatPerVbyte(),
+			TargetConfBlock: req.GetTargetConfirmationBlock(),
+		},
+	)
+	if err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			if update.Error != nil {
+				return associateStatusCode(s.logError(update.Error))
+			}
+
+			state := pb.ChannelCloseState_CHANNEL_CLOSE_PENDING
+			if update.Status.Confirmed {
+				state = pb.ChannelCloseState_CHANNEL_CLOSE_CONFIRMED
+			}
+			if err := srv.Send(&pb.CloseChannelResponse{
+				ClosingTxid: update.Status.ClosingTxid,
+				State:       state,
+			}); err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+		}
+	}
+}
+
+// ListChannels lists the open channels of the current node.
+func (s *channelServiceServer) ListChannels(ctx context.Context,
+	_ *pb.ListChannelsRequest) (*pb.ListChannelsResponse, error) {
+
+	channels, err := s.App.ListChannels(ctx)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return channelModelsToListChannelsResponse(channels), nil
+}
+
+// ListPendingChannels lists the channels of the current node
+// that are pending opening or closing.
+func (s *channelServiceServer) ListPendingChannels(ctx context.Context,
+	_ *pb.ListPendingChannelsRequest) (*pb.ListChannelsResponse, error) {
+
+	channels, err := s.App.ListPendingChannels(ctx)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return channelModelsToListChannelsResponse(channels), nil
+}
+
+// SubscribeChannelEvents streams channel events.
+func (s *channelServiceServer) SubscribeChannelEvents(_ *pb.SubscribeChannelEventsRequest,
+	srv pb.ChannelService_SubscribeChannelEventsServer) error {
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	events, err := s.App.SubscribeChannelEvents(ctx)
+	if err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case update, ok := <-events:
+			if !ok {
+				s.Log.Printf("Channel event subscription closed.")
+				return nil
+			}
+			if update.Error != nil {
+				return associateStatusCode(s.logError(update.Error))
+			}
+
+			resp := channelEventModelToSubscribeChannelEventsResponse(update.Event)
+			if err := srv.Send(resp); err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+		}
+	}
+}
+
 // NewChannelServiceServer initializes a new channel service.
 func NewChannelServiceServer(app *app.App) pb.ChannelServiceServer {
 	return &channelServiceServer{
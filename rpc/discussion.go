@@ -7,7 +7,7 @@ import (
 	"google.golang.org/grpc/status"
 
 	"github.com/c13n-io/c13n-go/app"
-	"github.com/c13n-io/c13n-go/model"
+	"github.com/c13n-io/c13n-go/archive"
 	pb "github.com/c13n-io/c13n-go/rpc/services"
 	"github.com/c13n-io/c13n-go/slog"
 )
@@ -29,9 +29,9 @@ func (s *discussionServiceServer) logError(err error) error {
 
 // Interface implementation
 
-// GetDiscussions returns information about all discussions
+// GetDiscussions returns information about the requested discussions
 // over the provided grpc stream.
-func (s *discussionServiceServer) GetDiscussions(_ *pb.GetDiscussionsRequest, srv pb.DiscussionService_GetDiscussionsServer) error {
+func (s *discussionServiceServer) GetDiscussions(req *pb.GetDiscussionsRequest, srv pb.DiscussionService_GetDiscussionsServer) error {
 
 	ctx := srv.Context()
 	ctx, cancel := context.WithCancel(ctx)
@@ -39,18 +39,28 @@ func (s *discussionServiceServer) GetDiscussions(_ *pb.GetDiscussionsRequest, sr
 		cancel()
 	}()
 
-	discussions, err := s.App.GetDiscussions(ctx)
+	pageOptions, err := pageOptionsFromRequest(req.GetPageOptions())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	order, err := discussionOrderFromRequest(req.GetOrder())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	filter, err := discussionFilterFromRequest(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	summaries, err := s.App.GetDiscussions(ctx, pageOptions, order, filter)
 	if err != nil {
 		return associateStatusCode(s.logError(err))
 	}
-	for _, disc := range discussions {
-		discInfo, err := discussionModelToDiscussionInfo(&disc)
+	for _, summary := range summaries {
+		discResp, err := discussionSummaryToGetDiscussionsResponse(&summary)
 		if err != nil {
 			return associateStatusCode(s.logError(err))
 		}
-		discResp := &pb.GetDiscussionsResponse{
-			Discussion: discInfo,
-		}
 		if err := srv.Send(discResp); err != nil {
 			return associateStatusCode(s.logError(err))
 		}
@@ -69,13 +79,9 @@ func (s *discussionServiceServer) GetDiscussionHistoryByID(req *pb.GetDiscussion
 		cancel()
 	}()
 
-	var pageOptions model.PageOptions
-	if pageOpts := req.GetPageOptions(); pageOpts != nil {
-		pageOptions = model.PageOptions{
-			LastID:   pageOpts.GetLastId(),
-			PageSize: uint64(pageOpts.GetPageSize()),
-			Reverse:  pageOpts.GetReverse(),
-		}
+	pageOptions, err := pageOptionsFromRequest(req.GetPageOptions())
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
 	}
 
 	msgList, err := s.App.GetDiscussionHistory(ctx, req.GetId(), pageOptions)
@@ -145,17 +151,63 @@ func (s *discussionServiceServer) UpdateDiscussionLastRead(ctx context.Context,
 
 	discussionID, lastReadID := req.DiscussionId, req.LastReadMsgId
 
-	if err := s.App.UpdateDiscussionLastRead(ctx, discussionID, lastReadID); err != nil {
+	if err := s.App.UpdateDiscussionLastRead(ctx, discussionID,
+		lastReadID, req.GetSendReadReceipt()); err != nil {
 		return nil, associateStatusCode(s.logError(err))
 	}
 
 	return &pb.UpdateDiscussionResponse{}, nil
 }
 
-// RemoveDiscussion removes a discussion from the database,
-// based on the id request field.
+// UpdateDiscussion replaces the metadata of a discussion.
+func (s *discussionServiceServer) UpdateDiscussion(ctx context.Context,
+	req *pb.UpdateDiscussionRequest) (*pb.UpdateDiscussionResponse, error) {
+
+	metadata := discussionMetadataFromRequest(req.GetMetadata())
+
+	if err := s.App.UpdateDiscussionMetadata(ctx, req.GetDiscussionId(),
+		metadata); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.UpdateDiscussionResponse{}, nil
+}
+
+// UpdateDiscussionOptions replaces the options of a discussion.
+func (s *discussionServiceServer) UpdateDiscussionOptions(ctx context.Context,
+	req *pb.UpdateDiscussionOptionsRequest) (*pb.UpdateDiscussionResponse, error) {
+
+	options := discussionOptionsFromRequest(req.GetOptions())
+
+	if err := s.App.UpdateDiscussionOptions(ctx, req.GetDiscussionId(),
+		options); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.UpdateDiscussionResponse{}, nil
+}
+
+// UpdateDiscussionRetention replaces the retention policy of a discussion.
+func (s *discussionServiceServer) UpdateDiscussionRetention(ctx context.Context,
+	req *pb.UpdateDiscussionRetentionRequest) (*pb.UpdateDiscussionResponse, error) {
+
+	policy, err := retentionPolicyFromRequest(req.GetRetention())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	if err := s.App.UpdateDiscussionRetention(ctx, req.GetDiscussionId(),
+		policy); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.UpdateDiscussionResponse{}, nil
+}
+
+// RemoveDiscussion soft deletes or purges a discussion,
+// based on the id and purge request fields.
 func (s *discussionServiceServer) RemoveDiscussion(ctx context.Context, req *pb.RemoveDiscussionRequest) (*pb.RemoveDiscussionResponse, error) {
-	err := s.App.RemoveDiscussion(ctx, req.GetId())
+	err := s.App.RemoveDiscussion(ctx, req.GetId(), req.GetPurge())
 	if err != nil {
 		return nil, associateStatusCode(s.logError(err))
 	}
@@ -163,6 +215,46 @@ func (s *discussionServiceServer) RemoveDiscussion(ctx context.Context, req *pb.
 	return &pb.RemoveDiscussionResponse{}, nil
 }
 
+// RestoreDiscussion restores a soft deleted discussion,
+// based on the id request field.
+func (s *discussionServiceServer) RestoreDiscussion(ctx context.Context, req *pb.RestoreDiscussionRequest) (*pb.RestoreDiscussionResponse, error) {
+	if err := s.App.RestoreDiscussion(ctx, req.GetId()); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.RestoreDiscussionResponse{}, nil
+}
+
+// ExportDiscussion sends an archive of the discussion
+// with the requested id over the provided stream, line by line.
+func (s *discussionServiceServer) ExportDiscussion(req *pb.ExportDiscussionRequest, srv pb.DiscussionService_ExportDiscussionServer) error {
+	w, err := archive.NewWriter(exportStreamWriter{srv: srv}, nil)
+	if err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+	if err := s.App.ExportDiscussions(srv.Context(), w, req.GetId()); err != nil {
+		return associateStatusCode(s.logError(err))
+	}
+
+	return nil
+}
+
+// exportStreamWriter sends the archive lines written to it
+// over an ExportDiscussion stream.
+// Each archive line is written with a single call to Write.
+type exportStreamWriter struct {
+	srv pb.DiscussionService_ExportDiscussionServer
+}
+
+func (w exportStreamWriter) Write(line []byte) (int, error) {
+	if err := w.srv.Send(&pb.ExportDiscussionResponse{Line: line}); err != nil {
+		return 0, err
+	}
+
+	return len(line), nil
+}
+
 // NewDiscussionServiceServer initializes a new discussion service.
 func NewDiscussionServiceServer(app *app.App) pb.DiscussionServiceServer {
 	return &discussionServiceServer{
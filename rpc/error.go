@@ -20,10 +20,13 @@ func associateStatusCode(err error) error {
 		// Missing app.NetworkError
 		case app.PermissionError:
 			return status.Errorf(codes.PermissionDenied, "%v", err)
-		case app.NoRouteFound, app.ContactNotFound, app.DiscussionNotFound:
+		case app.NoRouteFound, app.ContactNotFound, app.DiscussionNotFound,
+			app.MessageNotFound:
 			return status.Errorf(codes.NotFound, "%v", err)
-		case app.InvalidAddress:
+		case app.InvalidAddress, app.InvalidOptions:
 			return status.Errorf(codes.InvalidArgument, "%v", err)
+		case app.FailedPrecondition:
+			return status.Errorf(codes.FailedPrecondition, "%v", err)
 		// Missing app.InsufficientBalance
 		case app.ContactAlreadyExists, app.DiscussionAlreadyExists:
 			return status.Errorf(codes.AlreadyExists, "%v", err)
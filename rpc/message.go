@@ -4,6 +4,9 @@ import (
 	"context"
 	"fmt"
 
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
 	"github.com/c13n-io/c13n-go/app"
 	pb "github.com/c13n-io/c13n-go/rpc/services"
 	"github.com/c13n-io/c13n-go/slog"
@@ -38,7 +41,7 @@ func (s *messageServiceServer) EstimateMessage(ctx context.Context, req *pb.Esti
 	opts := messageOptionsFromRequest(req.GetOptions())
 
 	estimation, err := s.App.EstimatePayment(ctx,
-		msg.Payload, msg.AmtMsat, msg.DiscussionID, opts)
+		msg.Content(), msg.AmtMsat, msg.DiscussionID, opts)
 	if err != nil {
 		return nil, associateStatusCode(s.logError(err))
 	}
@@ -64,8 +67,18 @@ func (s *messageServiceServer) SendMessage(ctx context.Context, req *pb.SendMess
 	}
 	opts := messageOptionsFromRequest(req.GetOptions())
 
+	if req.GetAsync() {
+		pending, err := s.App.SendPaymentAsync(ctx,
+			msg.Content(), msg.AmtMsat, msg.DiscussionID, msg.PayReq, opts)
+		if err != nil {
+			return nil, associateStatusCode(s.logError(err))
+		}
+
+		return messageStatusUpdateToSendMessageResponse(pending), nil
+	}
+
 	msg, err = s.App.SendPayment(ctx,
-		msg.Payload, msg.AmtMsat, msg.DiscussionID, msg.PayReq, opts)
+		msg.Content(), msg.AmtMsat, msg.DiscussionID, msg.PayReq, opts)
 	if err != nil {
 		return nil, associateStatusCode(s.logError(err))
 	}
@@ -122,6 +135,80 @@ messageLoop:
 	return nil
 }
 
+// SubscribeMessageStatus returns message status updates on the provided grpc stream.
+func (s *messageServiceServer) SubscribeMessageStatus(req *pb.SubscribeMessageStatusRequest,
+	srv pb.MessageService_SubscribeMessageStatusServer) error {
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	updates, err := s.App.SubscribeMessageStatus(ctx, req.GetSendId())
+	if err != nil {
+		return associateStatusCode(s.logError(
+			fmt.Errorf("Client subscription failed: %w", err)))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.Log.Printf("Context cancelled")
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				s.Log.Printf("Subscription channel closed.")
+				return nil
+			}
+			if update.Error != nil {
+				return associateStatusCode(s.logError(
+					fmt.Errorf("message status subscription error")))
+			}
+
+			resp, err := messageStatusUpdateToSubscribeMessageStatusResponse(update.Update)
+			if err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+			if err := srv.Send(resp); err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+		}
+	}
+}
+
+// SearchMessages returns the requested range of messages
+// matching the provided search query and filters.
+func (s *messageServiceServer) SearchMessages(ctx context.Context, req *pb.SearchMessagesRequest) (*pb.SearchMessagesResponse, error) {
+	query, err := searchMessagesRequestToSearchQuery(req)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	pageOptions, err := pageOptionsFromRequest(req.GetPageOptions())
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, err.Error())
+	}
+
+	results, err := s.App.SearchMessages(ctx, query, pageOptions)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	resp, err := searchResultsToSearchMessagesResponse(results)
+	if err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return resp, nil
+}
+
+// DeleteMessage deletes a message locally, redacting its payload.
+func (s *messageServiceServer) DeleteMessage(ctx context.Context, req *pb.DeleteMessageRequest) (*pb.DeleteMessageResponse, error) {
+	if err := s.App.DeleteMessage(ctx, req.GetMessageId()); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.DeleteMessageResponse{}, nil
+}
+
 // NewMessageServiceServer initializes a new message service.
 func NewMessageServiceServer(app *app.App) pb.MessageServiceServer {
 	return &messageServiceServer{
@@ -0,0 +1,85 @@
+package rpc
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/c13n-io/c13n-go/app"
+	pb "github.com/c13n-io/c13n-go/rpc/services"
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+type presenceServiceServer struct {
+	Log *slog.Logger
+
+	App *app.App
+
+	pb.UnimplementedPresenceServiceServer
+}
+
+func (s *presenceServiceServer) logError(err error) error {
+	if err != nil {
+		s.Log.Errorf("%+v", err)
+	}
+	return err
+}
+
+// Interface implementation
+
+// SubscribePresence returns received presence indications on the provided grpc stream.
+func (s *presenceServiceServer) SubscribePresence(_ *pb.SubscribePresenceRequest,
+	srv pb.PresenceService_SubscribePresenceServer) error {
+
+	ctx, cancel := context.WithCancel(srv.Context())
+	defer cancel()
+
+	updates, err := s.App.SubscribePresence(ctx)
+	if err != nil {
+		return associateStatusCode(s.logError(
+			fmt.Errorf("Client subscription failed: %w", err)))
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			s.Log.Printf("Context cancelled")
+			return nil
+		case update, ok := <-updates:
+			if !ok {
+				s.Log.Printf("Subscription channel closed.")
+				return nil
+			}
+			if update.Error != nil {
+				return associateStatusCode(s.logError(
+					fmt.Errorf("presence subscription error")))
+			}
+
+			resp, err := presenceUpdateModelToRPCPresenceUpdate(update.Update)
+			if err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+			if err := srv.Send(resp); err != nil {
+				return associateStatusCode(s.logError(err))
+			}
+		}
+	}
+}
+
+// SetTyping sends a typing indication to the participants of a discussion.
+func (s *presenceServiceServer) SetTyping(ctx context.Context,
+	req *pb.SetTypingRequest) (*pb.SetTypingResponse, error) {
+
+	if err := s.App.SetTyping(ctx, req.GetDiscussionId(), req.GetTyping()); err != nil {
+		return nil, associateStatusCode(s.logError(err))
+	}
+
+	return &pb.SetTypingResponse{}, nil
+}
+
+// NewPresenceServiceServer initializes a new presence service.
+func NewPresenceServiceServer(app *app.App) pb.PresenceServiceServer {
+	return &presenceServiceServer{
+		Log: slog.NewLogger("presence-service"),
+		App: app,
+	}
+}
@@ -142,6 +142,8 @@ func (s *Server) registerAllServices() {
 	channeler := NewChannelServiceServer(s.App)
 	nodeInformant := NewNodeInfoServiceServer(s.App)
 	financier := NewPaymentServiceServer(s.App)
+	administrator := NewAdminServiceServer(s.App)
+	presenter := NewPresenceServiceServer(s.App)
 
 	// Register services
 	pb.RegisterContactServiceServer(s.Server, contacter)
@@ -150,6 +152,8 @@ func (s *Server) registerAllServices() {
 	pb.RegisterChannelServiceServer(s.Server, channeler)
 	pb.RegisterNodeInfoServiceServer(s.Server, nodeInformant)
 	pb.RegisterPaymentServiceServer(s.Server, financier)
+	pb.RegisterAdminServiceServer(s.Server, administrator)
+	pb.RegisterPresenceServiceServer(s.Server, presenter)
 }
 
 // WithBasicAuth creates an authorization interceptor with the provided basic auth credentials.
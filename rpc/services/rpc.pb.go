@@ -126,11 +126,443 @@ func (InvoiceHTLCState) EnumDescriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{1}
 }
 
+//* Represents the state of a channel closing.
+type ChannelCloseState int32
+
+const (
+	ChannelCloseState_CHANNEL_CLOSE_PENDING   ChannelCloseState = 0
+	ChannelCloseState_CHANNEL_CLOSE_CONFIRMED ChannelCloseState = 1
+)
+
+// Enum value maps for ChannelCloseState.
+var (
+	ChannelCloseState_name = map[int32]string{
+		0: "CHANNEL_CLOSE_PENDING",
+		1: "CHANNEL_CLOSE_CONFIRMED",
+	}
+	ChannelCloseState_value = map[string]int32{
+		"CHANNEL_CLOSE_PENDING":   0,
+		"CHANNEL_CLOSE_CONFIRMED": 1,
+	}
+)
+
+func (x ChannelCloseState) Enum() *ChannelCloseState {
+	p := new(ChannelCloseState)
+	*p = x
+	return p
+}
+
+func (x ChannelCloseState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelCloseState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[2].Descriptor()
+}
+
+func (ChannelCloseState) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[2]
+}
+
+func (x ChannelCloseState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelCloseState.Descriptor instead.
+func (ChannelCloseState) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{2}
+}
+
+//* Represents the state of a channel.
+type ChannelState int32
+
+const (
+	ChannelState_CHANNEL_OPEN          ChannelState = 0
+	ChannelState_CHANNEL_PENDING_OPEN  ChannelState = 1
+	ChannelState_CHANNEL_PENDING_CLOSE ChannelState = 2
+	ChannelState_CHANNEL_CLOSED        ChannelState = 3
+)
+
+// Enum value maps for ChannelState.
+var (
+	ChannelState_name = map[int32]string{
+		0: "CHANNEL_OPEN",
+		1: "CHANNEL_PENDING_OPEN",
+		2: "CHANNEL_PENDING_CLOSE",
+		3: "CHANNEL_CLOSED",
+	}
+	ChannelState_value = map[string]int32{
+		"CHANNEL_OPEN":          0,
+		"CHANNEL_PENDING_OPEN":  1,
+		"CHANNEL_PENDING_CLOSE": 2,
+		"CHANNEL_CLOSED":        3,
+	}
+)
+
+func (x ChannelState) Enum() *ChannelState {
+	p := new(ChannelState)
+	*p = x
+	return p
+}
+
+func (x ChannelState) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelState) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[3].Descriptor()
+}
+
+func (ChannelState) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[3]
+}
+
+func (x ChannelState) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelState.Descriptor instead.
+func (ChannelState) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{3}
+}
+
+//* Represents the type of a channel event.
+type ChannelEventType int32
+
+const (
+	ChannelEventType_CHANNEL_EVENT_OPEN           ChannelEventType = 0
+	ChannelEventType_CHANNEL_EVENT_PENDING_OPEN   ChannelEventType = 1
+	ChannelEventType_CHANNEL_EVENT_CLOSED         ChannelEventType = 2
+	ChannelEventType_CHANNEL_EVENT_ACTIVE         ChannelEventType = 3
+	ChannelEventType_CHANNEL_EVENT_INACTIVE       ChannelEventType = 4
+	ChannelEventType_CHANNEL_EVENT_FULLY_RESOLVED ChannelEventType = 5
+)
+
+// Enum value maps for ChannelEventType.
+var (
+	ChannelEventType_name = map[int32]string{
+		0: "CHANNEL_EVENT_OPEN",
+		1: "CHANNEL_EVENT_PENDING_OPEN",
+		2: "CHANNEL_EVENT_CLOSED",
+		3: "CHANNEL_EVENT_ACTIVE",
+		4: "CHANNEL_EVENT_INACTIVE",
+		5: "CHANNEL_EVENT_FULLY_RESOLVED",
+	}
+	ChannelEventType_value = map[string]int32{
+		"CHANNEL_EVENT_OPEN":           0,
+		"CHANNEL_EVENT_PENDING_OPEN":   1,
+		"CHANNEL_EVENT_CLOSED":         2,
+		"CHANNEL_EVENT_ACTIVE":         3,
+		"CHANNEL_EVENT_INACTIVE":       4,
+		"CHANNEL_EVENT_FULLY_RESOLVED": 5,
+	}
+)
+
+func (x ChannelEventType) Enum() *ChannelEventType {
+	p := new(ChannelEventType)
+	*p = x
+	return p
+}
+
+func (x ChannelEventType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ChannelEventType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[4].Descriptor()
+}
+
+func (ChannelEventType) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[4]
+}
+
+func (x ChannelEventType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ChannelEventType.Descriptor instead.
+func (ChannelEventType) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{4}
+}
+
+//* Represents the delivery status of a sent message.
+type MessageStatus int32
+
+const (
+	MessageStatus_MESSAGE_PENDING   MessageStatus = 0
+	MessageStatus_MESSAGE_IN_FLIGHT MessageStatus = 1
+	MessageStatus_MESSAGE_SUCCEEDED MessageStatus = 2
+	MessageStatus_MESSAGE_FAILED    MessageStatus = 3
+)
+
+// Enum value maps for MessageStatus.
+var (
+	MessageStatus_name = map[int32]string{
+		0: "MESSAGE_PENDING",
+		1: "MESSAGE_IN_FLIGHT",
+		2: "MESSAGE_SUCCEEDED",
+		3: "MESSAGE_FAILED",
+	}
+	MessageStatus_value = map[string]int32{
+		"MESSAGE_PENDING":   0,
+		"MESSAGE_IN_FLIGHT": 1,
+		"MESSAGE_SUCCEEDED": 2,
+		"MESSAGE_FAILED":    3,
+	}
+)
+
+func (x MessageStatus) Enum() *MessageStatus {
+	p := new(MessageStatus)
+	*p = x
+	return p
+}
+
+func (x MessageStatus) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageStatus) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[5].Descriptor()
+}
+
+func (MessageStatus) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[5]
+}
+
+func (x MessageStatus) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageStatus.Descriptor instead.
+func (MessageStatus) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{5}
+}
+
+//* Represents the content type of a message.
+type MessageContentType int32
+
+const (
+	//* A text message, optionally replying to another message.
+	MessageContentType_MESSAGE_CONTENT_TEXT MessageContentType = 0
+	//* A replacement of the payload of a previous message of the sender.
+	MessageContentType_MESSAGE_CONTENT_EDIT MessageContentType = 1
+	//* A request for the deletion of a previous message of the sender.
+	MessageContentType_MESSAGE_CONTENT_DELETE_REQUEST MessageContentType = 2
+	//* A reaction to a message. An empty reaction retracts a previous one.
+	MessageContentType_MESSAGE_CONTENT_REACTION MessageContentType = 3
+)
+
+// Enum value maps for MessageContentType.
+var (
+	MessageContentType_name = map[int32]string{
+		0: "MESSAGE_CONTENT_TEXT",
+		1: "MESSAGE_CONTENT_EDIT",
+		2: "MESSAGE_CONTENT_DELETE_REQUEST",
+		3: "MESSAGE_CONTENT_REACTION",
+	}
+	MessageContentType_value = map[string]int32{
+		"MESSAGE_CONTENT_TEXT":           0,
+		"MESSAGE_CONTENT_EDIT":           1,
+		"MESSAGE_CONTENT_DELETE_REQUEST": 2,
+		"MESSAGE_CONTENT_REACTION":       3,
+	}
+)
+
+func (x MessageContentType) Enum() *MessageContentType {
+	p := new(MessageContentType)
+	*p = x
+	return p
+}
+
+func (x MessageContentType) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageContentType) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[6].Descriptor()
+}
+
+func (MessageContentType) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[6]
+}
+
+func (x MessageContentType) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageContentType.Descriptor instead.
+func (MessageContentType) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{6}
+}
+
+//* Represents the direction of a message.
+type MessageDirection int32
+
+const (
+	//* Both sent and received messages.
+	MessageDirection_MESSAGE_DIRECTION_ANY MessageDirection = 0
+	//* Sent messages.
+	MessageDirection_MESSAGE_DIRECTION_SENT MessageDirection = 1
+	//* Received messages.
+	MessageDirection_MESSAGE_DIRECTION_RECEIVED MessageDirection = 2
+)
+
+// Enum value maps for MessageDirection.
+var (
+	MessageDirection_name = map[int32]string{
+		0: "MESSAGE_DIRECTION_ANY",
+		1: "MESSAGE_DIRECTION_SENT",
+		2: "MESSAGE_DIRECTION_RECEIVED",
+	}
+	MessageDirection_value = map[string]int32{
+		"MESSAGE_DIRECTION_ANY":      0,
+		"MESSAGE_DIRECTION_SENT":     1,
+		"MESSAGE_DIRECTION_RECEIVED": 2,
+	}
+)
+
+func (x MessageDirection) Enum() *MessageDirection {
+	p := new(MessageDirection)
+	*p = x
+	return p
+}
+
+func (x MessageDirection) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MessageDirection) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[7].Descriptor()
+}
+
+func (MessageDirection) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[7]
+}
+
+func (x MessageDirection) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MessageDirection.Descriptor instead.
+func (MessageDirection) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{7}
+}
+
+//* Represents the order of a discussion listing.
+type DiscussionOrder int32
+
+const (
+	//* Ascending discussion id.
+	DiscussionOrder_DISCUSSION_ORDER_ID DiscussionOrder = 0
+	//* Descending time of the last discussion message.
+	//
+	//Pinned discussions are placed first,
+	//while discussions without messages are placed last.
+	DiscussionOrder_DISCUSSION_ORDER_LAST_ACTIVITY DiscussionOrder = 1
+)
+
+// Enum value maps for DiscussionOrder.
+var (
+	DiscussionOrder_name = map[int32]string{
+		0: "DISCUSSION_ORDER_ID",
+		1: "DISCUSSION_ORDER_LAST_ACTIVITY",
+	}
+	DiscussionOrder_value = map[string]int32{
+		"DISCUSSION_ORDER_ID":            0,
+		"DISCUSSION_ORDER_LAST_ACTIVITY": 1,
+	}
+)
+
+func (x DiscussionOrder) Enum() *DiscussionOrder {
+	p := new(DiscussionOrder)
+	*p = x
+	return p
+}
+
+func (x DiscussionOrder) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (DiscussionOrder) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[8].Descriptor()
+}
+
+func (DiscussionOrder) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[8]
+}
+
+func (x DiscussionOrder) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use DiscussionOrder.Descriptor instead.
+func (DiscussionOrder) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{8}
+}
+
+//* Represents a filter on a discussion flag.
+type FlagFilter int32
+
+const (
+	//* Discussions regardless of the flag.
+	FlagFilter_FLAG_FILTER_ANY FlagFilter = 0
+	//* Discussions with the flag set.
+	FlagFilter_FLAG_FILTER_SET FlagFilter = 1
+	//* Discussions with the flag unset.
+	FlagFilter_FLAG_FILTER_UNSET FlagFilter = 2
+)
+
+// Enum value maps for FlagFilter.
+var (
+	FlagFilter_name = map[int32]string{
+		0: "FLAG_FILTER_ANY",
+		1: "FLAG_FILTER_SET",
+		2: "FLAG_FILTER_UNSET",
+	}
+	FlagFilter_value = map[string]int32{
+		"FLAG_FILTER_ANY":   0,
+		"FLAG_FILTER_SET":   1,
+		"FLAG_FILTER_UNSET": 2,
+	}
+)
+
+func (x FlagFilter) Enum() *FlagFilter {
+	p := new(FlagFilter)
+	*p = x
+	return p
+}
+
+func (x FlagFilter) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (FlagFilter) Descriptor() protoreflect.EnumDescriptor {
+	return file_rpc_services_rpc_proto_enumTypes[9].Descriptor()
+}
+
+func (FlagFilter) Type() protoreflect.EnumType {
+	return &file_rpc_services_rpc_proto_enumTypes[9]
+}
+
+func (x FlagFilter) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use FlagFilter.Descriptor instead.
+func (FlagFilter) EnumDescriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{9}
+}
+
 //*
 //Corresponds to pagination parameters for requests.
 //Represents a request for page_size elements,
 //terminating with the element with id last_id.
-//If reverse is true, the returned elements end with last_id.
+//If reverse is true, the returned elements end with last_id,
+//or with the last element if last_id is not set.
+//
+//The remaining fields filter the elements of the range,
+//are only meaningful for message requests
+//and do not restrict the range if unset.
 type KeySetPageOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -142,6 +574,16 @@ type KeySetPageOptions struct {
 	PageSize int64 `protobuf:"varint,2,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
 	//* Whether the range starts or ends with last_id element.
 	Reverse bool `protobuf:"varint,3,opt,name=reverse,proto3" json:"reverse,omitempty"`
+	//* The earliest time a message was stored.
+	FromTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	//* The latest time a message was stored.
+	ToTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	//* The minimum amount paid over a message (in millisatoshi).
+	MinAmtMsat int64 `protobuf:"varint,6,opt,name=min_amt_msat,json=minAmtMsat,proto3" json:"min_amt_msat,omitempty"`
+	//* The maximum amount paid over a message (in millisatoshi).
+	MaxAmtMsat int64 `protobuf:"varint,7,opt,name=max_amt_msat,json=maxAmtMsat,proto3" json:"max_amt_msat,omitempty"`
+	//* The direction of the messages.
+	Direction MessageDirection `protobuf:"varint,8,opt,name=direction,proto3,enum=services.MessageDirection" json:"direction,omitempty"`
 }
 
 func (x *KeySetPageOptions) Reset() {
@@ -197,6 +639,41 @@ func (x *KeySetPageOptions) GetReverse() bool {
 	return false
 }
 
+func (x *KeySetPageOptions) GetFromTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return nil
+}
+
+func (x *KeySetPageOptions) GetToTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return nil
+}
+
+func (x *KeySetPageOptions) GetMinAmtMsat() int64 {
+	if x != nil {
+		return x.MinAmtMsat
+	}
+	return 0
+}
+
+func (x *KeySetPageOptions) GetMaxAmtMsat() int64 {
+	if x != nil {
+		return x.MaxAmtMsat
+	}
+	return 0
+}
+
+func (x *KeySetPageOptions) GetDirection() MessageDirection {
+	if x != nil {
+		return x.Direction
+	}
+	return MessageDirection_MESSAGE_DIRECTION_ANY
+}
+
 type VersionRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
@@ -1109,22 +1586,30 @@ func (x *OpenChannelResponse) GetOutputIndex() uint32 {
 	return 0
 }
 
-//* A message representing a contact of the application.
-type ContactInfo struct {
+//* Corresponds to a request to close a channel.
+type CloseChannelRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The node corresponding to the contact.
-	Node *NodeInfo `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
-	//* The contact id.
-	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
-	//* A contact's chat nickname.
-	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+	//* The funding transaction of the channel.
+	FundingTxid string `protobuf:"bytes,1,opt,name=funding_txid,json=fundingTxid,proto3" json:"funding_txid,omitempty"`
+	//* The output index of the funding transaction.
+	OutputIndex uint32 `protobuf:"varint,2,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	//* Whether to force close the channel.
+	Force bool `protobuf:"varint,3,opt,name=force,proto3" json:"force,omitempty"`
+	//* The number of blocks the closing transaction should confirm by.
+	//
+	//Used for fee estimation, ignored for force closes.
+	TargetConfirmationBlock uint32 `protobuf:"varint,4,opt,name=target_confirmation_block,json=targetConfirmationBlock,proto3" json:"target_confirmation_block,omitempty"`
+	//* The fee rate (satoshis per virtual byte) the closing transaction should cost.
+	//
+	//Ignored for force closes.
+	SatPerVbyte uint64 `protobuf:"varint,5,opt,name=sat_per_vbyte,json=satPerVbyte,proto3" json:"sat_per_vbyte,omitempty"`
 }
 
-func (x *ContactInfo) Reset() {
-	*x = ContactInfo{}
+func (x *CloseChannelRequest) Reset() {
+	*x = CloseChannelRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[17]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1132,13 +1617,13 @@ func (x *ContactInfo) Reset() {
 	}
 }
 
-func (x *ContactInfo) String() string {
+func (x *CloseChannelRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ContactInfo) ProtoMessage() {}
+func (*CloseChannelRequest) ProtoMessage() {}
 
-func (x *ContactInfo) ProtoReflect() protoreflect.Message {
+func (x *CloseChannelRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[17]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1150,41 +1635,60 @@ func (x *ContactInfo) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ContactInfo.ProtoReflect.Descriptor instead.
-func (*ContactInfo) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseChannelRequest.ProtoReflect.Descriptor instead.
+func (*CloseChannelRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{17}
 }
 
-func (x *ContactInfo) GetNode() *NodeInfo {
+func (x *CloseChannelRequest) GetFundingTxid() string {
 	if x != nil {
-		return x.Node
+		return x.FundingTxid
 	}
-	return nil
+	return ""
 }
 
-func (x *ContactInfo) GetId() uint64 {
+func (x *CloseChannelRequest) GetOutputIndex() uint32 {
 	if x != nil {
-		return x.Id
+		return x.OutputIndex
 	}
 	return 0
 }
 
-func (x *ContactInfo) GetDisplayName() string {
+func (x *CloseChannelRequest) GetForce() bool {
 	if x != nil {
-		return x.DisplayName
+		return x.Force
 	}
-	return ""
+	return false
 }
 
-//* Corresponds to a request to list all contacts.
-type GetContactsRequest struct {
+func (x *CloseChannelRequest) GetTargetConfirmationBlock() uint32 {
+	if x != nil {
+		return x.TargetConfirmationBlock
+	}
+	return 0
+}
+
+func (x *CloseChannelRequest) GetSatPerVbyte() uint64 {
+	if x != nil {
+		return x.SatPerVbyte
+	}
+	return 0
+}
+
+//* A CloseChannelResponse is received in response to a CloseChannel call.
+type CloseChannelResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The closing transaction of the channel.
+	ClosingTxid string `protobuf:"bytes,1,opt,name=closing_txid,json=closingTxid,proto3" json:"closing_txid,omitempty"`
+	//* The state of the channel closing.
+	State ChannelCloseState `protobuf:"varint,2,opt,name=state,proto3,enum=services.ChannelCloseState" json:"state,omitempty"`
 }
 
-func (x *GetContactsRequest) Reset() {
-	*x = GetContactsRequest{}
+func (x *CloseChannelResponse) Reset() {
+	*x = CloseChannelResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[18]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1192,13 +1696,13 @@ func (x *GetContactsRequest) Reset() {
 	}
 }
 
-func (x *GetContactsRequest) String() string {
+func (x *CloseChannelResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactsRequest) ProtoMessage() {}
+func (*CloseChannelResponse) ProtoMessage() {}
 
-func (x *GetContactsRequest) ProtoReflect() protoreflect.Message {
+func (x *CloseChannelResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[18]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1210,23 +1714,55 @@ func (x *GetContactsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactsRequest.ProtoReflect.Descriptor instead.
-func (*GetContactsRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CloseChannelResponse.ProtoReflect.Descriptor instead.
+func (*CloseChannelResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{18}
 }
 
-//* A GetContactsResponse is received in response to a GetContacts rpc call.
-type GetContactsResponse struct {
+func (x *CloseChannelResponse) GetClosingTxid() string {
+	if x != nil {
+		return x.ClosingTxid
+	}
+	return ""
+}
+
+func (x *CloseChannelResponse) GetState() ChannelCloseState {
+	if x != nil {
+		return x.State
+	}
+	return ChannelCloseState_CHANNEL_CLOSE_PENDING
+}
+
+//* Represents a channel of the current node.
+type Channel struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The list of contacts in the database.
-	Contacts []*ContactInfo `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
-}
-
-func (x *GetContactsResponse) Reset() {
-	*x = GetContactsResponse{}
+	//* The short channel id of the channel. Zero for pending channels.
+	ChannelId uint64 `protobuf:"varint,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	//* The channel funding transaction.
+	FundingTxid string `protobuf:"bytes,2,opt,name=funding_txid,json=fundingTxid,proto3" json:"funding_txid,omitempty"`
+	//* The output index of the funding transaction.
+	OutputIndex uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	//* The address of the remote node.
+	RemoteAddress string `protobuf:"bytes,4,opt,name=remote_address,json=remoteAddress,proto3" json:"remote_address,omitempty"`
+	//* The name of the remote node (contact display name if a contact, otherwise node alias).
+	RemoteName string `protobuf:"bytes,5,opt,name=remote_name,json=remoteName,proto3" json:"remote_name,omitempty"`
+	//* The total channel capacity (in millisatoshi).
+	CapacityMsat uint64 `protobuf:"varint,6,opt,name=capacity_msat,json=capacityMsat,proto3" json:"capacity_msat,omitempty"`
+	//* The local balance of the channel (in millisatoshi).
+	LocalBalanceMsat uint64 `protobuf:"varint,7,opt,name=local_balance_msat,json=localBalanceMsat,proto3" json:"local_balance_msat,omitempty"`
+	//* The remote balance of the channel (in millisatoshi).
+	RemoteBalanceMsat uint64 `protobuf:"varint,8,opt,name=remote_balance_msat,json=remoteBalanceMsat,proto3" json:"remote_balance_msat,omitempty"`
+	//* Whether the channel is active (can be used for payments).
+	Active bool `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	//* The state of the channel.
+	State ChannelState `protobuf:"varint,10,opt,name=state,proto3,enum=services.ChannelState" json:"state,omitempty"`
+}
+
+func (x *Channel) Reset() {
+	*x = Channel{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[19]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1234,13 +1770,13 @@ func (x *GetContactsResponse) Reset() {
 	}
 }
 
-func (x *GetContactsResponse) String() string {
+func (x *Channel) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetContactsResponse) ProtoMessage() {}
+func (*Channel) ProtoMessage() {}
 
-func (x *GetContactsResponse) ProtoReflect() protoreflect.Message {
+func (x *Channel) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[19]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1252,30 +1788,90 @@ func (x *GetContactsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetContactsResponse.ProtoReflect.Descriptor instead.
-func (*GetContactsResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use Channel.ProtoReflect.Descriptor instead.
+func (*Channel) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *GetContactsResponse) GetContacts() []*ContactInfo {
+func (x *Channel) GetChannelId() uint64 {
 	if x != nil {
-		return x.Contacts
+		return x.ChannelId
 	}
-	return nil
+	return 0
 }
 
-//* Corresponds to a request to add a node as a contact.
-type AddContactRequest struct {
+func (x *Channel) GetFundingTxid() string {
+	if x != nil {
+		return x.FundingTxid
+	}
+	return ""
+}
+
+func (x *Channel) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
+	}
+	return 0
+}
+
+func (x *Channel) GetRemoteAddress() string {
+	if x != nil {
+		return x.RemoteAddress
+	}
+	return ""
+}
+
+func (x *Channel) GetRemoteName() string {
+	if x != nil {
+		return x.RemoteName
+	}
+	return ""
+}
+
+func (x *Channel) GetCapacityMsat() uint64 {
+	if x != nil {
+		return x.CapacityMsat
+	}
+	return 0
+}
+
+func (x *Channel) GetLocalBalanceMsat() uint64 {
+	if x != nil {
+		return x.LocalBalanceMsat
+	}
+	return 0
+}
+
+func (x *Channel) GetRemoteBalanceMsat() uint64 {
+	if x != nil {
+		return x.RemoteBalanceMsat
+	}
+	return 0
+}
+
+func (x *Channel) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Channel) GetState() ChannelState {
+	if x != nil {
+		return x.State
+	}
+	return ChannelState_CHANNEL_OPEN
+}
+
+//* Corresponds to a request to list the open channels.
+type ListChannelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The node to add as a contact.
-	Contact *ContactInfo `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *AddContactRequest) Reset() {
-	*x = AddContactRequest{}
+func (x *ListChannelsRequest) Reset() {
+	*x = ListChannelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[20]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1283,13 +1879,13 @@ func (x *AddContactRequest) Reset() {
 	}
 }
 
-func (x *AddContactRequest) String() string {
+func (x *ListChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddContactRequest) ProtoMessage() {}
+func (*ListChannelsRequest) ProtoMessage() {}
 
-func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
+func (x *ListChannelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[20]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1301,30 +1897,20 @@ func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddContactRequest.ProtoReflect.Descriptor instead.
-func (*AddContactRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListChannelsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *AddContactRequest) GetContact() *ContactInfo {
-	if x != nil {
-		return x.Contact
-	}
-	return nil
-}
-
-//* A AddContactResponse is received in response to an AddContact rpc call.
-type AddContactResponse struct {
+//* Corresponds to a request to list the pending channels.
+type ListPendingChannelsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The newly added contact's information.
-	Contact *ContactInfo `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *AddContactResponse) Reset() {
-	*x = AddContactResponse{}
+func (x *ListPendingChannelsRequest) Reset() {
+	*x = ListPendingChannelsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[21]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1332,13 +1918,13 @@ func (x *AddContactResponse) Reset() {
 	}
 }
 
-func (x *AddContactResponse) String() string {
+func (x *ListPendingChannelsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddContactResponse) ProtoMessage() {}
+func (*ListPendingChannelsRequest) ProtoMessage() {}
 
-func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
+func (x *ListPendingChannelsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[21]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1350,30 +1936,23 @@ func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddContactResponse.ProtoReflect.Descriptor instead.
-func (*AddContactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListPendingChannelsRequest.ProtoReflect.Descriptor instead.
+func (*ListPendingChannelsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{21}
 }
 
-func (x *AddContactResponse) GetContact() *ContactInfo {
-	if x != nil {
-		return x.Contact
-	}
-	return nil
-}
-
-//* Corresponds to a request to remove a contact.
-type RemoveContactByIDRequest struct {
+//* A ListChannelsResponse is received in response to a ListChannels or ListPendingChannels rpc call.
+type ListChannelsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The id of the contact to remove.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* The list of channels.
+	Channels []*Channel `protobuf:"bytes,1,rep,name=channels,proto3" json:"channels,omitempty"`
 }
 
-func (x *RemoveContactByIDRequest) Reset() {
-	*x = RemoveContactByIDRequest{}
+func (x *ListChannelsResponse) Reset() {
+	*x = ListChannelsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[22]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1381,13 +1960,13 @@ func (x *RemoveContactByIDRequest) Reset() {
 	}
 }
 
-func (x *RemoveContactByIDRequest) String() string {
+func (x *ListChannelsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveContactByIDRequest) ProtoMessage() {}
+func (*ListChannelsResponse) ProtoMessage() {}
 
-func (x *RemoveContactByIDRequest) ProtoReflect() protoreflect.Message {
+func (x *ListChannelsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[22]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1399,30 +1978,27 @@ func (x *RemoveContactByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveContactByIDRequest.ProtoReflect.Descriptor instead.
-func (*RemoveContactByIDRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use ListChannelsResponse.ProtoReflect.Descriptor instead.
+func (*ListChannelsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{22}
 }
 
-func (x *RemoveContactByIDRequest) GetId() uint64 {
+func (x *ListChannelsResponse) GetChannels() []*Channel {
 	if x != nil {
-		return x.Id
+		return x.Channels
 	}
-	return 0
+	return nil
 }
 
-//* Corresponds to a request to remove a contact.
-type RemoveContactByAddressRequest struct {
+//* Corresponds to a request to subscribe to channel events.
+type SubscribeChannelEventsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The Lightning address of the contact to remove.
-	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *RemoveContactByAddressRequest) Reset() {
-	*x = RemoveContactByAddressRequest{}
+func (x *SubscribeChannelEventsRequest) Reset() {
+	*x = SubscribeChannelEventsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[23]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1430,13 +2006,13 @@ func (x *RemoveContactByAddressRequest) Reset() {
 	}
 }
 
-func (x *RemoveContactByAddressRequest) String() string {
+func (x *SubscribeChannelEventsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveContactByAddressRequest) ProtoMessage() {}
+func (*SubscribeChannelEventsRequest) ProtoMessage() {}
 
-func (x *RemoveContactByAddressRequest) ProtoReflect() protoreflect.Message {
+func (x *SubscribeChannelEventsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[23]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1448,27 +2024,31 @@ func (x *RemoveContactByAddressRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveContactByAddressRequest.ProtoReflect.Descriptor instead.
-func (*RemoveContactByAddressRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeChannelEventsRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeChannelEventsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{23}
 }
 
-func (x *RemoveContactByAddressRequest) GetAddress() string {
-	if x != nil {
-		return x.Address
-	}
-	return ""
-}
-
-//* A RemoveContactResponse is received in response to a RemoveContactBy* rpc call.
-type RemoveContactResponse struct {
+//*
+//A SubscribeChannelEventsResponse is received in the stream returned in response to
+//a SubscribeChannelEvents rpc call, and represents a channel event.
+type SubscribeChannelEventsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The type of the event.
+	Type ChannelEventType `protobuf:"varint,1,opt,name=type,proto3,enum=services.ChannelEventType" json:"type,omitempty"`
+	//* The funding transaction of the channel.
+	FundingTxid string `protobuf:"bytes,2,opt,name=funding_txid,json=fundingTxid,proto3" json:"funding_txid,omitempty"`
+	//* The output index of the funding transaction.
+	OutputIndex uint32 `protobuf:"varint,3,opt,name=output_index,json=outputIndex,proto3" json:"output_index,omitempty"`
+	//* The channel details, only present for open and closed channel events.
+	Channel *Channel `protobuf:"bytes,4,opt,name=channel,proto3" json:"channel,omitempty"`
 }
 
-func (x *RemoveContactResponse) Reset() {
-	*x = RemoveContactResponse{}
+func (x *SubscribeChannelEventsResponse) Reset() {
+	*x = SubscribeChannelEventsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[24]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1476,13 +2056,13 @@ func (x *RemoveContactResponse) Reset() {
 	}
 }
 
-func (x *RemoveContactResponse) String() string {
+func (x *SubscribeChannelEventsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveContactResponse) ProtoMessage() {}
+func (*SubscribeChannelEventsResponse) ProtoMessage() {}
 
-func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
+func (x *SubscribeChannelEventsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[24]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1494,70 +2074,69 @@ func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveContactResponse.ProtoReflect.Descriptor instead.
-func (*RemoveContactResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SubscribeChannelEventsResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeChannelEventsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{24}
 }
 
-//* Represents a message of the application.
-type Message struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SubscribeChannelEventsResponse) GetType() ChannelEventType {
+	if x != nil {
+		return x.Type
+	}
+	return ChannelEventType_CHANNEL_EVENT_OPEN
+}
 
-	//* The unique id of the message.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	//* The discussion id this message is associated with.
-	DiscussionId uint64 `protobuf:"varint,2,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
-	//* The Lightning address of the sender node.
-	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
-	//* The Lightning address of the receiver node.
-	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
-	//* Whether the message sender was verified.
-	SenderVerified bool `protobuf:"varint,11,opt,name=sender_verified,json=senderVerified,proto3" json:"sender_verified,omitempty"`
-	//* The message payload.
-	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
-	//* The amount paid over this message (in millisatoshi).
-	AmtMsat int64 `protobuf:"varint,6,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The total routing fees paid for this message across all routes (in millisatoshi).
-	//
-	//This field is meaningful only for sent and estimated messages.
-	TotalFeesMsat int64 `protobuf:"varint,7,opt,name=total_fees_msat,json=totalFeesMsat,proto3" json:"total_fees_msat,omitempty"`
-	//* The time the message was sent.
-	SentTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sent_timestamp,json=sentTimestamp,proto3" json:"sent_timestamp,omitempty"`
-	//* The time the message was received.
-	ReceivedTimestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=received_timestamp,json=receivedTimestamp,proto3" json:"received_timestamp,omitempty"`
-	//* The routes that fulfilled this message.
-	//
-	//This field is meaningful only for sent and estimated messages.
-	PaymentRoutes []*PaymentRoute `protobuf:"bytes,10,rep,name=payment_routes,json=paymentRoutes,proto3" json:"payment_routes,omitempty"`
-	//* The preimage belonging to the associated payment.
-	//
-	//This field is only meaningful for received messages and
-	//messages sent successfully to non-group discussions.
-	Preimage string `protobuf:"bytes,12,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	//* The payment request this message was paid to.
-	//
-	//If empty, corresponds to a spontaneous payment.
-	PayReq string `protobuf:"bytes,13,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+func (x *SubscribeChannelEventsResponse) GetFundingTxid() string {
+	if x != nil {
+		return x.FundingTxid
+	}
+	return ""
 }
 
-func (x *Message) Reset() {
-	*x = Message{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[25]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SubscribeChannelEventsResponse) GetOutputIndex() uint32 {
+	if x != nil {
+		return x.OutputIndex
 	}
+	return 0
 }
 
-func (x *Message) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubscribeChannelEventsResponse) GetChannel() *Channel {
+	if x != nil {
+		return x.Channel
+	}
+	return nil
 }
 
-func (*Message) ProtoMessage() {}
+//* A message representing a contact of the application.
+type ContactInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 
-func (x *Message) ProtoReflect() protoreflect.Message {
+	//* The node corresponding to the contact.
+	Node *NodeInfo `protobuf:"bytes,1,opt,name=node,proto3" json:"node,omitempty"`
+	//* The contact id.
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	//* A contact's chat nickname.
+	DisplayName string `protobuf:"bytes,3,opt,name=display_name,json=displayName,proto3" json:"display_name,omitempty"`
+}
+
+func (x *ContactInfo) Reset() {
+	*x = ContactInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ContactInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ContactInfo) ProtoMessage() {}
+
+func (x *ContactInfo) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[25]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1569,120 +2148,41 @@ func (x *Message) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Message.ProtoReflect.Descriptor instead.
-func (*Message) Descriptor() ([]byte, []int) {
+// Deprecated: Use ContactInfo.ProtoReflect.Descriptor instead.
+func (*ContactInfo) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{25}
 }
 
-func (x *Message) GetId() uint64 {
-	if x != nil {
-		return x.Id
-	}
-	return 0
-}
-
-func (x *Message) GetDiscussionId() uint64 {
-	if x != nil {
-		return x.DiscussionId
-	}
-	return 0
-}
-
-func (x *Message) GetSender() string {
-	if x != nil {
-		return x.Sender
-	}
-	return ""
-}
-
-func (x *Message) GetReceiver() string {
-	if x != nil {
-		return x.Receiver
-	}
-	return ""
-}
-
-func (x *Message) GetSenderVerified() bool {
-	if x != nil {
-		return x.SenderVerified
-	}
-	return false
-}
-
-func (x *Message) GetPayload() string {
-	if x != nil {
-		return x.Payload
-	}
-	return ""
-}
-
-func (x *Message) GetAmtMsat() int64 {
-	if x != nil {
-		return x.AmtMsat
-	}
-	return 0
-}
-
-func (x *Message) GetTotalFeesMsat() int64 {
-	if x != nil {
-		return x.TotalFeesMsat
-	}
-	return 0
-}
-
-func (x *Message) GetSentTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.SentTimestamp
-	}
-	return nil
-}
-
-func (x *Message) GetReceivedTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.ReceivedTimestamp
-	}
-	return nil
-}
-
-func (x *Message) GetPaymentRoutes() []*PaymentRoute {
+func (x *ContactInfo) GetNode() *NodeInfo {
 	if x != nil {
-		return x.PaymentRoutes
+		return x.Node
 	}
 	return nil
 }
 
-func (x *Message) GetPreimage() string {
+func (x *ContactInfo) GetId() uint64 {
 	if x != nil {
-		return x.Preimage
+		return x.Id
 	}
-	return ""
+	return 0
 }
 
-func (x *Message) GetPayReq() string {
+func (x *ContactInfo) GetDisplayName() string {
 	if x != nil {
-		return x.PayReq
+		return x.DisplayName
 	}
 	return ""
 }
 
-//* Represents a route fulfilling a payment HTLC.
-type PaymentRoute struct {
+//* Corresponds to a request to list all contacts.
+type GetContactsRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The list of hops for this route.
-	Hops []*PaymentHop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
-	//* The total timelock of the route.
-	TotalTimelock uint32 `protobuf:"varint,2,opt,name=total_timelock,json=totalTimelock,proto3" json:"total_timelock,omitempty"`
-	//* The amount sent via this route, disregarding the route fees (in millisatoshi).
-	RouteAmtMsat int64 `protobuf:"varint,3,opt,name=route_amt_msat,json=routeAmtMsat,proto3" json:"route_amt_msat,omitempty"`
-	//* The fees paid for this route (in millisatoshi).
-	RouteFeesMsat int64 `protobuf:"varint,4,opt,name=route_fees_msat,json=routeFeesMsat,proto3" json:"route_fees_msat,omitempty"`
 }
 
-func (x *PaymentRoute) Reset() {
-	*x = PaymentRoute{}
+func (x *GetContactsRequest) Reset() {
+	*x = GetContactsRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[26]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1690,13 +2190,13 @@ func (x *PaymentRoute) Reset() {
 	}
 }
 
-func (x *PaymentRoute) String() string {
+func (x *GetContactsRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentRoute) ProtoMessage() {}
+func (*GetContactsRequest) ProtoMessage() {}
 
-func (x *PaymentRoute) ProtoReflect() protoreflect.Message {
+func (x *GetContactsRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[26]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1708,57 +2208,23 @@ func (x *PaymentRoute) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentRoute.ProtoReflect.Descriptor instead.
-func (*PaymentRoute) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContactsRequest.ProtoReflect.Descriptor instead.
+func (*GetContactsRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{26}
 }
 
-func (x *PaymentRoute) GetHops() []*PaymentHop {
-	if x != nil {
-		return x.Hops
-	}
-	return nil
-}
-
-func (x *PaymentRoute) GetTotalTimelock() uint32 {
-	if x != nil {
-		return x.TotalTimelock
-	}
-	return 0
-}
-
-func (x *PaymentRoute) GetRouteAmtMsat() int64 {
-	if x != nil {
-		return x.RouteAmtMsat
-	}
-	return 0
-}
-
-func (x *PaymentRoute) GetRouteFeesMsat() int64 {
-	if x != nil {
-		return x.RouteFeesMsat
-	}
-	return 0
-}
-
-//* Represents a hop of a route of a message.
-type PaymentHop struct {
+//* A GetContactsResponse is received in response to a GetContacts rpc call.
+type GetContactsResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The channel id.
-	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	//* The address of the hop node.
-	HopAddress string `protobuf:"bytes,2,opt,name=hop_address,json=hopAddress,proto3" json:"hop_address,omitempty"`
-	//* The amount to be forwarded by the hop (in millisatoshi).
-	AmtToForwardMsat int64 `protobuf:"varint,3,opt,name=amt_to_forward_msat,json=amtToForwardMsat,proto3" json:"amt_to_forward_msat,omitempty"`
-	//* The fee to be paid to the hop for forwarding the message (in millisatoshi).
-	FeeMsat int64 `protobuf:"varint,4,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
+	//* The list of contacts in the database.
+	Contacts []*ContactInfo `protobuf:"bytes,1,rep,name=contacts,proto3" json:"contacts,omitempty"`
 }
 
-func (x *PaymentHop) Reset() {
-	*x = PaymentHop{}
+func (x *GetContactsResponse) Reset() {
+	*x = GetContactsResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[27]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1766,13 +2232,13 @@ func (x *PaymentHop) Reset() {
 	}
 }
 
-func (x *PaymentHop) String() string {
+func (x *GetContactsResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PaymentHop) ProtoMessage() {}
+func (*GetContactsResponse) ProtoMessage() {}
 
-func (x *PaymentHop) ProtoReflect() protoreflect.Message {
+func (x *GetContactsResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[27]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1784,53 +2250,30 @@ func (x *PaymentHop) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PaymentHop.ProtoReflect.Descriptor instead.
-func (*PaymentHop) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetContactsResponse.ProtoReflect.Descriptor instead.
+func (*GetContactsResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{27}
 }
 
-func (x *PaymentHop) GetChanId() uint64 {
-	if x != nil {
-		return x.ChanId
-	}
-	return 0
-}
-
-func (x *PaymentHop) GetHopAddress() string {
-	if x != nil {
-		return x.HopAddress
-	}
-	return ""
-}
-
-func (x *PaymentHop) GetAmtToForwardMsat() int64 {
-	if x != nil {
-		return x.AmtToForwardMsat
-	}
-	return 0
-}
-
-func (x *PaymentHop) GetFeeMsat() int64 {
+func (x *GetContactsResponse) GetContacts() []*ContactInfo {
 	if x != nil {
-		return x.FeeMsat
+		return x.Contacts
 	}
-	return 0
+	return nil
 }
 
-//* Represents messaging options.
-type MessageOptions struct {
+//* Corresponds to a request to add a node as a contact.
+type AddContactRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The maximum fee allowed for a message (in millisatoshi).
-	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
-	//* Whether to include the sender address when sending a message.
-	Anonymous bool `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	//* The node to add as a contact.
+	Contact *ContactInfo `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *MessageOptions) Reset() {
-	*x = MessageOptions{}
+func (x *AddContactRequest) Reset() {
+	*x = AddContactRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[28]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1838,13 +2281,13 @@ func (x *MessageOptions) Reset() {
 	}
 }
 
-func (x *MessageOptions) String() string {
+func (x *AddContactRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*MessageOptions) ProtoMessage() {}
+func (*AddContactRequest) ProtoMessage() {}
 
-func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+func (x *AddContactRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[28]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1856,43 +2299,30 @@ func (x *MessageOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
-func (*MessageOptions) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddContactRequest.ProtoReflect.Descriptor instead.
+func (*AddContactRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{28}
 }
 
-func (x *MessageOptions) GetFeeLimitMsat() int64 {
+func (x *AddContactRequest) GetContact() *ContactInfo {
 	if x != nil {
-		return x.FeeLimitMsat
+		return x.Contact
 	}
-	return 0
+	return nil
 }
 
-func (x *MessageOptions) GetAnonymous() bool {
-	if x != nil {
-		return x.Anonymous
-	}
-	return false
-}
-
-//* Corresponds to a request to estimate a message.
-type EstimateMessageRequest struct {
+//* A AddContactResponse is received in response to an AddContact rpc call.
+type AddContactResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The discussion id where the message is to be sent.
-	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
-	//* The message payload (as a string).
-	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	//* The intended payment amount to the recipient of the message (in millisatoshi).
-	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* The message option overrides for the current message.
-	Options *MessageOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	//* The newly added contact's information.
+	Contact *ContactInfo `protobuf:"bytes,1,opt,name=contact,proto3" json:"contact,omitempty"`
 }
 
-func (x *EstimateMessageRequest) Reset() {
-	*x = EstimateMessageRequest{}
+func (x *AddContactResponse) Reset() {
+	*x = AddContactResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[29]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1900,13 +2330,13 @@ func (x *EstimateMessageRequest) Reset() {
 	}
 }
 
-func (x *EstimateMessageRequest) String() string {
+func (x *AddContactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateMessageRequest) ProtoMessage() {}
+func (*AddContactResponse) ProtoMessage() {}
 
-func (x *EstimateMessageRequest) ProtoReflect() protoreflect.Message {
+func (x *AddContactResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[29]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1918,55 +2348,30 @@ func (x *EstimateMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateMessageRequest.ProtoReflect.Descriptor instead.
-func (*EstimateMessageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use AddContactResponse.ProtoReflect.Descriptor instead.
+func (*AddContactResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{29}
 }
 
-func (x *EstimateMessageRequest) GetDiscussionId() uint64 {
-	if x != nil {
-		return x.DiscussionId
-	}
-	return 0
-}
-
-func (x *EstimateMessageRequest) GetPayload() string {
-	if x != nil {
-		return x.Payload
-	}
-	return ""
-}
-
-func (x *EstimateMessageRequest) GetAmtMsat() int64 {
-	if x != nil {
-		return x.AmtMsat
-	}
-	return 0
-}
-
-func (x *EstimateMessageRequest) GetOptions() *MessageOptions {
+func (x *AddContactResponse) GetContact() *ContactInfo {
 	if x != nil {
-		return x.Options
+		return x.Contact
 	}
 	return nil
 }
 
-//* A EstimateMessageResponse is received in response to a EstimateMessage rpc call.
-type EstimateMessageResponse struct {
+//* Corresponds to a request to remove a contact.
+type RemoveContactByIDRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//*
-	//The probability of successful arrival of the message,
-	//as reported by the Lightning daemon's mission control.
-	SuccessProb float64 `protobuf:"fixed64,1,opt,name=success_prob,json=successProb,proto3" json:"success_prob,omitempty"`
-	//* Contains the estimated route and fees for the requested message.
-	Message *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	//* The id of the contact to remove.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func (x *EstimateMessageResponse) Reset() {
-	*x = EstimateMessageResponse{}
+func (x *RemoveContactByIDRequest) Reset() {
+	*x = RemoveContactByIDRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[30]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1974,13 +2379,13 @@ func (x *EstimateMessageResponse) Reset() {
 	}
 }
 
-func (x *EstimateMessageResponse) String() string {
+func (x *RemoveContactByIDRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*EstimateMessageResponse) ProtoMessage() {}
+func (*RemoveContactByIDRequest) ProtoMessage() {}
 
-func (x *EstimateMessageResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveContactByIDRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[30]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -1992,52 +2397,30 @@ func (x *EstimateMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use EstimateMessageResponse.ProtoReflect.Descriptor instead.
-func (*EstimateMessageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveContactByIDRequest.ProtoReflect.Descriptor instead.
+func (*RemoveContactByIDRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{30}
 }
 
-func (x *EstimateMessageResponse) GetSuccessProb() float64 {
+func (x *RemoveContactByIDRequest) GetId() uint64 {
 	if x != nil {
-		return x.SuccessProb
+		return x.Id
 	}
 	return 0
 }
 
-func (x *EstimateMessageResponse) GetMessage() *Message {
-	if x != nil {
-		return x.Message
-	}
-	return nil
-}
-
-//* Corresponds to a request to send a message.
-type SendMessageRequest struct {
+//* Corresponds to a request to remove a contact.
+type RemoveContactByAddressRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The discussion id where the message is to be sent.
-	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
-	//* The message payload (as a string).
-	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
-	//* The intended payment amount to the recipient of the message (in millisatoshi).
-	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* A payment request (invoice) to pay to.
-	//
-	//If empty, a spontaneous message is sent.
-	//If specified, discussion_id is not used and should not be specified.
-	//Instead, the message will be sent to the discussion associated with
-	//the recipient specified by the the payment request (which will be
-	//created if it does not exist).
-	//The discussion_id will be returned in the response.
-	PayReq string `protobuf:"bytes,5,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
-	//* The message option overrides for the current message.
-	Options *MessageOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	//* The Lightning address of the contact to remove.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (x *SendMessageRequest) Reset() {
-	*x = SendMessageRequest{}
+func (x *RemoveContactByAddressRequest) Reset() {
+	*x = RemoveContactByAddressRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[31]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2045,13 +2428,13 @@ func (x *SendMessageRequest) Reset() {
 	}
 }
 
-func (x *SendMessageRequest) String() string {
+func (x *RemoveContactByAddressRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageRequest) ProtoMessage() {}
+func (*RemoveContactByAddressRequest) ProtoMessage() {}
 
-func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveContactByAddressRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[31]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2063,58 +2446,27 @@ func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
-func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveContactByAddressRequest.ProtoReflect.Descriptor instead.
+func (*RemoveContactByAddressRequest) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{31}
 }
 
-func (x *SendMessageRequest) GetDiscussionId() uint64 {
-	if x != nil {
-		return x.DiscussionId
-	}
-	return 0
-}
-
-func (x *SendMessageRequest) GetPayload() string {
-	if x != nil {
-		return x.Payload
-	}
-	return ""
-}
-
-func (x *SendMessageRequest) GetAmtMsat() int64 {
-	if x != nil {
-		return x.AmtMsat
-	}
-	return 0
-}
-
-func (x *SendMessageRequest) GetPayReq() string {
+func (x *RemoveContactByAddressRequest) GetAddress() string {
 	if x != nil {
-		return x.PayReq
+		return x.Address
 	}
 	return ""
 }
 
-func (x *SendMessageRequest) GetOptions() *MessageOptions {
-	if x != nil {
-		return x.Options
-	}
-	return nil
-}
-
-//* A SendMessageResponse is received in response to a SendMessage rpc call.
-type SendMessageResponse struct {
+//* A RemoveContactResponse is received in response to a RemoveContactBy* rpc call.
+type RemoveContactResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	//* The sent message.
-	SentMessage *Message `protobuf:"bytes,1,opt,name=sent_message,json=sentMessage,proto3" json:"sent_message,omitempty"`
 }
 
-func (x *SendMessageResponse) Reset() {
-	*x = SendMessageResponse{}
+func (x *RemoveContactResponse) Reset() {
+	*x = RemoveContactResponse{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[32]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2122,13 +2474,13 @@ func (x *SendMessageResponse) Reset() {
 	}
 }
 
-func (x *SendMessageResponse) String() string {
+func (x *RemoveContactResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SendMessageResponse) ProtoMessage() {}
+func (*RemoveContactResponse) ProtoMessage() {}
 
-func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+func (x *RemoveContactResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[32]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2140,29 +2492,115 @@ func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
-func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveContactResponse.ProtoReflect.Descriptor instead.
+func (*RemoveContactResponse) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{32}
 }
 
-func (x *SendMessageResponse) GetSentMessage() *Message {
-	if x != nil {
-		return x.SentMessage
-	}
-	return nil
-}
-
-//*
-//Corresponds to a request to create a stream
-//over which to be notified of received messages.
-type SubscribeMessageRequest struct {
+//* Represents a message of the application.
+type Message struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The unique id of the message.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* The discussion id this message is associated with.
+	DiscussionId uint64 `protobuf:"varint,2,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The Lightning address of the sender node.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	//* The Lightning address of the receiver node.
+	Receiver string `protobuf:"bytes,4,opt,name=receiver,proto3" json:"receiver,omitempty"`
+	//* Whether the message sender was verified.
+	SenderVerified bool `protobuf:"varint,11,opt,name=sender_verified,json=senderVerified,proto3" json:"sender_verified,omitempty"`
+	//* The message payload.
+	Payload string `protobuf:"bytes,5,opt,name=payload,proto3" json:"payload,omitempty"`
+	//* The amount paid over this message (in millisatoshi).
+	AmtMsat int64 `protobuf:"varint,6,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The total routing fees paid for this message across all routes (in millisatoshi).
+	//
+	//This field is meaningful only for sent and estimated messages.
+	TotalFeesMsat int64 `protobuf:"varint,7,opt,name=total_fees_msat,json=totalFeesMsat,proto3" json:"total_fees_msat,omitempty"`
+	//* The time the message was sent.
+	SentTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=sent_timestamp,json=sentTimestamp,proto3" json:"sent_timestamp,omitempty"`
+	//* The time the message was received.
+	ReceivedTimestamp *timestamppb.Timestamp `protobuf:"bytes,9,opt,name=received_timestamp,json=receivedTimestamp,proto3" json:"received_timestamp,omitempty"`
+	//* The routes that fulfilled this message.
+	//
+	//This field is meaningful only for sent and estimated messages.
+	PaymentRoutes []*PaymentRoute `protobuf:"bytes,10,rep,name=payment_routes,json=paymentRoutes,proto3" json:"payment_routes,omitempty"`
+	//* The preimage belonging to the associated payment.
+	//
+	//This field is only meaningful for received messages and
+	//messages sent successfully to non-group discussions.
+	Preimage string `protobuf:"bytes,12,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//* The payment request this message was paid to.
+	//
+	//If empty, corresponds to a spontaneous payment.
+	PayReq string `protobuf:"bytes,13,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+	//* Whether the message payload was transmitted end-to-end encrypted.
+	Encrypted bool `protobuf:"varint,14,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	//* The sender-assigned message identifier.
+	//
+	//It is used to reference the message in replies, edits,
+	//delete requests and reactions, and is empty for legacy messages.
+	MessageId string `protobuf:"bytes,15,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
+	//* The content type of the message.
+	ContentType MessageContentType `protobuf:"varint,16,opt,name=content_type,json=contentType,proto3,enum=services.MessageContentType" json:"content_type,omitempty"`
+	//* The sender-assigned identifier of the message replied to (if any).
+	ReplyTo string `protobuf:"bytes,17,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	//* The id of the message replied to.
+	//
+	//Only meaningful if reply_to_linked is set.
+	ReplyToId uint64 `protobuf:"varint,18,opt,name=reply_to_id,json=replyToId,proto3" json:"reply_to_id,omitempty"`
+	//* The sender-assigned identifier of the message targeted
+	//by an edit, delete request or reaction.
+	Target string `protobuf:"bytes,19,opt,name=target,proto3" json:"target,omitempty"`
+	//* The id of the targeted message.
+	//
+	//Only meaningful if target_linked is set.
+	TargetId uint64 `protobuf:"varint,20,opt,name=target_id,json=targetId,proto3" json:"target_id,omitempty"`
+	//* The time the last edit of the message was sent, if it was edited.
+	//
+	//This field is meaningful only for messages returned in discussion history.
+	EditedTimestamp *timestamppb.Timestamp `protobuf:"bytes,21,opt,name=edited_timestamp,json=editedTimestamp,proto3" json:"edited_timestamp,omitempty"`
+	//* Whether the sender requested the deletion of the message.
+	//
+	//This field is meaningful only for messages returned in discussion history.
+	Deleted bool `protobuf:"varint,22,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	//* The aggregated reactions to the message.
+	//
+	//This field is meaningful only for messages returned in discussion history.
+	Reactions []*MessageReaction `protobuf:"bytes,23,rep,name=reactions,proto3" json:"reactions,omitempty"`
+	//* Whether the message replied to is present in the discussion.
+	ReplyToLinked bool `protobuf:"varint,24,opt,name=reply_to_linked,json=replyToLinked,proto3" json:"reply_to_linked,omitempty"`
+	//* Whether the targeted message is present in the discussion.
+	//
+	//Messages targeting a message present in the discussion are not
+	//returned separately in discussion history, but are applied to it.
+	TargetLinked bool `protobuf:"varint,25,opt,name=target_linked,json=targetLinked,proto3" json:"target_linked,omitempty"`
+	//* The time the message was delivered to all recipients.
+	//
+	//Only set for outgoing messages, once all recipients
+	//have sent a delivery (or read) receipt.
+	DeliveredTimestamp *timestamppb.Timestamp `protobuf:"bytes,26,opt,name=delivered_timestamp,json=deliveredTimestamp,proto3" json:"delivered_timestamp,omitempty"`
+	//* The time the message was read by all recipients.
+	//
+	//Only set for outgoing messages, once all recipients
+	//have sent a read receipt.
+	ReadTimestamp *timestamppb.Timestamp `protobuf:"bytes,27,opt,name=read_timestamp,json=readTimestamp,proto3" json:"read_timestamp,omitempty"`
+	//* Whether the message payload was redacted locally,
+	//following a deletion or the expiry of the message.
+	Redacted bool `protobuf:"varint,29,opt,name=redacted,proto3" json:"redacted,omitempty"`
+	//* Whether the discussion of the message was muted when it was received.
+	//
+	//This field is meaningful only for messages received over SubscribeMessages.
+	//Clients should refrain from notifying the user about muted messages.
+	Muted bool `protobuf:"varint,28,opt,name=muted,proto3" json:"muted,omitempty"`
 }
 
-func (x *SubscribeMessageRequest) Reset() {
-	*x = SubscribeMessageRequest{}
+func (x *Message) Reset() {
+	*x = Message{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_rpc_services_rpc_proto_msgTypes[33]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2170,13 +2608,13 @@ func (x *SubscribeMessageRequest) Reset() {
 	}
 }
 
-func (x *SubscribeMessageRequest) String() string {
+func (x *Message) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*SubscribeMessageRequest) ProtoMessage() {}
+func (*Message) ProtoMessage() {}
 
-func (x *SubscribeMessageRequest) ProtoReflect() protoreflect.Message {
+func (x *Message) ProtoReflect() protoreflect.Message {
 	mi := &file_rpc_services_rpc_proto_msgTypes[33]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -2188,178 +2626,243 @@ func (x *SubscribeMessageRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use SubscribeMessageRequest.ProtoReflect.Descriptor instead.
-func (*SubscribeMessageRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use Message.ProtoReflect.Descriptor instead.
+func (*Message) Descriptor() ([]byte, []int) {
 	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{33}
 }
 
-//*
-//A SubscribeMessageResponse is received in the stream returned in response to
-//a SubscribeMessages rpc call, and represents a received message.
-type SubscribeMessageResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Message) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
 
-	//* The received message.
-	ReceivedMessage *Message `protobuf:"bytes,1,opt,name=received_message,json=receivedMessage,proto3" json:"received_message,omitempty"`
+func (x *Message) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
 }
 
-func (x *SubscribeMessageResponse) Reset() {
-	*x = SubscribeMessageResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[34]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Message) GetSender() string {
+	if x != nil {
+		return x.Sender
 	}
+	return ""
 }
 
-func (x *SubscribeMessageResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Message) GetReceiver() string {
+	if x != nil {
+		return x.Receiver
+	}
+	return ""
 }
 
-func (*SubscribeMessageResponse) ProtoMessage() {}
+func (x *Message) GetSenderVerified() bool {
+	if x != nil {
+		return x.SenderVerified
+	}
+	return false
+}
 
-func (x *SubscribeMessageResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[34]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Message) GetPayload() string {
+	if x != nil {
+		return x.Payload
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use SubscribeMessageResponse.ProtoReflect.Descriptor instead.
-func (*SubscribeMessageResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{34}
+func (x *Message) GetAmtMsat() int64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
 }
 
-func (x *SubscribeMessageResponse) GetReceivedMessage() *Message {
+func (x *Message) GetTotalFeesMsat() int64 {
 	if x != nil {
-		return x.ReceivedMessage
+		return x.TotalFeesMsat
+	}
+	return 0
+}
+
+func (x *Message) GetSentTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentTimestamp
 	}
 	return nil
 }
 
-//* Represents the information for a specific discussion.
-type DiscussionInfo struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *Message) GetReceivedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReceivedTimestamp
+	}
+	return nil
+}
 
-	//* The discussion id.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	//* The list of participants in the discussion.
-	Participants []string `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
-	//* The default options applicable for all discussion messages.
-	Options *DiscussionOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
-	//* The id of the last read message in the discussion.
-	LastReadMsgId uint64 `protobuf:"varint,4,opt,name=last_read_msg_id,json=lastReadMsgId,proto3" json:"last_read_msg_id,omitempty"`
-	//* The id of the last discussion message.
-	LastMsgId uint64 `protobuf:"varint,5,opt,name=last_msg_id,json=lastMsgId,proto3" json:"last_msg_id,omitempty"`
+func (x *Message) GetPaymentRoutes() []*PaymentRoute {
+	if x != nil {
+		return x.PaymentRoutes
+	}
+	return nil
 }
 
-func (x *DiscussionInfo) Reset() {
-	*x = DiscussionInfo{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[35]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *Message) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
 	}
+	return ""
 }
 
-func (x *DiscussionInfo) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *Message) GetPayReq() string {
+	if x != nil {
+		return x.PayReq
+	}
+	return ""
 }
 
-func (*DiscussionInfo) ProtoMessage() {}
+func (x *Message) GetEncrypted() bool {
+	if x != nil {
+		return x.Encrypted
+	}
+	return false
+}
 
-func (x *DiscussionInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[35]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *Message) GetMessageId() string {
+	if x != nil {
+		return x.MessageId
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use DiscussionInfo.ProtoReflect.Descriptor instead.
-func (*DiscussionInfo) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{35}
+func (x *Message) GetContentType() MessageContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return MessageContentType_MESSAGE_CONTENT_TEXT
 }
 
-func (x *DiscussionInfo) GetId() uint64 {
+func (x *Message) GetReplyTo() string {
 	if x != nil {
-		return x.Id
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *Message) GetReplyToId() uint64 {
+	if x != nil {
+		return x.ReplyToId
 	}
 	return 0
 }
 
-func (x *DiscussionInfo) GetParticipants() []string {
+func (x *Message) GetTarget() string {
 	if x != nil {
-		return x.Participants
+		return x.Target
+	}
+	return ""
+}
+
+func (x *Message) GetTargetId() uint64 {
+	if x != nil {
+		return x.TargetId
+	}
+	return 0
+}
+
+func (x *Message) GetEditedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.EditedTimestamp
 	}
 	return nil
 }
 
-func (x *DiscussionInfo) GetOptions() *DiscussionOptions {
+func (x *Message) GetDeleted() bool {
 	if x != nil {
-		return x.Options
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *Message) GetReactions() []*MessageReaction {
+	if x != nil {
+		return x.Reactions
 	}
 	return nil
 }
 
-func (x *DiscussionInfo) GetLastReadMsgId() uint64 {
+func (x *Message) GetReplyToLinked() bool {
 	if x != nil {
-		return x.LastReadMsgId
+		return x.ReplyToLinked
 	}
-	return 0
+	return false
 }
 
-func (x *DiscussionInfo) GetLastMsgId() uint64 {
+func (x *Message) GetTargetLinked() bool {
 	if x != nil {
-		return x.LastMsgId
+		return x.TargetLinked
 	}
-	return 0
+	return false
 }
 
-//* DiscussionOptions represents the per-discussion options.
-type DiscussionOptions struct {
+func (x *Message) GetDeliveredTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.DeliveredTimestamp
+	}
+	return nil
+}
+
+func (x *Message) GetReadTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ReadTimestamp
+	}
+	return nil
+}
+
+func (x *Message) GetRedacted() bool {
+	if x != nil {
+		return x.Redacted
+	}
+	return false
+}
+
+func (x *Message) GetMuted() bool {
+	if x != nil {
+		return x.Muted
+	}
+	return false
+}
+
+//* Represents the reactions of the same kind to a message.
+type MessageReaction struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The maximum fee allowed for sending a message (in millisatoshi).
-	//
-	//If not set, the default fee limit, as defined in the app package, is used.
-	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
-	//* Whether to send as anonymous on this discussion.
-	Anonymous bool `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	//* The reaction (e.g. an emoji).
+	Reaction string `protobuf:"bytes,1,opt,name=reaction,proto3" json:"reaction,omitempty"`
+	//* The Lightning addresses of the reacting nodes.
+	Senders []string `protobuf:"bytes,2,rep,name=senders,proto3" json:"senders,omitempty"`
 }
 
-func (x *DiscussionOptions) Reset() {
-	*x = DiscussionOptions{}
+func (x *MessageReaction) Reset() {
+	*x = MessageReaction{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[36]
+		mi := &file_rpc_services_rpc_proto_msgTypes[34]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *DiscussionOptions) String() string {
+func (x *MessageReaction) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*DiscussionOptions) ProtoMessage() {}
+func (*MessageReaction) ProtoMessage() {}
 
-func (x *DiscussionOptions) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[36]
+func (x *MessageReaction) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[34]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2370,49 +2873,58 @@ func (x *DiscussionOptions) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use DiscussionOptions.ProtoReflect.Descriptor instead.
-func (*DiscussionOptions) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{36}
+// Deprecated: Use MessageReaction.ProtoReflect.Descriptor instead.
+func (*MessageReaction) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{34}
 }
 
-func (x *DiscussionOptions) GetFeeLimitMsat() int64 {
+func (x *MessageReaction) GetReaction() string {
 	if x != nil {
-		return x.FeeLimitMsat
+		return x.Reaction
 	}
-	return 0
+	return ""
 }
 
-func (x *DiscussionOptions) GetAnonymous() bool {
+func (x *MessageReaction) GetSenders() []string {
 	if x != nil {
-		return x.Anonymous
+		return x.Senders
 	}
-	return false
+	return nil
 }
 
-//* Corresponds to a request to receive all discussion info.
-type GetDiscussionsRequest struct {
+//* Represents a route fulfilling a payment HTLC.
+type PaymentRoute struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The list of hops for this route.
+	Hops []*PaymentHop `protobuf:"bytes,1,rep,name=hops,proto3" json:"hops,omitempty"`
+	//* The total timelock of the route.
+	TotalTimelock uint32 `protobuf:"varint,2,opt,name=total_timelock,json=totalTimelock,proto3" json:"total_timelock,omitempty"`
+	//* The amount sent via this route, disregarding the route fees (in millisatoshi).
+	RouteAmtMsat int64 `protobuf:"varint,3,opt,name=route_amt_msat,json=routeAmtMsat,proto3" json:"route_amt_msat,omitempty"`
+	//* The fees paid for this route (in millisatoshi).
+	RouteFeesMsat int64 `protobuf:"varint,4,opt,name=route_fees_msat,json=routeFeesMsat,proto3" json:"route_fees_msat,omitempty"`
 }
 
-func (x *GetDiscussionsRequest) Reset() {
-	*x = GetDiscussionsRequest{}
+func (x *PaymentRoute) Reset() {
+	*x = PaymentRoute{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[37]
+		mi := &file_rpc_services_rpc_proto_msgTypes[35]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionsRequest) String() string {
+func (x *PaymentRoute) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionsRequest) ProtoMessage() {}
+func (*PaymentRoute) ProtoMessage() {}
 
-func (x *GetDiscussionsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[37]
+func (x *PaymentRoute) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[35]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2423,39 +2935,72 @@ func (x *GetDiscussionsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionsRequest.ProtoReflect.Descriptor instead.
-func (*GetDiscussionsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{37}
+// Deprecated: Use PaymentRoute.ProtoReflect.Descriptor instead.
+func (*PaymentRoute) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{35}
 }
 
-//*
-//A GetDiscussionsResponse is received in the stream returned in response
-//to a GetDiscussions rpc call, and represents a discussion.
-type GetDiscussionsResponse struct {
+func (x *PaymentRoute) GetHops() []*PaymentHop {
+	if x != nil {
+		return x.Hops
+	}
+	return nil
+}
+
+func (x *PaymentRoute) GetTotalTimelock() uint32 {
+	if x != nil {
+		return x.TotalTimelock
+	}
+	return 0
+}
+
+func (x *PaymentRoute) GetRouteAmtMsat() int64 {
+	if x != nil {
+		return x.RouteAmtMsat
+	}
+	return 0
+}
+
+func (x *PaymentRoute) GetRouteFeesMsat() int64 {
+	if x != nil {
+		return x.RouteFeesMsat
+	}
+	return 0
+}
+
+//* Represents a hop of a route of a message.
+type PaymentHop struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+	//* The channel id.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The address of the hop node.
+	HopAddress string `protobuf:"bytes,2,opt,name=hop_address,json=hopAddress,proto3" json:"hop_address,omitempty"`
+	//* The amount to be forwarded by the hop (in millisatoshi).
+	AmtToForwardMsat int64 `protobuf:"varint,3,opt,name=amt_to_forward_msat,json=amtToForwardMsat,proto3" json:"amt_to_forward_msat,omitempty"`
+	//* The fee to be paid to the hop for forwarding the message (in millisatoshi).
+	FeeMsat int64 `protobuf:"varint,4,opt,name=fee_msat,json=feeMsat,proto3" json:"fee_msat,omitempty"`
 }
 
-func (x *GetDiscussionsResponse) Reset() {
-	*x = GetDiscussionsResponse{}
+func (x *PaymentHop) Reset() {
+	*x = PaymentHop{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[38]
+		mi := &file_rpc_services_rpc_proto_msgTypes[36]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionsResponse) String() string {
+func (x *PaymentHop) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionsResponse) ProtoMessage() {}
+func (*PaymentHop) ProtoMessage() {}
 
-func (x *GetDiscussionsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[38]
+func (x *PaymentHop) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[36]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2466,49 +3011,72 @@ func (x *GetDiscussionsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionsResponse.ProtoReflect.Descriptor instead.
-func (*GetDiscussionsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{38}
+// Deprecated: Use PaymentHop.ProtoReflect.Descriptor instead.
+func (*PaymentHop) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{36}
 }
 
-func (x *GetDiscussionsResponse) GetDiscussion() *DiscussionInfo {
+func (x *PaymentHop) GetChanId() uint64 {
 	if x != nil {
-		return x.Discussion
+		return x.ChanId
 	}
-	return nil
+	return 0
 }
 
-//*
-//Corresponds to a request to create a stream over which to receive
-//previously exchanged messages of the identified discussion.
-type GetDiscussionHistoryByIDRequest struct {
+func (x *PaymentHop) GetHopAddress() string {
+	if x != nil {
+		return x.HopAddress
+	}
+	return ""
+}
+
+func (x *PaymentHop) GetAmtToForwardMsat() int64 {
+	if x != nil {
+		return x.AmtToForwardMsat
+	}
+	return 0
+}
+
+func (x *PaymentHop) GetFeeMsat() int64 {
+	if x != nil {
+		return x.FeeMsat
+	}
+	return 0
+}
+
+//* Represents messaging options.
+type MessageOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The discussion id of interest.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	//* The pagination options of the request.
-	PageOptions *KeySetPageOptions `protobuf:"bytes,2,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
+	//* The maximum fee allowed for a message (in millisatoshi).
+	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
+	//* Whether to include the sender address when sending a message.
+	Anonymous bool `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	//* Whether to encrypt the message payload end-to-end.
+	//
+	//Encrypted messages cannot be anonymous.
+	Encrypted bool `protobuf:"varint,3,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
 }
 
-func (x *GetDiscussionHistoryByIDRequest) Reset() {
-	*x = GetDiscussionHistoryByIDRequest{}
+func (x *MessageOptions) Reset() {
+	*x = MessageOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[39]
+		mi := &file_rpc_services_rpc_proto_msgTypes[37]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionHistoryByIDRequest) String() string {
+func (x *MessageOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionHistoryByIDRequest) ProtoMessage() {}
+func (*MessageOptions) ProtoMessage() {}
 
-func (x *GetDiscussionHistoryByIDRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[39]
+func (x *MessageOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[37]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2519,54 +3087,74 @@ func (x *GetDiscussionHistoryByIDRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionHistoryByIDRequest.ProtoReflect.Descriptor instead.
-func (*GetDiscussionHistoryByIDRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{39}
+// Deprecated: Use MessageOptions.ProtoReflect.Descriptor instead.
+func (*MessageOptions) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{37}
 }
 
-func (x *GetDiscussionHistoryByIDRequest) GetId() uint64 {
+func (x *MessageOptions) GetFeeLimitMsat() int64 {
 	if x != nil {
-		return x.Id
+		return x.FeeLimitMsat
 	}
 	return 0
 }
 
-func (x *GetDiscussionHistoryByIDRequest) GetPageOptions() *KeySetPageOptions {
+func (x *MessageOptions) GetAnonymous() bool {
 	if x != nil {
-		return x.PageOptions
+		return x.Anonymous
 	}
-	return nil
+	return false
 }
 
-//*
-//A GetDiscussionHistoryResponse is received in response to
-//a GetHistory rpc call, and represents an exchanged message.
-type GetDiscussionHistoryResponse struct {
+func (x *MessageOptions) GetEncrypted() bool {
+	if x != nil {
+		return x.Encrypted
+	}
+	return false
+}
+
+//* Corresponds to a request to estimate a message.
+type EstimateMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The exchanged message.
-	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	//* The discussion id where the message is to be sent.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The message payload (as a string).
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	//* The intended payment amount to the recipient of the message (in millisatoshi).
+	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* The message option overrides for the current message.
+	Options *MessageOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	//* The content type of the message.
+	ContentType MessageContentType `protobuf:"varint,5,opt,name=content_type,json=contentType,proto3,enum=services.MessageContentType" json:"content_type,omitempty"`
+	//* The sender-assigned identifier of the message replied to.
+	//
+	//Only valid for text messages.
+	ReplyTo string `protobuf:"bytes,6,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	//* The sender-assigned identifier of the message targeted
+	//by an edit, delete request or reaction.
+	Target string `protobuf:"bytes,7,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *GetDiscussionHistoryResponse) Reset() {
-	*x = GetDiscussionHistoryResponse{}
+func (x *EstimateMessageRequest) Reset() {
+	*x = EstimateMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[40]
+		mi := &file_rpc_services_rpc_proto_msgTypes[38]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionHistoryResponse) String() string {
+func (x *EstimateMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionHistoryResponse) ProtoMessage() {}
+func (*EstimateMessageRequest) ProtoMessage() {}
 
-func (x *GetDiscussionHistoryResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[40]
+func (x *EstimateMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[38]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2577,47 +3165,103 @@ func (x *GetDiscussionHistoryResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionHistoryResponse.ProtoReflect.Descriptor instead.
-func (*GetDiscussionHistoryResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{40}
+// Deprecated: Use EstimateMessageRequest.ProtoReflect.Descriptor instead.
+func (*EstimateMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{38}
 }
 
-func (x *GetDiscussionHistoryResponse) GetMessage() *Message {
+func (x *EstimateMessageRequest) GetDiscussionId() uint64 {
 	if x != nil {
-		return x.Message
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *EstimateMessageRequest) GetPayload() string {
+	if x != nil {
+		return x.Payload
+	}
+	return ""
+}
+
+func (x *EstimateMessageRequest) GetAmtMsat() int64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *EstimateMessageRequest) GetOptions() *MessageOptions {
+	if x != nil {
+		return x.Options
 	}
 	return nil
 }
 
-//*
-//Corresponds to a request for statistics about the requested
-//discussion, identified by its id.
-type GetDiscussionStatisticsRequest struct {
+func (x *EstimateMessageRequest) GetContentType() MessageContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return MessageContentType_MESSAGE_CONTENT_TEXT
+}
+
+func (x *EstimateMessageRequest) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *EstimateMessageRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+//* A EstimateMessageResponse is received in response to a EstimateMessage rpc call.
+type EstimateMessageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The discussion id.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//*
+	//The probability of successful arrival of the message,
+	//as reported by the Lightning daemon's mission control.
+	SuccessProb float64 `protobuf:"fixed64,1,opt,name=success_prob,json=successProb,proto3" json:"success_prob,omitempty"`
+	//* Contains the estimated route and fees for the requested message.
+	Message *Message `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	//* The encoded size of the message payload (in bytes).
+	PayloadSize uint64 `protobuf:"varint,3,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	//*
+	//The onion space remaining on the most constrained route
+	//of the message, after accounting for its payload (in bytes).
+	RemainingPayloadSize int64 `protobuf:"varint,4,opt,name=remaining_payload_size,json=remainingPayloadSize,proto3" json:"remaining_payload_size,omitempty"`
+	//*
+	//The number of parts the message is sent in.
+	//
+	//Payloads too large for a single payment are split into parts,
+	//each sent as a separate payment.
+	Parts uint32 `protobuf:"varint,5,opt,name=parts,proto3" json:"parts,omitempty"`
 }
 
-func (x *GetDiscussionStatisticsRequest) Reset() {
-	*x = GetDiscussionStatisticsRequest{}
+func (x *EstimateMessageResponse) Reset() {
+	*x = EstimateMessageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[41]
+		mi := &file_rpc_services_rpc_proto_msgTypes[39]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionStatisticsRequest) String() string {
+func (x *EstimateMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionStatisticsRequest) ProtoMessage() {}
+func (*EstimateMessageResponse) ProtoMessage() {}
 
-func (x *GetDiscussionStatisticsRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[41]
+func (x *EstimateMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[39]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2628,55 +3272,105 @@ func (x *GetDiscussionStatisticsRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionStatisticsRequest.ProtoReflect.Descriptor instead.
-func (*GetDiscussionStatisticsRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{41}
+// Deprecated: Use EstimateMessageResponse.ProtoReflect.Descriptor instead.
+func (*EstimateMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{39}
 }
 
-func (x *GetDiscussionStatisticsRequest) GetId() uint64 {
+func (x *EstimateMessageResponse) GetSuccessProb() float64 {
 	if x != nil {
-		return x.Id
+		return x.SuccessProb
 	}
 	return 0
 }
 
-//*
-//A GetDiscussionStatisticsResponse is received in response to
-//a GetDiscussionStatistics rpc call.
-type GetDiscussionStatisticsResponse struct {
+func (x *EstimateMessageResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+func (x *EstimateMessageResponse) GetPayloadSize() uint64 {
+	if x != nil {
+		return x.PayloadSize
+	}
+	return 0
+}
+
+func (x *EstimateMessageResponse) GetRemainingPayloadSize() int64 {
+	if x != nil {
+		return x.RemainingPayloadSize
+	}
+	return 0
+}
+
+func (x *EstimateMessageResponse) GetParts() uint32 {
+	if x != nil {
+		return x.Parts
+	}
+	return 0
+}
+
+//* Corresponds to a request to send a message.
+type SendMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The total amount sent in the discussion (in millisatoshi).
-	AmtMsatSent uint64 `protobuf:"varint,1,opt,name=amt_msat_sent,json=amtMsatSent,proto3" json:"amt_msat_sent,omitempty"`
-	//* The total amount received in the discussion (in millisatoshi).
-	AmtMsatReceived uint64 `protobuf:"varint,2,opt,name=amt_msat_received,json=amtMsatReceived,proto3" json:"amt_msat_received,omitempty"`
-	//* The total amount of fees for sent messages in the discussion (in millisatoshi).
-	AmtMsatFees uint64 `protobuf:"varint,3,opt,name=amt_msat_fees,json=amtMsatFees,proto3" json:"amt_msat_fees,omitempty"`
-	//* The total amount of sent messages in the discussion.
-	MessagesSent uint64 `protobuf:"varint,4,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
-	//* The total amount of received messages in the discussion.
-	MessagesReceived uint64 `protobuf:"varint,5,opt,name=messages_received,json=messagesReceived,proto3" json:"messages_received,omitempty"`
+	//* The discussion id where the message is to be sent.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The message payload (as a string).
+	Payload string `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	//* The intended payment amount to the recipient of the message (in millisatoshi).
+	AmtMsat int64 `protobuf:"varint,3,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* A payment request (invoice) to pay to.
+	//
+	//If empty, a spontaneous message is sent.
+	//If specified, discussion_id is not used and should not be specified.
+	//Instead, the message will be sent to the discussion associated with
+	//the recipient specified by the the payment request (which will be
+	//created if it does not exist).
+	//The discussion_id will be returned in the response.
+	PayReq string `protobuf:"bytes,5,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+	//* The message option overrides for the current message.
+	Options *MessageOptions `protobuf:"bytes,4,opt,name=options,proto3" json:"options,omitempty"`
+	//* Whether to return before the message is delivered.
+	//
+	//If set, the response contains only the send id and discussion id
+	//of the message, with a pending status.
+	Async bool `protobuf:"varint,6,opt,name=async,proto3" json:"async,omitempty"`
+	//* The content type of the message.
+	//
+	//Edits and delete requests apply only to previous messages of ours,
+	//and along with reactions, they cannot be sent anonymously.
+	ContentType MessageContentType `protobuf:"varint,7,opt,name=content_type,json=contentType,proto3,enum=services.MessageContentType" json:"content_type,omitempty"`
+	//* The sender-assigned identifier of the message replied to.
+	//
+	//Only valid for text messages.
+	ReplyTo string `protobuf:"bytes,8,opt,name=reply_to,json=replyTo,proto3" json:"reply_to,omitempty"`
+	//* The sender-assigned identifier of the message targeted
+	//by an edit, delete request or reaction.
+	Target string `protobuf:"bytes,9,opt,name=target,proto3" json:"target,omitempty"`
 }
 
-func (x *GetDiscussionStatisticsResponse) Reset() {
-	*x = GetDiscussionStatisticsResponse{}
+func (x *SendMessageRequest) Reset() {
+	*x = SendMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[42]
+		mi := &file_rpc_services_rpc_proto_msgTypes[40]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *GetDiscussionStatisticsResponse) String() string {
+func (x *SendMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDiscussionStatisticsResponse) ProtoMessage() {}
+func (*SendMessageRequest) ProtoMessage() {}
 
-func (x *GetDiscussionStatisticsResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[42]
+func (x *SendMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[40]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2687,72 +3381,109 @@ func (x *GetDiscussionStatisticsResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDiscussionStatisticsResponse.ProtoReflect.Descriptor instead.
-func (*GetDiscussionStatisticsResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{42}
+// Deprecated: Use SendMessageRequest.ProtoReflect.Descriptor instead.
+func (*SendMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{40}
 }
 
-func (x *GetDiscussionStatisticsResponse) GetAmtMsatSent() uint64 {
+func (x *SendMessageRequest) GetDiscussionId() uint64 {
 	if x != nil {
-		return x.AmtMsatSent
+		return x.DiscussionId
 	}
 	return 0
 }
 
-func (x *GetDiscussionStatisticsResponse) GetAmtMsatReceived() uint64 {
+func (x *SendMessageRequest) GetPayload() string {
 	if x != nil {
-		return x.AmtMsatReceived
+		return x.Payload
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDiscussionStatisticsResponse) GetAmtMsatFees() uint64 {
+func (x *SendMessageRequest) GetAmtMsat() int64 {
 	if x != nil {
-		return x.AmtMsatFees
+		return x.AmtMsat
 	}
 	return 0
 }
 
-func (x *GetDiscussionStatisticsResponse) GetMessagesSent() uint64 {
+func (x *SendMessageRequest) GetPayReq() string {
 	if x != nil {
-		return x.MessagesSent
+		return x.PayReq
 	}
-	return 0
+	return ""
 }
 
-func (x *GetDiscussionStatisticsResponse) GetMessagesReceived() uint64 {
+func (x *SendMessageRequest) GetOptions() *MessageOptions {
 	if x != nil {
-		return x.MessagesReceived
+		return x.Options
 	}
-	return 0
+	return nil
 }
 
-//* Corresponds to a request to add a discussion to database.
-type AddDiscussionRequest struct {
+func (x *SendMessageRequest) GetAsync() bool {
+	if x != nil {
+		return x.Async
+	}
+	return false
+}
+
+func (x *SendMessageRequest) GetContentType() MessageContentType {
+	if x != nil {
+		return x.ContentType
+	}
+	return MessageContentType_MESSAGE_CONTENT_TEXT
+}
+
+func (x *SendMessageRequest) GetReplyTo() string {
+	if x != nil {
+		return x.ReplyTo
+	}
+	return ""
+}
+
+func (x *SendMessageRequest) GetTarget() string {
+	if x != nil {
+		return x.Target
+	}
+	return ""
+}
+
+//* A SendMessageResponse is received in response to a SendMessage rpc call.
+type SendMessageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+	//* The sent message. Not present for asynchronous sends.
+	SentMessage *Message `protobuf:"bytes,1,opt,name=sent_message,json=sentMessage,proto3" json:"sent_message,omitempty"`
+	//* The id of the send operation, identifying the message status updates.
+	//
+	//Only present for asynchronous sends.
+	SendId uint64 `protobuf:"varint,2,opt,name=send_id,json=sendId,proto3" json:"send_id,omitempty"`
+	//* The discussion id the message is sent to.
+	DiscussionId uint64 `protobuf:"varint,3,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The delivery status of the message.
+	Status MessageStatus `protobuf:"varint,4,opt,name=status,proto3,enum=services.MessageStatus" json:"status,omitempty"`
 }
 
-func (x *AddDiscussionRequest) Reset() {
-	*x = AddDiscussionRequest{}
+func (x *SendMessageResponse) Reset() {
+	*x = SendMessageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[43]
+		mi := &file_rpc_services_rpc_proto_msgTypes[41]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddDiscussionRequest) String() string {
+func (x *SendMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDiscussionRequest) ProtoMessage() {}
+func (*SendMessageResponse) ProtoMessage() {}
 
-func (x *AddDiscussionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[43]
+func (x *SendMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[41]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2763,44 +3494,65 @@ func (x *AddDiscussionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDiscussionRequest.ProtoReflect.Descriptor instead.
-func (*AddDiscussionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{43}
+// Deprecated: Use SendMessageResponse.ProtoReflect.Descriptor instead.
+func (*SendMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{41}
 }
 
-func (x *AddDiscussionRequest) GetDiscussion() *DiscussionInfo {
+func (x *SendMessageResponse) GetSentMessage() *Message {
 	if x != nil {
-		return x.Discussion
+		return x.SentMessage
 	}
 	return nil
 }
 
-//* An AddDiscussionResponse is received in response to an AddDiscussion rpc call.
-type AddDiscussionResponse struct {
+func (x *SendMessageResponse) GetSendId() uint64 {
+	if x != nil {
+		return x.SendId
+	}
+	return 0
+}
+
+func (x *SendMessageResponse) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *SendMessageResponse) GetStatus() MessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MessageStatus_MESSAGE_PENDING
+}
+
+//*
+//Corresponds to a request to create a stream
+//over which to be notified of received messages.
+type SubscribeMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
-
-	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
 }
 
-func (x *AddDiscussionResponse) Reset() {
-	*x = AddDiscussionResponse{}
+func (x *SubscribeMessageRequest) Reset() {
+	*x = SubscribeMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[44]
+		mi := &file_rpc_services_rpc_proto_msgTypes[42]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *AddDiscussionResponse) String() string {
+func (x *SubscribeMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*AddDiscussionResponse) ProtoMessage() {}
+func (*SubscribeMessageRequest) ProtoMessage() {}
 
-func (x *AddDiscussionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[44]
+func (x *SubscribeMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[42]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2811,47 +3563,40 @@ func (x *AddDiscussionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use AddDiscussionResponse.ProtoReflect.Descriptor instead.
-func (*AddDiscussionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{44}
-}
-
-func (x *AddDiscussionResponse) GetDiscussion() *DiscussionInfo {
-	if x != nil {
-		return x.Discussion
-	}
-	return nil
+// Deprecated: Use SubscribeMessageRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{42}
 }
 
-//* Represents a request to update the last read discussion message.
-type UpdateDiscussionLastReadRequest struct {
+//*
+//A SubscribeMessageResponse is received in the stream returned in response to
+//a SubscribeMessages rpc call, and represents a received message.
+type SubscribeMessageResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The discussion id.
-	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
-	//* The message id to mark as the last read.
-	LastReadMsgId uint64 `protobuf:"varint,2,opt,name=last_read_msg_id,json=lastReadMsgId,proto3" json:"last_read_msg_id,omitempty"`
+	//* The received message.
+	ReceivedMessage *Message `protobuf:"bytes,1,opt,name=received_message,json=receivedMessage,proto3" json:"received_message,omitempty"`
 }
 
-func (x *UpdateDiscussionLastReadRequest) Reset() {
-	*x = UpdateDiscussionLastReadRequest{}
+func (x *SubscribeMessageResponse) Reset() {
+	*x = SubscribeMessageResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[45]
+		mi := &file_rpc_services_rpc_proto_msgTypes[43]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDiscussionLastReadRequest) String() string {
+func (x *SubscribeMessageResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDiscussionLastReadRequest) ProtoMessage() {}
+func (*SubscribeMessageResponse) ProtoMessage() {}
 
-func (x *UpdateDiscussionLastReadRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[45]
+func (x *SubscribeMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[43]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2862,51 +3607,47 @@ func (x *UpdateDiscussionLastReadRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDiscussionLastReadRequest.ProtoReflect.Descriptor instead.
-func (*UpdateDiscussionLastReadRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{45}
-}
-
-func (x *UpdateDiscussionLastReadRequest) GetDiscussionId() uint64 {
-	if x != nil {
-		return x.DiscussionId
-	}
-	return 0
+// Deprecated: Use SubscribeMessageResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{43}
 }
 
-func (x *UpdateDiscussionLastReadRequest) GetLastReadMsgId() uint64 {
+func (x *SubscribeMessageResponse) GetReceivedMessage() *Message {
 	if x != nil {
-		return x.LastReadMsgId
+		return x.ReceivedMessage
 	}
-	return 0
+	return nil
 }
 
 //*
-//An UpdateDiscussionResponse is returned in reponse
-//to an UpdateDiscussionLastRead request.
-type UpdateDiscussionResponse struct {
+//Corresponds to a request to create a stream
+//over which to be notified of message status updates.
+type SubscribeMessageStatusRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
+
+	//* The send id to receive updates for. If zero, updates for all sends are received.
+	SendId uint64 `protobuf:"varint,1,opt,name=send_id,json=sendId,proto3" json:"send_id,omitempty"`
 }
 
-func (x *UpdateDiscussionResponse) Reset() {
-	*x = UpdateDiscussionResponse{}
+func (x *SubscribeMessageStatusRequest) Reset() {
+	*x = SubscribeMessageStatusRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[46]
+		mi := &file_rpc_services_rpc_proto_msgTypes[44]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *UpdateDiscussionResponse) String() string {
+func (x *SubscribeMessageStatusRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*UpdateDiscussionResponse) ProtoMessage() {}
+func (*SubscribeMessageStatusRequest) ProtoMessage() {}
 
-func (x *UpdateDiscussionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[46]
+func (x *SubscribeMessageStatusRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[44]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2917,38 +3658,59 @@ func (x *UpdateDiscussionResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use UpdateDiscussionResponse.ProtoReflect.Descriptor instead.
-func (*UpdateDiscussionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{46}
+// Deprecated: Use SubscribeMessageStatusRequest.ProtoReflect.Descriptor instead.
+func (*SubscribeMessageStatusRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{44}
 }
 
-//* Corresponds to a request to remove a discussion.
-type RemoveDiscussionRequest struct {
+func (x *SubscribeMessageStatusRequest) GetSendId() uint64 {
+	if x != nil {
+		return x.SendId
+	}
+	return 0
+}
+
+//*
+//A SubscribeMessageStatusResponse is received in the stream returned in response to
+//a SubscribeMessageStatus rpc call, and represents a message status update.
+type SubscribeMessageStatusResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The id of the discussion to remove.
-	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-}
-
-func (x *RemoveDiscussionRequest) Reset() {
-	*x = RemoveDiscussionRequest{}
+	//* The id of the send operation.
+	SendId uint64 `protobuf:"varint,1,opt,name=send_id,json=sendId,proto3" json:"send_id,omitempty"`
+	//* The discussion id the message is sent to.
+	DiscussionId uint64 `protobuf:"varint,2,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The recipient the update refers to.
+	//
+	//If empty, the update refers to the message as a whole.
+	Recipient string `protobuf:"bytes,3,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	//* The delivery status.
+	Status MessageStatus `protobuf:"varint,4,opt,name=status,proto3,enum=services.MessageStatus" json:"status,omitempty"`
+	//* The reason of failure, if the status is failed.
+	FailureReason string `protobuf:"bytes,5,opt,name=failure_reason,json=failureReason,proto3" json:"failure_reason,omitempty"`
+	//* The sent message, present in successful updates referring to the message as a whole.
+	SentMessage *Message `protobuf:"bytes,6,opt,name=sent_message,json=sentMessage,proto3" json:"sent_message,omitempty"`
+}
+
+func (x *SubscribeMessageStatusResponse) Reset() {
+	*x = SubscribeMessageStatusResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[47]
+		mi := &file_rpc_services_rpc_proto_msgTypes[45]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RemoveDiscussionRequest) String() string {
+func (x *SubscribeMessageStatusResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RemoveDiscussionRequest) ProtoMessage() {}
+func (*SubscribeMessageStatusResponse) ProtoMessage() {}
 
-func (x *RemoveDiscussionRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[47]
+func (x *SubscribeMessageStatusResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[45]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -2959,90 +3721,97 @@ func (x *RemoveDiscussionRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RemoveDiscussionRequest.ProtoReflect.Descriptor instead.
-func (*RemoveDiscussionRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{47}
+// Deprecated: Use SubscribeMessageStatusResponse.ProtoReflect.Descriptor instead.
+func (*SubscribeMessageStatusResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{45}
 }
 
-func (x *RemoveDiscussionRequest) GetId() uint64 {
+func (x *SubscribeMessageStatusResponse) GetSendId() uint64 {
 	if x != nil {
-		return x.Id
+		return x.SendId
 	}
 	return 0
 }
 
-//* A RemoveDiscussionResponse is received in response to a RemoveDiscussion rpc call.
-type RemoveDiscussionResponse struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
+func (x *SubscribeMessageStatusResponse) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
 }
 
-func (x *RemoveDiscussionResponse) Reset() {
-	*x = RemoveDiscussionResponse{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[48]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
+func (x *SubscribeMessageStatusResponse) GetRecipient() string {
+	if x != nil {
+		return x.Recipient
 	}
+	return ""
 }
 
-func (x *RemoveDiscussionResponse) String() string {
-	return protoimpl.X.MessageStringOf(x)
+func (x *SubscribeMessageStatusResponse) GetStatus() MessageStatus {
+	if x != nil {
+		return x.Status
+	}
+	return MessageStatus_MESSAGE_PENDING
 }
 
-func (*RemoveDiscussionResponse) ProtoMessage() {}
-
-func (x *RemoveDiscussionResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[48]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
+func (x *SubscribeMessageStatusResponse) GetFailureReason() string {
+	if x != nil {
+		return x.FailureReason
 	}
-	return mi.MessageOf(x)
+	return ""
 }
 
-// Deprecated: Use RemoveDiscussionResponse.ProtoReflect.Descriptor instead.
-func (*RemoveDiscussionResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{48}
+func (x *SubscribeMessageStatusResponse) GetSentMessage() *Message {
+	if x != nil {
+		return x.SentMessage
+	}
+	return nil
 }
 
-//* Corresponds to an invoice creation request.
-type CreateInvoiceRequest struct {
+//*
+//Corresponds to a request to search the message history.
+//
+//Unset filters do not restrict the results.
+type SearchMessagesRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* Memo of the invoice.
-	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	//* The invoice amount (in millisatoshi).
-	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* Invoice expiry time (in seconds since creation).
-	Expiry int64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	//* Whether to include hints for private channels.
-	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+	//* The text to search for.
+	Query string `protobuf:"bytes,1,opt,name=query,proto3" json:"query,omitempty"`
+	//* The ids of the discussions to search in.
+	DiscussionIds []uint64 `protobuf:"varint,2,rep,packed,name=discussion_ids,json=discussionIds,proto3" json:"discussion_ids,omitempty"`
+	//* The Lightning address of the message sender.
+	Sender string `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	//* The earliest time a message was stored.
+	FromTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=from_timestamp,json=fromTimestamp,proto3" json:"from_timestamp,omitempty"`
+	//* The latest time a message was stored.
+	ToTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=to_timestamp,json=toTimestamp,proto3" json:"to_timestamp,omitempty"`
+	//* The minimum amount paid over a message (in millisatoshi).
+	MinAmtMsat int64 `protobuf:"varint,6,opt,name=min_amt_msat,json=minAmtMsat,proto3" json:"min_amt_msat,omitempty"`
+	//* The maximum amount paid over a message (in millisatoshi).
+	MaxAmtMsat int64 `protobuf:"varint,7,opt,name=max_amt_msat,json=maxAmtMsat,proto3" json:"max_amt_msat,omitempty"`
+	//* The pagination options of the request.
+	PageOptions *KeySetPageOptions `protobuf:"bytes,8,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
 }
 
-func (x *CreateInvoiceRequest) Reset() {
-	*x = CreateInvoiceRequest{}
+func (x *SearchMessagesRequest) Reset() {
+	*x = SearchMessagesRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[49]
+		mi := &file_rpc_services_rpc_proto_msgTypes[46]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateInvoiceRequest) String() string {
+func (x *SearchMessagesRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvoiceRequest) ProtoMessage() {}
+func (*SearchMessagesRequest) ProtoMessage() {}
 
-func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[49]
+func (x *SearchMessagesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[46]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3053,66 +3822,96 @@ func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
-func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{49}
+// Deprecated: Use SearchMessagesRequest.ProtoReflect.Descriptor instead.
+func (*SearchMessagesRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{46}
 }
 
-func (x *CreateInvoiceRequest) GetMemo() string {
+func (x *SearchMessagesRequest) GetQuery() string {
 	if x != nil {
-		return x.Memo
+		return x.Query
 	}
 	return ""
 }
 
-func (x *CreateInvoiceRequest) GetAmtMsat() uint64 {
+func (x *SearchMessagesRequest) GetDiscussionIds() []uint64 {
 	if x != nil {
-		return x.AmtMsat
+		return x.DiscussionIds
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *SearchMessagesRequest) GetFromTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.FromTimestamp
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetToTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ToTimestamp
+	}
+	return nil
+}
+
+func (x *SearchMessagesRequest) GetMinAmtMsat() int64 {
+	if x != nil {
+		return x.MinAmtMsat
 	}
 	return 0
 }
 
-func (x *CreateInvoiceRequest) GetExpiry() int64 {
+func (x *SearchMessagesRequest) GetMaxAmtMsat() int64 {
 	if x != nil {
-		return x.Expiry
+		return x.MaxAmtMsat
 	}
 	return 0
 }
 
-func (x *CreateInvoiceRequest) GetPrivate() bool {
+func (x *SearchMessagesRequest) GetPageOptions() *KeySetPageOptions {
 	if x != nil {
-		return x.Private
+		return x.PageOptions
 	}
-	return false
+	return nil
 }
 
-//* A CreateInvoiceResponse is received in response to an invoice creation request.
-type CreateInvoiceResponse struct {
+//*
+//A SearchMessagesResponse is received in response to
+//a SearchMessages rpc call, and contains the matching messages.
+type SearchMessagesResponse struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The created invoice.
-	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	//* The matching messages, in message id order.
+	Results []*SearchResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results,omitempty"`
 }
 
-func (x *CreateInvoiceResponse) Reset() {
-	*x = CreateInvoiceResponse{}
+func (x *SearchMessagesResponse) Reset() {
+	*x = SearchMessagesResponse{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[50]
+		mi := &file_rpc_services_rpc_proto_msgTypes[47]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *CreateInvoiceResponse) String() string {
+func (x *SearchMessagesResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*CreateInvoiceResponse) ProtoMessage() {}
+func (*SearchMessagesResponse) ProtoMessage() {}
 
-func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[50]
+func (x *SearchMessagesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[47]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3123,45 +3922,47 @@ func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use CreateInvoiceResponse.ProtoReflect.Descriptor instead.
-func (*CreateInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{50}
+// Deprecated: Use SearchMessagesResponse.ProtoReflect.Descriptor instead.
+func (*SearchMessagesResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{47}
 }
 
-func (x *CreateInvoiceResponse) GetInvoice() *Invoice {
+func (x *SearchMessagesResponse) GetResults() []*SearchResult {
 	if x != nil {
-		return x.Invoice
+		return x.Results
 	}
 	return nil
 }
 
-//* Corresponds to an invoice lookup request.
-type LookupInvoiceRequest struct {
+//* Represents a message matching a search query.
+type SearchResult struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* Payment Request
-	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+	//* The matching message.
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+	//* The ranges of the message payload matching the query terms.
+	Highlights []*TextRange `protobuf:"bytes,2,rep,name=highlights,proto3" json:"highlights,omitempty"`
 }
 
-func (x *LookupInvoiceRequest) Reset() {
-	*x = LookupInvoiceRequest{}
+func (x *SearchResult) Reset() {
+	*x = SearchResult{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[51]
+		mi := &file_rpc_services_rpc_proto_msgTypes[48]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupInvoiceRequest) String() string {
+func (x *SearchResult) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupInvoiceRequest) ProtoMessage() {}
+func (*SearchResult) ProtoMessage() {}
 
-func (x *LookupInvoiceRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[51]
+func (x *SearchResult) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[48]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3172,45 +3973,54 @@ func (x *LookupInvoiceRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupInvoiceRequest.ProtoReflect.Descriptor instead.
-func (*LookupInvoiceRequest) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{51}
+// Deprecated: Use SearchResult.ProtoReflect.Descriptor instead.
+func (*SearchResult) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{48}
 }
 
-func (x *LookupInvoiceRequest) GetPayReq() string {
+func (x *SearchResult) GetMessage() *Message {
 	if x != nil {
-		return x.PayReq
+		return x.Message
 	}
-	return ""
+	return nil
 }
 
-//* A LookupResponse is received in response to an invoice lookup request.
-type LookupInvoiceResponse struct {
+func (x *SearchResult) GetHighlights() []*TextRange {
+	if x != nil {
+		return x.Highlights
+	}
+	return nil
+}
+
+//* Represents a range of a text, in Unicode code points.
+type TextRange struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The returned invoice.
-	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+	//* The offset of the first code point of the range.
+	Start uint32 `protobuf:"varint,1,opt,name=start,proto3" json:"start,omitempty"`
+	//* The offset past the last code point of the range.
+	End uint32 `protobuf:"varint,2,opt,name=end,proto3" json:"end,omitempty"`
 }
 
-func (x *LookupInvoiceResponse) Reset() {
-	*x = LookupInvoiceResponse{}
+func (x *TextRange) Reset() {
+	*x = TextRange{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[52]
+		mi := &file_rpc_services_rpc_proto_msgTypes[49]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *LookupInvoiceResponse) String() string {
+func (x *TextRange) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*LookupInvoiceResponse) ProtoMessage() {}
+func (*TextRange) ProtoMessage() {}
 
-func (x *LookupInvoiceResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[52]
+func (x *TextRange) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[49]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3221,73 +4031,52 @@ func (x *LookupInvoiceResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use LookupInvoiceResponse.ProtoReflect.Descriptor instead.
-func (*LookupInvoiceResponse) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{52}
+// Deprecated: Use TextRange.ProtoReflect.Descriptor instead.
+func (*TextRange) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{49}
 }
 
-func (x *LookupInvoiceResponse) GetInvoice() *Invoice {
+func (x *TextRange) GetStart() uint32 {
 	if x != nil {
-		return x.Invoice
+		return x.Start
 	}
-	return nil
+	return 0
 }
 
-//* Represents an Lightning network invoice.
-type Invoice struct {
+func (x *TextRange) GetEnd() uint32 {
+	if x != nil {
+		return x.End
+	}
+	return 0
+}
+
+//* Corresponds to a request to delete a message locally.
+type DeleteMessageRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The invoice memo.
-	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
-	//* The preimage hash.
-	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
-	//* The invoice preimage.
-	Preimage string `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
-	//* The payment request of the invoice.
-	PaymentRequest string `protobuf:"bytes,4,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
-	//* The value (amount requested) of the invoice (in millisatoshi).
-	ValueMsat uint64 `protobuf:"varint,5,opt,name=value_msat,json=valueMsat,proto3" json:"value_msat,omitempty"`
-	//* The amount paid to the invoice (in millisatoshi).
-	AmtPaidMsat uint64 `protobuf:"varint,6,opt,name=amt_paid_msat,json=amtPaidMsat,proto3" json:"amt_paid_msat,omitempty"`
-	//* The time the invoice was created.
-	CreatedTimestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
-	//* The time the invoice was settled.
-	SettledTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=settled_timestamp,json=settledTimestamp,proto3" json:"settled_timestamp,omitempty"`
-	//* The invoice expiry (in seconds since creation time).
-	Expiry int64 `protobuf:"varint,9,opt,name=expiry,proto3" json:"expiry,omitempty"`
-	//* Whether the invoice contains hints for private channels.
-	Private bool `protobuf:"varint,10,opt,name=private,proto3" json:"private,omitempty"`
-	//* Invoice route hints.
-	RouteHints []*RouteHint `protobuf:"bytes,11,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
-	//* The invoice state.
-	State InvoiceState `protobuf:"varint,12,opt,name=state,proto3,enum=services.InvoiceState" json:"state,omitempty"`
-	//* The add index of the invoice.
-	AddIndex uint64 `protobuf:"varint,13,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
-	//* The settle index of the invoice.
-	SettleIndex uint64 `protobuf:"varint,14,opt,name=settle_index,json=settleIndex,proto3" json:"settle_index,omitempty"`
-	//* The set of HTLCs paying to the invoice.
-	InvoiceHtlcs []*InvoiceHTLC `protobuf:"bytes,15,rep,name=invoice_htlcs,json=invoiceHtlcs,proto3" json:"invoice_htlcs,omitempty"`
+	//* The id of the message to delete.
+	MessageId uint64 `protobuf:"varint,1,opt,name=message_id,json=messageId,proto3" json:"message_id,omitempty"`
 }
 
-func (x *Invoice) Reset() {
-	*x = Invoice{}
+func (x *DeleteMessageRequest) Reset() {
+	*x = DeleteMessageRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[53]
+		mi := &file_rpc_services_rpc_proto_msgTypes[50]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *Invoice) String() string {
+func (x *DeleteMessageRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Invoice) ProtoMessage() {}
+func (*DeleteMessageRequest) ProtoMessage() {}
 
-func (x *Invoice) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[53]
+func (x *DeleteMessageRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[50]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3298,143 +4087,206 @@ func (x *Invoice) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
-func (*Invoice) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{53}
+// Deprecated: Use DeleteMessageRequest.ProtoReflect.Descriptor instead.
+func (*DeleteMessageRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{50}
 }
 
-func (x *Invoice) GetMemo() string {
+func (x *DeleteMessageRequest) GetMessageId() uint64 {
 	if x != nil {
-		return x.Memo
+		return x.MessageId
 	}
-	return ""
+	return 0
 }
 
-func (x *Invoice) GetHash() string {
-	if x != nil {
-		return x.Hash
-	}
-	return ""
+//* A DeleteMessageResponse is received in response to a DeleteMessage rpc call.
+type DeleteMessageResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
 }
 
-func (x *Invoice) GetPreimage() string {
-	if x != nil {
-		return x.Preimage
+func (x *DeleteMessageResponse) Reset() {
+	*x = DeleteMessageResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[51]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return ""
 }
 
-func (x *Invoice) GetPaymentRequest() string {
-	if x != nil {
-		return x.PaymentRequest
-	}
-	return ""
+func (x *DeleteMessageResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func (x *Invoice) GetValueMsat() uint64 {
-	if x != nil {
-		return x.ValueMsat
+func (*DeleteMessageResponse) ProtoMessage() {}
+
+func (x *DeleteMessageResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[51]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return 0
+	return mi.MessageOf(x)
 }
 
-func (x *Invoice) GetAmtPaidMsat() uint64 {
-	if x != nil {
-		return x.AmtPaidMsat
-	}
-	return 0
+// Deprecated: Use DeleteMessageResponse.ProtoReflect.Descriptor instead.
+func (*DeleteMessageResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{51}
 }
 
-func (x *Invoice) GetCreatedTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.CreatedTimestamp
+//* Represents the information for a specific discussion.
+type DiscussionInfo struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* The list of participants in the discussion.
+	Participants []string `protobuf:"bytes,2,rep,name=participants,proto3" json:"participants,omitempty"`
+	//* The default options applicable for all discussion messages.
+	Options *DiscussionOptions `protobuf:"bytes,3,opt,name=options,proto3" json:"options,omitempty"`
+	//* The id of the last read message in the discussion.
+	LastReadMsgId uint64 `protobuf:"varint,4,opt,name=last_read_msg_id,json=lastReadMsgId,proto3" json:"last_read_msg_id,omitempty"`
+	//* The id of the last discussion message.
+	LastMsgId uint64 `protobuf:"varint,5,opt,name=last_msg_id,json=lastMsgId,proto3" json:"last_msg_id,omitempty"`
+	//* The user-assigned metadata of the discussion.
+	Metadata *DiscussionMetadata `protobuf:"bytes,6,opt,name=metadata,proto3" json:"metadata,omitempty"`
+	//* Whether the discussion is soft deleted.
+	Deleted bool `protobuf:"varint,7,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	//* The message retention policy of the discussion.
+	Retention *RetentionPolicy `protobuf:"bytes,8,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (x *DiscussionInfo) Reset() {
+	*x = DiscussionInfo{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[52]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
 	}
-	return nil
 }
 
-func (x *Invoice) GetSettledTimestamp() *timestamppb.Timestamp {
-	if x != nil {
-		return x.SettledTimestamp
+func (x *DiscussionInfo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DiscussionInfo) ProtoMessage() {}
+
+func (x *DiscussionInfo) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[52]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Invoice) GetExpiry() int64 {
+// Deprecated: Use DiscussionInfo.ProtoReflect.Descriptor instead.
+func (*DiscussionInfo) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *DiscussionInfo) GetId() uint64 {
 	if x != nil {
-		return x.Expiry
+		return x.Id
 	}
 	return 0
 }
 
-func (x *Invoice) GetPrivate() bool {
+func (x *DiscussionInfo) GetParticipants() []string {
 	if x != nil {
-		return x.Private
+		return x.Participants
 	}
-	return false
+	return nil
 }
 
-func (x *Invoice) GetRouteHints() []*RouteHint {
+func (x *DiscussionInfo) GetOptions() *DiscussionOptions {
 	if x != nil {
-		return x.RouteHints
+		return x.Options
 	}
 	return nil
 }
 
-func (x *Invoice) GetState() InvoiceState {
+func (x *DiscussionInfo) GetLastReadMsgId() uint64 {
 	if x != nil {
-		return x.State
+		return x.LastReadMsgId
 	}
-	return InvoiceState_INVOICE_OPEN
+	return 0
 }
 
-func (x *Invoice) GetAddIndex() uint64 {
+func (x *DiscussionInfo) GetLastMsgId() uint64 {
 	if x != nil {
-		return x.AddIndex
+		return x.LastMsgId
 	}
 	return 0
 }
 
-func (x *Invoice) GetSettleIndex() uint64 {
+func (x *DiscussionInfo) GetMetadata() *DiscussionMetadata {
 	if x != nil {
-		return x.SettleIndex
+		return x.Metadata
 	}
-	return 0
+	return nil
 }
 
-func (x *Invoice) GetInvoiceHtlcs() []*InvoiceHTLC {
+func (x *DiscussionInfo) GetDeleted() bool {
 	if x != nil {
-		return x.InvoiceHtlcs
+		return x.Deleted
+	}
+	return false
+}
+
+func (x *DiscussionInfo) GetRetention() *RetentionPolicy {
+	if x != nil {
+		return x.Retention
 	}
 	return nil
 }
 
-//* Represents a route hint for assistance in invoice payment.
-type RouteHint struct {
+//*
+//RetentionPolicy represents a local message retention policy.
+//
+//Messages exceeding the policy limits are redacted,
+//while their invoices and payments are retained.
+type RetentionPolicy struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* A chain of hop hints that can reach the desetination.
-	HopHints []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,json=hopHints,proto3" json:"hop_hints,omitempty"`
+	//* The maximum age of retained messages (in seconds).
+	//
+	//If not set, the age of messages is not limited.
+	MaxAgeSec uint64 `protobuf:"varint,1,opt,name=max_age_sec,json=maxAgeSec,proto3" json:"max_age_sec,omitempty"`
+	//* The maximum number of retained messages.
+	//
+	//If not set, the number of messages is not limited.
+	MaxCount uint64 `protobuf:"varint,2,opt,name=max_count,json=maxCount,proto3" json:"max_count,omitempty"`
 }
 
-func (x *RouteHint) Reset() {
-	*x = RouteHint{}
+func (x *RetentionPolicy) Reset() {
+	*x = RetentionPolicy{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[54]
+		mi := &file_rpc_services_rpc_proto_msgTypes[53]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *RouteHint) String() string {
+func (x *RetentionPolicy) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*RouteHint) ProtoMessage() {}
+func (*RetentionPolicy) ProtoMessage() {}
 
-func (x *RouteHint) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[54]
+func (x *RetentionPolicy) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[53]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3445,53 +4297,62 @@ func (x *RouteHint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use RouteHint.ProtoReflect.Descriptor instead.
-func (*RouteHint) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{54}
+// Deprecated: Use RetentionPolicy.ProtoReflect.Descriptor instead.
+func (*RetentionPolicy) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{53}
 }
 
-func (x *RouteHint) GetHopHints() []*HopHint {
+func (x *RetentionPolicy) GetMaxAgeSec() uint64 {
 	if x != nil {
-		return x.HopHints
+		return x.MaxAgeSec
 	}
-	return nil
+	return 0
 }
 
-//* Represents a hop hint.
-type HopHint struct {
+func (x *RetentionPolicy) GetMaxCount() uint64 {
+	if x != nil {
+		return x.MaxCount
+	}
+	return 0
+}
+
+//* DiscussionMetadata represents the user-assigned metadata of a discussion.
+type DiscussionMetadata struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* Public key of hop ingress node.
-	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
-	//* The short channel id of the channel to be used for the hop.
-	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	//* The base fee of the channel (in millisatoshi).
-	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
-	//* The fee rate of the channel (in microsatoshi/sat).
-	FeeRate uint32 `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
-	//* The timelock delta of the channel.
-	CltvExpiryDelta uint32 `protobuf:"varint,5,opt,name=cltv_expiry_delta,json=cltvExpiryDelta,proto3" json:"cltv_expiry_delta,omitempty"`
+	//* The discussion title.
+	Title string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
+	//* Whether the discussion is muted.
+	//
+	//Messages of muted discussions are flagged as such over SubscribeMessages.
+	Muted bool `protobuf:"varint,2,opt,name=muted,proto3" json:"muted,omitempty"`
+	//* Whether the discussion is archived.
+	Archived bool `protobuf:"varint,3,opt,name=archived,proto3" json:"archived,omitempty"`
+	//* Whether the discussion is pinned.
+	//
+	//Pinned discussions are placed first in last activity order.
+	Pinned bool `protobuf:"varint,4,opt,name=pinned,proto3" json:"pinned,omitempty"`
 }
 
-func (x *HopHint) Reset() {
-	*x = HopHint{}
+func (x *DiscussionMetadata) Reset() {
+	*x = DiscussionMetadata{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[55]
+		mi := &file_rpc_services_rpc_proto_msgTypes[54]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *HopHint) String() string {
+func (x *DiscussionMetadata) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*HopHint) ProtoMessage() {}
+func (*DiscussionMetadata) ProtoMessage() {}
 
-func (x *HopHint) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[55]
+func (x *DiscussionMetadata) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[54]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3502,83 +4363,75 @@ func (x *HopHint) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use HopHint.ProtoReflect.Descriptor instead.
-func (*HopHint) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{55}
+// Deprecated: Use DiscussionMetadata.ProtoReflect.Descriptor instead.
+func (*DiscussionMetadata) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{54}
 }
 
-func (x *HopHint) GetPubkey() string {
+func (x *DiscussionMetadata) GetTitle() string {
 	if x != nil {
-		return x.Pubkey
+		return x.Title
 	}
 	return ""
 }
 
-func (x *HopHint) GetChanId() uint64 {
-	if x != nil {
-		return x.ChanId
-	}
-	return 0
-}
-
-func (x *HopHint) GetFeeBaseMsat() uint32 {
+func (x *DiscussionMetadata) GetMuted() bool {
 	if x != nil {
-		return x.FeeBaseMsat
+		return x.Muted
 	}
-	return 0
+	return false
 }
 
-func (x *HopHint) GetFeeRate() uint32 {
+func (x *DiscussionMetadata) GetArchived() bool {
 	if x != nil {
-		return x.FeeRate
+		return x.Archived
 	}
-	return 0
+	return false
 }
 
-func (x *HopHint) GetCltvExpiryDelta() uint32 {
+func (x *DiscussionMetadata) GetPinned() bool {
 	if x != nil {
-		return x.CltvExpiryDelta
+		return x.Pinned
 	}
-	return 0
+	return false
 }
 
-//* Represents an HTLC paying to an invoice.
-type InvoiceHTLC struct {
+//* DiscussionOptions represents the per-discussion options.
+type DiscussionOptions struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	//* The short channel id of the channel the HTLC was arrived.
-	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
-	//* The amount of this HTLC (in millisatoshi).
-	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
-	//* State of the HTLC.
-	State InvoiceHTLCState `protobuf:"varint,3,opt,name=state,proto3,enum=services.InvoiceHTLCState" json:"state,omitempty"`
-	//* HTLC accept timestamp.
-	AcceptTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accept_timestamp,json=acceptTimestamp,proto3" json:"accept_timestamp,omitempty"`
-	//* HTLC resolve timestamp.
-	ResolveTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolve_timestamp,json=resolveTimestamp,proto3" json:"resolve_timestamp,omitempty"`
-	//* Block height at which this HTLC expires.
-	ExpiryHeight int32 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	//* The maximum fee allowed for sending a message (in millisatoshi).
+	//
+	//If not set, the default fee limit, as defined in the app package, is used.
+	FeeLimitMsat int64 `protobuf:"varint,1,opt,name=fee_limit_msat,json=feeLimitMsat,proto3" json:"fee_limit_msat,omitempty"`
+	//* Whether to send as anonymous on this discussion.
+	Anonymous bool `protobuf:"varint,2,opt,name=anonymous,proto3" json:"anonymous,omitempty"`
+	//* Whether to encrypt message payloads end-to-end on this discussion.
+	Encrypted bool `protobuf:"varint,3,opt,name=encrypted,proto3" json:"encrypted,omitempty"`
+	//* Whether to send delivery receipts for messages received
+	//from verified senders on this discussion.
+	Receipts bool `protobuf:"varint,4,opt,name=receipts,proto3" json:"receipts,omitempty"`
 }
 
-func (x *InvoiceHTLC) Reset() {
-	*x = InvoiceHTLC{}
+func (x *DiscussionOptions) Reset() {
+	*x = DiscussionOptions{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_rpc_services_rpc_proto_msgTypes[56]
+		mi := &file_rpc_services_rpc_proto_msgTypes[55]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
 }
 
-func (x *InvoiceHTLC) String() string {
+func (x *DiscussionOptions) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*InvoiceHTLC) ProtoMessage() {}
+func (*DiscussionOptions) ProtoMessage() {}
 
-func (x *InvoiceHTLC) ProtoReflect() protoreflect.Message {
-	mi := &file_rpc_services_rpc_proto_msgTypes[56]
+func (x *DiscussionOptions) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[55]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -3589,798 +4442,3834 @@ func (x *InvoiceHTLC) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use InvoiceHTLC.ProtoReflect.Descriptor instead.
-func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
-	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{56}
+// Deprecated: Use DiscussionOptions.ProtoReflect.Descriptor instead.
+func (*DiscussionOptions) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{55}
 }
 
-func (x *InvoiceHTLC) GetChanId() uint64 {
+func (x *DiscussionOptions) GetFeeLimitMsat() int64 {
 	if x != nil {
-		return x.ChanId
+		return x.FeeLimitMsat
 	}
 	return 0
 }
 
-func (x *InvoiceHTLC) GetAmtMsat() uint64 {
+func (x *DiscussionOptions) GetAnonymous() bool {
 	if x != nil {
-		return x.AmtMsat
+		return x.Anonymous
 	}
-	return 0
+	return false
 }
 
-func (x *InvoiceHTLC) GetState() InvoiceHTLCState {
+func (x *DiscussionOptions) GetEncrypted() bool {
 	if x != nil {
-		return x.State
+		return x.Encrypted
 	}
-	return InvoiceHTLCState_INVOICE_HTLC_ACCEPTED
+	return false
 }
 
-func (x *InvoiceHTLC) GetAcceptTimestamp() *timestamppb.Timestamp {
+func (x *DiscussionOptions) GetReceipts() bool {
 	if x != nil {
-		return x.AcceptTimestamp
+		return x.Receipts
 	}
-	return nil
+	return false
 }
 
-func (x *InvoiceHTLC) GetResolveTimestamp() *timestamppb.Timestamp {
+//* Corresponds to a request to receive discussion info.
+type GetDiscussionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The pagination options of the request.
+	//
+	//The range is anchored on the discussion with id last_id.
+	//In last activity order, an unset last_id corresponds to
+	//the first (or last, if reverse) discussion.
+	//Message filters do not apply to discussions.
+	PageOptions *KeySetPageOptions `protobuf:"bytes,1,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
+	//* The order of the discussions.
+	Order DiscussionOrder `protobuf:"varint,2,opt,name=order,proto3,enum=services.DiscussionOrder" json:"order,omitempty"`
+	//* Filters discussions by their archived flag.
+	Archived FlagFilter `protobuf:"varint,3,opt,name=archived,proto3,enum=services.FlagFilter" json:"archived,omitempty"`
+	//* Filters discussions by their pinned flag.
+	Pinned FlagFilter `protobuf:"varint,4,opt,name=pinned,proto3,enum=services.FlagFilter" json:"pinned,omitempty"`
+	//* Whether to list soft deleted discussions instead of active ones.
+	Deleted bool `protobuf:"varint,5,opt,name=deleted,proto3" json:"deleted,omitempty"`
+}
+
+func (x *GetDiscussionsRequest) Reset() {
+	*x = GetDiscussionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[56]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionsRequest) ProtoMessage() {}
+
+func (x *GetDiscussionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[56]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscussionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *GetDiscussionsRequest) GetPageOptions() *KeySetPageOptions {
 	if x != nil {
-		return x.ResolveTimestamp
+		return x.PageOptions
 	}
 	return nil
 }
 
-func (x *InvoiceHTLC) GetExpiryHeight() int32 {
+func (x *GetDiscussionsRequest) GetOrder() DiscussionOrder {
 	if x != nil {
-		return x.ExpiryHeight
+		return x.Order
 	}
-	return 0
+	return DiscussionOrder_DISCUSSION_ORDER_ID
 }
 
-var File_rpc_services_rpc_proto protoreflect.FileDescriptor
+func (x *GetDiscussionsRequest) GetArchived() FlagFilter {
+	if x != nil {
+		return x.Archived
+	}
+	return FlagFilter_FLAG_FILTER_ANY
+}
 
-var file_rpc_services_rpc_proto_rawDesc = []byte{
-	0x0a, 0x16, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x72,
-	0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x6d, 0x77, 0x69, 0x74, 0x6b, 0x6f, 0x77, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
-	0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69,
-	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x63, 0x0a, 0x11, 0x4b,
-	0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61, 0x67,
-	0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70, 0x61,
-	0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
-	0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73, 0x65,
-	0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x22, 0x5c, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x0a,
-	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07,
-	0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69,
-	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x12,
-	0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73, 0x68,
-	0x22, 0x52, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a, 0x05,
-	0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69,
-	0x61, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69, 0x6e,
-	0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
-	0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
-	0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72, 0x6b,
-	0x22, 0x6b, 0x0a, 0x10, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x04,
-	0x69, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18, 0x02,
-	0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
-	0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x14, 0x0a,
-	0x12, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x22, 0xb1, 0x03, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61,
-	0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x5f,
-	0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x61, 0x6c, 0x6c, 0x65,
-	0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x34, 0x0a,
-	0x16, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72,
-	0x6d, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x77,
-	0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
-	0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11,
-	0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x35, 0x0a, 0x17, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65,
-	0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x4c,
-	0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f,
-	0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x65, 0x6e, 0x64,
-	0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x6c,
-	0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52,
-	0x12, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d,
-	0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64,
-	0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x13, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x52, 0x65, 0x6d,
-	0x6f, 0x74, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e, 0x6f,
-	0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a,
-	0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52,
-	0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x53, 0x65, 0x61, 0x72,
-	0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x4e, 0x6f,
-	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28,
-	0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x12, 0x43, 0x6f, 0x6e, 0x6e,
-	0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e,
-	0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d,
-	0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a,
-	0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x43, 0x6f,
-	0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0xf5, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72,
-	0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a,
-	0x0d, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f, 0x63,
-	0x6f, 0x6e, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x69, 0x6e, 0x49,
-	0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x61, 0x72,
-	0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x74, 0x61,
-	0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e,
-	0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72,
-	0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61,
-	0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x4f, 0x70, 0x65,
-	0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54,
-	0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e,
-	0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75,
-	0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0x78, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e,
-	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
-	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a,
-	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
-	0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
-	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
-	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
-	0x22, 0x4c, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2,
-	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x4d,
-	0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x32, 0x0a,
-	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79,
-	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x51, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
-	0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
-	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64,
-	0x72, 0x65, 0x73, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xb8, 0x04,
-	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73,
-	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c,
-	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
-	0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x36, 0x36, 0x7d, 0x24, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x08,
-	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16,
-	0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
-	0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
-	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
-	0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65,
-	0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
-	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
-	0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61,
-	0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
-	0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65,
-	0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
-	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
-	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x74,
-	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x12, 0x72, 0x65, 0x63,
-	0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
-	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
-	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
-	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
-	0x6f, 0x75, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
-	0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
-	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
-	0x17, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x68, 0x6f, 0x70,
-	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x70, 0x52, 0x04, 0x68,
-	0x6f, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
-	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74,
-	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x6f,
-	0x75, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
-	0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74,
-	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d,
-	0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65,
-	0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x79,
-	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f,
-	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64,
-	0x12, 0x35, 0x0a, 0x0b, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61,
-	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x0a, 0x68, 0x6f, 0x70,
-	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x61, 0x6d, 0x74, 0x5f, 0x74,
-	0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x6d, 0x74, 0x54, 0x6f, 0x46, 0x6f, 0x72, 0x77, 0x61,
-	0x72, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73,
-	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73, 0x61,
-	0x74, 0x22, 0x54, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
-	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
-	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x65,
-	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f,
-	0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e,
-	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0xae, 0x01, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69,
-	0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
-	0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f,
-	0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
-	0x64, 0x12, 0x21, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74,
-	0x4d, 0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18,
-	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
-	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x79, 0x0a, 0x17, 0x45, 0x73, 0x74, 0x69,
-	0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x5f, 0x70,
-	0x72, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20,
-	0x01, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f, 0x62, 0x12, 0x33,
-	0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x22, 0xc3, 0x01, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73,
-	0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69,
-	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
-	0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
-	0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09,
-	0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x61, 0x6d, 0x74,
-	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
-	0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x17, 0x0a, 0x07,
-	0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
-	0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x53, 0x0a, 0x13, 0x53, 0x65, 0x6e,
-	0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x12, 0x3c, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20,
-	0x01, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x19,
-	0x0a, 0x17, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x18, 0x53, 0x75, 0x62,
-	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65,
-	0x64, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
-	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xd4, 0x01, 0x0a, 0x0e,
-	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16,
-	0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63,
-	0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x60, 0x01, 0x52, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e,
-	0x74, 0x73, 0x12, 0x35, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44,
-	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73,
-	0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67,
-	0x49, 0x64, 0x12, 0x1e, 0x0a, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69,
-	0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67,
-	0x49, 0x64, 0x22, 0x57, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x6c,
-	0x69, 0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
-	0x0c, 0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a,
-	0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08,
-	0x52, 0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x47,
-	0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x22, 0x5a, 0x0a, 0x16, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40,
-	0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
-	0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69,
-	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf,
-	0x1f, 0x02, 0x20, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x22, 0x79, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42,
-	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x70,
-	0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79,
-	0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b,
-	0x70, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x1c, 0x47,
-	0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74,
-	0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d,
-	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52,
-	0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44,
-	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74,
-	0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64,
-	0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x1f, 0x47,
-	0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74,
-	0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22,
-	0x0a, 0x0d, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x53, 0x65,
-	0x6e, 0x74, 0x12, 0x2a, 0x0a, 0x11, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x72,
-	0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61,
-	0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x22,
-	0x0a, 0x0d, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18,
-	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x46, 0x65,
-	0x65, 0x73, 0x12, 0x23, 0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73,
-	0x65, 0x6e, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61,
-	0x67, 0x65, 0x73, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x10, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65,
-	0x69, 0x76, 0x65, 0x64, 0x22, 0x58, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0a,
-	0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63,
-	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02,
-	0x20, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51,
-	0x0a, 0x15, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
-	0x6e, 0x22, 0x6f, 0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73,
-	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x61, 0x73,
-	0x74, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67,
-	0x49, 0x64, 0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63,
-	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x31,
-	0x0a, 0x17, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69,
-	0x64, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x77, 0x0a,
-	0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20,
-	0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74,
-	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74,
-	0x4d, 0x73, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07,
-	0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70,
-	0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x44, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
-	0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0x2f, 0x0a, 0x14,
-	0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71,
-	0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18,
-	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x22, 0x44, 0x0a,
-	0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x22, 0xdd, 0x04, 0x0a, 0x07, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12,
-	0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d,
-	0x65, 0x6d, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68, 0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d,
-	0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61,
-	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a,
-	0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04,
-	0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61,
-	0x6d, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01,
-	0x28, 0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x50, 0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12,
-	0x47, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
-	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
-	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54,
-	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x47, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20,
-	0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f,
-	0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52,
-	0x10, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
-	0x70, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28,
-	0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69,
-	0x76, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76,
-	0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e,
-	0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72,
-	0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74, 0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61,
-	0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65,
-	0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x1b, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x61, 0x64, 0x64, 0x49,
-	0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x69,
-	0x6e, 0x64, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x74,
-	0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x3a, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x73, 0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63,
-	0x65, 0x48, 0x54, 0x4c, 0x43, 0x52, 0x0c, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x74,
-	0x6c, 0x63, 0x73, 0x22, 0x3b, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74,
-	0x12, 0x2e, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x01, 0x20,
-	0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x48,
-	0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74, 0x73,
-	0x22, 0xa5, 0x01, 0x0a, 0x07, 0x48, 0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74, 0x12, 0x16, 0x0a, 0x06,
-	0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70, 0x75,
-	0x62, 0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18,
-	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x22, 0x0a,
-	0x0d, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03,
-	0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x42, 0x61, 0x73, 0x65, 0x4d, 0x73, 0x61,
-	0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74, 0x65, 0x18, 0x04, 0x20,
-	0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65, 0x12, 0x2a, 0x0a, 0x11,
-	0x63, 0x6c, 0x74, 0x76, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x64, 0x65, 0x6c, 0x74,
-	0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6c, 0x74, 0x76, 0x45, 0x78, 0x70,
-	0x69, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xa8, 0x02, 0x0a, 0x0b, 0x49, 0x6e, 0x76,
-	0x6f, 0x69, 0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
-	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49,
-	0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20,
-	0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x30, 0x0a, 0x05,
-	0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x54,
-	0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12, 0x45,
-	0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
-	0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c,
-	0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73,
-	0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x54, 0x69, 0x6d, 0x65,
-	0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65,
-	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b,
-	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x72, 0x65,
-	0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x23,
-	0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69, 0x67, 0x68, 0x74, 0x18,
-	0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x48, 0x65, 0x69,
-	0x67, 0x68, 0x74, 0x2a, 0x62, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x53, 0x74,
-	0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x4f,
-	0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45,
-	0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12, 0x13, 0x0a, 0x0f, 0x49,
-	0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x02,
-	0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x43, 0x41, 0x4e, 0x43,
-	0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x10, 0x49, 0x6e, 0x76, 0x6f, 0x69,
-	0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x49,
-	0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x41, 0x43, 0x43, 0x45,
-	0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43,
-	0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c, 0x45, 0x44, 0x10, 0x01,
-	0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43,
-	0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02, 0x32, 0xac, 0x04, 0x0a,
-	0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65, 0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f,
-	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x46, 0x0a,
-	0x0b, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
-	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66,
-	0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65,
-	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x73, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65,
-	0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66,
-	0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x13, 0x53,
-	0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
-	0x73, 0x73, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65,
-	0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
-	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x55, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
-	0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64,
-	0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49,
-	0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a,
-	0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e,
-	0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64,
-	0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x5e, 0x0a, 0x0e, 0x43,
-	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a,
-	0x0b, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e,
-	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
-	0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xeb, 0x02, 0x0a, 0x0e,
-	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c,
-	0x0a, 0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74,
-	0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
-	0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a,
-	0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
-	0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73,
-	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76,
-	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x22, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
-	0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
-	0x65, 0x22, 0x00, 0x12, 0x64, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
-	0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
-	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52,
-	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
-	0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0x98, 0x02, 0x0a, 0x0e, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0f,
-	0x45, 0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
-	0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d,
-	0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74,
-	0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
-	0x2e, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
-	0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65,
-	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
-	0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
-	0x22, 0x00, 0x30, 0x01, 0x32, 0xef, 0x04, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65,
-	0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x73,
-	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75,
-	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
-	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x30, 0x01, 0x12, 0x71, 0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x12,
-	0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69,
-	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42,
-	0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
-	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
-	0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x70, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73,
-	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63,
-	0x73, 0x12, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74,
-	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73,
-	0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65,
-	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65,
-	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x44,
-	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76,
-	0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
-	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x18,
-	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
-	0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x12, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
-	0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
-	0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75,
-	0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55,
-	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x52, 0x65, 0x6d,
-	0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e,
-	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
-	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
-	0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f,
-	0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70,
-	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xb8, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d, 0x65,
-	0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72, 0x65,
-	0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72,
-	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f,
-	0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a,
-	0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1e,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f,
-	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70,
-	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x00, 0x42, 0x29, 0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
-	0x63, 0x31, 0x33, 0x6e, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x31, 0x33, 0x6e, 0x2d, 0x67, 0x6f, 0x2f,
-	0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72,
-	0x6f, 0x74, 0x6f, 0x33,
+func (x *GetDiscussionsRequest) GetPinned() FlagFilter {
+	if x != nil {
+		return x.Pinned
+	}
+	return FlagFilter_FLAG_FILTER_ANY
 }
 
-var (
-	file_rpc_services_rpc_proto_rawDescOnce sync.Once
-	file_rpc_services_rpc_proto_rawDescData = file_rpc_services_rpc_proto_rawDesc
-)
+func (x *GetDiscussionsRequest) GetDeleted() bool {
+	if x != nil {
+		return x.Deleted
+	}
+	return false
+}
 
-func file_rpc_services_rpc_proto_rawDescGZIP() []byte {
-	file_rpc_services_rpc_proto_rawDescOnce.Do(func() {
-		file_rpc_services_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_services_rpc_proto_rawDescData)
-	})
-	return file_rpc_services_rpc_proto_rawDescData
+//*
+//A GetDiscussionsResponse is received in the stream returned in response
+//to a GetDiscussions rpc call, and represents a discussion.
+type GetDiscussionsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+	//* The number of received messages after the last read message.
+	UnreadCount uint64 `protobuf:"varint,2,opt,name=unread_count,json=unreadCount,proto3" json:"unread_count,omitempty"`
+	//* The last message of the discussion, if any.
+	//
+	//Edits, delete requests and reactions are applied to it,
+	//rather than returned as the last message.
+	LastMessage *Message `protobuf:"bytes,3,opt,name=last_message,json=lastMessage,proto3" json:"last_message,omitempty"`
+	//* The contact display names of the participants, in participant order.
+	//
+	//Participants not stored as contacts have an empty display name.
+	ParticipantNames []string `protobuf:"bytes,4,rep,name=participant_names,json=participantNames,proto3" json:"participant_names,omitempty"`
 }
 
-var file_rpc_services_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
-var file_rpc_services_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 57)
-var file_rpc_services_rpc_proto_goTypes = []interface{}{
-	(InvoiceState)(0),                       // 0: services.InvoiceState
-	(InvoiceHTLCState)(0),                   // 1: services.InvoiceHTLCState
-	(*KeySetPageOptions)(nil),               // 2: services.KeySetPageOptions
-	(*VersionRequest)(nil),                  // 3: services.VersionRequest
-	(*Version)(nil),                         // 4: services.Version
-	(*NodeInfo)(nil),                        // 5: services.NodeInfo
-	(*SelfInfoRequest)(nil),                 // 6: services.SelfInfoRequest
-	(*Chain)(nil),                           // 7: services.Chain
-	(*SelfInfoResponse)(nil),                // 8: services.SelfInfoResponse
-	(*SelfBalanceRequest)(nil),              // 9: services.SelfBalanceRequest
-	(*SelfBalanceResponse)(nil),             // 10: services.SelfBalanceResponse
-	(*GetNodesRequest)(nil),                 // 11: services.GetNodesRequest
-	(*SearchNodeByAddressRequest)(nil),      // 12: services.SearchNodeByAddressRequest
-	(*SearchNodeByAliasRequest)(nil),        // 13: services.SearchNodeByAliasRequest
-	(*NodeInfoResponse)(nil),                // 14: services.NodeInfoResponse
-	(*ConnectNodeRequest)(nil),              // 15: services.ConnectNodeRequest
-	(*ConnectNodeResponse)(nil),             // 16: services.ConnectNodeResponse
-	(*OpenChannelRequest)(nil),              // 17: services.OpenChannelRequest
-	(*OpenChannelResponse)(nil),             // 18: services.OpenChannelResponse
-	(*ContactInfo)(nil),                     // 19: services.ContactInfo
-	(*GetContactsRequest)(nil),              // 20: services.GetContactsRequest
-	(*GetContactsResponse)(nil),             // 21: services.GetContactsResponse
-	(*AddContactRequest)(nil),               // 22: services.AddContactRequest
-	(*AddContactResponse)(nil),              // 23: services.AddContactResponse
-	(*RemoveContactByIDRequest)(nil),        // 24: services.RemoveContactByIDRequest
-	(*RemoveContactByAddressRequest)(nil),   // 25: services.RemoveContactByAddressRequest
-	(*RemoveContactResponse)(nil),           // 26: services.RemoveContactResponse
-	(*Message)(nil),                         // 27: services.Message
-	(*PaymentRoute)(nil),                    // 28: services.PaymentRoute
-	(*PaymentHop)(nil),                      // 29: services.PaymentHop
-	(*MessageOptions)(nil),                  // 30: services.MessageOptions
-	(*EstimateMessageRequest)(nil),          // 31: services.EstimateMessageRequest
-	(*EstimateMessageResponse)(nil),         // 32: services.EstimateMessageResponse
-	(*SendMessageRequest)(nil),              // 33: services.SendMessageRequest
-	(*SendMessageResponse)(nil),             // 34: services.SendMessageResponse
-	(*SubscribeMessageRequest)(nil),         // 35: services.SubscribeMessageRequest
-	(*SubscribeMessageResponse)(nil),        // 36: services.SubscribeMessageResponse
-	(*DiscussionInfo)(nil),                  // 37: services.DiscussionInfo
-	(*DiscussionOptions)(nil),               // 38: services.DiscussionOptions
-	(*GetDiscussionsRequest)(nil),           // 39: services.GetDiscussionsRequest
-	(*GetDiscussionsResponse)(nil),          // 40: services.GetDiscussionsResponse
-	(*GetDiscussionHistoryByIDRequest)(nil), // 41: services.GetDiscussionHistoryByIDRequest
-	(*GetDiscussionHistoryResponse)(nil),    // 42: services.GetDiscussionHistoryResponse
-	(*GetDiscussionStatisticsRequest)(nil),  // 43: services.GetDiscussionStatisticsRequest
-	(*GetDiscussionStatisticsResponse)(nil), // 44: services.GetDiscussionStatisticsResponse
-	(*AddDiscussionRequest)(nil),            // 45: services.AddDiscussionRequest
-	(*AddDiscussionResponse)(nil),           // 46: services.AddDiscussionResponse
-	(*UpdateDiscussionLastReadRequest)(nil), // 47: services.UpdateDiscussionLastReadRequest
-	(*UpdateDiscussionResponse)(nil),        // 48: services.UpdateDiscussionResponse
-	(*RemoveDiscussionRequest)(nil),         // 49: services.RemoveDiscussionRequest
-	(*RemoveDiscussionResponse)(nil),        // 50: services.RemoveDiscussionResponse
-	(*CreateInvoiceRequest)(nil),            // 51: services.CreateInvoiceRequest
-	(*CreateInvoiceResponse)(nil),           // 52: services.CreateInvoiceResponse
-	(*LookupInvoiceRequest)(nil),            // 53: services.LookupInvoiceRequest
-	(*LookupInvoiceResponse)(nil),           // 54: services.LookupInvoiceResponse
-	(*Invoice)(nil),                         // 55: services.Invoice
-	(*RouteHint)(nil),                       // 56: services.RouteHint
-	(*HopHint)(nil),                         // 57: services.HopHint
-	(*InvoiceHTLC)(nil),                     // 58: services.InvoiceHTLC
-	(*timestamppb.Timestamp)(nil),           // 59: google.protobuf.Timestamp
+func (x *GetDiscussionsResponse) Reset() {
+	*x = GetDiscussionsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[57]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
 }
-var file_rpc_services_rpc_proto_depIdxs = []int32{
-	5,  // 0: services.SelfInfoResponse.info:type_name -> services.NodeInfo
-	7,  // 1: services.SelfInfoResponse.chains:type_name -> services.Chain
-	5,  // 2: services.NodeInfoResponse.nodes:type_name -> services.NodeInfo
-	5,  // 3: services.ContactInfo.node:type_name -> services.NodeInfo
-	19, // 4: services.GetContactsResponse.contacts:type_name -> services.ContactInfo
-	19, // 5: services.AddContactRequest.contact:type_name -> services.ContactInfo
-	19, // 6: services.AddContactResponse.contact:type_name -> services.ContactInfo
-	59, // 7: services.Message.sent_timestamp:type_name -> google.protobuf.Timestamp
-	59, // 8: services.Message.received_timestamp:type_name -> google.protobuf.Timestamp
-	28, // 9: services.Message.payment_routes:type_name -> services.PaymentRoute
-	29, // 10: services.PaymentRoute.hops:type_name -> services.PaymentHop
-	30, // 11: services.EstimateMessageRequest.options:type_name -> services.MessageOptions
-	27, // 12: services.EstimateMessageResponse.message:type_name -> services.Message
-	30, // 13: services.SendMessageRequest.options:type_name -> services.MessageOptions
-	27, // 14: services.SendMessageResponse.sent_message:type_name -> services.Message
-	27, // 15: services.SubscribeMessageResponse.received_message:type_name -> services.Message
-	38, // 16: services.DiscussionInfo.options:type_name -> services.DiscussionOptions
-	37, // 17: services.GetDiscussionsResponse.discussion:type_name -> services.DiscussionInfo
-	2,  // 18: services.GetDiscussionHistoryByIDRequest.page_options:type_name -> services.KeySetPageOptions
-	27, // 19: services.GetDiscussionHistoryResponse.message:type_name -> services.Message
-	37, // 20: services.AddDiscussionRequest.discussion:type_name -> services.DiscussionInfo
-	37, // 21: services.AddDiscussionResponse.discussion:type_name -> services.DiscussionInfo
-	55, // 22: services.CreateInvoiceResponse.invoice:type_name -> services.Invoice
-	55, // 23: services.LookupInvoiceResponse.invoice:type_name -> services.Invoice
-	59, // 24: services.Invoice.created_timestamp:type_name -> google.protobuf.Timestamp
-	59, // 25: services.Invoice.settled_timestamp:type_name -> google.protobuf.Timestamp
-	56, // 26: services.Invoice.route_hints:type_name -> services.RouteHint
-	0,  // 27: services.Invoice.state:type_name -> services.InvoiceState
-	58, // 28: services.Invoice.invoice_htlcs:type_name -> services.InvoiceHTLC
-	57, // 29: services.RouteHint.hop_hints:type_name -> services.HopHint
-	1,  // 30: services.InvoiceHTLC.state:type_name -> services.InvoiceHTLCState
-	59, // 31: services.InvoiceHTLC.accept_timestamp:type_name -> google.protobuf.Timestamp
-	59, // 32: services.InvoiceHTLC.resolve_timestamp:type_name -> google.protobuf.Timestamp
-	3,  // 33: services.NodeInfoService.GetVersion:input_type -> services.VersionRequest
-	6,  // 34: services.NodeInfoService.GetSelfInfo:input_type -> services.SelfInfoRequest
-	9,  // 35: services.NodeInfoService.GetSelfBalance:input_type -> services.SelfBalanceRequest
-	11, // 36: services.NodeInfoService.GetNodes:input_type -> services.GetNodesRequest
-	12, // 37: services.NodeInfoService.SearchNodeByAddress:input_type -> services.SearchNodeByAddressRequest
-	13, // 38: services.NodeInfoService.SearchNodeByAlias:input_type -> services.SearchNodeByAliasRequest
-	15, // 39: services.NodeInfoService.ConnectNode:input_type -> services.ConnectNodeRequest
-	17, // 40: services.ChannelService.OpenChannel:input_type -> services.OpenChannelRequest
-	20, // 41: services.ContactService.GetContacts:input_type -> services.GetContactsRequest
-	22, // 42: services.ContactService.AddContact:input_type -> services.AddContactRequest
-	24, // 43: services.ContactService.RemoveContactByID:input_type -> services.RemoveContactByIDRequest
-	25, // 44: services.ContactService.RemoveContactByAddress:input_type -> services.RemoveContactByAddressRequest
-	31, // 45: services.MessageService.EstimateMessage:input_type -> services.EstimateMessageRequest
-	33, // 46: services.MessageService.SendMessage:input_type -> services.SendMessageRequest
-	35, // 47: services.MessageService.SubscribeMessages:input_type -> services.SubscribeMessageRequest
-	39, // 48: services.DiscussionService.GetDiscussions:input_type -> services.GetDiscussionsRequest
-	41, // 49: services.DiscussionService.GetDiscussionHistoryByID:input_type -> services.GetDiscussionHistoryByIDRequest
-	43, // 50: services.DiscussionService.GetDiscussionStatistics:input_type -> services.GetDiscussionStatisticsRequest
-	45, // 51: services.DiscussionService.AddDiscussion:input_type -> services.AddDiscussionRequest
-	47, // 52: services.DiscussionService.UpdateDiscussionLastRead:input_type -> services.UpdateDiscussionLastReadRequest
-	49, // 53: services.DiscussionService.RemoveDiscussion:input_type -> services.RemoveDiscussionRequest
-	51, // 54: services.PaymentService.CreateInvoice:input_type -> services.CreateInvoiceRequest
-	53, // 55: services.PaymentService.LookupInvoice:input_type -> services.LookupInvoiceRequest
-	4,  // 56: services.NodeInfoService.GetVersion:output_type -> services.Version
-	8,  // 57: services.NodeInfoService.GetSelfInfo:output_type -> services.SelfInfoResponse
-	10, // 58: services.NodeInfoService.GetSelfBalance:output_type -> services.SelfBalanceResponse
-	14, // 59: services.NodeInfoService.GetNodes:output_type -> services.NodeInfoResponse
-	14, // 60: services.NodeInfoService.SearchNodeByAddress:output_type -> services.NodeInfoResponse
-	14, // 61: services.NodeInfoService.SearchNodeByAlias:output_type -> services.NodeInfoResponse
-	16, // 62: services.NodeInfoService.ConnectNode:output_type -> services.ConnectNodeResponse
-	18, // 63: services.ChannelService.OpenChannel:output_type -> services.OpenChannelResponse
-	21, // 64: services.ContactService.GetContacts:output_type -> services.GetContactsResponse
-	23, // 65: services.ContactService.AddContact:output_type -> services.AddContactResponse
-	26, // 66: services.ContactService.RemoveContactByID:output_type -> services.RemoveContactResponse
-	26, // 67: services.ContactService.RemoveContactByAddress:output_type -> services.RemoveContactResponse
-	32, // 68: services.MessageService.EstimateMessage:output_type -> services.EstimateMessageResponse
-	34, // 69: services.MessageService.SendMessage:output_type -> services.SendMessageResponse
-	36, // 70: services.MessageService.SubscribeMessages:output_type -> services.SubscribeMessageResponse
-	40, // 71: services.DiscussionService.GetDiscussions:output_type -> services.GetDiscussionsResponse
-	42, // 72: services.DiscussionService.GetDiscussionHistoryByID:output_type -> services.GetDiscussionHistoryResponse
-	44, // 73: services.DiscussionService.GetDiscussionStatistics:output_type -> services.GetDiscussionStatisticsResponse
-	46, // 74: services.DiscussionService.AddDiscussion:output_type -> services.AddDiscussionResponse
-	48, // 75: services.DiscussionService.UpdateDiscussionLastRead:output_type -> services.UpdateDiscussionResponse
-	50, // 76: services.DiscussionService.RemoveDiscussion:output_type -> services.RemoveDiscussionResponse
-	52, // 77: services.PaymentService.CreateInvoice:output_type -> services.CreateInvoiceResponse
-	54, // 78: services.PaymentService.LookupInvoice:output_type -> services.LookupInvoiceResponse
-	56, // [56:79] is the sub-list for method output_type
-	33, // [33:56] is the sub-list for method input_type
-	33, // [33:33] is the sub-list for extension type_name
-	33, // [33:33] is the sub-list for extension extendee
-	0,  // [0:33] is the sub-list for field type_name
+
+func (x *GetDiscussionsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
 }
 
-func init() { file_rpc_services_rpc_proto_init() }
-func file_rpc_services_rpc_proto_init() {
-	if File_rpc_services_rpc_proto != nil {
-		return
+func (*GetDiscussionsResponse) ProtoMessage() {}
+
+func (x *GetDiscussionsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[57]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	if !protoimpl.UnsafeEnabled {
-		file_rpc_services_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*KeySetPageOptions); i {
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscussionsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *GetDiscussionsResponse) GetDiscussion() *DiscussionInfo {
+	if x != nil {
+		return x.Discussion
+	}
+	return nil
+}
+
+func (x *GetDiscussionsResponse) GetUnreadCount() uint64 {
+	if x != nil {
+		return x.UnreadCount
+	}
+	return 0
+}
+
+func (x *GetDiscussionsResponse) GetLastMessage() *Message {
+	if x != nil {
+		return x.LastMessage
+	}
+	return nil
+}
+
+func (x *GetDiscussionsResponse) GetParticipantNames() []string {
+	if x != nil {
+		return x.ParticipantNames
+	}
+	return nil
+}
+
+//*
+//Corresponds to a request to create a stream over which to receive
+//previously exchanged messages of the identified discussion.
+type GetDiscussionHistoryByIDRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id of interest.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* The pagination options of the request.
+	PageOptions *KeySetPageOptions `protobuf:"bytes,2,opt,name=page_options,json=pageOptions,proto3" json:"page_options,omitempty"`
+}
+
+func (x *GetDiscussionHistoryByIDRequest) Reset() {
+	*x = GetDiscussionHistoryByIDRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[58]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionHistoryByIDRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionHistoryByIDRequest) ProtoMessage() {}
+
+func (x *GetDiscussionHistoryByIDRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[58]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionHistoryByIDRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscussionHistoryByIDRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *GetDiscussionHistoryByIDRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *GetDiscussionHistoryByIDRequest) GetPageOptions() *KeySetPageOptions {
+	if x != nil {
+		return x.PageOptions
+	}
+	return nil
+}
+
+//*
+//A GetDiscussionHistoryResponse is received in response to
+//a GetHistory rpc call, and represents an exchanged message.
+type GetDiscussionHistoryResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The exchanged message.
+	Message *Message `protobuf:"bytes,1,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (x *GetDiscussionHistoryResponse) Reset() {
+	*x = GetDiscussionHistoryResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[59]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionHistoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionHistoryResponse) ProtoMessage() {}
+
+func (x *GetDiscussionHistoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[59]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionHistoryResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscussionHistoryResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *GetDiscussionHistoryResponse) GetMessage() *Message {
+	if x != nil {
+		return x.Message
+	}
+	return nil
+}
+
+//*
+//Corresponds to a request for statistics about the requested
+//discussion, identified by its id.
+type GetDiscussionStatisticsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *GetDiscussionStatisticsRequest) Reset() {
+	*x = GetDiscussionStatisticsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[60]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionStatisticsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionStatisticsRequest) ProtoMessage() {}
+
+func (x *GetDiscussionStatisticsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[60]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionStatisticsRequest.ProtoReflect.Descriptor instead.
+func (*GetDiscussionStatisticsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetDiscussionStatisticsRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+//*
+//A GetDiscussionStatisticsResponse is received in response to
+//a GetDiscussionStatistics rpc call.
+type GetDiscussionStatisticsResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The total amount sent in the discussion (in millisatoshi).
+	AmtMsatSent uint64 `protobuf:"varint,1,opt,name=amt_msat_sent,json=amtMsatSent,proto3" json:"amt_msat_sent,omitempty"`
+	//* The total amount received in the discussion (in millisatoshi).
+	AmtMsatReceived uint64 `protobuf:"varint,2,opt,name=amt_msat_received,json=amtMsatReceived,proto3" json:"amt_msat_received,omitempty"`
+	//* The total amount of fees for sent messages in the discussion (in millisatoshi).
+	AmtMsatFees uint64 `protobuf:"varint,3,opt,name=amt_msat_fees,json=amtMsatFees,proto3" json:"amt_msat_fees,omitempty"`
+	//* The total amount of sent messages in the discussion.
+	MessagesSent uint64 `protobuf:"varint,4,opt,name=messages_sent,json=messagesSent,proto3" json:"messages_sent,omitempty"`
+	//* The total amount of received messages in the discussion.
+	MessagesReceived uint64 `protobuf:"varint,5,opt,name=messages_received,json=messagesReceived,proto3" json:"messages_received,omitempty"`
+}
+
+func (x *GetDiscussionStatisticsResponse) Reset() {
+	*x = GetDiscussionStatisticsResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[61]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *GetDiscussionStatisticsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDiscussionStatisticsResponse) ProtoMessage() {}
+
+func (x *GetDiscussionStatisticsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[61]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDiscussionStatisticsResponse.ProtoReflect.Descriptor instead.
+func (*GetDiscussionStatisticsResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetDiscussionStatisticsResponse) GetAmtMsatSent() uint64 {
+	if x != nil {
+		return x.AmtMsatSent
+	}
+	return 0
+}
+
+func (x *GetDiscussionStatisticsResponse) GetAmtMsatReceived() uint64 {
+	if x != nil {
+		return x.AmtMsatReceived
+	}
+	return 0
+}
+
+func (x *GetDiscussionStatisticsResponse) GetAmtMsatFees() uint64 {
+	if x != nil {
+		return x.AmtMsatFees
+	}
+	return 0
+}
+
+func (x *GetDiscussionStatisticsResponse) GetMessagesSent() uint64 {
+	if x != nil {
+		return x.MessagesSent
+	}
+	return 0
+}
+
+func (x *GetDiscussionStatisticsResponse) GetMessagesReceived() uint64 {
+	if x != nil {
+		return x.MessagesReceived
+	}
+	return 0
+}
+
+//* Corresponds to a request to add a discussion to database.
+type AddDiscussionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+}
+
+func (x *AddDiscussionRequest) Reset() {
+	*x = AddDiscussionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[62]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDiscussionRequest) ProtoMessage() {}
+
+func (x *AddDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[62]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*AddDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *AddDiscussionRequest) GetDiscussion() *DiscussionInfo {
+	if x != nil {
+		return x.Discussion
+	}
+	return nil
+}
+
+//* An AddDiscussionResponse is received in response to an AddDiscussion rpc call.
+type AddDiscussionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Discussion *DiscussionInfo `protobuf:"bytes,1,opt,name=discussion,proto3" json:"discussion,omitempty"`
+}
+
+func (x *AddDiscussionResponse) Reset() {
+	*x = AddDiscussionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[63]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *AddDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddDiscussionResponse) ProtoMessage() {}
+
+func (x *AddDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[63]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*AddDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *AddDiscussionResponse) GetDiscussion() *DiscussionInfo {
+	if x != nil {
+		return x.Discussion
+	}
+	return nil
+}
+
+//* Represents a request to update the last read discussion message.
+type UpdateDiscussionLastReadRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The message id to mark as the last read.
+	LastReadMsgId uint64 `protobuf:"varint,2,opt,name=last_read_msg_id,json=lastReadMsgId,proto3" json:"last_read_msg_id,omitempty"`
+	//* Whether to send a read receipt to the verified senders
+	//of the messages up to the last read one.
+	SendReadReceipt bool `protobuf:"varint,3,opt,name=send_read_receipt,json=sendReadReceipt,proto3" json:"send_read_receipt,omitempty"`
+}
+
+func (x *UpdateDiscussionLastReadRequest) Reset() {
+	*x = UpdateDiscussionLastReadRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[64]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDiscussionLastReadRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscussionLastReadRequest) ProtoMessage() {}
+
+func (x *UpdateDiscussionLastReadRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[64]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscussionLastReadRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDiscussionLastReadRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *UpdateDiscussionLastReadRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *UpdateDiscussionLastReadRequest) GetLastReadMsgId() uint64 {
+	if x != nil {
+		return x.LastReadMsgId
+	}
+	return 0
+}
+
+func (x *UpdateDiscussionLastReadRequest) GetSendReadReceipt() bool {
+	if x != nil {
+		return x.SendReadReceipt
+	}
+	return false
+}
+
+//* Represents a request to update the metadata of a discussion.
+type UpdateDiscussionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The new discussion metadata.
+	Metadata *DiscussionMetadata `protobuf:"bytes,2,opt,name=metadata,proto3" json:"metadata,omitempty"`
+}
+
+func (x *UpdateDiscussionRequest) Reset() {
+	*x = UpdateDiscussionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[65]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscussionRequest) ProtoMessage() {}
+
+func (x *UpdateDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[65]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *UpdateDiscussionRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *UpdateDiscussionRequest) GetMetadata() *DiscussionMetadata {
+	if x != nil {
+		return x.Metadata
+	}
+	return nil
+}
+
+//* Represents a request to update the options of a discussion.
+type UpdateDiscussionOptionsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The new discussion options.
+	Options *DiscussionOptions `protobuf:"bytes,2,opt,name=options,proto3" json:"options,omitempty"`
+}
+
+func (x *UpdateDiscussionOptionsRequest) Reset() {
+	*x = UpdateDiscussionOptionsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[66]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDiscussionOptionsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscussionOptionsRequest) ProtoMessage() {}
+
+func (x *UpdateDiscussionOptionsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[66]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscussionOptionsRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDiscussionOptionsRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *UpdateDiscussionOptionsRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *UpdateDiscussionOptionsRequest) GetOptions() *DiscussionOptions {
+	if x != nil {
+		return x.Options
+	}
+	return nil
+}
+
+//* Represents a request to update the retention policy of a discussion.
+type UpdateDiscussionRetentionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The discussion id.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The new retention policy.
+	Retention *RetentionPolicy `protobuf:"bytes,2,opt,name=retention,proto3" json:"retention,omitempty"`
+}
+
+func (x *UpdateDiscussionRetentionRequest) Reset() {
+	*x = UpdateDiscussionRetentionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[67]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDiscussionRetentionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscussionRetentionRequest) ProtoMessage() {}
+
+func (x *UpdateDiscussionRetentionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[67]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscussionRetentionRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDiscussionRetentionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *UpdateDiscussionRetentionRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *UpdateDiscussionRetentionRequest) GetRetention() *RetentionPolicy {
+	if x != nil {
+		return x.Retention
+	}
+	return nil
+}
+
+//*
+//An UpdateDiscussionResponse is returned in reponse to an
+//UpdateDiscussionLastRead, UpdateDiscussion, UpdateDiscussionOptions
+//or UpdateDiscussionRetention request.
+type UpdateDiscussionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *UpdateDiscussionResponse) Reset() {
+	*x = UpdateDiscussionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[68]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDiscussionResponse) ProtoMessage() {}
+
+func (x *UpdateDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[68]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{68}
+}
+
+//* Corresponds to a request to remove a discussion.
+type RemoveDiscussionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The id of the discussion to remove.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	//* Whether to purge the discussion and its messages,
+	//instead of soft deleting it.
+	Purge bool `protobuf:"varint,2,opt,name=purge,proto3" json:"purge,omitempty"`
+}
+
+func (x *RemoveDiscussionRequest) Reset() {
+	*x = RemoveDiscussionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[69]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscussionRequest) ProtoMessage() {}
+
+func (x *RemoveDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[69]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*RemoveDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *RemoveDiscussionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *RemoveDiscussionRequest) GetPurge() bool {
+	if x != nil {
+		return x.Purge
+	}
+	return false
+}
+
+//* A RemoveDiscussionResponse is received in response to a RemoveDiscussion rpc call.
+type RemoveDiscussionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RemoveDiscussionResponse) Reset() {
+	*x = RemoveDiscussionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[70]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemoveDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveDiscussionResponse) ProtoMessage() {}
+
+func (x *RemoveDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[70]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*RemoveDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{70}
+}
+
+//* Corresponds to a request to restore a soft deleted discussion.
+type RestoreDiscussionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The id of the discussion to restore.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *RestoreDiscussionRequest) Reset() {
+	*x = RestoreDiscussionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[71]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDiscussionRequest) ProtoMessage() {}
+
+func (x *RestoreDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[71]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*RestoreDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *RestoreDiscussionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+//* A RestoreDiscussionResponse is received in response to a RestoreDiscussion rpc call.
+type RestoreDiscussionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *RestoreDiscussionResponse) Reset() {
+	*x = RestoreDiscussionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[72]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RestoreDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RestoreDiscussionResponse) ProtoMessage() {}
+
+func (x *RestoreDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[72]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RestoreDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*RestoreDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{72}
+}
+
+//* Corresponds to a request to export a discussion.
+type ExportDiscussionRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The id of the discussion to export.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (x *ExportDiscussionRequest) Reset() {
+	*x = ExportDiscussionRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[73]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportDiscussionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDiscussionRequest) ProtoMessage() {}
+
+func (x *ExportDiscussionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[73]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDiscussionRequest.ProtoReflect.Descriptor instead.
+func (*ExportDiscussionRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ExportDiscussionRequest) GetId() uint64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+//*
+//An ExportDiscussionResponse is received in the stream returned in response
+//to an ExportDiscussion rpc call, and contains a single archive line.
+type ExportDiscussionResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The archive line, including its line terminator.
+	Line []byte `protobuf:"bytes,1,opt,name=line,proto3" json:"line,omitempty"`
+}
+
+func (x *ExportDiscussionResponse) Reset() {
+	*x = ExportDiscussionResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[74]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ExportDiscussionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportDiscussionResponse) ProtoMessage() {}
+
+func (x *ExportDiscussionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[74]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportDiscussionResponse.ProtoReflect.Descriptor instead.
+func (*ExportDiscussionResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ExportDiscussionResponse) GetLine() []byte {
+	if x != nil {
+		return x.Line
+	}
+	return nil
+}
+
+//* Corresponds to an invoice creation request.
+type CreateInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* Memo of the invoice.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	//* The invoice amount (in millisatoshi).
+	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* Invoice expiry time (in seconds since creation).
+	Expiry int64 `protobuf:"varint,3,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	//* Whether to include hints for private channels.
+	Private bool `protobuf:"varint,4,opt,name=private,proto3" json:"private,omitempty"`
+}
+
+func (x *CreateInvoiceRequest) Reset() {
+	*x = CreateInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[75]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceRequest) ProtoMessage() {}
+
+func (x *CreateInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[75]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *CreateInvoiceRequest) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *CreateInvoiceRequest) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequest) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *CreateInvoiceRequest) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+//* A CreateInvoiceResponse is received in response to an invoice creation request.
+type CreateInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The created invoice.
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *CreateInvoiceResponse) Reset() {
+	*x = CreateInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[76]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateInvoiceResponse) ProtoMessage() {}
+
+func (x *CreateInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[76]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*CreateInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *CreateInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+//* Corresponds to an invoice lookup request.
+type LookupInvoiceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* Payment Request
+	PayReq string `protobuf:"bytes,1,opt,name=pay_req,json=payReq,proto3" json:"pay_req,omitempty"`
+}
+
+func (x *LookupInvoiceRequest) Reset() {
+	*x = LookupInvoiceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[77]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupInvoiceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupInvoiceRequest) ProtoMessage() {}
+
+func (x *LookupInvoiceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[77]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupInvoiceRequest.ProtoReflect.Descriptor instead.
+func (*LookupInvoiceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *LookupInvoiceRequest) GetPayReq() string {
+	if x != nil {
+		return x.PayReq
+	}
+	return ""
+}
+
+//* A LookupResponse is received in response to an invoice lookup request.
+type LookupInvoiceResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The returned invoice.
+	Invoice *Invoice `protobuf:"bytes,1,opt,name=invoice,proto3" json:"invoice,omitempty"`
+}
+
+func (x *LookupInvoiceResponse) Reset() {
+	*x = LookupInvoiceResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[78]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *LookupInvoiceResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*LookupInvoiceResponse) ProtoMessage() {}
+
+func (x *LookupInvoiceResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[78]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use LookupInvoiceResponse.ProtoReflect.Descriptor instead.
+func (*LookupInvoiceResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *LookupInvoiceResponse) GetInvoice() *Invoice {
+	if x != nil {
+		return x.Invoice
+	}
+	return nil
+}
+
+//* Represents an Lightning network invoice.
+type Invoice struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The invoice memo.
+	Memo string `protobuf:"bytes,1,opt,name=memo,proto3" json:"memo,omitempty"`
+	//* The preimage hash.
+	Hash string `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	//* The invoice preimage.
+	Preimage string `protobuf:"bytes,3,opt,name=preimage,proto3" json:"preimage,omitempty"`
+	//* The payment request of the invoice.
+	PaymentRequest string `protobuf:"bytes,4,opt,name=payment_request,json=paymentRequest,proto3" json:"payment_request,omitempty"`
+	//* The value (amount requested) of the invoice (in millisatoshi).
+	ValueMsat uint64 `protobuf:"varint,5,opt,name=value_msat,json=valueMsat,proto3" json:"value_msat,omitempty"`
+	//* The amount paid to the invoice (in millisatoshi).
+	AmtPaidMsat uint64 `protobuf:"varint,6,opt,name=amt_paid_msat,json=amtPaidMsat,proto3" json:"amt_paid_msat,omitempty"`
+	//* The time the invoice was created.
+	CreatedTimestamp *timestamppb.Timestamp `protobuf:"bytes,7,opt,name=created_timestamp,json=createdTimestamp,proto3" json:"created_timestamp,omitempty"`
+	//* The time the invoice was settled.
+	SettledTimestamp *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=settled_timestamp,json=settledTimestamp,proto3" json:"settled_timestamp,omitempty"`
+	//* The invoice expiry (in seconds since creation time).
+	Expiry int64 `protobuf:"varint,9,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	//* Whether the invoice contains hints for private channels.
+	Private bool `protobuf:"varint,10,opt,name=private,proto3" json:"private,omitempty"`
+	//* Invoice route hints.
+	RouteHints []*RouteHint `protobuf:"bytes,11,rep,name=route_hints,json=routeHints,proto3" json:"route_hints,omitempty"`
+	//* The invoice state.
+	State InvoiceState `protobuf:"varint,12,opt,name=state,proto3,enum=services.InvoiceState" json:"state,omitempty"`
+	//* The add index of the invoice.
+	AddIndex uint64 `protobuf:"varint,13,opt,name=add_index,json=addIndex,proto3" json:"add_index,omitempty"`
+	//* The settle index of the invoice.
+	SettleIndex uint64 `protobuf:"varint,14,opt,name=settle_index,json=settleIndex,proto3" json:"settle_index,omitempty"`
+	//* The set of HTLCs paying to the invoice.
+	InvoiceHtlcs []*InvoiceHTLC `protobuf:"bytes,15,rep,name=invoice_htlcs,json=invoiceHtlcs,proto3" json:"invoice_htlcs,omitempty"`
+}
+
+func (x *Invoice) Reset() {
+	*x = Invoice{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[79]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Invoice) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Invoice) ProtoMessage() {}
+
+func (x *Invoice) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[79]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Invoice.ProtoReflect.Descriptor instead.
+func (*Invoice) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *Invoice) GetMemo() string {
+	if x != nil {
+		return x.Memo
+	}
+	return ""
+}
+
+func (x *Invoice) GetHash() string {
+	if x != nil {
+		return x.Hash
+	}
+	return ""
+}
+
+func (x *Invoice) GetPreimage() string {
+	if x != nil {
+		return x.Preimage
+	}
+	return ""
+}
+
+func (x *Invoice) GetPaymentRequest() string {
+	if x != nil {
+		return x.PaymentRequest
+	}
+	return ""
+}
+
+func (x *Invoice) GetValueMsat() uint64 {
+	if x != nil {
+		return x.ValueMsat
+	}
+	return 0
+}
+
+func (x *Invoice) GetAmtPaidMsat() uint64 {
+	if x != nil {
+		return x.AmtPaidMsat
+	}
+	return 0
+}
+
+func (x *Invoice) GetCreatedTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedTimestamp
+	}
+	return nil
+}
+
+func (x *Invoice) GetSettledTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SettledTimestamp
+	}
+	return nil
+}
+
+func (x *Invoice) GetExpiry() int64 {
+	if x != nil {
+		return x.Expiry
+	}
+	return 0
+}
+
+func (x *Invoice) GetPrivate() bool {
+	if x != nil {
+		return x.Private
+	}
+	return false
+}
+
+func (x *Invoice) GetRouteHints() []*RouteHint {
+	if x != nil {
+		return x.RouteHints
+	}
+	return nil
+}
+
+func (x *Invoice) GetState() InvoiceState {
+	if x != nil {
+		return x.State
+	}
+	return InvoiceState_INVOICE_OPEN
+}
+
+func (x *Invoice) GetAddIndex() uint64 {
+	if x != nil {
+		return x.AddIndex
+	}
+	return 0
+}
+
+func (x *Invoice) GetSettleIndex() uint64 {
+	if x != nil {
+		return x.SettleIndex
+	}
+	return 0
+}
+
+func (x *Invoice) GetInvoiceHtlcs() []*InvoiceHTLC {
+	if x != nil {
+		return x.InvoiceHtlcs
+	}
+	return nil
+}
+
+//* Corresponds to a request to rebuild the message history.
+type ResyncRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *ResyncRequest) Reset() {
+	*x = ResyncRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[80]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncRequest) ProtoMessage() {}
+
+func (x *ResyncRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[80]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncRequest.ProtoReflect.Descriptor instead.
+func (*ResyncRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{80}
+}
+
+//* A ResyncResponse is received in response to a Resync rpc call.
+type ResyncResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The number of settled invoices examined.
+	Invoices uint64 `protobuf:"varint,1,opt,name=invoices,proto3" json:"invoices,omitempty"`
+	//* The number of completed payments examined.
+	Payments uint64 `protobuf:"varint,2,opt,name=payments,proto3" json:"payments,omitempty"`
+	//* The number of restored messages.
+	Messages uint64 `protobuf:"varint,3,opt,name=messages,proto3" json:"messages,omitempty"`
+}
+
+func (x *ResyncResponse) Reset() {
+	*x = ResyncResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[81]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ResyncResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ResyncResponse) ProtoMessage() {}
+
+func (x *ResyncResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[81]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ResyncResponse.ProtoReflect.Descriptor instead.
+func (*ResyncResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ResyncResponse) GetInvoices() uint64 {
+	if x != nil {
+		return x.Invoices
+	}
+	return 0
+}
+
+func (x *ResyncResponse) GetPayments() uint64 {
+	if x != nil {
+		return x.Payments
+	}
+	return 0
+}
+
+func (x *ResyncResponse) GetMessages() uint64 {
+	if x != nil {
+		return x.Messages
+	}
+	return 0
+}
+
+//* Corresponds to a request to back up the database.
+type BackupDatabaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//*
+	//The version after which changed entries are backed up
+	//(0 for a full backup).
+	SinceVersion uint64 `protobuf:"varint,1,opt,name=since_version,json=sinceVersion,proto3" json:"since_version,omitempty"`
+}
+
+func (x *BackupDatabaseRequest) Reset() {
+	*x = BackupDatabaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[82]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupDatabaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseRequest) ProtoMessage() {}
+
+func (x *BackupDatabaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[82]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseRequest.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *BackupDatabaseRequest) GetSinceVersion() uint64 {
+	if x != nil {
+		return x.SinceVersion
+	}
+	return 0
+}
+
+//*
+//A BackupDatabaseResponse is received in the stream returned
+//in response to a BackupDatabase rpc call.
+type BackupDatabaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* A chunk of the backup.
+	Chunk []byte `protobuf:"bytes,1,opt,name=chunk,proto3" json:"chunk,omitempty"`
+	//*
+	//The version a subsequent incremental backup should request
+	//(set only in the last response).
+	Version uint64 `protobuf:"varint,2,opt,name=version,proto3" json:"version,omitempty"`
+}
+
+func (x *BackupDatabaseResponse) Reset() {
+	*x = BackupDatabaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[83]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *BackupDatabaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BackupDatabaseResponse) ProtoMessage() {}
+
+func (x *BackupDatabaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[83]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BackupDatabaseResponse.ProtoReflect.Descriptor instead.
+func (*BackupDatabaseResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *BackupDatabaseResponse) GetChunk() []byte {
+	if x != nil {
+		return x.Chunk
+	}
+	return nil
+}
+
+func (x *BackupDatabaseResponse) GetVersion() uint64 {
+	if x != nil {
+		return x.Version
+	}
+	return 0
+}
+
+//* Corresponds to a request to subscribe to presence indications.
+type SubscribePresenceRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SubscribePresenceRequest) Reset() {
+	*x = SubscribePresenceRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[84]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SubscribePresenceRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SubscribePresenceRequest) ProtoMessage() {}
+
+func (x *SubscribePresenceRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[84]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SubscribePresenceRequest.ProtoReflect.Descriptor instead.
+func (*SubscribePresenceRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{84}
+}
+
+//* Represents a presence indication sent by a discussion participant.
+type PresenceUpdate struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The id of the discussion the indication refers to.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* The address of the participant sending the indication.
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	//* Whether the participant is typing.
+	Typing bool `protobuf:"varint,3,opt,name=typing,proto3" json:"typing,omitempty"`
+	//* The time the indication was sent.
+	SentTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=sent_timestamp,json=sentTimestamp,proto3" json:"sent_timestamp,omitempty"`
+}
+
+func (x *PresenceUpdate) Reset() {
+	*x = PresenceUpdate{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[85]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PresenceUpdate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PresenceUpdate) ProtoMessage() {}
+
+func (x *PresenceUpdate) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[85]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PresenceUpdate.ProtoReflect.Descriptor instead.
+func (*PresenceUpdate) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *PresenceUpdate) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *PresenceUpdate) GetSender() string {
+	if x != nil {
+		return x.Sender
+	}
+	return ""
+}
+
+func (x *PresenceUpdate) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+func (x *PresenceUpdate) GetSentTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.SentTimestamp
+	}
+	return nil
+}
+
+//* Corresponds to a request to send a typing indication.
+type SetTypingRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The id of the discussion.
+	DiscussionId uint64 `protobuf:"varint,1,opt,name=discussion_id,json=discussionId,proto3" json:"discussion_id,omitempty"`
+	//* Whether the user is typing.
+	Typing bool `protobuf:"varint,2,opt,name=typing,proto3" json:"typing,omitempty"`
+}
+
+func (x *SetTypingRequest) Reset() {
+	*x = SetTypingRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[86]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTypingRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypingRequest) ProtoMessage() {}
+
+func (x *SetTypingRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[86]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypingRequest.ProtoReflect.Descriptor instead.
+func (*SetTypingRequest) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *SetTypingRequest) GetDiscussionId() uint64 {
+	if x != nil {
+		return x.DiscussionId
+	}
+	return 0
+}
+
+func (x *SetTypingRequest) GetTyping() bool {
+	if x != nil {
+		return x.Typing
+	}
+	return false
+}
+
+//* A SetTypingResponse is received in response to a SetTyping rpc call.
+type SetTypingResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+}
+
+func (x *SetTypingResponse) Reset() {
+	*x = SetTypingResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[87]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SetTypingResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SetTypingResponse) ProtoMessage() {}
+
+func (x *SetTypingResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[87]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SetTypingResponse.ProtoReflect.Descriptor instead.
+func (*SetTypingResponse) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{87}
+}
+
+//* Represents a route hint for assistance in invoice payment.
+type RouteHint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* A chain of hop hints that can reach the desetination.
+	HopHints []*HopHint `protobuf:"bytes,1,rep,name=hop_hints,json=hopHints,proto3" json:"hop_hints,omitempty"`
+}
+
+func (x *RouteHint) Reset() {
+	*x = RouteHint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[88]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RouteHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteHint) ProtoMessage() {}
+
+func (x *RouteHint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[88]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteHint.ProtoReflect.Descriptor instead.
+func (*RouteHint) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *RouteHint) GetHopHints() []*HopHint {
+	if x != nil {
+		return x.HopHints
+	}
+	return nil
+}
+
+//* Represents a hop hint.
+type HopHint struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* Public key of hop ingress node.
+	Pubkey string `protobuf:"bytes,1,opt,name=pubkey,proto3" json:"pubkey,omitempty"`
+	//* The short channel id of the channel to be used for the hop.
+	ChanId uint64 `protobuf:"varint,2,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The base fee of the channel (in millisatoshi).
+	FeeBaseMsat uint32 `protobuf:"varint,3,opt,name=fee_base_msat,json=feeBaseMsat,proto3" json:"fee_base_msat,omitempty"`
+	//* The fee rate of the channel (in microsatoshi/sat).
+	FeeRate uint32 `protobuf:"varint,4,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	//* The timelock delta of the channel.
+	CltvExpiryDelta uint32 `protobuf:"varint,5,opt,name=cltv_expiry_delta,json=cltvExpiryDelta,proto3" json:"cltv_expiry_delta,omitempty"`
+}
+
+func (x *HopHint) Reset() {
+	*x = HopHint{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[89]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *HopHint) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*HopHint) ProtoMessage() {}
+
+func (x *HopHint) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[89]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use HopHint.ProtoReflect.Descriptor instead.
+func (*HopHint) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{89}
+}
+
+func (x *HopHint) GetPubkey() string {
+	if x != nil {
+		return x.Pubkey
+	}
+	return ""
+}
+
+func (x *HopHint) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
+	}
+	return 0
+}
+
+func (x *HopHint) GetFeeBaseMsat() uint32 {
+	if x != nil {
+		return x.FeeBaseMsat
+	}
+	return 0
+}
+
+func (x *HopHint) GetFeeRate() uint32 {
+	if x != nil {
+		return x.FeeRate
+	}
+	return 0
+}
+
+func (x *HopHint) GetCltvExpiryDelta() uint32 {
+	if x != nil {
+		return x.CltvExpiryDelta
+	}
+	return 0
+}
+
+//* Represents an HTLC paying to an invoice.
+type InvoiceHTLC struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	//* The short channel id of the channel the HTLC was arrived.
+	ChanId uint64 `protobuf:"varint,1,opt,name=chan_id,json=chanId,proto3" json:"chan_id,omitempty"`
+	//* The amount of this HTLC (in millisatoshi).
+	AmtMsat uint64 `protobuf:"varint,2,opt,name=amt_msat,json=amtMsat,proto3" json:"amt_msat,omitempty"`
+	//* State of the HTLC.
+	State InvoiceHTLCState `protobuf:"varint,3,opt,name=state,proto3,enum=services.InvoiceHTLCState" json:"state,omitempty"`
+	//* HTLC accept timestamp.
+	AcceptTimestamp *timestamppb.Timestamp `protobuf:"bytes,4,opt,name=accept_timestamp,json=acceptTimestamp,proto3" json:"accept_timestamp,omitempty"`
+	//* HTLC resolve timestamp.
+	ResolveTimestamp *timestamppb.Timestamp `protobuf:"bytes,5,opt,name=resolve_timestamp,json=resolveTimestamp,proto3" json:"resolve_timestamp,omitempty"`
+	//* Block height at which this HTLC expires.
+	ExpiryHeight int32 `protobuf:"varint,6,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+}
+
+func (x *InvoiceHTLC) Reset() {
+	*x = InvoiceHTLC{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_rpc_services_rpc_proto_msgTypes[90]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *InvoiceHTLC) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*InvoiceHTLC) ProtoMessage() {}
+
+func (x *InvoiceHTLC) ProtoReflect() protoreflect.Message {
+	mi := &file_rpc_services_rpc_proto_msgTypes[90]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use InvoiceHTLC.ProtoReflect.Descriptor instead.
+func (*InvoiceHTLC) Descriptor() ([]byte, []int) {
+	return file_rpc_services_rpc_proto_rawDescGZIP(), []int{90}
+}
+
+func (x *InvoiceHTLC) GetChanId() uint64 {
+	if x != nil {
+		return x.ChanId
+	}
+	return 0
+}
+
+func (x *InvoiceHTLC) GetAmtMsat() uint64 {
+	if x != nil {
+		return x.AmtMsat
+	}
+	return 0
+}
+
+func (x *InvoiceHTLC) GetState() InvoiceHTLCState {
+	if x != nil {
+		return x.State
+	}
+	return InvoiceHTLCState_INVOICE_HTLC_ACCEPTED
+}
+
+func (x *InvoiceHTLC) GetAcceptTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.AcceptTimestamp
+	}
+	return nil
+}
+
+func (x *InvoiceHTLC) GetResolveTimestamp() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ResolveTimestamp
+	}
+	return nil
+}
+
+func (x *InvoiceHTLC) GetExpiryHeight() int32 {
+	if x != nil {
+		return x.ExpiryHeight
+	}
+	return 0
+}
+
+var File_rpc_services_rpc_proto protoreflect.FileDescriptor
+
+var file_rpc_services_rpc_proto_rawDesc = []byte{
+	0x0a, 0x16, 0x72, 0x70, 0x63, 0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2f, 0x72,
+	0x70, 0x63, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x12, 0x08, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x1a, 0x1f, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x1a, 0x36, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f,
+	0x6d, 0x77, 0x69, 0x74, 0x6b, 0x6f, 0x77, 0x2f, 0x67, 0x6f, 0x2d, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x2d, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x6f, 0x72, 0x73, 0x2f, 0x76, 0x61, 0x6c, 0x69,
+	0x64, 0x61, 0x74, 0x6f, 0x72, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0xe3, 0x02, 0x0a, 0x11,
+	0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x12, 0x17, 0x0a, 0x07, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x06, 0x6c, 0x61, 0x73, 0x74, 0x49, 0x64, 0x12, 0x1b, 0x0a, 0x09, 0x70, 0x61,
+	0x67, 0x65, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x08, 0x70,
+	0x61, 0x67, 0x65, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72,
+	0x73, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x72, 0x65, 0x76, 0x65, 0x72, 0x73,
+	0x65, 0x12, 0x41, 0x0a, 0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74,
+	0x61, 0x6d, 0x70, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x41, 0x6d,
+	0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x74,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78,
+	0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x38, 0x0a, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63,
+	0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x69, 0x72,
+	0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x64, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f,
+	0x6e, 0x22, 0x10, 0x0a, 0x0e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x22, 0x5c, 0x0a, 0x07, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x16, 0x0a, 0x06, 0x63, 0x6f, 0x6d, 0x6d,
+	0x69, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74,
+	0x12, 0x1f, 0x0a, 0x0b, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x5f, 0x68, 0x61, 0x73, 0x68, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a, 0x63, 0x6f, 0x6d, 0x6d, 0x69, 0x74, 0x48, 0x61, 0x73,
+	0x68, 0x22, 0x52, 0x0a, 0x08, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x14, 0x0a,
+	0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c,
+	0x69, 0x61, 0x73, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d,
+	0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x11, 0x0a, 0x0f, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x37, 0x0a, 0x05, 0x43, 0x68, 0x61, 0x69,
+	0x6e, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x05, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x12, 0x18, 0x0a, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f,
+	0x72, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x6e, 0x65, 0x74, 0x77, 0x6f, 0x72,
+	0x6b, 0x22, 0x6b, 0x0a, 0x10, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x69, 0x6e, 0x66, 0x6f, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
+	0x04, 0x69, 0x6e, 0x66, 0x6f, 0x12, 0x27, 0x0a, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x18,
+	0x02, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x68, 0x61, 0x69, 0x6e, 0x52, 0x06, 0x63, 0x68, 0x61, 0x69, 0x6e, 0x73, 0x22, 0x14,
+	0x0a, 0x12, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x22, 0xb1, 0x03, 0x0a, 0x13, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c,
+	0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a, 0x14,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64,
+	0x5f, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x12, 0x77, 0x61, 0x6c, 0x6c,
+	0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65, 0x64, 0x53, 0x61, 0x74, 0x12, 0x34,
+	0x0a, 0x16, 0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x5f, 0x75, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69,
+	0x72, 0x6d, 0x65, 0x64, 0x5f, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14,
+	0x77, 0x61, 0x6c, 0x6c, 0x65, 0x74, 0x55, 0x6e, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x65,
+	0x64, 0x53, 0x61, 0x74, 0x12, 0x2c, 0x0a, 0x12, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x10, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x2e, 0x0a, 0x13, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x72, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52,
+	0x11, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x35, 0x0a, 0x17, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70,
+	0x65, 0x6e, 0x5f, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x14, 0x70, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e,
+	0x4c, 0x6f, 0x63, 0x61, 0x6c, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x37, 0x0a, 0x18, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x5f, 0x6f, 0x70, 0x65, 0x6e, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x15, 0x70, 0x65, 0x6e,
+	0x64, 0x69, 0x6e, 0x67, 0x4f, 0x70, 0x65, 0x6e, 0x52, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x30, 0x0a, 0x14, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f,
+	0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x12, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x4c, 0x6f, 0x63, 0x61, 0x6c,
+	0x4d, 0x73, 0x61, 0x74, 0x12, 0x32, 0x0a, 0x15, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65,
+	0x64, 0x5f, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x13, 0x75, 0x6e, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x52, 0x65,
+	0x6d, 0x6f, 0x74, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x22, 0x11, 0x0a, 0x0f, 0x47, 0x65, 0x74, 0x4e,
+	0x6f, 0x64, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x4c, 0x0a, 0x1a, 0x53,
+	0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65,
+	0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x2e, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10,
+	0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24,
+	0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x30, 0x0a, 0x18, 0x53, 0x65, 0x61,
+	0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69, 0x61, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x61, 0x6c, 0x69, 0x61, 0x73, 0x22, 0x3c, 0x0a, 0x10, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12,
+	0x28, 0x0a, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e,
+	0x66, 0x6f, 0x52, 0x05, 0x6e, 0x6f, 0x64, 0x65, 0x73, 0x22, 0x60, 0x0a, 0x12, 0x43, 0x6f, 0x6e,
+	0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x2e, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39,
+	0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x1a, 0x0a, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x68, 0x6f, 0x73, 0x74, 0x70, 0x6f, 0x72, 0x74, 0x22, 0x15, 0x0a, 0x13, 0x43,
+	0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0xf5, 0x01, 0x0a, 0x12, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x18, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x61, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x22,
+	0x0a, 0x0d, 0x70, 0x75, 0x73, 0x68, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x75, 0x73, 0x68, 0x41, 0x6d, 0x74, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x26, 0x0a, 0x0f, 0x6d, 0x69, 0x6e, 0x5f, 0x69, 0x6e, 0x70, 0x75, 0x74, 0x5f,
+	0x63, 0x6f, 0x6e, 0x66, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0d, 0x6d, 0x69, 0x6e,
+	0x49, 0x6e, 0x70, 0x75, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x73, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f,
+	0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65,
+	0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73,
+	0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62, 0x79, 0x74, 0x65, 0x22, 0x5b, 0x0a, 0x13, 0x4f, 0x70,
+	0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67,
+	0x54, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69,
+	0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70,
+	0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x22, 0xd9, 0x01, 0x0a, 0x13, 0x43, 0x6c, 0x6f, 0x73,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x29, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x58, 0x01, 0x52, 0x0b, 0x66,
+	0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d,
+	0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x14, 0x0a,
+	0x05, 0x66, 0x6f, 0x72, 0x63, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x66, 0x6f,
+	0x72, 0x63, 0x65, 0x12, 0x3a, 0x0a, 0x19, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x5f, 0x63, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x5f, 0x62, 0x6c, 0x6f, 0x63, 0x6b,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x17, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x43, 0x6f,
+	0x6e, 0x66, 0x69, 0x72, 0x6d, 0x61, 0x74, 0x69, 0x6f, 0x6e, 0x42, 0x6c, 0x6f, 0x63, 0x6b, 0x12,
+	0x22, 0x0a, 0x0d, 0x73, 0x61, 0x74, 0x5f, 0x70, 0x65, 0x72, 0x5f, 0x76, 0x62, 0x79, 0x74, 0x65,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x73, 0x61, 0x74, 0x50, 0x65, 0x72, 0x56, 0x62,
+	0x79, 0x74, 0x65, 0x22, 0x6c, 0x0a, 0x14, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x63,
+	0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x0b, 0x63, 0x6c, 0x6f, 0x73, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12, 0x31,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1b, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x43, 0x6c, 0x6f, 0x73, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74,
+	0x65, 0x22, 0xff, 0x02, 0x0a, 0x07, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x0a,
+	0x0a, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x09, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x49, 0x64, 0x12, 0x21, 0x0a, 0x0c,
+	0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74, 0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12,
+	0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64,
+	0x65, 0x78, 0x12, 0x25, 0x0a, 0x0e, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1f, 0x0a, 0x0b, 0x72, 0x65, 0x6d,
+	0x6f, 0x74, 0x65, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0a,
+	0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x4e, 0x61, 0x6d, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x63, 0x61,
+	0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x63, 0x61, 0x70, 0x61, 0x63, 0x69, 0x74, 0x79, 0x4d, 0x73, 0x61, 0x74, 0x12,
+	0x2c, 0x0a, 0x12, 0x6c, 0x6f, 0x63, 0x61, 0x6c, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10, 0x6c, 0x6f, 0x63,
+	0x61, 0x6c, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2e, 0x0a,
+	0x13, 0x72, 0x65, 0x6d, 0x6f, 0x74, 0x65, 0x5f, 0x62, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x08, 0x20, 0x01, 0x28, 0x04, 0x52, 0x11, 0x72, 0x65, 0x6d, 0x6f,
+	0x74, 0x65, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x16, 0x0a,
+	0x06, 0x61, 0x63, 0x74, 0x69, 0x76, 0x65, 0x18, 0x09, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x61,
+	0x63, 0x74, 0x69, 0x76, 0x65, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0a,
+	0x20, 0x01, 0x28, 0x0e, 0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74,
+	0x61, 0x74, 0x65, 0x22, 0x15, 0x0a, 0x13, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e,
+	0x65, 0x6c, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x1c, 0x0a, 0x1a, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x45, 0x0a, 0x14, 0x4c, 0x69, 0x73, 0x74,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x12, 0x2d, 0x0a, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x18, 0x01, 0x20, 0x03,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x08, 0x63, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x22,
+	0x1f, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e,
+	0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x22, 0xc3, 0x01, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68,
+	0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x2e, 0x0a, 0x04, 0x74, 0x79, 0x70, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x04, 0x74,
+	0x79, 0x70, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x66, 0x75, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x5f, 0x74,
+	0x78, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0b, 0x66, 0x75, 0x6e, 0x64, 0x69,
+	0x6e, 0x67, 0x54, 0x78, 0x69, 0x64, 0x12, 0x21, 0x0a, 0x0c, 0x6f, 0x75, 0x74, 0x70, 0x75, 0x74,
+	0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x6f, 0x75,
+	0x74, 0x70, 0x75, 0x74, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x2b, 0x0a, 0x07, 0x63, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x07, 0x63,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x22, 0x78, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63,
+	0x74, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x2e, 0x0a, 0x04, 0x6e, 0x6f, 0x64, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e,
+	0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52,
+	0x04, 0x6e, 0x6f, 0x64, 0x65, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x21, 0x0a,
+	0x0c, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x5f, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x52, 0x0b, 0x64, 0x69, 0x73, 0x70, 0x6c, 0x61, 0x79, 0x4e, 0x61, 0x6d, 0x65,
+	0x22, 0x14, 0x0a, 0x12, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x48, 0x0a, 0x13, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x31, 0x0a,
+	0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32,
+	0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x08, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73,
+	0x22, 0x4c, 0x0a, 0x11, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x4d,
+	0x0a, 0x12, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x37, 0x0a, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x63, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x22, 0x32, 0x0a,
+	0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79,
+	0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x22, 0x51, 0x0a, 0x1d, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x30, 0x0a, 0x07, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x16, 0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a,
+	0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x17, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xcf, 0x09,
+	0x0a, 0x07, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2c,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14,
+	0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x36, 0x36, 0x7d, 0x24, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x32, 0x0a, 0x08,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x16,
+	0xe2, 0xdf, 0x1f, 0x12, 0x0a, 0x0e, 0x5e, 0x5b, 0x61, 0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b,
+	0x36, 0x36, 0x7d, 0x24, 0x20, 0x01, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x0f, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x5f, 0x76, 0x65, 0x72, 0x69, 0x66,
+	0x69, 0x65, 0x64, 0x18, 0x0b, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0e, 0x73, 0x65, 0x6e, 0x64, 0x65,
+	0x72, 0x56, 0x65, 0x72, 0x69, 0x66, 0x69, 0x65, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79,
+	0x6c, 0x6f, 0x61, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c,
+	0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61,
+	0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x2e, 0x0a, 0x0f, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f,
+	0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x46, 0x65,
+	0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x41, 0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x49, 0x0a, 0x12, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x11, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x12, 0x3d, 0x0a, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f,
+	0x72, 0x6f, 0x75, 0x74, 0x65, 0x73, 0x18, 0x0a, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52,
+	0x6f, 0x75, 0x74, 0x65, 0x52, 0x0d, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75,
+	0x74, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18,
+	0x0c, 0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12,
+	0x17, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x0d, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x0e, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x63,
+	0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1d, 0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x5f, 0x69, 0x64, 0x18, 0x0f, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x49, 0x64, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74,
+	0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x10, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f,
+	0x74, 0x6f, 0x18, 0x11, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54,
+	0x6f, 0x12, 0x1e, 0x0a, 0x0b, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x69, 0x64,
+	0x18, 0x12, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x49,
+	0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x13, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x12, 0x1b, 0x0a, 0x09, 0x74, 0x61, 0x72,
+	0x67, 0x65, 0x74, 0x5f, 0x69, 0x64, 0x18, 0x14, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x74, 0x61,
+	0x72, 0x67, 0x65, 0x74, 0x49, 0x64, 0x12, 0x45, 0x0a, 0x10, 0x65, 0x64, 0x69, 0x74, 0x65, 0x64,
+	0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x15, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
+	0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x65, 0x64,
+	0x69, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x18, 0x0a,
+	0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x16, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07,
+	0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x18, 0x17, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61,
+	0x63, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x09, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x73,
+	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x5f, 0x6c, 0x69, 0x6e,
+	0x6b, 0x65, 0x64, 0x18, 0x18, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0d, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x6f, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x74, 0x61, 0x72, 0x67,
+	0x65, 0x74, 0x5f, 0x6c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x18, 0x19, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x0c, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x4c, 0x69, 0x6e, 0x6b, 0x65, 0x64, 0x12, 0x4b, 0x0a,
+	0x13, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73,
+	0x74, 0x61, 0x6d, 0x70, 0x18, 0x1a, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f,
+	0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x12, 0x64, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x65,
+	0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x41, 0x0a, 0x0e, 0x72, 0x65,
+	0x61, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x1b, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0d,
+	0x72, 0x65, 0x61, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x1a, 0x0a,
+	0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x18, 0x1d, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x08, 0x72, 0x65, 0x64, 0x61, 0x63, 0x74, 0x65, 0x64, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x75, 0x74,
+	0x65, 0x64, 0x18, 0x1c, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x22,
+	0x47, 0x0a, 0x0f, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x61, 0x63, 0x74, 0x69,
+	0x6f, 0x6e, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x08, 0x72, 0x65, 0x61, 0x63, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x18,
+	0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x52,
+	0x07, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x73, 0x22, 0xad, 0x01, 0x0a, 0x0c, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x52, 0x6f, 0x75, 0x74, 0x65, 0x12, 0x28, 0x0a, 0x04, 0x68, 0x6f, 0x70,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x50, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x70, 0x52, 0x04, 0x68,
+	0x6f, 0x70, 0x73, 0x12, 0x25, 0x0a, 0x0e, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0d, 0x74, 0x6f, 0x74,
+	0x61, 0x6c, 0x54, 0x69, 0x6d, 0x65, 0x6c, 0x6f, 0x63, 0x6b, 0x12, 0x24, 0x0a, 0x0e, 0x72, 0x6f,
+	0x75, 0x74, 0x65, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x03, 0x52, 0x0c, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x26, 0x0a, 0x0f, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x5f, 0x6d,
+	0x73, 0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0d, 0x72, 0x6f, 0x75, 0x74, 0x65,
+	0x46, 0x65, 0x65, 0x73, 0x4d, 0x73, 0x61, 0x74, 0x22, 0xa6, 0x01, 0x0a, 0x0a, 0x50, 0x61, 0x79,
+	0x6d, 0x65, 0x6e, 0x74, 0x48, 0x6f, 0x70, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49, 0x64,
+	0x12, 0x35, 0x0a, 0x0b, 0x68, 0x6f, 0x70, 0x5f, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x14, 0xe2, 0xdf, 0x1f, 0x10, 0x0a, 0x0e, 0x5e, 0x5b, 0x61,
+	0x2d, 0x7a, 0x30, 0x2d, 0x39, 0x5d, 0x7b, 0x36, 0x36, 0x7d, 0x24, 0x52, 0x0a, 0x68, 0x6f, 0x70,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x2d, 0x0a, 0x13, 0x61, 0x6d, 0x74, 0x5f, 0x74,
+	0x6f, 0x5f, 0x66, 0x6f, 0x72, 0x77, 0x61, 0x72, 0x64, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x03, 0x52, 0x10, 0x61, 0x6d, 0x74, 0x54, 0x6f, 0x46, 0x6f, 0x72, 0x77, 0x61,
+	0x72, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x66, 0x65, 0x65, 0x4d, 0x73, 0x61,
+	0x74, 0x22, 0x72, 0x0a, 0x0e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69, 0x6d, 0x69, 0x74,
+	0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c, 0x66, 0x65, 0x65,
+	0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09, 0x61, 0x6e, 0x6f,
+	0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x61, 0x6e,
+	0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e, 0x63, 0x72, 0x79,
+	0x70, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65, 0x6e, 0x63, 0x72,
+	0x79, 0x70, 0x74, 0x65, 0x64, 0x22, 0xa2, 0x02, 0x0a, 0x16, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12,
+	0x21, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73,
+	0x61, 0x74, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x04, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x5f, 0x74, 0x79, 0x70, 0x65, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43,
+	0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74,
+	0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x5f, 0x74, 0x6f, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79,
+	0x54, 0x6f, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xe8, 0x01, 0x0a, 0x17, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x29, 0x0a, 0x0c, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73,
+	0x73, 0x5f, 0x70, 0x72, 0x6f, 0x62, 0x18, 0x01, 0x20, 0x01, 0x28, 0x01, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x20, 0x01, 0x52, 0x0b, 0x73, 0x75, 0x63, 0x63, 0x65, 0x73, 0x73, 0x50, 0x72, 0x6f,
+	0x62, 0x12, 0x33, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x21, 0x0a, 0x0c, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61,
+	0x64, 0x5f, 0x73, 0x69, 0x7a, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x70, 0x61,
+	0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12, 0x34, 0x0a, 0x16, 0x72, 0x65, 0x6d,
+	0x61, 0x69, 0x6e, 0x69, 0x6e, 0x67, 0x5f, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x5f, 0x73,
+	0x69, 0x7a, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x03, 0x52, 0x14, 0x72, 0x65, 0x6d, 0x61, 0x69,
+	0x6e, 0x69, 0x6e, 0x67, 0x50, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x53, 0x69, 0x7a, 0x65, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x61, 0x72, 0x74, 0x73, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05,
+	0x70, 0x61, 0x72, 0x74, 0x73, 0x22, 0xcd, 0x02, 0x0a, 0x12, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d,
+	0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49,
+	0x64, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x09, 0x52, 0x07, 0x70, 0x61, 0x79, 0x6c, 0x6f, 0x61, 0x64, 0x12, 0x21, 0x0a, 0x08, 0x61,
+	0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x17,
+	0x0a, 0x07, 0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x06, 0x70, 0x61, 0x79, 0x52, 0x65, 0x71, 0x12, 0x32, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x14, 0x0a, 0x05, 0x61,
+	0x73, 0x79, 0x6e, 0x63, 0x18, 0x06, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x61, 0x73, 0x79, 0x6e,
+	0x63, 0x12, 0x3f, 0x0a, 0x0c, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x79, 0x70,
+	0x65, 0x18, 0x07, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65, 0x6e,
+	0x74, 0x54, 0x79, 0x70, 0x65, 0x52, 0x0b, 0x63, 0x6f, 0x6e, 0x74, 0x65, 0x6e, 0x74, 0x54, 0x79,
+	0x70, 0x65, 0x12, 0x19, 0x0a, 0x08, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x5f, 0x74, 0x6f, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x07, 0x72, 0x65, 0x70, 0x6c, 0x79, 0x54, 0x6f, 0x12, 0x16, 0x0a,
+	0x06, 0x74, 0x61, 0x72, 0x67, 0x65, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x74,
+	0x61, 0x72, 0x67, 0x65, 0x74, 0x22, 0xc2, 0x01, 0x0a, 0x13, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x3c, 0x0a,
+	0x0c, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0b,
+	0x73, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73,
+	0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65,
+	0x6e, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61,
+	0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74,
+	0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x22, 0x19, 0x0a, 0x17, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x60, 0x0a, 0x18, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x44, 0x0a, 0x10, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x5f, 0x6d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x42, 0x06,
+	0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0f, 0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0x38, 0x0a, 0x1d, 0x53, 0x75, 0x62, 0x73, 0x63,
+	0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64,
+	0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49,
+	0x64, 0x22, 0x8a, 0x02, 0x0a, 0x1e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d,
+	0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x17, 0x0a, 0x07, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x49, 0x64, 0x12, 0x23, 0x0a,
+	0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x02,
+	0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x49, 0x64, 0x12, 0x1c, 0x0a, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x72, 0x65, 0x63, 0x69, 0x70, 0x69, 0x65, 0x6e, 0x74,
+	0x12, 0x2f, 0x0a, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x17, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x06, 0x73, 0x74, 0x61, 0x74, 0x75,
+	0x73, 0x12, 0x25, 0x0a, 0x0e, 0x66, 0x61, 0x69, 0x6c, 0x75, 0x72, 0x65, 0x5f, 0x72, 0x65, 0x61,
+	0x73, 0x6f, 0x6e, 0x18, 0x05, 0x20, 0x01, 0x28, 0x09, 0x52, 0x0d, 0x66, 0x61, 0x69, 0x6c, 0x75,
+	0x72, 0x65, 0x52, 0x65, 0x61, 0x73, 0x6f, 0x6e, 0x12, 0x34, 0x0a, 0x0c, 0x73, 0x65, 0x6e, 0x74,
+	0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x52, 0x0b, 0x73, 0x65, 0x6e, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x22, 0xf2,
+	0x02, 0x0a, 0x15, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x14, 0x0a, 0x05, 0x71, 0x75, 0x65, 0x72,
+	0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x05, 0x71, 0x75, 0x65, 0x72, 0x79, 0x12, 0x25,
+	0x0a, 0x0e, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x73,
+	0x18, 0x02, 0x20, 0x03, 0x28, 0x04, 0x52, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x64, 0x73, 0x12, 0x16, 0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18,
+	0x03, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x41, 0x0a,
+	0x0e, 0x66, 0x72, 0x6f, 0x6d, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70,
+	0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x52, 0x0d, 0x66, 0x72, 0x6f, 0x6d, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x3d, 0x0a, 0x0c, 0x74, 0x6f, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x05, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0b, 0x74, 0x6f, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12,
+	0x20, 0x0a, 0x0c, 0x6d, 0x69, 0x6e, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18,
+	0x06, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x69, 0x6e, 0x41, 0x6d, 0x74, 0x4d, 0x73, 0x61,
+	0x74, 0x12, 0x20, 0x0a, 0x0c, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x07, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0a, 0x6d, 0x61, 0x78, 0x41, 0x6d, 0x74, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69,
+	0x6f, 0x6e, 0x73, 0x22, 0x4a, 0x0a, 0x16, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x30, 0x0a,
+	0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x16,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68,
+	0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x52, 0x07, 0x72, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x73, 0x22,
+	0x70, 0x0a, 0x0c, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x52, 0x65, 0x73, 0x75, 0x6c, 0x74, 0x12,
+	0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b,
+	0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73,
+	0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x33, 0x0a, 0x0a,
+	0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74, 0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x54, 0x65, 0x78, 0x74,
+	0x52, 0x61, 0x6e, 0x67, 0x65, 0x52, 0x0a, 0x68, 0x69, 0x67, 0x68, 0x6c, 0x69, 0x67, 0x68, 0x74,
+	0x73, 0x22, 0x33, 0x0a, 0x09, 0x54, 0x65, 0x78, 0x74, 0x52, 0x61, 0x6e, 0x67, 0x65, 0x12, 0x14,
+	0x0a, 0x05, 0x73, 0x74, 0x61, 0x72, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x05, 0x73,
+	0x74, 0x61, 0x72, 0x74, 0x12, 0x10, 0x0a, 0x03, 0x65, 0x6e, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x52, 0x03, 0x65, 0x6e, 0x64, 0x22, 0x35, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x0a, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x49, 0x64, 0x22, 0x17, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0xe1, 0x02, 0x0a, 0x0e, 0x44, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69,
+	0x64, 0x12, 0x2a, 0x0a, 0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74,
+	0x73, 0x18, 0x02, 0x20, 0x03, 0x28, 0x09, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x60, 0x01, 0x52,
+	0x0c, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x73, 0x12, 0x35, 0x0a,
+	0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x07, 0x6f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72, 0x65, 0x61,
+	0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0d,
+	0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x1e, 0x0a,
+	0x0b, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x05, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12, 0x38, 0x0a,
+	0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x06, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x52, 0x08, 0x6d,
+	0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x12, 0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74,
+	0x65, 0x64, 0x18, 0x07, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x64, 0x12, 0x37, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x08,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x52,
+	0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x22, 0x4e, 0x0a, 0x0f, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x12, 0x1e, 0x0a,
+	0x0b, 0x6d, 0x61, 0x78, 0x5f, 0x61, 0x67, 0x65, 0x5f, 0x73, 0x65, 0x63, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x09, 0x6d, 0x61, 0x78, 0x41, 0x67, 0x65, 0x53, 0x65, 0x63, 0x12, 0x1b, 0x0a,
+	0x09, 0x6d, 0x61, 0x78, 0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x6d, 0x61, 0x78, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x74, 0x0a, 0x12, 0x44, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61,
+	0x12, 0x14, 0x0a, 0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52,
+	0x05, 0x74, 0x69, 0x74, 0x6c, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05, 0x6d, 0x75, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08,
+	0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e,
+	0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64,
+	0x22, 0x91, 0x01, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f,
+	0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x24, 0x0a, 0x0e, 0x66, 0x65, 0x65, 0x5f, 0x6c, 0x69,
+	0x6d, 0x69, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x0c,
+	0x66, 0x65, 0x65, 0x4c, 0x69, 0x6d, 0x69, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x1c, 0x0a, 0x09,
+	0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52,
+	0x09, 0x61, 0x6e, 0x6f, 0x6e, 0x79, 0x6d, 0x6f, 0x75, 0x73, 0x12, 0x1c, 0x0a, 0x09, 0x65, 0x6e,
+	0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09, 0x65,
+	0x6e, 0x63, 0x72, 0x79, 0x70, 0x74, 0x65, 0x64, 0x12, 0x1a, 0x0a, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x18, 0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x08, 0x72, 0x65, 0x63, 0x65,
+	0x69, 0x70, 0x74, 0x73, 0x22, 0x82, 0x02, 0x0a, 0x15, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x3e,
+	0x0a, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x5f, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x50, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x2f,
+	0x0a, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x19, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x52, 0x05, 0x6f, 0x72, 0x64, 0x65, 0x72, 0x12,
+	0x30, 0x0a, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65, 0x64, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x6c, 0x61,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x08, 0x61, 0x72, 0x63, 0x68, 0x69, 0x76, 0x65,
+	0x64, 0x12, 0x2c, 0x0a, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01, 0x28,
+	0x0e, 0x32, 0x14, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x46, 0x6c, 0x61,
+	0x67, 0x46, 0x69, 0x6c, 0x74, 0x65, 0x72, 0x52, 0x06, 0x70, 0x69, 0x6e, 0x6e, 0x65, 0x64, 0x12,
+	0x18, 0x0a, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x08,
+	0x52, 0x07, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x64, 0x22, 0xe0, 0x01, 0x0a, 0x16, 0x47, 0x65,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x12, 0x40, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e,
+	0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x0a, 0x0c, 0x75, 0x6e, 0x72, 0x65, 0x61, 0x64,
+	0x5f, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x75, 0x6e,
+	0x72, 0x65, 0x61, 0x64, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x34, 0x0a, 0x0c, 0x6c, 0x61, 0x73,
+	0x74, 0x5f, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x52, 0x0b, 0x6c, 0x61, 0x73, 0x74, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12,
+	0x2b, 0x0a, 0x11, 0x70, 0x61, 0x72, 0x74, 0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x5f, 0x6e,
+	0x61, 0x6d, 0x65, 0x73, 0x18, 0x04, 0x20, 0x03, 0x28, 0x09, 0x52, 0x10, 0x70, 0x61, 0x72, 0x74,
+	0x69, 0x63, 0x69, 0x70, 0x61, 0x6e, 0x74, 0x4e, 0x61, 0x6d, 0x65, 0x73, 0x22, 0x79, 0x0a, 0x1f,
+	0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73,
+	0x74, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12,
+	0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f,
+	0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12, 0x3e, 0x0a, 0x0c, 0x70, 0x61, 0x67, 0x65, 0x5f,
+	0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4b, 0x65, 0x79, 0x53, 0x65, 0x74, 0x50,
+	0x61, 0x67, 0x65, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x0b, 0x70, 0x61, 0x67, 0x65,
+	0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x4b, 0x0a, 0x1c, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x6d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x07, 0x6d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x22, 0x30, 0x0a, 0x1e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0xe7, 0x01, 0x0a, 0x1f, 0x47, 0x65, 0x74, 0x44, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69,
+	0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6d,
+	0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x53, 0x65, 0x6e, 0x74, 0x12, 0x2a,
+	0x0a, 0x11, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x72, 0x65, 0x63, 0x65, 0x69,
+	0x76, 0x65, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0f, 0x61, 0x6d, 0x74, 0x4d, 0x73,
+	0x61, 0x74, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6d,
+	0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x5f, 0x66, 0x65, 0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x46, 0x65, 0x65, 0x73, 0x12, 0x23,
+	0x0a, 0x0d, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f, 0x73, 0x65, 0x6e, 0x74, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x53,
+	0x65, 0x6e, 0x74, 0x12, 0x2b, 0x0a, 0x11, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x5f,
+	0x72, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x10,
+	0x6d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x52, 0x65, 0x63, 0x65, 0x69, 0x76, 0x65, 0x64,
+	0x22, 0x58, 0x0a, 0x14, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x40, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x49, 0x6e, 0x66, 0x6f, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x0a,
+	0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x51, 0x0a, 0x15, 0x41, 0x64,
+	0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x12, 0x38, 0x0a, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x6e, 0x66,
+	0x6f, 0x52, 0x0a, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x9b, 0x01,
+	0x0a, 0x1f, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x27, 0x0a, 0x10, 0x6c, 0x61, 0x73, 0x74, 0x5f, 0x72,
+	0x65, 0x61, 0x64, 0x5f, 0x6d, 0x73, 0x67, 0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0d, 0x6c, 0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x4d, 0x73, 0x67, 0x49, 0x64, 0x12,
+	0x2a, 0x0a, 0x11, 0x73, 0x65, 0x6e, 0x64, 0x5f, 0x72, 0x65, 0x61, 0x64, 0x5f, 0x72, 0x65, 0x63,
+	0x65, 0x69, 0x70, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x0f, 0x73, 0x65, 0x6e, 0x64,
+	0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x63, 0x65, 0x69, 0x70, 0x74, 0x22, 0x80, 0x01, 0x0a, 0x17,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c,
+	0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x40, 0x0a, 0x08,
+	0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1c,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x4d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x42, 0x06, 0xe2, 0xdf,
+	0x1f, 0x02, 0x20, 0x01, 0x52, 0x08, 0x6d, 0x65, 0x74, 0x61, 0x64, 0x61, 0x74, 0x61, 0x22, 0x84,
+	0x01, 0x0a, 0x1e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f,
+	0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x3d, 0x0a, 0x07, 0x6f, 0x70, 0x74, 0x69, 0x6f, 0x6e,
+	0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74,
+	0x69, 0x6f, 0x6e, 0x73, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x07, 0x6f, 0x70,
+	0x74, 0x69, 0x6f, 0x6e, 0x73, 0x22, 0x88, 0x01, 0x0a, 0x20, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74,
+	0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12,
+	0x3f, 0x0a, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x06, 0xe2,
+	0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x09, 0x72, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e,
+	0x22, 0x1a, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x47, 0x0a, 0x17,
+	0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x16, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x04, 0x42, 0x06, 0xe2, 0xdf, 0x1f, 0x02, 0x20, 0x01, 0x52, 0x02, 0x69, 0x64, 0x12,
+	0x14, 0x0a, 0x05, 0x70, 0x75, 0x72, 0x67, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x05,
+	0x70, 0x75, 0x72, 0x67, 0x65, 0x22, 0x1a, 0x0a, 0x18, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x2a, 0x0a, 0x18, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a,
+	0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x1b, 0x0a,
+	0x19, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x29, 0x0a, 0x17, 0x45, 0x78,
+	0x70, 0x6f, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x0e, 0x0a, 0x02, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x02, 0x69, 0x64, 0x22, 0x2e, 0x0a, 0x18, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44,
+	0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x12, 0x0a, 0x04, 0x6c, 0x69, 0x6e, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52,
+	0x04, 0x6c, 0x69, 0x6e, 0x65, 0x22, 0x77, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x12, 0x0a,
+	0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d,
+	0x6f, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61, 0x74, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x16, 0x0a, 0x06,
+	0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78,
+	0x70, 0x69, 0x72, 0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18,
+	0x04, 0x20, 0x01, 0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x22, 0x44,
+	0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b, 0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x22, 0x2f, 0x0a, 0x14, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e,
+	0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x17, 0x0a, 0x07,
+	0x70, 0x61, 0x79, 0x5f, 0x72, 0x65, 0x71, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06, 0x70,
+	0x61, 0x79, 0x52, 0x65, 0x71, 0x22, 0x44, 0x0a, 0x15, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x2b,
+	0x0a, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32,
+	0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x52, 0x07, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x22, 0xdd, 0x04, 0x0a, 0x07,
+	0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x12, 0x0a, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x6d, 0x65, 0x6d, 0x6f, 0x12, 0x12, 0x0a, 0x04, 0x68,
+	0x61, 0x73, 0x68, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x04, 0x68, 0x61, 0x73, 0x68, 0x12,
+	0x1a, 0x0a, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x08, 0x70, 0x72, 0x65, 0x69, 0x6d, 0x61, 0x67, 0x65, 0x12, 0x27, 0x0a, 0x0f, 0x70,
+	0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x5f, 0x72, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x0e, 0x70, 0x61, 0x79, 0x6d, 0x65, 0x6e, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x0a, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x05, 0x20, 0x01, 0x28, 0x04, 0x52, 0x09, 0x76, 0x61, 0x6c, 0x75, 0x65, 0x4d,
+	0x73, 0x61, 0x74, 0x12, 0x22, 0x0a, 0x0d, 0x61, 0x6d, 0x74, 0x5f, 0x70, 0x61, 0x69, 0x64, 0x5f,
+	0x6d, 0x73, 0x61, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0b, 0x61, 0x6d, 0x74, 0x50,
+	0x61, 0x69, 0x64, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x47, 0x0a, 0x11, 0x63, 0x72, 0x65, 0x61, 0x74,
+	0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x07, 0x20, 0x01,
+	0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10,
+	0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x64, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x12, 0x47, 0x0a, 0x11, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64, 0x5f, 0x74, 0x69, 0x6d, 0x65,
+	0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69,
+	0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x10, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x64,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x16, 0x0a, 0x06, 0x65, 0x78, 0x70,
+	0x69, 0x72, 0x79, 0x18, 0x09, 0x20, 0x01, 0x28, 0x03, 0x52, 0x06, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x12, 0x18, 0x0a, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x18, 0x0a, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x07, 0x70, 0x72, 0x69, 0x76, 0x61, 0x74, 0x65, 0x12, 0x34, 0x0a, 0x0b, 0x72,
+	0x6f, 0x75, 0x74, 0x65, 0x5f, 0x68, 0x69, 0x6e, 0x74, 0x73, 0x18, 0x0b, 0x20, 0x03, 0x28, 0x0b,
+	0x32, 0x13, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x6f, 0x75, 0x74,
+	0x65, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x0a, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x48, 0x69, 0x6e, 0x74,
+	0x73, 0x12, 0x2c, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x0c, 0x20, 0x01, 0x28, 0x0e,
+	0x32, 0x16, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f,
+	0x69, 0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x1b, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0d, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x08, 0x61, 0x64, 0x64, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12, 0x21, 0x0a, 0x0c,
+	0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x5f, 0x69, 0x6e, 0x64, 0x65, 0x78, 0x18, 0x0e, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0b, 0x73, 0x65, 0x74, 0x74, 0x6c, 0x65, 0x49, 0x6e, 0x64, 0x65, 0x78, 0x12,
+	0x3a, 0x0a, 0x0d, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x5f, 0x68, 0x74, 0x6c, 0x63, 0x73,
+	0x18, 0x0f, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x52, 0x0c, 0x69,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x74, 0x6c, 0x63, 0x73, 0x22, 0x0f, 0x0a, 0x0d, 0x52,
+	0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0x64, 0x0a, 0x0e,
+	0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1a,
+	0x0a, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x08, 0x69, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x70, 0x61,
+	0x79, 0x6d, 0x65, 0x6e, 0x74, 0x73, 0x12, 0x1a, 0x0a, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x04, 0x52, 0x08, 0x6d, 0x65, 0x73, 0x73, 0x61, 0x67,
+	0x65, 0x73, 0x22, 0x3c, 0x0a, 0x15, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x73,
+	0x69, 0x6e, 0x63, 0x65, 0x5f, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x01, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x0c, 0x73, 0x69, 0x6e, 0x63, 0x65, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e,
+	0x22, 0x48, 0x0a, 0x16, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x14, 0x0a, 0x05, 0x63, 0x68,
+	0x75, 0x6e, 0x6b, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0c, 0x52, 0x05, 0x63, 0x68, 0x75, 0x6e, 0x6b,
+	0x12, 0x18, 0x0a, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x04, 0x52, 0x07, 0x76, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x1a, 0x0a, 0x18, 0x53, 0x75,
+	0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x22, 0xa8, 0x01, 0x0a, 0x0e, 0x50, 0x72, 0x65, 0x73, 0x65,
+	0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04,
+	0x52, 0x0c, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16,
+	0x0a, 0x06, 0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x52, 0x06,
+	0x73, 0x65, 0x6e, 0x64, 0x65, 0x72, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x74, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x12, 0x41,
+	0x0a, 0x0e, 0x73, 0x65, 0x6e, 0x74, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e,
+	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x52, 0x0d, 0x73, 0x65, 0x6e, 0x74, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d,
+	0x70, 0x22, 0x4f, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x23, 0x0a, 0x0d, 0x64, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x0c, 0x64, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x49, 0x64, 0x12, 0x16, 0x0a, 0x06, 0x74, 0x79,
+	0x70, 0x69, 0x6e, 0x67, 0x18, 0x02, 0x20, 0x01, 0x28, 0x08, 0x52, 0x06, 0x74, 0x79, 0x70, 0x69,
+	0x6e, 0x67, 0x22, 0x13, 0x0a, 0x11, 0x53, 0x65, 0x74, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x3b, 0x0a, 0x09, 0x52, 0x6f, 0x75, 0x74, 0x65,
+	0x48, 0x69, 0x6e, 0x74, 0x12, 0x2e, 0x0a, 0x09, 0x68, 0x6f, 0x70, 0x5f, 0x68, 0x69, 0x6e, 0x74,
+	0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x48, 0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74, 0x52, 0x08, 0x68, 0x6f, 0x70, 0x48,
+	0x69, 0x6e, 0x74, 0x73, 0x22, 0xa5, 0x01, 0x0a, 0x07, 0x48, 0x6f, 0x70, 0x48, 0x69, 0x6e, 0x74,
+	0x12, 0x16, 0x0a, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x18, 0x01, 0x20, 0x01, 0x28, 0x09,
+	0x52, 0x06, 0x70, 0x75, 0x62, 0x6b, 0x65, 0x79, 0x12, 0x17, 0x0a, 0x07, 0x63, 0x68, 0x61, 0x6e,
+	0x5f, 0x69, 0x64, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63, 0x68, 0x61, 0x6e, 0x49,
+	0x64, 0x12, 0x22, 0x0a, 0x0d, 0x66, 0x65, 0x65, 0x5f, 0x62, 0x61, 0x73, 0x65, 0x5f, 0x6d, 0x73,
+	0x61, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0b, 0x66, 0x65, 0x65, 0x42, 0x61, 0x73,
+	0x65, 0x4d, 0x73, 0x61, 0x74, 0x12, 0x19, 0x0a, 0x08, 0x66, 0x65, 0x65, 0x5f, 0x72, 0x61, 0x74,
+	0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x07, 0x66, 0x65, 0x65, 0x52, 0x61, 0x74, 0x65,
+	0x12, 0x2a, 0x0a, 0x11, 0x63, 0x6c, 0x74, 0x76, 0x5f, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f,
+	0x64, 0x65, 0x6c, 0x74, 0x61, 0x18, 0x05, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0f, 0x63, 0x6c, 0x74,
+	0x76, 0x45, 0x78, 0x70, 0x69, 0x72, 0x79, 0x44, 0x65, 0x6c, 0x74, 0x61, 0x22, 0xa8, 0x02, 0x0a,
+	0x0b, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x12, 0x17, 0x0a, 0x07,
+	0x63, 0x68, 0x61, 0x6e, 0x5f, 0x69, 0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x04, 0x52, 0x06, 0x63,
+	0x68, 0x61, 0x6e, 0x49, 0x64, 0x12, 0x19, 0x0a, 0x08, 0x61, 0x6d, 0x74, 0x5f, 0x6d, 0x73, 0x61,
+	0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x04, 0x52, 0x07, 0x61, 0x6d, 0x74, 0x4d, 0x73, 0x61, 0x74,
+	0x12, 0x30, 0x0a, 0x05, 0x73, 0x74, 0x61, 0x74, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32,
+	0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x52, 0x05, 0x73, 0x74, 0x61,
+	0x74, 0x65, 0x12, 0x45, 0x0a, 0x10, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74, 0x5f, 0x74, 0x69, 0x6d,
+	0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54,
+	0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x52, 0x0f, 0x61, 0x63, 0x63, 0x65, 0x70, 0x74,
+	0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x12, 0x47, 0x0a, 0x11, 0x72, 0x65, 0x73,
+	0x6f, 0x6c, 0x76, 0x65, 0x5f, 0x74, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70, 0x18, 0x05,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x1a, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61, 0x6d, 0x70,
+	0x52, 0x10, 0x72, 0x65, 0x73, 0x6f, 0x6c, 0x76, 0x65, 0x54, 0x69, 0x6d, 0x65, 0x73, 0x74, 0x61,
+	0x6d, 0x70, 0x12, 0x23, 0x0a, 0x0d, 0x65, 0x78, 0x70, 0x69, 0x72, 0x79, 0x5f, 0x68, 0x65, 0x69,
+	0x67, 0x68, 0x74, 0x18, 0x06, 0x20, 0x01, 0x28, 0x05, 0x52, 0x0c, 0x65, 0x78, 0x70, 0x69, 0x72,
+	0x79, 0x48, 0x65, 0x69, 0x67, 0x68, 0x74, 0x2a, 0x62, 0x0a, 0x0c, 0x49, 0x6e, 0x76, 0x6f, 0x69,
+	0x63, 0x65, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a, 0x0c, 0x49, 0x4e, 0x56, 0x4f, 0x49,
+	0x43, 0x45, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x49, 0x4e, 0x56,
+	0x4f, 0x49, 0x43, 0x45, 0x5f, 0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x01, 0x12,
+	0x13, 0x0a, 0x0f, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c,
+	0x45, 0x44, 0x10, 0x02, 0x12, 0x15, 0x0a, 0x11, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f,
+	0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a, 0x63, 0x0a, 0x10, 0x49,
+	0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x48, 0x54, 0x4c, 0x43, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12,
+	0x19, 0x0a, 0x15, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f,
+	0x41, 0x43, 0x43, 0x45, 0x50, 0x54, 0x45, 0x44, 0x10, 0x00, 0x12, 0x18, 0x0a, 0x14, 0x49, 0x4e,
+	0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f, 0x48, 0x54, 0x4c, 0x43, 0x5f, 0x53, 0x45, 0x54, 0x54, 0x4c,
+	0x45, 0x44, 0x10, 0x01, 0x12, 0x1a, 0x0a, 0x16, 0x49, 0x4e, 0x56, 0x4f, 0x49, 0x43, 0x45, 0x5f,
+	0x48, 0x54, 0x4c, 0x43, 0x5f, 0x43, 0x41, 0x4e, 0x43, 0x45, 0x4c, 0x4c, 0x45, 0x44, 0x10, 0x02,
+	0x2a, 0x4b, 0x0a, 0x11, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x43, 0x6c, 0x6f, 0x73, 0x65,
+	0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00,
+	0x12, 0x1b, 0x0a, 0x17, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x43, 0x4c, 0x4f, 0x53,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x46, 0x49, 0x52, 0x4d, 0x45, 0x44, 0x10, 0x01, 0x2a, 0x69, 0x0a,
+	0x0c, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x53, 0x74, 0x61, 0x74, 0x65, 0x12, 0x10, 0x0a,
+	0x0c, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x00, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49,
+	0x4e, 0x47, 0x5f, 0x4f, 0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x19, 0x0a, 0x15, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x43, 0x4c, 0x4f,
+	0x53, 0x45, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f,
+	0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x03, 0x2a, 0xbc, 0x01, 0x0a, 0x10, 0x43, 0x68, 0x61,
+	0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x16, 0x0a,
+	0x12, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x4f,
+	0x50, 0x45, 0x4e, 0x10, 0x00, 0x12, 0x1e, 0x0a, 0x1a, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x5f, 0x4f,
+	0x50, 0x45, 0x4e, 0x10, 0x01, 0x12, 0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x43, 0x4c, 0x4f, 0x53, 0x45, 0x44, 0x10, 0x02, 0x12,
+	0x18, 0x0a, 0x14, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54,
+	0x5f, 0x41, 0x43, 0x54, 0x49, 0x56, 0x45, 0x10, 0x03, 0x12, 0x1a, 0x0a, 0x16, 0x43, 0x48, 0x41,
+	0x4e, 0x4e, 0x45, 0x4c, 0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x49, 0x4e, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x45, 0x10, 0x04, 0x12, 0x20, 0x0a, 0x1c, 0x43, 0x48, 0x41, 0x4e, 0x4e, 0x45, 0x4c,
+	0x5f, 0x45, 0x56, 0x45, 0x4e, 0x54, 0x5f, 0x46, 0x55, 0x4c, 0x4c, 0x59, 0x5f, 0x52, 0x45, 0x53,
+	0x4f, 0x4c, 0x56, 0x45, 0x44, 0x10, 0x05, 0x2a, 0x66, 0x0a, 0x0d, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x13, 0x0a, 0x0f, 0x4d, 0x45, 0x53, 0x53,
+	0x41, 0x47, 0x45, 0x5f, 0x50, 0x45, 0x4e, 0x44, 0x49, 0x4e, 0x47, 0x10, 0x00, 0x12, 0x15, 0x0a,
+	0x11, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x49, 0x4e, 0x5f, 0x46, 0x4c, 0x49, 0x47,
+	0x48, 0x54, 0x10, 0x01, 0x12, 0x15, 0x0a, 0x11, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f,
+	0x53, 0x55, 0x43, 0x43, 0x45, 0x45, 0x44, 0x45, 0x44, 0x10, 0x02, 0x12, 0x12, 0x0a, 0x0e, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x46, 0x41, 0x49, 0x4c, 0x45, 0x44, 0x10, 0x03, 0x2a,
+	0x8a, 0x01, 0x0a, 0x12, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x65,
+	0x6e, 0x74, 0x54, 0x79, 0x70, 0x65, 0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47,
+	0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x54, 0x45, 0x58, 0x54, 0x10, 0x00,
+	0x12, 0x18, 0x0a, 0x14, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54,
+	0x45, 0x4e, 0x54, 0x5f, 0x45, 0x44, 0x49, 0x54, 0x10, 0x01, 0x12, 0x22, 0x0a, 0x1e, 0x4d, 0x45,
+	0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e, 0x54, 0x5f, 0x44, 0x45,
+	0x4c, 0x45, 0x54, 0x45, 0x5f, 0x52, 0x45, 0x51, 0x55, 0x45, 0x53, 0x54, 0x10, 0x02, 0x12, 0x1c,
+	0x0a, 0x18, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x43, 0x4f, 0x4e, 0x54, 0x45, 0x4e,
+	0x54, 0x5f, 0x52, 0x45, 0x41, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x10, 0x03, 0x2a, 0x69, 0x0a, 0x10,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x44, 0x69, 0x72, 0x65, 0x63, 0x74, 0x69, 0x6f, 0x6e,
+	0x12, 0x19, 0x0a, 0x15, 0x4d, 0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45,
+	0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x1a, 0x0a, 0x16, 0x4d,
+	0x45, 0x53, 0x53, 0x41, 0x47, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e,
+	0x5f, 0x53, 0x45, 0x4e, 0x54, 0x10, 0x01, 0x12, 0x1e, 0x0a, 0x1a, 0x4d, 0x45, 0x53, 0x53, 0x41,
+	0x47, 0x45, 0x5f, 0x44, 0x49, 0x52, 0x45, 0x43, 0x54, 0x49, 0x4f, 0x4e, 0x5f, 0x52, 0x45, 0x43,
+	0x45, 0x49, 0x56, 0x45, 0x44, 0x10, 0x02, 0x2a, 0x4e, 0x0a, 0x0f, 0x44, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x72, 0x64, 0x65, 0x72, 0x12, 0x17, 0x0a, 0x13, 0x44, 0x49,
+	0x53, 0x43, 0x55, 0x53, 0x53, 0x49, 0x4f, 0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x49,
+	0x44, 0x10, 0x00, 0x12, 0x22, 0x0a, 0x1e, 0x44, 0x49, 0x53, 0x43, 0x55, 0x53, 0x53, 0x49, 0x4f,
+	0x4e, 0x5f, 0x4f, 0x52, 0x44, 0x45, 0x52, 0x5f, 0x4c, 0x41, 0x53, 0x54, 0x5f, 0x41, 0x43, 0x54,
+	0x49, 0x56, 0x49, 0x54, 0x59, 0x10, 0x01, 0x2a, 0x4d, 0x0a, 0x0a, 0x46, 0x6c, 0x61, 0x67, 0x46,
+	0x69, 0x6c, 0x74, 0x65, 0x72, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x46, 0x49,
+	0x4c, 0x54, 0x45, 0x52, 0x5f, 0x41, 0x4e, 0x59, 0x10, 0x00, 0x12, 0x13, 0x0a, 0x0f, 0x46, 0x4c,
+	0x41, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x53, 0x45, 0x54, 0x10, 0x01, 0x12,
+	0x15, 0x0a, 0x11, 0x46, 0x4c, 0x41, 0x47, 0x5f, 0x46, 0x49, 0x4c, 0x54, 0x45, 0x52, 0x5f, 0x55,
+	0x4e, 0x53, 0x45, 0x54, 0x10, 0x02, 0x32, 0xac, 0x04, 0x0a, 0x0f, 0x4e, 0x6f, 0x64, 0x65, 0x49,
+	0x6e, 0x66, 0x6f, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x3b, 0x0a, 0x0a, 0x47, 0x65,
+	0x74, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x56, 0x65, 0x72, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x11, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x56, 0x65,
+	0x72, 0x73, 0x69, 0x6f, 0x6e, 0x22, 0x00, 0x12, 0x46, 0x0a, 0x0b, 0x47, 0x65, 0x74, 0x53, 0x65,
+	0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c,
+	0x66, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12,
+	0x4f, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x53, 0x65, 0x6c, 0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63,
+	0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c,
+	0x66, 0x42, 0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x6c, 0x66, 0x42,
+	0x61, 0x6c, 0x61, 0x6e, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x43, 0x0a, 0x08, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73, 0x12, 0x19, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x59, 0x0a, 0x13, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e,
+	0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x24, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f,
+	0x64, 0x65, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f,
+	0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00,
+	0x12, 0x55, 0x0a, 0x11, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79,
+	0x41, 0x6c, 0x69, 0x61, 0x73, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4e, 0x6f, 0x64, 0x65, 0x42, 0x79, 0x41, 0x6c, 0x69,
+	0x61, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4e, 0x6f, 0x64, 0x65, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x43, 0x6f, 0x6e, 0x6e, 0x65,
+	0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x43, 0x6f, 0x6e, 0x6e, 0x65, 0x63, 0x74, 0x4e, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xd2, 0x03, 0x0a, 0x0e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65,
+	0x6c, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a, 0x0b, 0x4f, 0x70, 0x65, 0x6e,
+	0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x4f, 0x70, 0x65, 0x6e, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x51, 0x0a, 0x0c, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43,
+	0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x43, 0x6c, 0x6f, 0x73, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x4f, 0x0a, 0x0c, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x12, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x13, 0x4c, 0x69,
+	0x73, 0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c,
+	0x73, 0x12, 0x24, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73,
+	0x74, 0x50, 0x65, 0x6e, 0x64, 0x69, 0x6e, 0x67, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x73, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6f, 0x0a, 0x16, 0x53, 0x75, 0x62,
+	0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65,
+	0x6e, 0x74, 0x73, 0x12, 0x27, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45,
+	0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62,
+	0x65, 0x43, 0x68, 0x61, 0x6e, 0x6e, 0x65, 0x6c, 0x45, 0x76, 0x65, 0x6e, 0x74, 0x73, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xeb, 0x02, 0x0a, 0x0e, 0x43,
+	0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x4c, 0x0a,
+	0x0b, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x73, 0x12, 0x1c, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61,
+	0x63, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x49, 0x0a, 0x0a, 0x41,
+	0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x12, 0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x41, 0x64, 0x64, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5a, 0x0a, 0x11, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x12, 0x22, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e,
+	0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x64, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74,
+	0x61, 0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x27, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f,
+	0x6e, 0x74, 0x61, 0x63, 0x74, 0x42, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x6f, 0x6e, 0x74, 0x61, 0x63, 0x74, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32, 0xb4, 0x04, 0x0a, 0x0e, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x58, 0x0a, 0x0f, 0x45,
+	0x73, 0x74, 0x69, 0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x20,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69, 0x6d, 0x61,
+	0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x1a, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x73, 0x74, 0x69,
+	0x6d, 0x61, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x4c, 0x0a, 0x0b, 0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x12, 0x1c, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x53, 0x65, 0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1d, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65,
+	0x6e, 0x64, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73,
+	0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x30, 0x01, 0x12, 0x6f, 0x0a, 0x16, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65,
+	0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x12, 0x27, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69,
+	0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x28, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61,
+	0x67, 0x65, 0x53, 0x74, 0x61, 0x74, 0x75, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x30, 0x01, 0x12, 0x55, 0x0a, 0x0e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x53, 0x65, 0x61, 0x72, 0x63, 0x68, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x44,
+	0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65, 0x73, 0x73, 0x61, 0x67, 0x65, 0x12, 0x1e, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x4d, 0x65,
+	0x73, 0x73, 0x61, 0x67, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x32,
+	0xe5, 0x08, 0x0a, 0x11, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x57, 0x0a, 0x0e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63,
+	0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63,
+	0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x71,
+	0x0a, 0x18, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48,
+	0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x12, 0x29, 0x2e, 0x73, 0x65, 0x72,
+	0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73,
+	0x69, 0x6f, 0x6e, 0x48, 0x69, 0x73, 0x74, 0x6f, 0x72, 0x79, 0x42, 0x79, 0x49, 0x44, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x26, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x48, 0x69,
+	0x73, 0x74, 0x6f, 0x72, 0x79, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30,
+	0x01, 0x12, 0x70, 0x0a, 0x17, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x12, 0x28, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53, 0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x47, 0x65, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x53,
+	0x74, 0x61, 0x74, 0x69, 0x73, 0x74, 0x69, 0x63, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x00, 0x12, 0x52, 0x0a, 0x0d, 0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x12, 0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x41, 0x64, 0x64, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73,
+	0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6b, 0x0a, 0x18, 0x55, 0x70, 0x64, 0x61, 0x74,
+	0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c, 0x61, 0x73, 0x74, 0x52,
+	0x65, 0x61, 0x64, 0x12, 0x29, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55,
+	0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4c,
+	0x61, 0x73, 0x74, 0x52, 0x65, 0x61, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22,
+	0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73,
+	0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73,
+	0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x00, 0x12, 0x69, 0x0a, 0x17, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x12, 0x28, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x4f, 0x70, 0x74, 0x69, 0x6f, 0x6e, 0x73, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69,
+	0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x6d, 0x0a, 0x19,
+	0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e,
+	0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x12, 0x2a, 0x2e, 0x73, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x73, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75,
+	0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x74, 0x65, 0x6e, 0x74, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f,
+	0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5b, 0x0a, 0x10, 0x52,
+	0x65, 0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12,
+	0x21, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65,
+	0x6d, 0x6f, 0x76, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5e, 0x0a, 0x11, 0x52, 0x65, 0x73, 0x74,
+	0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x22, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x74, 0x6f, 0x72, 0x65,
+	0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x1a, 0x23, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73,
+	0x74, 0x6f, 0x72, 0x65, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65,
+	0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x5d, 0x0a, 0x10, 0x45, 0x78, 0x70, 0x6f,
+	0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x12, 0x21, 0x2e, 0x73,
+	0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72, 0x74, 0x44, 0x69,
+	0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x45, 0x78, 0x70, 0x6f, 0x72,
+	0x74, 0x44, 0x69, 0x73, 0x63, 0x75, 0x73, 0x73, 0x69, 0x6f, 0x6e, 0x52, 0x65, 0x73, 0x70, 0x6f,
+	0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xb8, 0x01, 0x0a, 0x0e, 0x50, 0x61, 0x79, 0x6d,
+	0x65, 0x6e, 0x74, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12, 0x52, 0x0a, 0x0d, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12, 0x1e, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1f, 0x2e, 0x73, 0x65,
+	0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x49, 0x6e, 0x76,
+	0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x12, 0x52,
+	0x0a, 0x0d, 0x4c, 0x6f, 0x6f, 0x6b, 0x75, 0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x12,
+	0x1e, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x4c, 0x6f, 0x6f, 0x6b, 0x75,
+	0x70, 0x49, 0x6e, 0x76, 0x6f, 0x69, 0x63, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x32, 0xa6, 0x01, 0x0a, 0x0c, 0x41, 0x64, 0x6d, 0x69, 0x6e, 0x53, 0x65, 0x72, 0x76,
+	0x69, 0x63, 0x65, 0x12, 0x3d, 0x0a, 0x06, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x12, 0x17, 0x2e,
+	0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65,
+	0x73, 0x2e, 0x52, 0x65, 0x73, 0x79, 0x6e, 0x63, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65,
+	0x22, 0x00, 0x12, 0x57, 0x0a, 0x0e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61,
+	0x62, 0x61, 0x73, 0x65, 0x12, 0x1f, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x20, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73,
+	0x2e, 0x42, 0x61, 0x63, 0x6b, 0x75, 0x70, 0x44, 0x61, 0x74, 0x61, 0x62, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x30, 0x01, 0x32, 0xb0, 0x01, 0x0a, 0x0f,
+	0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x53, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x12,
+	0x55, 0x0a, 0x11, 0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65, 0x73,
+	0x65, 0x6e, 0x63, 0x65, 0x12, 0x22, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e,
+	0x53, 0x75, 0x62, 0x73, 0x63, 0x72, 0x69, 0x62, 0x65, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x18, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69,
+	0x63, 0x65, 0x73, 0x2e, 0x50, 0x72, 0x65, 0x73, 0x65, 0x6e, 0x63, 0x65, 0x55, 0x70, 0x64, 0x61,
+	0x74, 0x65, 0x22, 0x00, 0x30, 0x01, 0x12, 0x46, 0x0a, 0x09, 0x53, 0x65, 0x74, 0x54, 0x79, 0x70,
+	0x69, 0x6e, 0x67, 0x12, 0x1a, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53,
+	0x65, 0x74, 0x54, 0x79, 0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
+	0x1b, 0x2e, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x2e, 0x53, 0x65, 0x74, 0x54, 0x79,
+	0x70, 0x69, 0x6e, 0x67, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x00, 0x42, 0x29,
+	0x5a, 0x27, 0x67, 0x69, 0x74, 0x68, 0x75, 0x62, 0x2e, 0x63, 0x6f, 0x6d, 0x2f, 0x63, 0x31, 0x33,
+	0x6e, 0x2d, 0x69, 0x6f, 0x2f, 0x63, 0x31, 0x33, 0x6e, 0x2d, 0x67, 0x6f, 0x2f, 0x72, 0x70, 0x63,
+	0x2f, 0x73, 0x65, 0x72, 0x76, 0x69, 0x63, 0x65, 0x73, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x33,
+}
+
+var (
+	file_rpc_services_rpc_proto_rawDescOnce sync.Once
+	file_rpc_services_rpc_proto_rawDescData = file_rpc_services_rpc_proto_rawDesc
+)
+
+func file_rpc_services_rpc_proto_rawDescGZIP() []byte {
+	file_rpc_services_rpc_proto_rawDescOnce.Do(func() {
+		file_rpc_services_rpc_proto_rawDescData = protoimpl.X.CompressGZIP(file_rpc_services_rpc_proto_rawDescData)
+	})
+	return file_rpc_services_rpc_proto_rawDescData
+}
+
+var file_rpc_services_rpc_proto_enumTypes = make([]protoimpl.EnumInfo, 10)
+var file_rpc_services_rpc_proto_msgTypes = make([]protoimpl.MessageInfo, 91)
+var file_rpc_services_rpc_proto_goTypes = []interface{}{
+	(InvoiceState)(0),                        // 0: services.InvoiceState
+	(InvoiceHTLCState)(0),                    // 1: services.InvoiceHTLCState
+	(ChannelCloseState)(0),                   // 2: services.ChannelCloseState
+	(ChannelState)(0),                        // 3: services.ChannelState
+	(ChannelEventType)(0),                    // 4: services.ChannelEventType
+	(MessageStatus)(0),                       // 5: services.MessageStatus
+	(MessageContentType)(0),                  // 6: services.MessageContentType
+	(MessageDirection)(0),                    // 7: services.MessageDirection
+	(DiscussionOrder)(0),                     // 8: services.DiscussionOrder
+	(FlagFilter)(0),                          // 9: services.FlagFilter
+	(*KeySetPageOptions)(nil),                // 10: services.KeySetPageOptions
+	(*VersionRequest)(nil),                   // 11: services.VersionRequest
+	(*Version)(nil),                          // 12: services.Version
+	(*NodeInfo)(nil),                         // 13: services.NodeInfo
+	(*SelfInfoRequest)(nil),                  // 14: services.SelfInfoRequest
+	(*Chain)(nil),                            // 15: services.Chain
+	(*SelfInfoResponse)(nil),                 // 16: services.SelfInfoResponse
+	(*SelfBalanceRequest)(nil),               // 17: services.SelfBalanceRequest
+	(*SelfBalanceResponse)(nil),              // 18: services.SelfBalanceResponse
+	(*GetNodesRequest)(nil),                  // 19: services.GetNodesRequest
+	(*SearchNodeByAddressRequest)(nil),       // 20: services.SearchNodeByAddressRequest
+	(*SearchNodeByAliasRequest)(nil),         // 21: services.SearchNodeByAliasRequest
+	(*NodeInfoResponse)(nil),                 // 22: services.NodeInfoResponse
+	(*ConnectNodeRequest)(nil),               // 23: services.ConnectNodeRequest
+	(*ConnectNodeResponse)(nil),              // 24: services.ConnectNodeResponse
+	(*OpenChannelRequest)(nil),               // 25: services.OpenChannelRequest
+	(*OpenChannelResponse)(nil),              // 26: services.OpenChannelResponse
+	(*CloseChannelRequest)(nil),              // 27: services.CloseChannelRequest
+	(*CloseChannelResponse)(nil),             // 28: services.CloseChannelResponse
+	(*Channel)(nil),                          // 29: services.Channel
+	(*ListChannelsRequest)(nil),              // 30: services.ListChannelsRequest
+	(*ListPendingChannelsRequest)(nil),       // 31: services.ListPendingChannelsRequest
+	(*ListChannelsResponse)(nil),             // 32: services.ListChannelsResponse
+	(*SubscribeChannelEventsRequest)(nil),    // 33: services.SubscribeChannelEventsRequest
+	(*SubscribeChannelEventsResponse)(nil),   // 34: services.SubscribeChannelEventsResponse
+	(*ContactInfo)(nil),                      // 35: services.ContactInfo
+	(*GetContactsRequest)(nil),               // 36: services.GetContactsRequest
+	(*GetContactsResponse)(nil),              // 37: services.GetContactsResponse
+	(*AddContactRequest)(nil),                // 38: services.AddContactRequest
+	(*AddContactResponse)(nil),               // 39: services.AddContactResponse
+	(*RemoveContactByIDRequest)(nil),         // 40: services.RemoveContactByIDRequest
+	(*RemoveContactByAddressRequest)(nil),    // 41: services.RemoveContactByAddressRequest
+	(*RemoveContactResponse)(nil),            // 42: services.RemoveContactResponse
+	(*Message)(nil),                          // 43: services.Message
+	(*MessageReaction)(nil),                  // 44: services.MessageReaction
+	(*PaymentRoute)(nil),                     // 45: services.PaymentRoute
+	(*PaymentHop)(nil),                       // 46: services.PaymentHop
+	(*MessageOptions)(nil),                   // 47: services.MessageOptions
+	(*EstimateMessageRequest)(nil),           // 48: services.EstimateMessageRequest
+	(*EstimateMessageResponse)(nil),          // 49: services.EstimateMessageResponse
+	(*SendMessageRequest)(nil),               // 50: services.SendMessageRequest
+	(*SendMessageResponse)(nil),              // 51: services.SendMessageResponse
+	(*SubscribeMessageRequest)(nil),          // 52: services.SubscribeMessageRequest
+	(*SubscribeMessageResponse)(nil),         // 53: services.SubscribeMessageResponse
+	(*SubscribeMessageStatusRequest)(nil),    // 54: services.SubscribeMessageStatusRequest
+	(*SubscribeMessageStatusResponse)(nil),   // 55: services.SubscribeMessageStatusResponse
+	(*SearchMessagesRequest)(nil),            // 56: services.SearchMessagesRequest
+	(*SearchMessagesResponse)(nil),           // 57: services.SearchMessagesResponse
+	(*SearchResult)(nil),                     // 58: services.SearchResult
+	(*TextRange)(nil),                        // 59: services.TextRange
+	(*DeleteMessageRequest)(nil),             // 60: services.DeleteMessageRequest
+	(*DeleteMessageResponse)(nil),            // 61: services.DeleteMessageResponse
+	(*DiscussionInfo)(nil),                   // 62: services.DiscussionInfo
+	(*RetentionPolicy)(nil),                  // 63: services.RetentionPolicy
+	(*DiscussionMetadata)(nil),               // 64: services.DiscussionMetadata
+	(*DiscussionOptions)(nil),                // 65: services.DiscussionOptions
+	(*GetDiscussionsRequest)(nil),            // 66: services.GetDiscussionsRequest
+	(*GetDiscussionsResponse)(nil),           // 67: services.GetDiscussionsResponse
+	(*GetDiscussionHistoryByIDRequest)(nil),  // 68: services.GetDiscussionHistoryByIDRequest
+	(*GetDiscussionHistoryResponse)(nil),     // 69: services.GetDiscussionHistoryResponse
+	(*GetDiscussionStatisticsRequest)(nil),   // 70: services.GetDiscussionStatisticsRequest
+	(*GetDiscussionStatisticsResponse)(nil),  // 71: services.GetDiscussionStatisticsResponse
+	(*AddDiscussionRequest)(nil),             // 72: services.AddDiscussionRequest
+	(*AddDiscussionResponse)(nil),            // 73: services.AddDiscussionResponse
+	(*UpdateDiscussionLastReadRequest)(nil),  // 74: services.UpdateDiscussionLastReadRequest
+	(*UpdateDiscussionRequest)(nil),          // 75: services.UpdateDiscussionRequest
+	(*UpdateDiscussionOptionsRequest)(nil),   // 76: services.UpdateDiscussionOptionsRequest
+	(*UpdateDiscussionRetentionRequest)(nil), // 77: services.UpdateDiscussionRetentionRequest
+	(*UpdateDiscussionResponse)(nil),         // 78: services.UpdateDiscussionResponse
+	(*RemoveDiscussionRequest)(nil),          // 79: services.RemoveDiscussionRequest
+	(*RemoveDiscussionResponse)(nil),         // 80: services.RemoveDiscussionResponse
+	(*RestoreDiscussionRequest)(nil),         // 81: services.RestoreDiscussionRequest
+	(*RestoreDiscussionResponse)(nil),        // 82: services.RestoreDiscussionResponse
+	(*ExportDiscussionRequest)(nil),          // 83: services.ExportDiscussionRequest
+	(*ExportDiscussionResponse)(nil),         // 84: services.ExportDiscussionResponse
+	(*CreateInvoiceRequest)(nil),             // 85: services.CreateInvoiceRequest
+	(*CreateInvoiceResponse)(nil),            // 86: services.CreateInvoiceResponse
+	(*LookupInvoiceRequest)(nil),             // 87: services.LookupInvoiceRequest
+	(*LookupInvoiceResponse)(nil),            // 88: services.LookupInvoiceResponse
+	(*Invoice)(nil),                          // 89: services.Invoice
+	(*ResyncRequest)(nil),                    // 90: services.ResyncRequest
+	(*ResyncResponse)(nil),                   // 91: services.ResyncResponse
+	(*BackupDatabaseRequest)(nil),            // 92: services.BackupDatabaseRequest
+	(*BackupDatabaseResponse)(nil),           // 93: services.BackupDatabaseResponse
+	(*SubscribePresenceRequest)(nil),         // 94: services.SubscribePresenceRequest
+	(*PresenceUpdate)(nil),                   // 95: services.PresenceUpdate
+	(*SetTypingRequest)(nil),                 // 96: services.SetTypingRequest
+	(*SetTypingResponse)(nil),                // 97: services.SetTypingResponse
+	(*RouteHint)(nil),                        // 98: services.RouteHint
+	(*HopHint)(nil),                          // 99: services.HopHint
+	(*InvoiceHTLC)(nil),                      // 100: services.InvoiceHTLC
+	(*timestamppb.Timestamp)(nil),            // 101: google.protobuf.Timestamp
+}
+var file_rpc_services_rpc_proto_depIdxs = []int32{
+	101, // 0: services.KeySetPageOptions.from_timestamp:type_name -> google.protobuf.Timestamp
+	101, // 1: services.KeySetPageOptions.to_timestamp:type_name -> google.protobuf.Timestamp
+	7,   // 2: services.KeySetPageOptions.direction:type_name -> services.MessageDirection
+	13,  // 3: services.SelfInfoResponse.info:type_name -> services.NodeInfo
+	15,  // 4: services.SelfInfoResponse.chains:type_name -> services.Chain
+	13,  // 5: services.NodeInfoResponse.nodes:type_name -> services.NodeInfo
+	2,   // 6: services.CloseChannelResponse.state:type_name -> services.ChannelCloseState
+	3,   // 7: services.Channel.state:type_name -> services.ChannelState
+	29,  // 8: services.ListChannelsResponse.channels:type_name -> services.Channel
+	4,   // 9: services.SubscribeChannelEventsResponse.type:type_name -> services.ChannelEventType
+	29,  // 10: services.SubscribeChannelEventsResponse.channel:type_name -> services.Channel
+	13,  // 11: services.ContactInfo.node:type_name -> services.NodeInfo
+	35,  // 12: services.GetContactsResponse.contacts:type_name -> services.ContactInfo
+	35,  // 13: services.AddContactRequest.contact:type_name -> services.ContactInfo
+	35,  // 14: services.AddContactResponse.contact:type_name -> services.ContactInfo
+	101, // 15: services.Message.sent_timestamp:type_name -> google.protobuf.Timestamp
+	101, // 16: services.Message.received_timestamp:type_name -> google.protobuf.Timestamp
+	45,  // 17: services.Message.payment_routes:type_name -> services.PaymentRoute
+	6,   // 18: services.Message.content_type:type_name -> services.MessageContentType
+	101, // 19: services.Message.edited_timestamp:type_name -> google.protobuf.Timestamp
+	44,  // 20: services.Message.reactions:type_name -> services.MessageReaction
+	101, // 21: services.Message.delivered_timestamp:type_name -> google.protobuf.Timestamp
+	101, // 22: services.Message.read_timestamp:type_name -> google.protobuf.Timestamp
+	46,  // 23: services.PaymentRoute.hops:type_name -> services.PaymentHop
+	47,  // 24: services.EstimateMessageRequest.options:type_name -> services.MessageOptions
+	6,   // 25: services.EstimateMessageRequest.content_type:type_name -> services.MessageContentType
+	43,  // 26: services.EstimateMessageResponse.message:type_name -> services.Message
+	47,  // 27: services.SendMessageRequest.options:type_name -> services.MessageOptions
+	6,   // 28: services.SendMessageRequest.content_type:type_name -> services.MessageContentType
+	43,  // 29: services.SendMessageResponse.sent_message:type_name -> services.Message
+	5,   // 30: services.SendMessageResponse.status:type_name -> services.MessageStatus
+	43,  // 31: services.SubscribeMessageResponse.received_message:type_name -> services.Message
+	5,   // 32: services.SubscribeMessageStatusResponse.status:type_name -> services.MessageStatus
+	43,  // 33: services.SubscribeMessageStatusResponse.sent_message:type_name -> services.Message
+	101, // 34: services.SearchMessagesRequest.from_timestamp:type_name -> google.protobuf.Timestamp
+	101, // 35: services.SearchMessagesRequest.to_timestamp:type_name -> google.protobuf.Timestamp
+	10,  // 36: services.SearchMessagesRequest.page_options:type_name -> services.KeySetPageOptions
+	58,  // 37: services.SearchMessagesResponse.results:type_name -> services.SearchResult
+	43,  // 38: services.SearchResult.message:type_name -> services.Message
+	59,  // 39: services.SearchResult.highlights:type_name -> services.TextRange
+	65,  // 40: services.DiscussionInfo.options:type_name -> services.DiscussionOptions
+	64,  // 41: services.DiscussionInfo.metadata:type_name -> services.DiscussionMetadata
+	63,  // 42: services.DiscussionInfo.retention:type_name -> services.RetentionPolicy
+	10,  // 43: services.GetDiscussionsRequest.page_options:type_name -> services.KeySetPageOptions
+	8,   // 44: services.GetDiscussionsRequest.order:type_name -> services.DiscussionOrder
+	9,   // 45: services.GetDiscussionsRequest.archived:type_name -> services.FlagFilter
+	9,   // 46: services.GetDiscussionsRequest.pinned:type_name -> services.FlagFilter
+	62,  // 47: services.GetDiscussionsResponse.discussion:type_name -> services.DiscussionInfo
+	43,  // 48: services.GetDiscussionsResponse.last_message:type_name -> services.Message
+	10,  // 49: services.GetDiscussionHistoryByIDRequest.page_options:type_name -> services.KeySetPageOptions
+	43,  // 50: services.GetDiscussionHistoryResponse.message:type_name -> services.Message
+	62,  // 51: services.AddDiscussionRequest.discussion:type_name -> services.DiscussionInfo
+	62,  // 52: services.AddDiscussionResponse.discussion:type_name -> services.DiscussionInfo
+	64,  // 53: services.UpdateDiscussionRequest.metadata:type_name -> services.DiscussionMetadata
+	65,  // 54: services.UpdateDiscussionOptionsRequest.options:type_name -> services.DiscussionOptions
+	63,  // 55: services.UpdateDiscussionRetentionRequest.retention:type_name -> services.RetentionPolicy
+	89,  // 56: services.CreateInvoiceResponse.invoice:type_name -> services.Invoice
+	89,  // 57: services.LookupInvoiceResponse.invoice:type_name -> services.Invoice
+	101, // 58: services.Invoice.created_timestamp:type_name -> google.protobuf.Timestamp
+	101, // 59: services.Invoice.settled_timestamp:type_name -> google.protobuf.Timestamp
+	98,  // 60: services.Invoice.route_hints:type_name -> services.RouteHint
+	0,   // 61: services.Invoice.state:type_name -> services.InvoiceState
+	100, // 62: services.Invoice.invoice_htlcs:type_name -> services.InvoiceHTLC
+	101, // 63: services.PresenceUpdate.sent_timestamp:type_name -> google.protobuf.Timestamp
+	99,  // 64: services.RouteHint.hop_hints:type_name -> services.HopHint
+	1,   // 65: services.InvoiceHTLC.state:type_name -> services.InvoiceHTLCState
+	101, // 66: services.InvoiceHTLC.accept_timestamp:type_name -> google.protobuf.Timestamp
+	101, // 67: services.InvoiceHTLC.resolve_timestamp:type_name -> google.protobuf.Timestamp
+	11,  // 68: services.NodeInfoService.GetVersion:input_type -> services.VersionRequest
+	14,  // 69: services.NodeInfoService.GetSelfInfo:input_type -> services.SelfInfoRequest
+	17,  // 70: services.NodeInfoService.GetSelfBalance:input_type -> services.SelfBalanceRequest
+	19,  // 71: services.NodeInfoService.GetNodes:input_type -> services.GetNodesRequest
+	20,  // 72: services.NodeInfoService.SearchNodeByAddress:input_type -> services.SearchNodeByAddressRequest
+	21,  // 73: services.NodeInfoService.SearchNodeByAlias:input_type -> services.SearchNodeByAliasRequest
+	23,  // 74: services.NodeInfoService.ConnectNode:input_type -> services.ConnectNodeRequest
+	25,  // 75: services.ChannelService.OpenChannel:input_type -> services.OpenChannelRequest
+	27,  // 76: services.ChannelService.CloseChannel:input_type -> services.CloseChannelRequest
+	30,  // 77: services.ChannelService.ListChannels:input_type -> services.ListChannelsRequest
+	31,  // 78: services.ChannelService.ListPendingChannels:input_type -> services.ListPendingChannelsRequest
+	33,  // 79: services.ChannelService.SubscribeChannelEvents:input_type -> services.SubscribeChannelEventsRequest
+	36,  // 80: services.ContactService.GetContacts:input_type -> services.GetContactsRequest
+	38,  // 81: services.ContactService.AddContact:input_type -> services.AddContactRequest
+	40,  // 82: services.ContactService.RemoveContactByID:input_type -> services.RemoveContactByIDRequest
+	41,  // 83: services.ContactService.RemoveContactByAddress:input_type -> services.RemoveContactByAddressRequest
+	48,  // 84: services.MessageService.EstimateMessage:input_type -> services.EstimateMessageRequest
+	50,  // 85: services.MessageService.SendMessage:input_type -> services.SendMessageRequest
+	52,  // 86: services.MessageService.SubscribeMessages:input_type -> services.SubscribeMessageRequest
+	54,  // 87: services.MessageService.SubscribeMessageStatus:input_type -> services.SubscribeMessageStatusRequest
+	56,  // 88: services.MessageService.SearchMessages:input_type -> services.SearchMessagesRequest
+	60,  // 89: services.MessageService.DeleteMessage:input_type -> services.DeleteMessageRequest
+	66,  // 90: services.DiscussionService.GetDiscussions:input_type -> services.GetDiscussionsRequest
+	68,  // 91: services.DiscussionService.GetDiscussionHistoryByID:input_type -> services.GetDiscussionHistoryByIDRequest
+	70,  // 92: services.DiscussionService.GetDiscussionStatistics:input_type -> services.GetDiscussionStatisticsRequest
+	72,  // 93: services.DiscussionService.AddDiscussion:input_type -> services.AddDiscussionRequest
+	74,  // 94: services.DiscussionService.UpdateDiscussionLastRead:input_type -> services.UpdateDiscussionLastReadRequest
+	75,  // 95: services.DiscussionService.UpdateDiscussion:input_type -> services.UpdateDiscussionRequest
+	76,  // 96: services.DiscussionService.UpdateDiscussionOptions:input_type -> services.UpdateDiscussionOptionsRequest
+	77,  // 97: services.DiscussionService.UpdateDiscussionRetention:input_type -> services.UpdateDiscussionRetentionRequest
+	79,  // 98: services.DiscussionService.RemoveDiscussion:input_type -> services.RemoveDiscussionRequest
+	81,  // 99: services.DiscussionService.RestoreDiscussion:input_type -> services.RestoreDiscussionRequest
+	83,  // 100: services.DiscussionService.ExportDiscussion:input_type -> services.ExportDiscussionRequest
+	85,  // 101: services.PaymentService.CreateInvoice:input_type -> services.CreateInvoiceRequest
+	87,  // 102: services.PaymentService.LookupInvoice:input_type -> services.LookupInvoiceRequest
+	90,  // 103: services.AdminService.Resync:input_type -> services.ResyncRequest
+	92,  // 104: services.AdminService.BackupDatabase:input_type -> services.BackupDatabaseRequest
+	94,  // 105: services.PresenceService.SubscribePresence:input_type -> services.SubscribePresenceRequest
+	96,  // 106: services.PresenceService.SetTyping:input_type -> services.SetTypingRequest
+	12,  // 107: services.NodeInfoService.GetVersion:output_type -> services.Version
+	16,  // 108: services.NodeInfoService.GetSelfInfo:output_type -> services.SelfInfoResponse
+	18,  // 109: services.NodeInfoService.GetSelfBalance:output_type -> services.SelfBalanceResponse
+	22,  // 110: services.NodeInfoService.GetNodes:output_type -> services.NodeInfoResponse
+	22,  // 111: services.NodeInfoService.SearchNodeByAddress:output_type -> services.NodeInfoResponse
+	22,  // 112: services.NodeInfoService.SearchNodeByAlias:output_type -> services.NodeInfoResponse
+	24,  // 113: services.NodeInfoService.ConnectNode:output_type -> services.ConnectNodeResponse
+	26,  // 114: services.ChannelService.OpenChannel:output_type -> services.OpenChannelResponse
+	28,  // 115: services.ChannelService.CloseChannel:output_type -> services.CloseChannelResponse
+	32,  // 116: services.ChannelService.ListChannels:output_type -> services.ListChannelsResponse
+	32,  // 117: services.ChannelService.ListPendingChannels:output_type -> services.ListChannelsResponse
+	34,  // 118: services.ChannelService.SubscribeChannelEvents:output_type -> services.SubscribeChannelEventsResponse
+	37,  // 119: services.ContactService.GetContacts:output_type -> services.GetContactsResponse
+	39,  // 120: services.ContactService.AddContact:output_type -> services.AddContactResponse
+	42,  // 121: services.ContactService.RemoveContactByID:output_type -> services.RemoveContactResponse
+	42,  // 122: services.ContactService.RemoveContactByAddress:output_type -> services.RemoveContactResponse
+	49,  // 123: services.MessageService.EstimateMessage:output_type -> services.EstimateMessageResponse
+	51,  // 124: services.MessageService.SendMessage:output_type -> services.SendMessageResponse
+	53,  // 125: services.MessageService.SubscribeMessages:output_type -> services.SubscribeMessageResponse
+	55,  // 126: services.MessageService.SubscribeMessageStatus:output_type -> services.SubscribeMessageStatusResponse
+	57,  // 127: services.MessageService.SearchMessages:output_type -> services.SearchMessagesResponse
+	61,  // 128: services.MessageService.DeleteMessage:output_type -> services.DeleteMessageResponse
+	67,  // 129: services.DiscussionService.GetDiscussions:output_type -> services.GetDiscussionsResponse
+	69,  // 130: services.DiscussionService.GetDiscussionHistoryByID:output_type -> services.GetDiscussionHistoryResponse
+	71,  // 131: services.DiscussionService.GetDiscussionStatistics:output_type -> services.GetDiscussionStatisticsResponse
+	73,  // 132: services.DiscussionService.AddDiscussion:output_type -> services.AddDiscussionResponse
+	78,  // 133: services.DiscussionService.UpdateDiscussionLastRead:output_type -> services.UpdateDiscussionResponse
+	78,  // 134: services.DiscussionService.UpdateDiscussion:output_type -> services.UpdateDiscussionResponse
+	78,  // 135: services.DiscussionService.UpdateDiscussionOptions:output_type -> services.UpdateDiscussionResponse
+	78,  // 136: services.DiscussionService.UpdateDiscussionRetention:output_type -> services.UpdateDiscussionResponse
+	80,  // 137: services.DiscussionService.RemoveDiscussion:output_type -> services.RemoveDiscussionResponse
+	82,  // 138: services.DiscussionService.RestoreDiscussion:output_type -> services.RestoreDiscussionResponse
+	84,  // 139: services.DiscussionService.ExportDiscussion:output_type -> services.ExportDiscussionResponse
+	86,  // 140: services.PaymentService.CreateInvoice:output_type -> services.CreateInvoiceResponse
+	88,  // 141: services.PaymentService.LookupInvoice:output_type -> services.LookupInvoiceResponse
+	91,  // 142: services.AdminService.Resync:output_type -> services.ResyncResponse
+	93,  // 143: services.AdminService.BackupDatabase:output_type -> services.BackupDatabaseResponse
+	95,  // 144: services.PresenceService.SubscribePresence:output_type -> services.PresenceUpdate
+	97,  // 145: services.PresenceService.SetTyping:output_type -> services.SetTypingResponse
+	107, // [107:146] is the sub-list for method output_type
+	68,  // [68:107] is the sub-list for method input_type
+	68,  // [68:68] is the sub-list for extension type_name
+	68,  // [68:68] is the sub-list for extension extendee
+	0,   // [0:68] is the sub-list for field type_name
+}
+
+func init() { file_rpc_services_rpc_proto_init() }
+func file_rpc_services_rpc_proto_init() {
+	if File_rpc_services_rpc_proto != nil {
+		return
+	}
+	if !protoimpl.UnsafeEnabled {
+		file_rpc_services_rpc_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*KeySetPageOptions); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*VersionRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Version); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfo); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfInfoRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Chain); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfBalanceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SelfBalanceResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetNodesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchNodeByAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchNodeByAliasRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*NodeInfoResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectNodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ConnectNodeResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*OpenChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseChannelRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CloseChannelResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Channel); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListPendingChannelsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListChannelsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeChannelEventsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeChannelEventsResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ContactInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4391,8 +8280,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*VersionRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4403,8 +8292,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Version); i {
+		file_rpc_services_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetContactsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4415,8 +8304,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeInfo); i {
+		file_rpc_services_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddContactRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4427,8 +8316,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfInfoRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4439,8 +8328,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Chain); i {
+		file_rpc_services_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContactByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4451,8 +8340,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfInfoResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContactByAddressRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4463,8 +8352,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfBalanceRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveContactResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4475,8 +8364,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SelfBalanceResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*Message); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4487,8 +8376,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetNodesRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageReaction); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4499,8 +8388,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchNodeByAddressRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentRoute); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4511,8 +8400,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SearchNodeByAliasRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PaymentHop); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4523,8 +8412,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*NodeInfoResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MessageOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4535,8 +8424,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectNodeRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4547,8 +8436,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ConnectNodeResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*EstimateMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4559,8 +8448,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenChannelRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4571,8 +8460,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*OpenChannelResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SendMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4583,8 +8472,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ContactInfo); i {
+		file_rpc_services_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4595,8 +8484,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactsRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4607,8 +8496,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetContactsResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMessageStatusRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4619,8 +8508,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddContactRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribeMessageStatusResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4631,8 +8520,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddContactResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMessagesRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4643,8 +8532,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveContactByIDRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchMessagesResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4655,8 +8544,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveContactByAddressRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SearchResult); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4667,8 +8556,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveContactResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*TextRange); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4679,8 +8568,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*Message); i {
+		file_rpc_services_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMessageRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4691,8 +8580,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaymentRoute); i {
+		file_rpc_services_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteMessageResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4703,8 +8592,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[27].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PaymentHop); i {
+		file_rpc_services_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscussionInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4715,8 +8604,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[28].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*MessageOptions); i {
+		file_rpc_services_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RetentionPolicy); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4727,8 +8616,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[29].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateMessageRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscussionMetadata); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4739,8 +8628,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[30].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*EstimateMessageResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DiscussionOptions); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4751,8 +8640,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[31].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMessageRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiscussionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4763,8 +8652,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[32].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SendMessageResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[57].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiscussionsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4775,8 +8664,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[33].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeMessageRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[58].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiscussionHistoryByIDRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4787,8 +8676,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[34].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*SubscribeMessageResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[59].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiscussionHistoryResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4799,8 +8688,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[35].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscussionInfo); i {
+		file_rpc_services_rpc_proto_msgTypes[60].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiscussionStatisticsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4811,8 +8700,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[36].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DiscussionOptions); i {
+		file_rpc_services_rpc_proto_msgTypes[61].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*GetDiscussionStatisticsResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4823,8 +8712,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[37].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDiscussionsRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[62].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDiscussionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4835,8 +8724,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[38].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDiscussionsResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[63].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*AddDiscussionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4847,8 +8736,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[39].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDiscussionHistoryByIDRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[64].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDiscussionLastReadRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4859,8 +8748,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[40].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDiscussionHistoryResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[65].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDiscussionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4871,8 +8760,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[41].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDiscussionStatisticsRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[66].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDiscussionOptionsRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4883,8 +8772,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[42].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*GetDiscussionStatisticsResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[67].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDiscussionRetentionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4895,8 +8784,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[43].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddDiscussionRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[68].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateDiscussionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4907,8 +8796,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[44].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddDiscussionResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[69].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDiscussionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4919,8 +8808,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[45].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDiscussionLastReadRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[70].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemoveDiscussionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4931,8 +8820,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[46].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*UpdateDiscussionResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[71].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDiscussionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4943,8 +8832,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[47].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveDiscussionRequest); i {
+		file_rpc_services_rpc_proto_msgTypes[72].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RestoreDiscussionResponse); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4955,8 +8844,8 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[48].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*RemoveDiscussionResponse); i {
+		file_rpc_services_rpc_proto_msgTypes[73].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDiscussionRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -4967,7 +8856,19 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[49].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[74].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ExportDiscussionResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[75].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateInvoiceRequest); i {
 			case 0:
 				return &v.state
@@ -4979,7 +8880,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[50].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[76].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*CreateInvoiceResponse); i {
 			case 0:
 				return &v.state
@@ -4991,7 +8892,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[51].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[77].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupInvoiceRequest); i {
 			case 0:
 				return &v.state
@@ -5003,7 +8904,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[52].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[78].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*LookupInvoiceResponse); i {
 			case 0:
 				return &v.state
@@ -5015,7 +8916,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[53].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[79].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*Invoice); i {
 			case 0:
 				return &v.state
@@ -5027,7 +8928,103 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[54].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[80].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResyncRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[81].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ResyncResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[82].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupDatabaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[83].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*BackupDatabaseResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[84].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SubscribePresenceRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[85].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PresenceUpdate); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[86].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTypingRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[87].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SetTypingResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_rpc_services_rpc_proto_msgTypes[88].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*RouteHint); i {
 			case 0:
 				return &v.state
@@ -5039,7 +9036,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[55].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[89].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*HopHint); i {
 			case 0:
 				return &v.state
@@ -5051,7 +9048,7 @@ func file_rpc_services_rpc_proto_init() {
 				return nil
 			}
 		}
-		file_rpc_services_rpc_proto_msgTypes[56].Exporter = func(v interface{}, i int) interface{} {
+		file_rpc_services_rpc_proto_msgTypes[90].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*InvoiceHTLC); i {
 			case 0:
 				return &v.state
@@ -5069,10 +9066,10 @@ func file_rpc_services_rpc_proto_init() {
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_rpc_services_rpc_proto_rawDesc,
-			NumEnums:      2,
-			NumMessages:   57,
+			NumEnums:      10,
+			NumMessages:   91,
 			NumExtensions: 0,
-			NumServices:   6,
+			NumServices:   8,
 		},
 		GoTypes:           file_rpc_services_rpc_proto_goTypes,
 		DependencyIndexes: file_rpc_services_rpc_proto_depIdxs,
@@ -20,6 +20,16 @@ var _ = fmt.Errorf
 var _ = math.Inf
 
 func (this *KeySetPageOptions) Validate() error {
+	if this.FromTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FromTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FromTimestamp", err)
+		}
+	}
+	if this.ToTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ToTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ToTimestamp", err)
+		}
+	}
 	return nil
 }
 func (this *VersionRequest) Validate() error {
@@ -110,6 +120,45 @@ func (this *OpenChannelRequest) Validate() error {
 func (this *OpenChannelResponse) Validate() error {
 	return nil
 }
+func (this *CloseChannelRequest) Validate() error {
+	if this.FundingTxid == "" {
+		return github_com_mwitkow_go_proto_validators.FieldError("FundingTxid", fmt.Errorf(`value '%v' must not be an empty string`, this.FundingTxid))
+	}
+	return nil
+}
+func (this *CloseChannelResponse) Validate() error {
+	return nil
+}
+func (this *Channel) Validate() error {
+	return nil
+}
+func (this *ListChannelsRequest) Validate() error {
+	return nil
+}
+func (this *ListPendingChannelsRequest) Validate() error {
+	return nil
+}
+func (this *ListChannelsResponse) Validate() error {
+	for _, item := range this.Channels {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Channels", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SubscribeChannelEventsRequest) Validate() error {
+	return nil
+}
+func (this *SubscribeChannelEventsResponse) Validate() error {
+	if this.Channel != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Channel); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Channel", err)
+		}
+	}
+	return nil
+}
 func (this *ContactInfo) Validate() error {
 	if nil == this.Node {
 		return github_com_mwitkow_go_proto_validators.FieldError("Node", fmt.Errorf("message must exist"))
@@ -199,6 +248,31 @@ func (this *Message) Validate() error {
 			}
 		}
 	}
+	if this.EditedTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.EditedTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("EditedTimestamp", err)
+		}
+	}
+	for _, item := range this.Reactions {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Reactions", err)
+			}
+		}
+	}
+	if this.DeliveredTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.DeliveredTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("DeliveredTimestamp", err)
+		}
+	}
+	if this.ReadTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ReadTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ReadTimestamp", err)
+		}
+	}
+	return nil
+}
+func (this *MessageReaction) Validate() error {
 	return nil
 }
 func (this *PaymentRoute) Validate() error {
@@ -275,6 +349,69 @@ func (this *SubscribeMessageResponse) Validate() error {
 	}
 	return nil
 }
+func (this *SubscribeMessageStatusRequest) Validate() error {
+	return nil
+}
+func (this *SubscribeMessageStatusResponse) Validate() error {
+	if this.SentMessage != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.SentMessage); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("SentMessage", err)
+		}
+	}
+	return nil
+}
+func (this *SearchMessagesRequest) Validate() error {
+	if this.FromTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.FromTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("FromTimestamp", err)
+		}
+	}
+	if this.ToTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.ToTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("ToTimestamp", err)
+		}
+	}
+	if this.PageOptions != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.PageOptions); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("PageOptions", err)
+		}
+	}
+	return nil
+}
+func (this *SearchMessagesResponse) Validate() error {
+	for _, item := range this.Results {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Results", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *SearchResult) Validate() error {
+	if this.Message != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Message); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Message", err)
+		}
+	}
+	for _, item := range this.Highlights {
+		if item != nil {
+			if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(item); err != nil {
+				return github_com_mwitkow_go_proto_validators.FieldError("Highlights", err)
+			}
+		}
+	}
+	return nil
+}
+func (this *TextRange) Validate() error {
+	return nil
+}
+func (this *DeleteMessageRequest) Validate() error {
+	return nil
+}
+func (this *DeleteMessageResponse) Validate() error {
+	return nil
+}
 func (this *DiscussionInfo) Validate() error {
 	if len(this.Participants) < 1 {
 		return github_com_mwitkow_go_proto_validators.FieldError("Participants", fmt.Errorf(`value '%v' must contain at least 1 elements`, this.Participants))
@@ -284,12 +421,33 @@ func (this *DiscussionInfo) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Options", err)
 		}
 	}
+	if this.Metadata != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Metadata); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Metadata", err)
+		}
+	}
+	if this.Retention != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Retention); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Retention", err)
+		}
+	}
+	return nil
+}
+func (this *RetentionPolicy) Validate() error {
+	return nil
+}
+func (this *DiscussionMetadata) Validate() error {
 	return nil
 }
 func (this *DiscussionOptions) Validate() error {
 	return nil
 }
 func (this *GetDiscussionsRequest) Validate() error {
+	if this.PageOptions != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.PageOptions); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("PageOptions", err)
+		}
+	}
 	return nil
 }
 func (this *GetDiscussionsResponse) Validate() error {
@@ -301,6 +459,11 @@ func (this *GetDiscussionsResponse) Validate() error {
 			return github_com_mwitkow_go_proto_validators.FieldError("Discussion", err)
 		}
 	}
+	if this.LastMessage != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.LastMessage); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("LastMessage", err)
+		}
+	}
 	return nil
 }
 func (this *GetDiscussionHistoryByIDRequest) Validate() error {
@@ -347,6 +510,39 @@ func (this *AddDiscussionResponse) Validate() error {
 func (this *UpdateDiscussionLastReadRequest) Validate() error {
 	return nil
 }
+func (this *UpdateDiscussionRequest) Validate() error {
+	if nil == this.Metadata {
+		return github_com_mwitkow_go_proto_validators.FieldError("Metadata", fmt.Errorf("message must exist"))
+	}
+	if this.Metadata != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Metadata); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Metadata", err)
+		}
+	}
+	return nil
+}
+func (this *UpdateDiscussionOptionsRequest) Validate() error {
+	if nil == this.Options {
+		return github_com_mwitkow_go_proto_validators.FieldError("Options", fmt.Errorf("message must exist"))
+	}
+	if this.Options != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Options); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Options", err)
+		}
+	}
+	return nil
+}
+func (this *UpdateDiscussionRetentionRequest) Validate() error {
+	if nil == this.Retention {
+		return github_com_mwitkow_go_proto_validators.FieldError("Retention", fmt.Errorf("message must exist"))
+	}
+	if this.Retention != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.Retention); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("Retention", err)
+		}
+	}
+	return nil
+}
 func (this *UpdateDiscussionResponse) Validate() error {
 	return nil
 }
@@ -356,6 +552,18 @@ func (this *RemoveDiscussionRequest) Validate() error {
 func (this *RemoveDiscussionResponse) Validate() error {
 	return nil
 }
+func (this *RestoreDiscussionRequest) Validate() error {
+	return nil
+}
+func (this *RestoreDiscussionResponse) Validate() error {
+	return nil
+}
+func (this *ExportDiscussionRequest) Validate() error {
+	return nil
+}
+func (this *ExportDiscussionResponse) Validate() error {
+	return nil
+}
 func (this *CreateInvoiceRequest) Validate() error {
 	return nil
 }
@@ -405,6 +613,35 @@ func (this *Invoice) Validate() error {
 	}
 	return nil
 }
+func (this *ResyncRequest) Validate() error {
+	return nil
+}
+func (this *ResyncResponse) Validate() error {
+	return nil
+}
+func (this *BackupDatabaseRequest) Validate() error {
+	return nil
+}
+func (this *BackupDatabaseResponse) Validate() error {
+	return nil
+}
+func (this *SubscribePresenceRequest) Validate() error {
+	return nil
+}
+func (this *PresenceUpdate) Validate() error {
+	if this.SentTimestamp != nil {
+		if err := github_com_mwitkow_go_proto_validators.CallValidatorIfExists(this.SentTimestamp); err != nil {
+			return github_com_mwitkow_go_proto_validators.FieldError("SentTimestamp", err)
+		}
+	}
+	return nil
+}
+func (this *SetTypingRequest) Validate() error {
+	return nil
+}
+func (this *SetTypingResponse) Validate() error {
+	return nil
+}
 func (this *RouteHint) Validate() error {
 	for _, item := range this.HopHints {
 		if item != nil {
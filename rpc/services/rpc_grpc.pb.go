@@ -375,6 +375,24 @@ type ChannelServiceClient interface {
 	//Returns immediately after the funding transaction has been published,
 	//but does not wait for the channel to be considered open.
 	OpenChannel(ctx context.Context, in *OpenChannelRequest, opts ...grpc.CallOption) (*OpenChannelResponse, error)
+	//*
+	//Closes a channel.
+	//
+	//Returns a stream of channel closing updates,
+	//which terminates once the closing transaction has been confirmed.
+	CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (ChannelService_CloseChannelClient, error)
+	//*
+	//Lists the open channels of the current node.
+	ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	//*
+	//Lists the channels of the current node pending opening or closing.
+	ListPendingChannels(ctx context.Context, in *ListPendingChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error)
+	//*
+	//Subscribes to channel events.
+	//
+	//Returns a stream of events about channels
+	//being opened, closed, activated or deactivated.
+	SubscribeChannelEvents(ctx context.Context, in *SubscribeChannelEventsRequest, opts ...grpc.CallOption) (ChannelService_SubscribeChannelEventsClient, error)
 }
 
 type channelServiceClient struct {
@@ -394,6 +412,88 @@ func (c *channelServiceClient) OpenChannel(ctx context.Context, in *OpenChannelR
 	return out, nil
 }
 
+func (c *channelServiceClient) CloseChannel(ctx context.Context, in *CloseChannelRequest, opts ...grpc.CallOption) (ChannelService_CloseChannelClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChannelService_ServiceDesc.Streams[0], "/services.ChannelService/CloseChannel", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &channelServiceCloseChannelClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChannelService_CloseChannelClient interface {
+	Recv() (*CloseChannelResponse, error)
+	grpc.ClientStream
+}
+
+type channelServiceCloseChannelClient struct {
+	grpc.ClientStream
+}
+
+func (x *channelServiceCloseChannelClient) Recv() (*CloseChannelResponse, error) {
+	m := new(CloseChannelResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *channelServiceClient) ListChannels(ctx context.Context, in *ListChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, "/services.ChannelService/ListChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) ListPendingChannels(ctx context.Context, in *ListPendingChannelsRequest, opts ...grpc.CallOption) (*ListChannelsResponse, error) {
+	out := new(ListChannelsResponse)
+	err := c.cc.Invoke(ctx, "/services.ChannelService/ListPendingChannels", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *channelServiceClient) SubscribeChannelEvents(ctx context.Context, in *SubscribeChannelEventsRequest, opts ...grpc.CallOption) (ChannelService_SubscribeChannelEventsClient, error) {
+	stream, err := c.cc.NewStream(ctx, &ChannelService_ServiceDesc.Streams[1], "/services.ChannelService/SubscribeChannelEvents", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &channelServiceSubscribeChannelEventsClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type ChannelService_SubscribeChannelEventsClient interface {
+	Recv() (*SubscribeChannelEventsResponse, error)
+	grpc.ClientStream
+}
+
+type channelServiceSubscribeChannelEventsClient struct {
+	grpc.ClientStream
+}
+
+func (x *channelServiceSubscribeChannelEventsClient) Recv() (*SubscribeChannelEventsResponse, error) {
+	m := new(SubscribeChannelEventsResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // ChannelServiceServer is the server API for ChannelService service.
 // All implementations must embed UnimplementedChannelServiceServer
 // for forward compatibility
@@ -404,6 +504,24 @@ type ChannelServiceServer interface {
 	//Returns immediately after the funding transaction has been published,
 	//but does not wait for the channel to be considered open.
 	OpenChannel(context.Context, *OpenChannelRequest) (*OpenChannelResponse, error)
+	//*
+	//Closes a channel.
+	//
+	//Returns a stream of channel closing updates,
+	//which terminates once the closing transaction has been confirmed.
+	CloseChannel(*CloseChannelRequest, ChannelService_CloseChannelServer) error
+	//*
+	//Lists the open channels of the current node.
+	ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error)
+	//*
+	//Lists the channels of the current node pending opening or closing.
+	ListPendingChannels(context.Context, *ListPendingChannelsRequest) (*ListChannelsResponse, error)
+	//*
+	//Subscribes to channel events.
+	//
+	//Returns a stream of events about channels
+	//being opened, closed, activated or deactivated.
+	SubscribeChannelEvents(*SubscribeChannelEventsRequest, ChannelService_SubscribeChannelEventsServer) error
 	mustEmbedUnimplementedChannelServiceServer()
 }
 
@@ -414,6 +532,18 @@ type UnimplementedChannelServiceServer struct {
 func (UnimplementedChannelServiceServer) OpenChannel(context.Context, *OpenChannelRequest) (*OpenChannelResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OpenChannel not implemented")
 }
+func (UnimplementedChannelServiceServer) CloseChannel(*CloseChannelRequest, ChannelService_CloseChannelServer) error {
+	return status.Errorf(codes.Unimplemented, "method CloseChannel not implemented")
+}
+func (UnimplementedChannelServiceServer) ListChannels(context.Context, *ListChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) ListPendingChannels(context.Context, *ListPendingChannelsRequest) (*ListChannelsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListPendingChannels not implemented")
+}
+func (UnimplementedChannelServiceServer) SubscribeChannelEvents(*SubscribeChannelEventsRequest, ChannelService_SubscribeChannelEventsServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeChannelEvents not implemented")
+}
 func (UnimplementedChannelServiceServer) mustEmbedUnimplementedChannelServiceServer() {}
 
 // UnsafeChannelServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -445,6 +575,84 @@ func _ChannelService_OpenChannel_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ChannelService_CloseChannel_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(CloseChannelRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChannelServiceServer).CloseChannel(m, &channelServiceCloseChannelServer{stream})
+}
+
+type ChannelService_CloseChannelServer interface {
+	Send(*CloseChannelResponse) error
+	grpc.ServerStream
+}
+
+type channelServiceCloseChannelServer struct {
+	grpc.ServerStream
+}
+
+func (x *channelServiceCloseChannelServer) Send(m *CloseChannelResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _ChannelService_ListChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.ChannelService/ListChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListChannels(ctx, req.(*ListChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_ListPendingChannels_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListPendingChannelsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ChannelServiceServer).ListPendingChannels(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.ChannelService/ListPendingChannels",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ChannelServiceServer).ListPendingChannels(ctx, req.(*ListPendingChannelsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ChannelService_SubscribeChannelEvents_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeChannelEventsRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(ChannelServiceServer).SubscribeChannelEvents(m, &channelServiceSubscribeChannelEventsServer{stream})
+}
+
+type ChannelService_SubscribeChannelEventsServer interface {
+	Send(*SubscribeChannelEventsResponse) error
+	grpc.ServerStream
+}
+
+type channelServiceSubscribeChannelEventsServer struct {
+	grpc.ServerStream
+}
+
+func (x *channelServiceSubscribeChannelEventsServer) Send(m *SubscribeChannelEventsResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // ChannelService_ServiceDesc is the grpc.ServiceDesc for ChannelService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -456,8 +664,27 @@ var ChannelService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "OpenChannel",
 			Handler:    _ChannelService_OpenChannel_Handler,
 		},
+		{
+			MethodName: "ListChannels",
+			Handler:    _ChannelService_ListChannels_Handler,
+		},
+		{
+			MethodName: "ListPendingChannels",
+			Handler:    _ChannelService_ListPendingChannels_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "CloseChannel",
+			Handler:       _ChannelService_CloseChannel_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "SubscribeChannelEvents",
+			Handler:       _ChannelService_SubscribeChannelEvents_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/services/rpc.proto",
 }
 
@@ -696,12 +923,24 @@ type MessageServiceClient interface {
 	//
 	//In case of failure (payment amount too large or small, payload too large),
 	//an empty response is returned.
+	//
+	//The response also reports the encoded payload size, the number of parts
+	//the message would be split in and the onion space remaining
+	//on the most constrained estimated route.
 	EstimateMessage(ctx context.Context, in *EstimateMessageRequest, opts ...grpc.CallOption) (*EstimateMessageResponse, error)
 	//*
 	//Sends a message
 	//
 	//In case of failure (payment amount too large or small, payload too large),
 	//an empty response is returned.
+	//
+	//Payloads too large for a single payment are transparently split
+	//into ordered parts, each sent as a separate payment.
+	//Every part but the first carries 1000 millisatoshi,
+	//the remainder of the amount being carried by the first part.
+	//
+	//If async is set, the call returns as soon as the message is accepted
+	//for sending, and its delivery can be followed via SubscribeMessageStatus.
 	SendMessage(ctx context.Context, in *SendMessageRequest, opts ...grpc.CallOption) (*SendMessageResponse, error)
 	//*
 	//Creates a unidirectional stream from server to client
@@ -709,6 +948,31 @@ type MessageServiceClient interface {
 	//
 	//The stream does not terminate until the client stops it.
 	SubscribeMessages(ctx context.Context, in *SubscribeMessageRequest, opts ...grpc.CallOption) (MessageService_SubscribeMessagesClient, error)
+	//*
+	//Creates a unidirectional stream from server to client
+	//over which delivery status updates of sent messages are sent.
+	//
+	//If a send id is specified, the stream starts with the current status
+	//of the send operation, so that a client subscribing after
+	//the final update, or reconnecting, still receives it.
+	//The stream does not terminate until the client stops it.
+	SubscribeMessageStatus(ctx context.Context, in *SubscribeMessageStatusRequest, opts ...grpc.CallOption) (MessageService_SubscribeMessageStatusClient, error)
+	//*
+	//Searches the message history for messages matching the requested filters.
+	//
+	//A message matches the query if its payload contains, for every query term,
+	//a word starting with that term (case-insensitive).
+	//Edits, delete requests and reactions are applied to the matching messages,
+	//and deleted messages, as well as messages of deleted discussions, are omitted.
+	SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error)
+	//*
+	//Deletes a message locally.
+	//
+	//The message payload is redacted, along with the payloads of
+	//the edits, delete requests and reactions targeting it,
+	//while its invoice or payments are retained for balance history.
+	//No delete request is sent to the discussion participants.
+	DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*DeleteMessageResponse, error)
 }
 
 type messageServiceClient struct {
@@ -769,6 +1033,56 @@ func (x *messageServiceSubscribeMessagesClient) Recv() (*SubscribeMessageRespons
 	return m, nil
 }
 
+func (c *messageServiceClient) SubscribeMessageStatus(ctx context.Context, in *SubscribeMessageStatusRequest, opts ...grpc.CallOption) (MessageService_SubscribeMessageStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &MessageService_ServiceDesc.Streams[1], "/services.MessageService/SubscribeMessageStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &messageServiceSubscribeMessageStatusClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type MessageService_SubscribeMessageStatusClient interface {
+	Recv() (*SubscribeMessageStatusResponse, error)
+	grpc.ClientStream
+}
+
+type messageServiceSubscribeMessageStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *messageServiceSubscribeMessageStatusClient) Recv() (*SubscribeMessageStatusResponse, error) {
+	m := new(SubscribeMessageStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *messageServiceClient) SearchMessages(ctx context.Context, in *SearchMessagesRequest, opts ...grpc.CallOption) (*SearchMessagesResponse, error) {
+	out := new(SearchMessagesResponse)
+	err := c.cc.Invoke(ctx, "/services.MessageService/SearchMessages", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *messageServiceClient) DeleteMessage(ctx context.Context, in *DeleteMessageRequest, opts ...grpc.CallOption) (*DeleteMessageResponse, error) {
+	out := new(DeleteMessageResponse)
+	err := c.cc.Invoke(ctx, "/services.MessageService/DeleteMessage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MessageServiceServer is the server API for MessageService service.
 // All implementations must embed UnimplementedMessageServiceServer
 // for forward compatibility
@@ -778,12 +1092,24 @@ type MessageServiceServer interface {
 	//
 	//In case of failure (payment amount too large or small, payload too large),
 	//an empty response is returned.
+	//
+	//The response also reports the encoded payload size, the number of parts
+	//the message would be split in and the onion space remaining
+	//on the most constrained estimated route.
 	EstimateMessage(context.Context, *EstimateMessageRequest) (*EstimateMessageResponse, error)
 	//*
 	//Sends a message
 	//
 	//In case of failure (payment amount too large or small, payload too large),
 	//an empty response is returned.
+	//
+	//Payloads too large for a single payment are transparently split
+	//into ordered parts, each sent as a separate payment.
+	//Every part but the first carries 1000 millisatoshi,
+	//the remainder of the amount being carried by the first part.
+	//
+	//If async is set, the call returns as soon as the message is accepted
+	//for sending, and its delivery can be followed via SubscribeMessageStatus.
 	SendMessage(context.Context, *SendMessageRequest) (*SendMessageResponse, error)
 	//*
 	//Creates a unidirectional stream from server to client
@@ -791,6 +1117,31 @@ type MessageServiceServer interface {
 	//
 	//The stream does not terminate until the client stops it.
 	SubscribeMessages(*SubscribeMessageRequest, MessageService_SubscribeMessagesServer) error
+	//*
+	//Creates a unidirectional stream from server to client
+	//over which delivery status updates of sent messages are sent.
+	//
+	//If a send id is specified, the stream starts with the current status
+	//of the send operation, so that a client subscribing after
+	//the final update, or reconnecting, still receives it.
+	//The stream does not terminate until the client stops it.
+	SubscribeMessageStatus(*SubscribeMessageStatusRequest, MessageService_SubscribeMessageStatusServer) error
+	//*
+	//Searches the message history for messages matching the requested filters.
+	//
+	//A message matches the query if its payload contains, for every query term,
+	//a word starting with that term (case-insensitive).
+	//Edits, delete requests and reactions are applied to the matching messages,
+	//and deleted messages, as well as messages of deleted discussions, are omitted.
+	SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error)
+	//*
+	//Deletes a message locally.
+	//
+	//The message payload is redacted, along with the payloads of
+	//the edits, delete requests and reactions targeting it,
+	//while its invoice or payments are retained for balance history.
+	//No delete request is sent to the discussion participants.
+	DeleteMessage(context.Context, *DeleteMessageRequest) (*DeleteMessageResponse, error)
 	mustEmbedUnimplementedMessageServiceServer()
 }
 
@@ -807,6 +1158,15 @@ func (UnimplementedMessageServiceServer) SendMessage(context.Context, *SendMessa
 func (UnimplementedMessageServiceServer) SubscribeMessages(*SubscribeMessageRequest, MessageService_SubscribeMessagesServer) error {
 	return status.Errorf(codes.Unimplemented, "method SubscribeMessages not implemented")
 }
+func (UnimplementedMessageServiceServer) SubscribeMessageStatus(*SubscribeMessageStatusRequest, MessageService_SubscribeMessageStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribeMessageStatus not implemented")
+}
+func (UnimplementedMessageServiceServer) SearchMessages(context.Context, *SearchMessagesRequest) (*SearchMessagesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SearchMessages not implemented")
+}
+func (UnimplementedMessageServiceServer) DeleteMessage(context.Context, *DeleteMessageRequest) (*DeleteMessageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteMessage not implemented")
+}
 func (UnimplementedMessageServiceServer) mustEmbedUnimplementedMessageServiceServer() {}
 
 // UnsafeMessageServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -877,6 +1237,63 @@ func (x *messageServiceSubscribeMessagesServer) Send(m *SubscribeMessageResponse
 	return x.ServerStream.SendMsg(m)
 }
 
+func _MessageService_SubscribeMessageStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeMessageStatusRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(MessageServiceServer).SubscribeMessageStatus(m, &messageServiceSubscribeMessageStatusServer{stream})
+}
+
+type MessageService_SubscribeMessageStatusServer interface {
+	Send(*SubscribeMessageStatusResponse) error
+	grpc.ServerStream
+}
+
+type messageServiceSubscribeMessageStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *messageServiceSubscribeMessageStatusServer) Send(m *SubscribeMessageStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _MessageService_SearchMessages_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SearchMessagesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).SearchMessages(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.MessageService/SearchMessages",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).SearchMessages(ctx, req.(*SearchMessagesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _MessageService_DeleteMessage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteMessageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MessageServiceServer).DeleteMessage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.MessageService/DeleteMessage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MessageServiceServer).DeleteMessage(ctx, req.(*DeleteMessageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // MessageService_ServiceDesc is the grpc.ServiceDesc for MessageService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -892,6 +1309,14 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "SendMessage",
 			Handler:    _MessageService_SendMessage_Handler,
 		},
+		{
+			MethodName: "SearchMessages",
+			Handler:    _MessageService_SearchMessages_Handler,
+		},
+		{
+			MethodName: "DeleteMessage",
+			Handler:    _MessageService_DeleteMessage_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -899,6 +1324,11 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _MessageService_SubscribeMessages_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "SubscribeMessageStatus",
+			Handler:       _MessageService_SubscribeMessageStatus_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc/services/rpc.proto",
 }
@@ -909,7 +1339,9 @@ var MessageService_ServiceDesc = grpc.ServiceDesc{
 type DiscussionServiceClient interface {
 	//*
 	//Creates a unidirectional stream from server to client
-	//over which all discussions' info are sent.
+	//over which the requested range of discussions' info are sent,
+	//along with their unread message count, last message
+	//and participant display names.
 	//
 	//The stream terminates when all discussion info is transmitted.
 	GetDiscussions(ctx context.Context, in *GetDiscussionsRequest, opts ...grpc.CallOption) (DiscussionService_GetDiscussionsClient, error)
@@ -918,6 +1350,9 @@ type DiscussionServiceClient interface {
 	//over which all previously exchanged messages belonging to
 	//a specific discussion are sent.
 	//
+	//Replies reference the message they reply to, while edits,
+	//delete requests and reactions are applied to the message they target.
+	//
 	//The stream terminates when all discussion history is transmitted.
 	GetDiscussionHistoryByID(ctx context.Context, in *GetDiscussionHistoryByIDRequest, opts ...grpc.CallOption) (DiscussionService_GetDiscussionHistoryByIDClient, error)
 	//*
@@ -930,11 +1365,51 @@ type DiscussionServiceClient interface {
 	//Updates a discussion's last read message.
 	UpdateDiscussionLastRead(ctx context.Context, in *UpdateDiscussionLastReadRequest, opts ...grpc.CallOption) (*UpdateDiscussionResponse, error)
 	//*
-	//Remove a discussion from the database.
-	RemoveDiscussion(ctx context.Context, in *RemoveDiscussionRequest, opts ...grpc.CallOption) (*RemoveDiscussionResponse, error)
-}
-
-type discussionServiceClient struct {
+	//Updates a discussion's metadata.
+	//
+	//The discussion metadata is replaced by the one provided in the request.
+	UpdateDiscussion(ctx context.Context, in *UpdateDiscussionRequest, opts ...grpc.CallOption) (*UpdateDiscussionResponse, error)
+	//*
+	//Updates a discussion's options.
+	//
+	//The discussion options are replaced by the ones provided in the request.
+	//Anonymous options are disallowed for group discussions,
+	//as are anonymous encrypted options.
+	//If the fee limit is not set, the default fee limit is used.
+	UpdateDiscussionOptions(ctx context.Context, in *UpdateDiscussionOptionsRequest, opts ...grpc.CallOption) (*UpdateDiscussionResponse, error)
+	//*
+	//Updates a discussion's message retention policy.
+	//
+	//The limits set by the policy override those of the default policy.
+	//Messages exceeding their retention policy are redacted periodically.
+	UpdateDiscussionRetention(ctx context.Context, in *UpdateDiscussionRetentionRequest, opts ...grpc.CallOption) (*UpdateDiscussionResponse, error)
+	//*
+	//Removes a discussion.
+	//
+	//Unless purge is requested, the discussion is soft deleted:
+	//it is hidden from discussion listings until it is restored
+	//or a new discussion message is exchanged.
+	//Purging removes the discussion and its messages from the database,
+	//retaining the invoices and payments of the messages.
+	RemoveDiscussion(ctx context.Context, in *RemoveDiscussionRequest, opts ...grpc.CallOption) (*RemoveDiscussionResponse, error)
+	//*
+	//Restores a soft deleted discussion.
+	RestoreDiscussion(ctx context.Context, in *RestoreDiscussionRequest, opts ...grpc.CallOption) (*RestoreDiscussionResponse, error)
+	//*
+	//Creates a unidirectional stream from server to client
+	//over which an archive of the requested discussion is sent,
+	//containing the discussion, the contacts of its participants,
+	//its messages and the invoices and payments carrying them.
+	//
+	//Each response contains a single line of the archive (JSON lines),
+	//so that the concatenation of the responses forms the archive.
+	//The archive can be imported with the import subcommand.
+	//
+	//The stream terminates when the whole archive is transmitted.
+	ExportDiscussion(ctx context.Context, in *ExportDiscussionRequest, opts ...grpc.CallOption) (DiscussionService_ExportDiscussionClient, error)
+}
+
+type discussionServiceClient struct {
 	cc grpc.ClientConnInterface
 }
 
@@ -1033,6 +1508,33 @@ func (c *discussionServiceClient) UpdateDiscussionLastRead(ctx context.Context,
 	return out, nil
 }
 
+func (c *discussionServiceClient) UpdateDiscussion(ctx context.Context, in *UpdateDiscussionRequest, opts ...grpc.CallOption) (*UpdateDiscussionResponse, error) {
+	out := new(UpdateDiscussionResponse)
+	err := c.cc.Invoke(ctx, "/services.DiscussionService/UpdateDiscussion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) UpdateDiscussionOptions(ctx context.Context, in *UpdateDiscussionOptionsRequest, opts ...grpc.CallOption) (*UpdateDiscussionResponse, error) {
+	out := new(UpdateDiscussionResponse)
+	err := c.cc.Invoke(ctx, "/services.DiscussionService/UpdateDiscussionOptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) UpdateDiscussionRetention(ctx context.Context, in *UpdateDiscussionRetentionRequest, opts ...grpc.CallOption) (*UpdateDiscussionResponse, error) {
+	out := new(UpdateDiscussionResponse)
+	err := c.cc.Invoke(ctx, "/services.DiscussionService/UpdateDiscussionRetention", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *discussionServiceClient) RemoveDiscussion(ctx context.Context, in *RemoveDiscussionRequest, opts ...grpc.CallOption) (*RemoveDiscussionResponse, error) {
 	out := new(RemoveDiscussionResponse)
 	err := c.cc.Invoke(ctx, "/services.DiscussionService/RemoveDiscussion", in, out, opts...)
@@ -1042,13 +1544,56 @@ func (c *discussionServiceClient) RemoveDiscussion(ctx context.Context, in *Remo
 	return out, nil
 }
 
+func (c *discussionServiceClient) RestoreDiscussion(ctx context.Context, in *RestoreDiscussionRequest, opts ...grpc.CallOption) (*RestoreDiscussionResponse, error) {
+	out := new(RestoreDiscussionResponse)
+	err := c.cc.Invoke(ctx, "/services.DiscussionService/RestoreDiscussion", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *discussionServiceClient) ExportDiscussion(ctx context.Context, in *ExportDiscussionRequest, opts ...grpc.CallOption) (DiscussionService_ExportDiscussionClient, error) {
+	stream, err := c.cc.NewStream(ctx, &DiscussionService_ServiceDesc.Streams[2], "/services.DiscussionService/ExportDiscussion", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &discussionServiceExportDiscussionClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type DiscussionService_ExportDiscussionClient interface {
+	Recv() (*ExportDiscussionResponse, error)
+	grpc.ClientStream
+}
+
+type discussionServiceExportDiscussionClient struct {
+	grpc.ClientStream
+}
+
+func (x *discussionServiceExportDiscussionClient) Recv() (*ExportDiscussionResponse, error) {
+	m := new(ExportDiscussionResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 // DiscussionServiceServer is the server API for DiscussionService service.
 // All implementations must embed UnimplementedDiscussionServiceServer
 // for forward compatibility
 type DiscussionServiceServer interface {
 	//*
 	//Creates a unidirectional stream from server to client
-	//over which all discussions' info are sent.
+	//over which the requested range of discussions' info are sent,
+	//along with their unread message count, last message
+	//and participant display names.
 	//
 	//The stream terminates when all discussion info is transmitted.
 	GetDiscussions(*GetDiscussionsRequest, DiscussionService_GetDiscussionsServer) error
@@ -1057,6 +1602,9 @@ type DiscussionServiceServer interface {
 	//over which all previously exchanged messages belonging to
 	//a specific discussion are sent.
 	//
+	//Replies reference the message they reply to, while edits,
+	//delete requests and reactions are applied to the message they target.
+	//
 	//The stream terminates when all discussion history is transmitted.
 	GetDiscussionHistoryByID(*GetDiscussionHistoryByIDRequest, DiscussionService_GetDiscussionHistoryByIDServer) error
 	//*
@@ -1069,8 +1617,48 @@ type DiscussionServiceServer interface {
 	//Updates a discussion's last read message.
 	UpdateDiscussionLastRead(context.Context, *UpdateDiscussionLastReadRequest) (*UpdateDiscussionResponse, error)
 	//*
-	//Remove a discussion from the database.
+	//Updates a discussion's metadata.
+	//
+	//The discussion metadata is replaced by the one provided in the request.
+	UpdateDiscussion(context.Context, *UpdateDiscussionRequest) (*UpdateDiscussionResponse, error)
+	//*
+	//Updates a discussion's options.
+	//
+	//The discussion options are replaced by the ones provided in the request.
+	//Anonymous options are disallowed for group discussions,
+	//as are anonymous encrypted options.
+	//If the fee limit is not set, the default fee limit is used.
+	UpdateDiscussionOptions(context.Context, *UpdateDiscussionOptionsRequest) (*UpdateDiscussionResponse, error)
+	//*
+	//Updates a discussion's message retention policy.
+	//
+	//The limits set by the policy override those of the default policy.
+	//Messages exceeding their retention policy are redacted periodically.
+	UpdateDiscussionRetention(context.Context, *UpdateDiscussionRetentionRequest) (*UpdateDiscussionResponse, error)
+	//*
+	//Removes a discussion.
+	//
+	//Unless purge is requested, the discussion is soft deleted:
+	//it is hidden from discussion listings until it is restored
+	//or a new discussion message is exchanged.
+	//Purging removes the discussion and its messages from the database,
+	//retaining the invoices and payments of the messages.
 	RemoveDiscussion(context.Context, *RemoveDiscussionRequest) (*RemoveDiscussionResponse, error)
+	//*
+	//Restores a soft deleted discussion.
+	RestoreDiscussion(context.Context, *RestoreDiscussionRequest) (*RestoreDiscussionResponse, error)
+	//*
+	//Creates a unidirectional stream from server to client
+	//over which an archive of the requested discussion is sent,
+	//containing the discussion, the contacts of its participants,
+	//its messages and the invoices and payments carrying them.
+	//
+	//Each response contains a single line of the archive (JSON lines),
+	//so that the concatenation of the responses forms the archive.
+	//The archive can be imported with the import subcommand.
+	//
+	//The stream terminates when the whole archive is transmitted.
+	ExportDiscussion(*ExportDiscussionRequest, DiscussionService_ExportDiscussionServer) error
 	mustEmbedUnimplementedDiscussionServiceServer()
 }
 
@@ -1093,9 +1681,24 @@ func (UnimplementedDiscussionServiceServer) AddDiscussion(context.Context, *AddD
 func (UnimplementedDiscussionServiceServer) UpdateDiscussionLastRead(context.Context, *UpdateDiscussionLastReadRequest) (*UpdateDiscussionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiscussionLastRead not implemented")
 }
+func (UnimplementedDiscussionServiceServer) UpdateDiscussion(context.Context, *UpdateDiscussionRequest) (*UpdateDiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiscussion not implemented")
+}
+func (UnimplementedDiscussionServiceServer) UpdateDiscussionOptions(context.Context, *UpdateDiscussionOptionsRequest) (*UpdateDiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiscussionOptions not implemented")
+}
+func (UnimplementedDiscussionServiceServer) UpdateDiscussionRetention(context.Context, *UpdateDiscussionRetentionRequest) (*UpdateDiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDiscussionRetention not implemented")
+}
 func (UnimplementedDiscussionServiceServer) RemoveDiscussion(context.Context, *RemoveDiscussionRequest) (*RemoveDiscussionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveDiscussion not implemented")
 }
+func (UnimplementedDiscussionServiceServer) RestoreDiscussion(context.Context, *RestoreDiscussionRequest) (*RestoreDiscussionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RestoreDiscussion not implemented")
+}
+func (UnimplementedDiscussionServiceServer) ExportDiscussion(*ExportDiscussionRequest, DiscussionService_ExportDiscussionServer) error {
+	return status.Errorf(codes.Unimplemented, "method ExportDiscussion not implemented")
+}
 func (UnimplementedDiscussionServiceServer) mustEmbedUnimplementedDiscussionServiceServer() {}
 
 // UnsafeDiscussionServiceServer may be embedded to opt out of forward compatibility for this service.
@@ -1205,6 +1808,60 @@ func _DiscussionService_UpdateDiscussionLastRead_Handler(srv interface{}, ctx co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscussionService_UpdateDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDiscussionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).UpdateDiscussion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.DiscussionService/UpdateDiscussion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).UpdateDiscussion(ctx, req.(*UpdateDiscussionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_UpdateDiscussionOptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDiscussionOptionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).UpdateDiscussionOptions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.DiscussionService/UpdateDiscussionOptions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).UpdateDiscussionOptions(ctx, req.(*UpdateDiscussionOptionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_UpdateDiscussionRetention_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDiscussionRetentionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).UpdateDiscussionRetention(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.DiscussionService/UpdateDiscussionRetention",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).UpdateDiscussionRetention(ctx, req.(*UpdateDiscussionRetentionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _DiscussionService_RemoveDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(RemoveDiscussionRequest)
 	if err := dec(in); err != nil {
@@ -1223,6 +1880,45 @@ func _DiscussionService_RemoveDiscussion_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DiscussionService_RestoreDiscussion_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RestoreDiscussionRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DiscussionServiceServer).RestoreDiscussion(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.DiscussionService/RestoreDiscussion",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DiscussionServiceServer).RestoreDiscussion(ctx, req.(*RestoreDiscussionRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DiscussionService_ExportDiscussion_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(ExportDiscussionRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(DiscussionServiceServer).ExportDiscussion(m, &discussionServiceExportDiscussionServer{stream})
+}
+
+type DiscussionService_ExportDiscussionServer interface {
+	Send(*ExportDiscussionResponse) error
+	grpc.ServerStream
+}
+
+type discussionServiceExportDiscussionServer struct {
+	grpc.ServerStream
+}
+
+func (x *discussionServiceExportDiscussionServer) Send(m *ExportDiscussionResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
 // DiscussionService_ServiceDesc is the grpc.ServiceDesc for DiscussionService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -1242,10 +1938,26 @@ var DiscussionService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateDiscussionLastRead",
 			Handler:    _DiscussionService_UpdateDiscussionLastRead_Handler,
 		},
+		{
+			MethodName: "UpdateDiscussion",
+			Handler:    _DiscussionService_UpdateDiscussion_Handler,
+		},
+		{
+			MethodName: "UpdateDiscussionOptions",
+			Handler:    _DiscussionService_UpdateDiscussionOptions_Handler,
+		},
+		{
+			MethodName: "UpdateDiscussionRetention",
+			Handler:    _DiscussionService_UpdateDiscussionRetention_Handler,
+		},
 		{
 			MethodName: "RemoveDiscussion",
 			Handler:    _DiscussionService_RemoveDiscussion_Handler,
 		},
+		{
+			MethodName: "RestoreDiscussion",
+			Handler:    _DiscussionService_RestoreDiscussion_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -1258,6 +1970,11 @@ var DiscussionService_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _DiscussionService_GetDiscussionHistoryByID_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "ExportDiscussion",
+			Handler:       _DiscussionService_ExportDiscussion_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc/services/rpc.proto",
 }
@@ -1391,3 +2108,367 @@ var PaymentService_ServiceDesc = grpc.ServiceDesc{
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "rpc/services/rpc.proto",
 }
+
+// AdminServiceClient is the client API for AdminService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type AdminServiceClient interface {
+	//*
+	//Rebuilds the message history from the underlying node.
+	//
+	//Walks the settled invoices and completed payments of the node,
+	//restoring any messages they carry and recreating their discussions.
+	//Invoices and payments already present are skipped,
+	//so the operation can be safely repeated.
+	Resync(ctx context.Context, in *ResyncRequest, opts ...grpc.CallOption) (*ResyncResponse, error)
+	//*
+	//Creates a unidirectional stream from server to client
+	//over which a backup of the database is sent, while the server is running.
+	//
+	//The backup contains the database entries changed after the requested
+	//version, with version 0 requesting a full backup.
+	//Backups of encrypted databases are encrypted with a key derived
+	//from the database encryption key, and can be restored
+	//with the db restore subcommand.
+	//
+	//The concatenation of the response chunks forms the backup.
+	//The last response carries the version that a subsequent
+	//incremental backup should request.
+	BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (AdminService_BackupDatabaseClient, error)
+}
+
+type adminServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAdminServiceClient(cc grpc.ClientConnInterface) AdminServiceClient {
+	return &adminServiceClient{cc}
+}
+
+func (c *adminServiceClient) Resync(ctx context.Context, in *ResyncRequest, opts ...grpc.CallOption) (*ResyncResponse, error) {
+	out := new(ResyncResponse)
+	err := c.cc.Invoke(ctx, "/services.AdminService/Resync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *adminServiceClient) BackupDatabase(ctx context.Context, in *BackupDatabaseRequest, opts ...grpc.CallOption) (AdminService_BackupDatabaseClient, error) {
+	stream, err := c.cc.NewStream(ctx, &AdminService_ServiceDesc.Streams[0], "/services.AdminService/BackupDatabase", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &adminServiceBackupDatabaseClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type AdminService_BackupDatabaseClient interface {
+	Recv() (*BackupDatabaseResponse, error)
+	grpc.ClientStream
+}
+
+type adminServiceBackupDatabaseClient struct {
+	grpc.ClientStream
+}
+
+func (x *adminServiceBackupDatabaseClient) Recv() (*BackupDatabaseResponse, error) {
+	m := new(BackupDatabaseResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AdminServiceServer is the server API for AdminService service.
+// All implementations must embed UnimplementedAdminServiceServer
+// for forward compatibility
+type AdminServiceServer interface {
+	//*
+	//Rebuilds the message history from the underlying node.
+	//
+	//Walks the settled invoices and completed payments of the node,
+	//restoring any messages they carry and recreating their discussions.
+	//Invoices and payments already present are skipped,
+	//so the operation can be safely repeated.
+	Resync(context.Context, *ResyncRequest) (*ResyncResponse, error)
+	//*
+	//Creates a unidirectional stream from server to client
+	//over which a backup of the database is sent, while the server is running.
+	//
+	//The backup contains the database entries changed after the requested
+	//version, with version 0 requesting a full backup.
+	//Backups of encrypted databases are encrypted with a key derived
+	//from the database encryption key, and can be restored
+	//with the db restore subcommand.
+	//
+	//The concatenation of the response chunks forms the backup.
+	//The last response carries the version that a subsequent
+	//incremental backup should request.
+	BackupDatabase(*BackupDatabaseRequest, AdminService_BackupDatabaseServer) error
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+// UnimplementedAdminServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedAdminServiceServer struct {
+}
+
+func (UnimplementedAdminServiceServer) Resync(context.Context, *ResyncRequest) (*ResyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resync not implemented")
+}
+func (UnimplementedAdminServiceServer) BackupDatabase(*BackupDatabaseRequest, AdminService_BackupDatabaseServer) error {
+	return status.Errorf(codes.Unimplemented, "method BackupDatabase not implemented")
+}
+func (UnimplementedAdminServiceServer) mustEmbedUnimplementedAdminServiceServer() {}
+
+// UnsafeAdminServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to AdminServiceServer will
+// result in compilation errors.
+type UnsafeAdminServiceServer interface {
+	mustEmbedUnimplementedAdminServiceServer()
+}
+
+func RegisterAdminServiceServer(s grpc.ServiceRegistrar, srv AdminServiceServer) {
+	s.RegisterService(&AdminService_ServiceDesc, srv)
+}
+
+func _AdminService_Resync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AdminServiceServer).Resync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.AdminService/Resync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AdminServiceServer).Resync(ctx, req.(*ResyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AdminService_BackupDatabase_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(BackupDatabaseRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(AdminServiceServer).BackupDatabase(m, &adminServiceBackupDatabaseServer{stream})
+}
+
+type AdminService_BackupDatabaseServer interface {
+	Send(*BackupDatabaseResponse) error
+	grpc.ServerStream
+}
+
+type adminServiceBackupDatabaseServer struct {
+	grpc.ServerStream
+}
+
+func (x *adminServiceBackupDatabaseServer) Send(m *BackupDatabaseResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+// AdminService_ServiceDesc is the grpc.ServiceDesc for AdminService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var AdminService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.AdminService",
+	HandlerType: (*AdminServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Resync",
+			Handler:    _AdminService_Resync_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "BackupDatabase",
+			Handler:       _AdminService_BackupDatabase_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc/services/rpc.proto",
+}
+
+// PresenceServiceClient is the client API for PresenceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type PresenceServiceClient interface {
+	//*
+	//Subscribe to presence indications received from discussion participants.
+	//
+	//Indications are received only if enabled in the configuration,
+	//since this requires intercepting the HTLCs forwarded by the Lightning daemon.
+	//Only indications from verified senders on existing discussions are received.
+	SubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (PresenceService_SubscribePresenceClient, error)
+	//*
+	//Send a typing indication to the participants of a discussion.
+	//
+	//Indications are not stored, so clients are expected to repeat them
+	//while the user is typing and consider them expired otherwise.
+	//Indications cannot be sent on anonymous discussions.
+	SetTyping(ctx context.Context, in *SetTypingRequest, opts ...grpc.CallOption) (*SetTypingResponse, error)
+}
+
+type presenceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewPresenceServiceClient(cc grpc.ClientConnInterface) PresenceServiceClient {
+	return &presenceServiceClient{cc}
+}
+
+func (c *presenceServiceClient) SubscribePresence(ctx context.Context, in *SubscribePresenceRequest, opts ...grpc.CallOption) (PresenceService_SubscribePresenceClient, error) {
+	stream, err := c.cc.NewStream(ctx, &PresenceService_ServiceDesc.Streams[0], "/services.PresenceService/SubscribePresence", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &presenceServiceSubscribePresenceClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type PresenceService_SubscribePresenceClient interface {
+	Recv() (*PresenceUpdate, error)
+	grpc.ClientStream
+}
+
+type presenceServiceSubscribePresenceClient struct {
+	grpc.ClientStream
+}
+
+func (x *presenceServiceSubscribePresenceClient) Recv() (*PresenceUpdate, error) {
+	m := new(PresenceUpdate)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *presenceServiceClient) SetTyping(ctx context.Context, in *SetTypingRequest, opts ...grpc.CallOption) (*SetTypingResponse, error) {
+	out := new(SetTypingResponse)
+	err := c.cc.Invoke(ctx, "/services.PresenceService/SetTyping", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// PresenceServiceServer is the server API for PresenceService service.
+// All implementations must embed UnimplementedPresenceServiceServer
+// for forward compatibility
+type PresenceServiceServer interface {
+	//*
+	//Subscribe to presence indications received from discussion participants.
+	//
+	//Indications are received only if enabled in the configuration,
+	//since this requires intercepting the HTLCs forwarded by the Lightning daemon.
+	//Only indications from verified senders on existing discussions are received.
+	SubscribePresence(*SubscribePresenceRequest, PresenceService_SubscribePresenceServer) error
+	//*
+	//Send a typing indication to the participants of a discussion.
+	//
+	//Indications are not stored, so clients are expected to repeat them
+	//while the user is typing and consider them expired otherwise.
+	//Indications cannot be sent on anonymous discussions.
+	SetTyping(context.Context, *SetTypingRequest) (*SetTypingResponse, error)
+	mustEmbedUnimplementedPresenceServiceServer()
+}
+
+// UnimplementedPresenceServiceServer must be embedded to have forward compatible implementations.
+type UnimplementedPresenceServiceServer struct {
+}
+
+func (UnimplementedPresenceServiceServer) SubscribePresence(*SubscribePresenceRequest, PresenceService_SubscribePresenceServer) error {
+	return status.Errorf(codes.Unimplemented, "method SubscribePresence not implemented")
+}
+func (UnimplementedPresenceServiceServer) SetTyping(context.Context, *SetTypingRequest) (*SetTypingResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetTyping not implemented")
+}
+func (UnimplementedPresenceServiceServer) mustEmbedUnimplementedPresenceServiceServer() {}
+
+// UnsafePresenceServiceServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to PresenceServiceServer will
+// result in compilation errors.
+type UnsafePresenceServiceServer interface {
+	mustEmbedUnimplementedPresenceServiceServer()
+}
+
+func RegisterPresenceServiceServer(s grpc.ServiceRegistrar, srv PresenceServiceServer) {
+	s.RegisterService(&PresenceService_ServiceDesc, srv)
+}
+
+func _PresenceService_SubscribePresence_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribePresenceRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(PresenceServiceServer).SubscribePresence(m, &presenceServiceSubscribePresenceServer{stream})
+}
+
+type PresenceService_SubscribePresenceServer interface {
+	Send(*PresenceUpdate) error
+	grpc.ServerStream
+}
+
+type presenceServiceSubscribePresenceServer struct {
+	grpc.ServerStream
+}
+
+func (x *presenceServiceSubscribePresenceServer) Send(m *PresenceUpdate) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _PresenceService_SetTyping_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetTypingRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(PresenceServiceServer).SetTyping(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/services.PresenceService/SetTyping",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(PresenceServiceServer).SetTyping(ctx, req.(*SetTypingRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// PresenceService_ServiceDesc is the grpc.ServiceDesc for PresenceService service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var PresenceService_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "services.PresenceService",
+	HandlerType: (*PresenceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "SetTyping",
+			Handler:    _PresenceService_SetTyping_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "SubscribePresence",
+			Handler:       _PresenceService_SubscribePresence_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "rpc/services/rpc.proto",
+}
@@ -2,6 +2,7 @@ package rpc
 
 import (
 	"fmt"
+	"math"
 	"time"
 
 	"github.com/lightningnetwork/lnd/lnrpc"
@@ -18,6 +19,56 @@ func newProtoTimestamp(t time.Time) (*timestamppb.Timestamp, error) {
 	return ts, ts.CheckValid()
 }
 
+// protoTimestampToNs converts a timestamp to nanoseconds since Unix Epoch.
+// An unset timestamp is converted to 0.
+func protoTimestampToNs(ts *timestamppb.Timestamp) (int64, error) {
+	if ts == nil {
+		return 0, nil
+	}
+	if err := ts.CheckValid(); err != nil {
+		return 0, err
+	}
+
+	return ts.AsTime().UnixNano(), nil
+}
+
+// Pagination
+
+func pageOptionsFromRequest(opts *pb.KeySetPageOptions) (model.PageOptions, error) {
+	if opts == nil {
+		return model.PageOptions{}, nil
+	}
+
+	pageOpts := model.PageOptions{
+		LastID:     opts.GetLastId(),
+		PageSize:   uint64(opts.GetPageSize()),
+		Reverse:    opts.GetReverse(),
+		MinAmtMsat: opts.GetMinAmtMsat(),
+		MaxAmtMsat: opts.GetMaxAmtMsat(),
+	}
+
+	var err error
+	if pageOpts.FromTimeNs, err = protoTimestampToNs(opts.GetFromTimestamp()); err != nil {
+		return pageOpts, err
+	}
+	if pageOpts.ToTimeNs, err = protoTimestampToNs(opts.GetToTimestamp()); err != nil {
+		return pageOpts, err
+	}
+
+	switch opts.GetDirection() {
+	case pb.MessageDirection_MESSAGE_DIRECTION_ANY:
+		pageOpts.Direction = model.DirectionAny
+	case pb.MessageDirection_MESSAGE_DIRECTION_SENT:
+		pageOpts.Direction = model.DirectionSent
+	case pb.MessageDirection_MESSAGE_DIRECTION_RECEIVED:
+		pageOpts.Direction = model.DirectionReceived
+	default:
+		return pageOpts, fmt.Errorf("unknown message direction %v", opts.GetDirection())
+	}
+
+	return pageOpts, nil
+}
+
 // Invoice
 
 func invoiceModelToRPCInvoice(invoice *model.Invoice) (*pb.Invoice, error) {
@@ -184,29 +235,101 @@ func messageModelToRPCMessage(message *model.Message) (*pb.Message, error) {
 		}
 	}
 
+	var edited *timestamppb.Timestamp
+	if message.EditedTimeNs > 0 {
+		if edited, err = newProtoTimestamp(time.Unix(0, message.EditedTimeNs)); err != nil {
+			return nil, fmt.Errorf("Marshal error: invalid timestamp: %v", err)
+		}
+	}
+
+	var delivered, read *timestamppb.Timestamp
+	if message.DeliveredTimeNs > 0 {
+		if delivered, err = newProtoTimestamp(time.Unix(0, message.DeliveredTimeNs)); err != nil {
+			return nil, fmt.Errorf("Marshal error: invalid timestamp: %v", err)
+		}
+	}
+	if message.ReadTimeNs > 0 {
+		if read, err = newProtoTimestamp(time.Unix(0, message.ReadTimeNs)); err != nil {
+			return nil, fmt.Errorf("Marshal error: invalid timestamp: %v", err)
+		}
+	}
+
+	reactions := make([]*pb.MessageReaction, len(message.Reactions))
+	for i, r := range message.Reactions {
+		reactions[i] = &pb.MessageReaction{
+			Reaction: r.Reaction,
+			Senders:  r.Senders,
+		}
+	}
+
 	preimage := message.Preimage.String()
 
 	return &pb.Message{
-		Id:                message.ID,
-		DiscussionId:      message.DiscussionID,
-		Sender:            message.Sender,
-		Receiver:          message.Receiver,
-		SenderVerified:    message.SenderVerified,
-		Payload:           message.Payload,
-		AmtMsat:           message.AmtMsat,
-		TotalFeesMsat:     message.TotalFeesMsat,
-		SentTimestamp:     sent,
-		ReceivedTimestamp: rcvd,
-		PaymentRoutes:     paymentRoutes,
-		Preimage:          preimage,
-		PayReq:            message.PayReq,
+		Id:                 message.ID,
+		DiscussionId:       message.DiscussionID,
+		Sender:             message.Sender,
+		Receiver:           message.Receiver,
+		SenderVerified:     message.SenderVerified,
+		Encrypted:          message.Encrypted,
+		Payload:            message.Payload,
+		AmtMsat:            message.AmtMsat,
+		TotalFeesMsat:      message.TotalFeesMsat,
+		SentTimestamp:      sent,
+		ReceivedTimestamp:  rcvd,
+		PaymentRoutes:      paymentRoutes,
+		Preimage:           preimage,
+		PayReq:             message.PayReq,
+		MessageId:          message.MessageID,
+		ContentType:        messageContentTypeModelToRPCContentType(message.ContentType),
+		ReplyTo:            message.ReplyTo,
+		ReplyToId:          message.ReplyToID,
+		ReplyToLinked:      message.ReplyToLinked,
+		Target:             message.Target,
+		TargetId:           message.TargetID,
+		TargetLinked:       message.TargetLinked,
+		EditedTimestamp:    edited,
+		Deleted:            message.Deleted,
+		Reactions:          reactions,
+		DeliveredTimestamp: delivered,
+		ReadTimestamp:      read,
+		Redacted:           message.Redacted,
+		Muted:              message.Muted,
 	}, nil
 }
 
+func messageContentTypeModelToRPCContentType(t model.ContentType) pb.MessageContentType {
+	switch t {
+	case model.ContentTypeEdit:
+		return pb.MessageContentType_MESSAGE_CONTENT_EDIT
+	case model.ContentTypeDeleteRequest:
+		return pb.MessageContentType_MESSAGE_CONTENT_DELETE_REQUEST
+	case model.ContentTypeReaction:
+		return pb.MessageContentType_MESSAGE_CONTENT_REACTION
+	default:
+		return pb.MessageContentType_MESSAGE_CONTENT_TEXT
+	}
+}
+
+func messageContentTypeFromRequest(t pb.MessageContentType) (model.ContentType, error) {
+	switch t {
+	case pb.MessageContentType_MESSAGE_CONTENT_TEXT:
+		return model.ContentTypeText, nil
+	case pb.MessageContentType_MESSAGE_CONTENT_EDIT:
+		return model.ContentTypeEdit, nil
+	case pb.MessageContentType_MESSAGE_CONTENT_DELETE_REQUEST:
+		return model.ContentTypeDeleteRequest, nil
+	case pb.MessageContentType_MESSAGE_CONTENT_REACTION:
+		return model.ContentTypeReaction, nil
+	default:
+		return 0, fmt.Errorf("unknown message content type %v", t)
+	}
+}
+
 func messageOptionsFromRequest(opts *pb.MessageOptions) model.MessageOptions {
 	return model.MessageOptions{
 		FeeLimitMsat: opts.GetFeeLimitMsat(),
 		Anonymous:    opts.GetAnonymous(),
+		Encrypted:    opts.GetEncrypted(),
 	}
 }
 
@@ -217,8 +340,11 @@ func messageModelToEstimateMessageResponse(message *model.Message) (*pb.Estimate
 	}
 
 	return &pb.EstimateMessageResponse{
-		Message:     rpcMessage,
-		SuccessProb: message.SuccessProb,
+		Message:              rpcMessage,
+		SuccessProb:          message.SuccessProb,
+		PayloadSize:          uint64(message.PayloadSize),
+		RemainingPayloadSize: int64(message.RemainingPayloadSize),
+		Parts:                uint32(message.Parts),
 	}, nil
 }
 
@@ -229,15 +355,85 @@ func messageModelToSendMessageResponse(message *model.Message) (*pb.SendMessageR
 	}
 
 	return &pb.SendMessageResponse{
-		SentMessage: rpcMessage,
+		SentMessage:  rpcMessage,
+		DiscussionId: message.DiscussionID,
+		Status:       pb.MessageStatus_MESSAGE_SUCCEEDED,
+	}, nil
+}
+
+func messageStatusModelToRPCMessageStatus(status model.MessageStatus) pb.MessageStatus {
+	switch status {
+	case model.MessageINFLIGHT:
+		return pb.MessageStatus_MESSAGE_IN_FLIGHT
+	case model.MessageSUCCEEDED:
+		return pb.MessageStatus_MESSAGE_SUCCEEDED
+	case model.MessageFAILED:
+		return pb.MessageStatus_MESSAGE_FAILED
+	default:
+		return pb.MessageStatus_MESSAGE_PENDING
+	}
+}
+
+func messageStatusUpdateToSendMessageResponse(update *model.MessageStatusUpdate) *pb.SendMessageResponse {
+	return &pb.SendMessageResponse{
+		SendId:       update.SendID,
+		DiscussionId: update.DiscussionID,
+		Status:       messageStatusModelToRPCMessageStatus(update.Status),
+	}
+}
+
+func messageStatusUpdateToSubscribeMessageStatusResponse(
+	update *model.MessageStatusUpdate) (*pb.SubscribeMessageStatusResponse, error) {
+
+	var rpcMessage *pb.Message
+	if update.Message != nil {
+		var err error
+		if rpcMessage, err = messageModelToRPCMessage(update.Message); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.SubscribeMessageStatusResponse{
+		SendId:        update.SendID,
+		DiscussionId:  update.DiscussionID,
+		Recipient:     update.Recipient,
+		Status:        messageStatusModelToRPCMessageStatus(update.Status),
+		FailureReason: update.FailureReason,
+		SentMessage:   rpcMessage,
+	}, nil
+}
+
+// Presence Transformations
+
+func presenceUpdateModelToRPCPresenceUpdate(
+	update *model.PresenceUpdate) (*pb.PresenceUpdate, error) {
+
+	sent, err := newProtoTimestamp(time.Unix(0, update.SentTimeNs))
+	if err != nil {
+		return nil, fmt.Errorf("Marshal error: invalid timestamp: %v", err)
+	}
+
+	return &pb.PresenceUpdate{
+		DiscussionId:  update.DiscussionID,
+		Sender:        update.Sender,
+		Typing:        update.Typing,
+		SentTimestamp: sent,
 	}, nil
 }
 
 func estimateMessageRequestToMessageModel(req *pb.EstimateMessageRequest) (*model.Message, error) {
+	contentType, err := messageContentTypeFromRequest(req.GetContentType())
+	if err != nil {
+		return nil, err
+	}
+
 	return &model.Message{
 		DiscussionID: req.GetDiscussionId(),
 		Payload:      req.GetPayload(),
 		AmtMsat:      req.GetAmtMsat(),
+		ContentType:  contentType,
+		ReplyTo:      req.GetReplyTo(),
+		Target:       req.GetTarget(),
 	}, nil
 }
 
@@ -248,11 +444,19 @@ func sendMessageRequestToMessageModel(req *pb.SendMessageRequest) (*model.Messag
 			"both pay_req and discussion are specified")
 	}
 
+	contentType, err := messageContentTypeFromRequest(req.GetContentType())
+	if err != nil {
+		return nil, err
+	}
+
 	return &model.Message{
 		DiscussionID: discID,
 		Payload:      req.GetPayload(),
 		AmtMsat:      req.GetAmtMsat(),
 		PayReq:       payReq,
+		ContentType:  contentType,
+		ReplyTo:      req.GetReplyTo(),
+		Target:       req.GetTarget(),
 	}, nil
 }
 
@@ -279,15 +483,64 @@ func messageModelToHistoryMessageResponse(message *model.Message) (*pb.GetDiscus
 	}, nil
 }
 
+func searchMessagesRequestToSearchQuery(req *pb.SearchMessagesRequest) (
+	model.SearchQuery, error) {
+
+	query := model.SearchQuery{
+		Text:          req.GetQuery(),
+		DiscussionIDs: req.GetDiscussionIds(),
+		Sender:        req.GetSender(),
+		MinAmtMsat:    req.GetMinAmtMsat(),
+		MaxAmtMsat:    req.GetMaxAmtMsat(),
+	}
+
+	var err error
+	if query.FromTimeNs, err = protoTimestampToNs(req.GetFromTimestamp()); err != nil {
+		return query, err
+	}
+	if query.ToTimeNs, err = protoTimestampToNs(req.GetToTimestamp()); err != nil {
+		return query, err
+	}
+
+	return query, nil
+}
+
+func searchResultsToSearchMessagesResponse(results []model.SearchResult) (
+	*pb.SearchMessagesResponse, error) {
+
+	resp := &pb.SearchMessagesResponse{
+		Results: make([]*pb.SearchResult, len(results)),
+	}
+	for i := range results {
+		msg, err := messageModelToRPCMessage(&results[i].Message)
+		if err != nil {
+			return nil, err
+		}
+
+		highlights := make([]*pb.TextRange, len(results[i].Highlights))
+		for j, h := range results[i].Highlights {
+			highlights[j] = &pb.TextRange{
+				Start: uint32(h.Start),
+				End:   uint32(h.End),
+			}
+		}
+
+		resp.Results[i] = &pb.SearchResult{
+			Message:    msg,
+			Highlights: highlights,
+		}
+	}
+
+	return resp, nil
+}
+
 // Discussion Transformations
 
 func discussionInfoToDiscussionModel(discussion *pb.DiscussionInfo) model.Discussion {
 	discussionInfo := model.Discussion{
 		Participants: discussion.GetParticipants(),
-		Options: model.MessageOptions{
-			FeeLimitMsat: discussion.GetOptions().GetFeeLimitMsat(),
-			Anonymous:    discussion.GetOptions().GetAnonymous(),
-		},
+		Options:      discussionOptionsFromRequest(discussion.GetOptions()),
+		Metadata:     discussionMetadataFromRequest(discussion.GetMetadata()),
 	}
 
 	return discussionInfo
@@ -300,14 +553,120 @@ func discussionModelToDiscussionInfo(discussion *model.Discussion) (*pb.Discussi
 		Options: &pb.DiscussionOptions{
 			FeeLimitMsat: discussion.Options.FeeLimitMsat,
 			Anonymous:    discussion.Options.Anonymous,
+			Encrypted:    discussion.Options.Encrypted,
+			Receipts:     discussion.Options.Receipts,
 		},
 		LastReadMsgId: discussion.LastReadID,
 		LastMsgId:     discussion.LastMessageID,
+		Metadata: &pb.DiscussionMetadata{
+			Title:    discussion.Metadata.Title,
+			Muted:    discussion.Metadata.Muted,
+			Archived: discussion.Metadata.Archived,
+			Pinned:   discussion.Metadata.Pinned,
+		},
+		Deleted: discussion.Deleted,
+		Retention: &pb.RetentionPolicy{
+			MaxAgeSec: uint64(discussion.Retention.MaxAge / time.Second),
+			MaxCount:  discussion.Retention.MaxCount,
+		},
 	}
 
 	return discInfo, nil
 }
 
+func discussionOptionsFromRequest(options *pb.DiscussionOptions) model.MessageOptions {
+	return model.MessageOptions{
+		FeeLimitMsat: options.GetFeeLimitMsat(),
+		Anonymous:    options.GetAnonymous(),
+		Encrypted:    options.GetEncrypted(),
+		Receipts:     options.GetReceipts(),
+	}
+}
+
+func retentionPolicyFromRequest(policy *pb.RetentionPolicy) (model.RetentionPolicy, error) {
+	maxAgeSec := policy.GetMaxAgeSec()
+	if maxAgeSec > uint64(math.MaxInt64/int64(time.Second)) {
+		return model.RetentionPolicy{}, fmt.Errorf("retention age %ds too large", maxAgeSec)
+	}
+
+	return model.RetentionPolicy{
+		MaxAge:   time.Duration(maxAgeSec) * time.Second,
+		MaxCount: policy.GetMaxCount(),
+	}, nil
+}
+
+func discussionMetadataFromRequest(metadata *pb.DiscussionMetadata) model.DiscussionMetadata {
+	return model.DiscussionMetadata{
+		Title:    metadata.GetTitle(),
+		Muted:    metadata.GetMuted(),
+		Archived: metadata.GetArchived(),
+		Pinned:   metadata.GetPinned(),
+	}
+}
+
+func discussionOrderFromRequest(order pb.DiscussionOrder) (model.DiscussionOrder, error) {
+	switch order {
+	case pb.DiscussionOrder_DISCUSSION_ORDER_ID:
+		return model.DiscussionOrderID, nil
+	case pb.DiscussionOrder_DISCUSSION_ORDER_LAST_ACTIVITY:
+		return model.DiscussionOrderLastActivity, nil
+	default:
+		return 0, fmt.Errorf("unknown discussion order %v", order)
+	}
+}
+
+func flagFilterFromRequest(filter pb.FlagFilter) (model.FlagFilter, error) {
+	switch filter {
+	case pb.FlagFilter_FLAG_FILTER_ANY:
+		return model.FlagAny, nil
+	case pb.FlagFilter_FLAG_FILTER_SET:
+		return model.FlagSet, nil
+	case pb.FlagFilter_FLAG_FILTER_UNSET:
+		return model.FlagUnset, nil
+	default:
+		return 0, fmt.Errorf("unknown flag filter %v", filter)
+	}
+}
+
+func discussionFilterFromRequest(req *pb.GetDiscussionsRequest) (model.DiscussionFilter, error) {
+	filter := model.DiscussionFilter{
+		Deleted: req.GetDeleted(),
+	}
+
+	var err error
+	if filter.Archived, err = flagFilterFromRequest(req.GetArchived()); err != nil {
+		return filter, err
+	}
+	if filter.Pinned, err = flagFilterFromRequest(req.GetPinned()); err != nil {
+		return filter, err
+	}
+
+	return filter, nil
+}
+
+func discussionSummaryToGetDiscussionsResponse(
+	summary *model.DiscussionSummary) (*pb.GetDiscussionsResponse, error) {
+
+	discInfo, err := discussionModelToDiscussionInfo(&summary.Discussion)
+	if err != nil {
+		return nil, err
+	}
+
+	var lastMessage *pb.Message
+	if summary.LastMessage != nil {
+		if lastMessage, err = messageModelToRPCMessage(summary.LastMessage); err != nil {
+			return nil, err
+		}
+	}
+
+	return &pb.GetDiscussionsResponse{
+		Discussion:       discInfo,
+		UnreadCount:      summary.UnreadCount,
+		LastMessage:      lastMessage,
+		ParticipantNames: summary.ParticipantNames,
+	}, nil
+}
+
 // Node Transformations
 
 func nodeModelToNodeInfo(node model.Node) *pb.NodeInfo {
@@ -346,3 +705,74 @@ func contactInfoToContactModel(contact *pb.ContactInfo) model.Contact {
 
 	return contactInfo
 }
+
+// Channel Transformations
+
+func channelModelToRPCChannel(channel model.Channel) *pb.Channel {
+	var state pb.ChannelState
+	switch channel.State {
+	case lnchat.ChannelOPEN:
+		state = pb.ChannelState_CHANNEL_OPEN
+	case lnchat.ChannelPENDINGOPEN:
+		state = pb.ChannelState_CHANNEL_PENDING_OPEN
+	case lnchat.ChannelPENDINGCLOSE:
+		state = pb.ChannelState_CHANNEL_PENDING_CLOSE
+	case lnchat.ChannelCLOSED:
+		state = pb.ChannelState_CHANNEL_CLOSED
+	}
+
+	return &pb.Channel{
+		ChannelId:         channel.ChannelID,
+		FundingTxid:       channel.ChannelPoint.FundingTxid,
+		OutputIndex:       channel.ChannelPoint.OutputIndex,
+		RemoteAddress:     channel.RemoteAddress,
+		RemoteName:        channel.RemoteName,
+		CapacityMsat:      uint64(channel.CapacityMsat),
+		LocalBalanceMsat:  uint64(channel.LocalBalanceMsat),
+		RemoteBalanceMsat: uint64(channel.RemoteBalanceMsat),
+		Active:            channel.Active,
+		State:             state,
+	}
+}
+
+func channelModelsToListChannelsResponse(channels []model.Channel) *pb.ListChannelsResponse {
+	resp := &pb.ListChannelsResponse{
+		Channels: make([]*pb.Channel, len(channels)),
+	}
+	for i, channel := range channels {
+		resp.Channels[i] = channelModelToRPCChannel(channel)
+	}
+
+	return resp
+}
+
+func channelEventModelToSubscribeChannelEventsResponse(
+	event *model.ChannelEvent) *pb.SubscribeChannelEventsResponse {
+
+	var eventType pb.ChannelEventType
+	switch event.Type {
+	case lnchat.ChannelEventOPEN:
+		eventType = pb.ChannelEventType_CHANNEL_EVENT_OPEN
+	case lnchat.ChannelEventPENDINGOPEN:
+		eventType = pb.ChannelEventType_CHANNEL_EVENT_PENDING_OPEN
+	case lnchat.ChannelEventCLOSED:
+		eventType = pb.ChannelEventType_CHANNEL_EVENT_CLOSED
+	case lnchat.ChannelEventACTIVE:
+		eventType = pb.ChannelEventType_CHANNEL_EVENT_ACTIVE
+	case lnchat.ChannelEventINACTIVE:
+		eventType = pb.ChannelEventType_CHANNEL_EVENT_INACTIVE
+	case lnchat.ChannelEventFULLYRESOLVED:
+		eventType = pb.ChannelEventType_CHANNEL_EVENT_FULLY_RESOLVED
+	}
+
+	resp := &pb.SubscribeChannelEventsResponse{
+		Type:        eventType,
+		FundingTxid: event.ChannelPoint.FundingTxid,
+		OutputIndex: event.ChannelPoint.OutputIndex,
+	}
+	if event.Channel != nil {
+		resp.Channel = channelModelToRPCChannel(*event.Channel)
+	}
+
+	return resp
+}
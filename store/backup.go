@@ -0,0 +1,291 @@
+package store
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"io"
+)
+
+// A backup consists of a header, containing the backup magic and
+// whether the backup is encrypted, followed by a sequence of chunks.
+// Each chunk consists of a flag byte marking the final chunk,
+// the chunk body length and the body.
+// The final chunk has an empty body, so that truncation is detected.
+// The chunk bodies of encrypted backups are sealed with AES-256-GCM under
+// a key derived from the database encryption key, prefixed with the nonce
+// used, while the chunk sequence number and flag are authenticated.
+const (
+	backupMagic     = "C13NBKP1"
+	backupChunkSize = 1 << 16
+	backupKeyLabel  = "c13n backup encryption"
+
+	// backupMaxPendingWrites is the number of pending writes
+	// allowed during a backup load.
+	backupMaxPendingWrites = 256
+)
+
+var (
+	// ErrBackupKeyMismatch is returned in case a backup was not created
+	// from a database with the same encryption key.
+	ErrBackupKeyMismatch = fmt.Errorf("Backup encryption key mismatch")
+	// ErrMalformedBackup is returned in case a backup is truncated or corrupted.
+	ErrMalformedBackup = fmt.Errorf("Malformed backup")
+)
+
+// Backup writes a backup of the database entries changed after
+// the provided version (0 for a full backup) to a writer.
+// Backups of encrypted databases are encrypted with a key
+// derived from the database encryption key.
+// The returned version is the one a subsequent incremental backup
+// should start from, and equals the provided one if no entries changed.
+func (db *bhDatabase) Backup(w io.Writer, since uint64) (uint64, error) {
+	bw, err := newBackupWriter(w, db.bhOptions.EncryptionKey)
+	if err != nil {
+		return 0, err
+	}
+
+	last, err := db.bh.Badger().Backup(bw, since)
+	if err != nil {
+		return 0, err
+	}
+
+	// Incremental backups contain the entries newer than the version.
+	if last < since {
+		last = since
+	}
+
+	return last, bw.Close()
+}
+
+// Restore loads a backup created by Backup into the database.
+// The backup is verified to have been created from a database with
+// the same encryption key before any of its entries is loaded.
+// Incremental backups must be restored in the order they were created.
+func (db *bhDatabase) Restore(r io.Reader) error {
+	br, err := newBackupReader(r, db.bhOptions.EncryptionKey)
+	if err != nil {
+		return err
+	}
+
+	return db.bh.Badger().Load(br, backupMaxPendingWrites)
+}
+
+// backupAEAD creates the cipher used for backups of databases
+// encrypted with the provided key.
+func backupAEAD(dbKey []byte) (cipher.AEAD, error) {
+	key := sha256.Sum256(append([]byte(backupKeyLabel), dbKey...))
+
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.NewGCM(block)
+}
+
+// backupChunkData returns the additional data
+// authenticated along with an encrypted chunk.
+func backupChunkData(seq uint64, final bool) []byte {
+	b := make([]byte, 9)
+	binary.BigEndian.PutUint64(b, seq)
+	if final {
+		b[8] = 1
+	}
+
+	return b
+}
+
+type backupWriter struct {
+	w    *bufio.Writer
+	aead cipher.AEAD
+	buf  []byte
+	seq  uint64
+}
+
+func newBackupWriter(w io.Writer, dbKey []byte) (*backupWriter, error) {
+	bw := &backupWriter{
+		w:   bufio.NewWriter(w),
+		buf: make([]byte, 0, backupChunkSize),
+	}
+
+	header := []byte(backupMagic + "\x00")
+	if len(dbKey) != 0 {
+		aead, err := backupAEAD(dbKey)
+		if err != nil {
+			return nil, err
+		}
+		bw.aead = aead
+		header[len(backupMagic)] = 1
+	}
+
+	if _, err := bw.w.Write(header); err != nil {
+		return nil, err
+	}
+
+	return bw, nil
+}
+
+// Write buffers data, writing a chunk whenever the buffer fills.
+func (bw *backupWriter) Write(p []byte) (int, error) {
+	written := 0
+	for len(p) > 0 {
+		n := copy(bw.buf[len(bw.buf):cap(bw.buf)], p)
+		bw.buf = bw.buf[:len(bw.buf)+n]
+		p, written = p[n:], written+n
+
+		if len(bw.buf) == cap(bw.buf) {
+			if err := bw.writeChunk(bw.buf, false); err != nil {
+				return written, err
+			}
+			bw.buf = bw.buf[:0]
+		}
+	}
+
+	return written, nil
+}
+
+// Close writes any buffered data and the final chunk.
+// It does not close the underlying writer.
+func (bw *backupWriter) Close() error {
+	if len(bw.buf) > 0 {
+		if err := bw.writeChunk(bw.buf, false); err != nil {
+			return err
+		}
+		bw.buf = bw.buf[:0]
+	}
+	if err := bw.writeChunk(nil, true); err != nil {
+		return err
+	}
+
+	return bw.w.Flush()
+}
+
+func (bw *backupWriter) writeChunk(body []byte, final bool) error {
+	bw.seq++
+
+	if bw.aead != nil {
+		nonce := make([]byte, bw.aead.NonceSize())
+		if _, err := rand.Read(nonce); err != nil {
+			return fmt.Errorf("could not generate nonce: %w", err)
+		}
+		body = bw.aead.Seal(nonce, nonce, body, backupChunkData(bw.seq, final))
+	}
+
+	prefix := make([]byte, 5)
+	if final {
+		prefix[0] = 1
+	}
+	binary.BigEndian.PutUint32(prefix[1:], uint32(len(body)))
+
+	if _, err := bw.w.Write(prefix); err != nil {
+		return err
+	}
+	_, err := bw.w.Write(body)
+
+	return err
+}
+
+type backupReader struct {
+	r     *bufio.Reader
+	aead  cipher.AEAD
+	chunk bytes.Reader
+	seq   uint64
+	done  bool
+}
+
+// newBackupReader reads the backup header and first chunk,
+// verifying the backup against the database encryption key.
+func newBackupReader(r io.Reader, dbKey []byte) (*backupReader, error) {
+	br := &backupReader{
+		r: bufio.NewReader(r),
+	}
+
+	header := make([]byte, len(backupMagic)+1)
+	if _, err := io.ReadFull(br.r, header); err != nil ||
+		string(header[:len(backupMagic)]) != backupMagic {
+
+		return nil, fmt.Errorf("%w: invalid header", ErrMalformedBackup)
+	}
+
+	encrypted := header[len(backupMagic)] == 1
+	if encrypted != (len(dbKey) != 0) {
+		return nil, ErrBackupKeyMismatch
+	}
+	if encrypted {
+		aead, err := backupAEAD(dbKey)
+		if err != nil {
+			return nil, err
+		}
+		br.aead = aead
+	}
+
+	if err := br.readChunk(); err != nil {
+		return nil, err
+	}
+
+	return br, nil
+}
+
+func (br *backupReader) Read(p []byte) (int, error) {
+	for br.chunk.Len() == 0 {
+		if br.done {
+			return 0, io.EOF
+		}
+		if err := br.readChunk(); err != nil {
+			return 0, err
+		}
+	}
+
+	return br.chunk.Read(p)
+}
+
+func (br *backupReader) readChunk() error {
+	br.seq++
+
+	prefix := make([]byte, 5)
+	if _, err := io.ReadFull(br.r, prefix); err != nil {
+		return fmt.Errorf("%w: missing chunk", ErrMalformedBackup)
+	}
+	final := prefix[0] == 1
+
+	size := binary.BigEndian.Uint32(prefix[1:])
+	if size > backupChunkSize+1024 {
+		return fmt.Errorf("%w: chunk too large", ErrMalformedBackup)
+	}
+	body := make([]byte, size)
+	if _, err := io.ReadFull(br.r, body); err != nil {
+		return fmt.Errorf("%w: truncated chunk", ErrMalformedBackup)
+	}
+
+	if br.aead != nil {
+		nonceSize := br.aead.NonceSize()
+		if len(body) < nonceSize+br.aead.Overhead() {
+			return fmt.Errorf("%w: chunk too short", ErrMalformedBackup)
+		}
+		var err error
+		body, err = br.aead.Open(nil, body[:nonceSize], body[nonceSize:],
+			backupChunkData(br.seq, final))
+		if err != nil {
+			// Failing to open the first chunk is attributed
+			// to a different database key.
+			if br.seq == 1 {
+				return ErrBackupKeyMismatch
+			}
+			return fmt.Errorf("%w: could not open chunk: %v", ErrMalformedBackup, err)
+		}
+	}
+
+	if final && len(body) != 0 {
+		return fmt.Errorf("%w: non-empty final chunk", ErrMalformedBackup)
+	}
+	br.done = final
+	br.chunk.Reset(body)
+
+	return nil
+}
@@ -0,0 +1,74 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestBackupRestore(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	alice := generateContact("alie", "alice", generateHex(t, 33))
+	_, err := db.AddContact(&alice)
+	require.NoError(t, err)
+
+	full := new(bytes.Buffer)
+	version, err := db.Backup(full, 0)
+	require.NoError(t, err)
+	assert.NotContains(t, full.String(), "alice")
+
+	bob := generateContact("bobby", "bob", generateHex(t, 33))
+	_, err = db.AddContact(&bob)
+	require.NoError(t, err)
+
+	incremental := new(bytes.Buffer)
+	next, err := db.Backup(incremental, version)
+	require.NoError(t, err)
+	assert.Greater(t, next, version)
+
+	// Backups without changes retain the version.
+	unchanged, err := db.Backup(new(bytes.Buffer), next)
+	require.NoError(t, err)
+	assert.Equal(t, next, unchanged)
+
+	restored, cleanupRestored := createInMemoryDB(t)
+	defer cleanupRestored()
+
+	require.NoError(t, restored.Restore(bytes.NewReader(full.Bytes())))
+	contacts, err := restored.GetContacts()
+	require.NoError(t, err)
+	require.Len(t, contacts, 1)
+	assert.Equal(t, alice.Address, contacts[0].Address)
+
+	require.NoError(t, restored.Restore(bytes.NewReader(incremental.Bytes())))
+	contacts, err = restored.GetContacts()
+	require.NoError(t, err)
+	assert.Len(t, contacts, 2)
+
+	// Truncated backups are rejected.
+	truncated := full.Bytes()[:full.Len()-1]
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(truncated)), ErrMalformedBackup)
+
+	// Backups of databases with a different key are rejected.
+	other, err := New("", WithBadgerOption(
+		func(o badger.Options) badger.Options {
+			o = o.WithInMemory(true)
+			o = o.WithEncryptionKey([]byte("6543210987654321"))
+			o = o.WithIndexCacheSize(1 << 20)
+			return o
+		}),
+	)
+	require.NoError(t, err)
+	defer other.Close()
+
+	err = other.Restore(bytes.NewReader(full.Bytes()))
+	assert.ErrorIs(t, err, ErrBackupKeyMismatch)
+	contacts, err = other.GetContacts()
+	require.NoError(t, err)
+	assert.Empty(t, contacts)
+}
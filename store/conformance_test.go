@@ -0,0 +1,1262 @@
+package store
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/lightningnetwork/lnd/lnrpc"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// The conformance suite verifies the behaviour of the Database interface,
+// and runs against all database backends.
+// Conformance tests must not depend on the ids assigned by a backend.
+
+var conformanceBackends = []struct {
+	name string
+	open func(t *testing.T, key []byte) Database
+}{
+	{
+		name: "badger",
+		open: func(t *testing.T, key []byte) Database {
+			db, err := New("", WithBadgerOption(
+				func(o badger.Options) badger.Options {
+					return o.WithInMemory(true).WithEncryptionKey(key).
+						WithIndexCacheSize(1 << 20)
+				}),
+			)
+			require.NoError(t, err)
+			t.Cleanup(func() { assert.NoError(t, db.Close()) })
+
+			return db
+		},
+	},
+	{
+		name: "sqlite",
+		open: func(t *testing.T, key []byte) Database {
+			db, err := NewSQLite(":memory:", WithBackupEncryptionKey(key))
+			require.NoError(t, err)
+			t.Cleanup(func() { assert.NoError(t, db.Close()) })
+
+			return db
+		},
+	},
+}
+
+var conformanceTests = []struct {
+	name string
+	test func(t *testing.T, open func(key []byte) Database)
+}{
+	{"Contacts", testConformanceContacts},
+	{"Discussions", testConformanceDiscussions},
+	{"DiscussionListing", testConformanceDiscussionListing},
+	{"UnreadCount", testConformanceUnreadCount},
+	{"InvoicesPayments", testConformanceInvoicesPayments},
+	{"Messages", testConformanceMessages},
+	{"MessageReferences", testConformanceMessageReferences},
+	{"MessageFilters", testConformanceMessageFilters},
+	{"Search", testConformanceSearch},
+	{"Receipts", testConformanceReceipts},
+	{"Retention", testConformanceRetention},
+	{"Import", testConformanceImport},
+	{"Outbox", testConformanceOutbox},
+	{"BackupRestore", testConformanceBackupRestore},
+}
+
+func TestConformance(t *testing.T) {
+	key := []byte("1234567890123456")
+
+	for _, backend := range conformanceBackends {
+		backend := backend
+		t.Run(backend.name, func(t *testing.T) {
+			for _, tc := range conformanceTests {
+				tc := tc
+				t.Run(tc.name, func(t *testing.T) {
+					tc.test(t, func(k []byte) Database {
+						if k == nil {
+							k = key
+						}
+						return backend.open(t, k)
+					})
+				})
+			}
+		})
+	}
+}
+
+// conformanceFixture stores the records used by conformance tests.
+type conformanceFixture struct {
+	t  *testing.T
+	db Database
+}
+
+func (f *conformanceFixture) discussion(participants ...string) *model.Discussion {
+	if len(participants) == 0 {
+		participants = []string{generateHex(f.t, 33)}
+	}
+
+	discussion := generateDiscussion(participants)
+	disc, err := f.db.AddDiscussion(&discussion)
+	require.NoError(f.t, err)
+
+	return disc
+}
+
+// incoming stores an incoming message of a discussion with the provided
+// content, carried by an invoice paying the provided amount.
+func (f *conformanceFixture) incoming(disc *model.Discussion,
+	content model.MessageContent, amtMsat int64) *model.RawMessage {
+
+	rawMsg, err := model.NewRawMessage(disc, content)
+	require.NoError(f.t, err)
+
+	generated, inv := generateIncoming(f.t, disc.Participants[0])
+	inv.Hash = generateHex(f.t, 32)
+	inv.AmtPaid = lnchat.NewAmount(amtMsat)
+	rawMsg.Sender, rawMsg.Signature = generated.Sender, generated.Signature
+	rawMsg.SignatureVerified = generated.SignatureVerified
+	rawMsg.InvoiceSettleIndex = generated.InvoiceSettleIndex
+
+	require.NoError(f.t, f.db.AddInvoice(inv))
+	require.NoError(f.t, f.db.AddRawMessage(rawMsg))
+
+	return rawMsg
+}
+
+func (f *conformanceFixture) text(disc *model.Discussion, body string) *model.RawMessage {
+	return f.incoming(disc, model.MessageContent{Body: body}, 1000)
+}
+
+// outgoing stores an outgoing message of a discussion, carried by
+// a payment to each participant with a succeeded attempt of amtMsat.
+func (f *conformanceFixture) outgoing(disc *model.Discussion,
+	amtMsat int64) (*model.RawMessage, []*model.Payment) {
+
+	rawMsg, payments := generateOutgoing(f.t, disc.Participants...)
+	rawMsg.DiscussionID = disc.ID
+	for _, p := range payments {
+		p.Hash = generateHex(f.t, 32)
+		p.Htlcs = []lnchat.HTLCAttempt{
+			{
+				Route:  lnchat.Route{Amt: lnchat.NewAmount(amtMsat)},
+				Status: lnrpc.HTLCAttempt_SUCCEEDED,
+			},
+			{
+				Route:  lnchat.Route{Amt: lnchat.NewAmount(amtMsat)},
+				Status: lnrpc.HTLCAttempt_FAILED,
+			},
+		}
+	}
+
+	require.NoError(f.t, f.db.AddPayments(payments...))
+	require.NoError(f.t, f.db.AddRawMessage(rawMsg))
+
+	return rawMsg, payments
+}
+
+func (f *conformanceFixture) messages(disc *model.Discussion,
+	pageOpts model.PageOptions) []uint64 {
+
+	list, err := f.db.GetMessages(disc.ID, pageOpts)
+	require.NoError(f.t, err)
+
+	return aggregateIDs(list)
+}
+
+func (f *conformanceFixture) search(query model.SearchQuery,
+	pageOpts model.PageOptions) []uint64 {
+
+	list, err := f.db.SearchMessages(query, pageOpts)
+	require.NoError(f.t, err)
+
+	return aggregateIDs(list)
+}
+
+// assertRawMessage asserts that a retrieved raw message equals the
+// expected one. Backends retain the timestamp instant, not its location.
+func assertRawMessage(t *testing.T, expected *model.RawMessage, actual *model.RawMessage) {
+	t.Helper()
+
+	assert.True(t, expected.Timestamp.Equal(actual.Timestamp),
+		"timestamp %v differs from %v", actual.Timestamp, expected.Timestamp)
+	retrieved := *actual
+	retrieved.Timestamp = expected.Timestamp
+	assert.Equal(t, expected, &retrieved)
+}
+
+func aggregateIDs(list []MessageAggregate) []uint64 {
+	ids := make([]uint64, len(list))
+	for i, msg := range list {
+		ids[i] = msg.RawMessage.ID
+	}
+
+	return ids
+}
+
+func rawIDs(raws ...*model.RawMessage) []uint64 {
+	ids := make([]uint64, len(raws))
+	for i, raw := range raws {
+		ids[i] = raw.ID
+	}
+
+	return ids
+}
+
+func discussionIDs(discussions []model.Discussion) []uint64 {
+	ids := make([]uint64, len(discussions))
+	for i, disc := range discussions {
+		ids[i] = disc.ID
+	}
+
+	return ids
+}
+
+func testConformanceContacts(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+
+	alice := generateContact("alie", "alice", generateHex(t, 33))
+	bob := generateContact("bobby", "bob", generateHex(t, 33))
+	for _, c := range []*model.Contact{&alice, &bob} {
+		_, err := db.AddContact(c)
+		require.NoError(t, err)
+	}
+	assert.NotEqual(t, alice.ID, bob.ID)
+
+	duplicate := generateContact("al", "alice", alice.Address)
+	_, err := db.AddContact(&duplicate)
+	assert.ErrorIs(t, err, ErrContactAlreadyExists)
+
+	contact, err := db.GetContact(alice.Address)
+	require.NoError(t, err)
+	assert.Equal(t, alice, *contact)
+
+	contact, err = db.GetContactByID(bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bob, *contact)
+
+	contacts, err := db.GetContacts()
+	require.NoError(t, err)
+	assert.Equal(t, []model.Contact{alice, bob}, contacts)
+
+	contact, err = db.RemoveContact(alice.Address)
+	require.NoError(t, err)
+	assert.Equal(t, alice, *contact)
+
+	contact, err = db.RemoveContactByID(bob.ID)
+	require.NoError(t, err)
+	assert.Equal(t, bob, *contact)
+
+	_, err = db.GetContact(alice.Address)
+	assert.ErrorIs(t, err, ErrContactNotFound)
+	_, err = db.GetContactByID(bob.ID)
+	assert.ErrorIs(t, err, ErrContactNotFound)
+	_, err = db.RemoveContactByID(bob.ID)
+	assert.ErrorIs(t, err, ErrContactNotFound)
+
+	contacts, err = db.GetContacts()
+	require.NoError(t, err)
+	assert.Empty(t, contacts)
+}
+
+func testConformanceDiscussions(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	participants := []string{generateHex(t, 33), generateHex(t, 33)}
+	disc := f.discussion(participants[1], participants[0])
+
+	// Discussions are unique by participant set, regardless of order.
+	found, err := db.GetDiscussionByParticipants(
+		[]string{participants[0], participants[1]})
+	require.NoError(t, err)
+	assert.Equal(t, disc, found)
+
+	duplicate := generateDiscussion([]string{participants[0], participants[1]})
+	_, err = db.AddDiscussion(&duplicate)
+	assert.ErrorIs(t, err, ErrDiscussionAlreadyExists)
+
+	metadata := model.DiscussionMetadata{Title: "title", Muted: true, Pinned: true}
+	options := model.MessageOptions{FeeLimitMsat: 1000, Anonymous: true}
+	policy := model.RetentionPolicy{MaxCount: 10, MaxAge: time.Hour}
+	require.NoError(t, db.UpdateDiscussionMetadata(disc.ID, metadata))
+	require.NoError(t, db.UpdateDiscussionOptions(disc.ID, options))
+	require.NoError(t, db.UpdateDiscussionRetention(disc.ID, policy))
+
+	found, err = db.GetDiscussion(disc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, metadata, found.Metadata)
+	assert.Equal(t, options, found.Options)
+	assert.Equal(t, policy, found.Retention)
+
+	// Soft deleted discussions are restored by new messages.
+	require.NoError(t, db.SoftDeleteDiscussion(disc.ID))
+	found, err = db.GetDiscussion(disc.ID)
+	require.NoError(t, err)
+	assert.True(t, found.Deleted)
+
+	msg := f.text(disc, "hello")
+	found, err = db.GetDiscussion(disc.ID)
+	require.NoError(t, err)
+	assert.False(t, found.Deleted)
+	assert.Equal(t, msg.ID, found.LastMessageID)
+
+	require.NoError(t, db.SoftDeleteDiscussion(disc.ID))
+	require.NoError(t, db.RestoreDiscussion(disc.ID))
+	found, err = db.GetDiscussion(disc.ID)
+	require.NoError(t, err)
+	assert.False(t, found.Deleted)
+
+	// Removing a discussion removes its messages.
+	removed, err := db.RemoveDiscussion(disc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, disc.ID, removed.ID)
+
+	_, err = db.GetDiscussion(disc.ID)
+	assert.ErrorIs(t, err, ErrDiscussionNotFound)
+	_, err = db.GetMessages(disc.ID, model.PageOptions{})
+	assert.ErrorIs(t, err, ErrDiscussionNotFound)
+	assert.Empty(t, f.search(model.SearchQuery{Text: "hello"}, model.PageOptions{}))
+
+	_, err = db.RemoveDiscussion(disc.ID)
+	assert.ErrorIs(t, err, ErrDiscussionNotFound)
+	assert.ErrorIs(t, db.SoftDeleteDiscussion(disc.ID), ErrDiscussionNotFound)
+	assert.ErrorIs(t, db.UpdateDiscussionMetadata(disc.ID, metadata),
+		ErrDiscussionNotFound)
+}
+
+func testConformanceDiscussionListing(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	resetTimestampGetter := overrideTimestampGetterFrom(time.Unix(0, 0), time.Hour)
+	defer resetTimestampGetter()
+
+	discs := make([]*model.Discussion, 4)
+	for i := range discs {
+		discs[i] = f.discussion()
+	}
+	var ids []uint64
+	for _, disc := range discs {
+		ids = append(ids, disc.ID)
+	}
+
+	list := func(pageOpts model.PageOptions, order model.DiscussionOrder,
+		filter model.DiscussionFilter) []uint64 {
+
+		discussions, err := db.GetDiscussions(pageOpts, order, filter)
+		require.NoError(t, err)
+
+		return discussionIDs(discussions)
+	}
+
+	assert.Equal(t, ids, list(model.PageOptions{}, model.DiscussionOrderID,
+		model.DiscussionFilter{}))
+	assert.Equal(t, ids[1:3], list(model.PageOptions{LastID: ids[1], PageSize: 2},
+		model.DiscussionOrderID, model.DiscussionFilter{}))
+	assert.Equal(t, ids[1:3], list(model.PageOptions{LastID: ids[2], PageSize: 2,
+		Reverse: true}, model.DiscussionOrderID, model.DiscussionFilter{}))
+	assert.Equal(t, ids[2:], list(model.PageOptions{PageSize: 2, Reverse: true},
+		model.DiscussionOrderID, model.DiscussionFilter{}))
+
+	// Pinned discussions are listed first, and discussions
+	// without messages are listed last.
+	f.text(discs[2], "first")
+	f.text(discs[0], "second")
+	f.text(discs[1], "third")
+	require.NoError(t, db.UpdateDiscussionMetadata(discs[2].ID,
+		model.DiscussionMetadata{Pinned: true}))
+
+	activity := []uint64{ids[2], ids[1], ids[0], ids[3]}
+	assert.Equal(t, activity, list(model.PageOptions{},
+		model.DiscussionOrderLastActivity, model.DiscussionFilter{}))
+	assert.Equal(t, activity[1:3], list(model.PageOptions{LastID: ids[1], PageSize: 2},
+		model.DiscussionOrderLastActivity, model.DiscussionFilter{}))
+	assert.Equal(t, activity[2:], list(model.PageOptions{PageSize: 2, Reverse: true},
+		model.DiscussionOrderLastActivity, model.DiscussionFilter{}))
+
+	// Discussions are relisted as they receive messages.
+	f.text(discs[0], "fourth")
+	activity = []uint64{ids[2], ids[0], ids[1], ids[3]}
+	assert.Equal(t, activity, list(model.PageOptions{},
+		model.DiscussionOrderLastActivity, model.DiscussionFilter{}))
+	assert.Equal(t, activity[1:3], list(model.PageOptions{LastID: ids[1], PageSize: 2,
+		Reverse: true}, model.DiscussionOrderLastActivity, model.DiscussionFilter{}))
+
+	require.NoError(t, db.SoftDeleteDiscussion(discs[0].ID))
+	assert.Equal(t, []uint64{ids[2]}, list(model.PageOptions{},
+		model.DiscussionOrderID, model.DiscussionFilter{Pinned: model.FlagSet}))
+	assert.Equal(t, []uint64{ids[1], ids[3]}, list(model.PageOptions{},
+		model.DiscussionOrderID, model.DiscussionFilter{Pinned: model.FlagUnset}))
+	assert.Equal(t, []uint64{ids[0]}, list(model.PageOptions{},
+		model.DiscussionOrderID, model.DiscussionFilter{Deleted: true}))
+
+	// Unlisted discussions anchor pages on their position in the listing.
+	assert.Equal(t, []uint64{ids[1], ids[3]}, list(model.PageOptions{LastID: ids[2]},
+		model.DiscussionOrderLastActivity, model.DiscussionFilter{Pinned: model.FlagUnset}))
+	assert.Equal(t, []uint64{ids[2]}, list(model.PageOptions{LastID: ids[1], Reverse: true},
+		model.DiscussionOrderLastActivity, model.DiscussionFilter{Pinned: model.FlagSet}))
+}
+
+func testConformanceUnreadCount(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	disc, other := f.discussion(), f.discussion()
+
+	count, err := db.GetUnreadCount(disc.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	first := f.text(disc, "first")
+	f.outgoing(disc, 1000)
+	second := f.text(disc, "second")
+	f.incoming(disc, model.MessageContent{
+		ContentType: model.ContentTypeReaction,
+		Body:        "👍",
+		Target:      first.MessageID,
+	}, 1000)
+	otherMsg := f.text(other, "other")
+
+	// Only received standalone messages are counted.
+	require.NoError(t, db.UpdateDiscussionLastRead(disc.ID, first.ID))
+	count, err = db.GetUnreadCount(disc.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	require.NoError(t, db.UpdateDiscussionLastRead(disc.ID, second.ID))
+	count, err = db.GetUnreadCount(disc.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// Messages stored before their target are counted until linked.
+	const lateID = "00000000-0000-4000-8000-000000000001"
+	f.incoming(disc, model.MessageContent{
+		ContentType: model.ContentTypeReaction,
+		Body:        "👍",
+		Target:      lateID,
+	}, 1000)
+	count, err = db.GetUnreadCount(disc.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	late, inv := generateIncoming(t, disc.Participants[0])
+	late.DiscussionID, late.MessageID = disc.ID, lateID
+	require.NoError(t, db.AddInvoice(inv))
+	require.NoError(t, db.AddRawMessage(late))
+	count, err = db.GetUnreadCount(disc.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+
+	stored, err := db.GetDiscussion(disc.ID)
+	require.NoError(t, err)
+	assert.True(t, late.Timestamp.Equal(stored.LastActivity))
+
+	assert.ErrorIs(t, db.UpdateDiscussionLastRead(disc.ID, otherMsg.ID),
+		ErrMessageInvalidDisc)
+	assert.ErrorIs(t, db.UpdateDiscussionLastRead(disc.ID, otherMsg.ID+100),
+		ErrMessageNotFound)
+
+	_, err = db.GetUnreadCount(disc.ID + other.ID + 100)
+	assert.ErrorIs(t, err, ErrDiscussionNotFound)
+}
+
+func testConformanceInvoicesPayments(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+
+	idx, err := db.GetLastInvoiceIndex()
+	require.NoError(t, err)
+	assert.Zero(t, idx)
+	idx, err = db.GetLastPaymentIndex()
+	require.NoError(t, err)
+	assert.Zero(t, idx)
+
+	_, first := generateIncoming(t, generateHex(t, 33))
+	_, second := generateIncoming(t, generateHex(t, 33))
+	require.NoError(t, db.AddInvoice(second))
+	require.NoError(t, db.AddInvoice(first))
+	assert.ErrorIs(t, db.AddInvoice(first), ErrDuplicateInvoice)
+
+	idx, err = db.GetLastInvoiceIndex()
+	require.NoError(t, err)
+	assert.Equal(t, second.SettleIndex, idx)
+
+	_, payments := generateOutgoing(t, generateHex(t, 33), generateHex(t, 33))
+	require.NoError(t, db.AddPayments(payments[0]))
+
+	// Payments are stored atomically.
+	_, more := generateOutgoing(t, generateHex(t, 33))
+	assert.ErrorIs(t, db.AddPayments(more[0], payments[0]), ErrDuplicatePayment)
+	idx, err = db.GetLastPaymentIndex()
+	require.NoError(t, err)
+	assert.Equal(t, payments[0].PaymentIndex, idx)
+
+	require.NoError(t, db.AddPayments(payments[1], more[0]))
+	idx, err = db.GetLastPaymentIndex()
+	require.NoError(t, err)
+	assert.Equal(t, more[0].PaymentIndex, idx)
+	require.NoError(t, db.AddPayments())
+
+	// Invoices are stored atomically with the message they carry.
+	disc := (&conformanceFixture{t: t, db: db}).discussion()
+	rawMsg, inv := generateIncoming(t, disc.Participants[0])
+	rawMsg.DiscussionID = disc.ID + 100
+	assert.ErrorIs(t, db.AddInvoiceMessage(inv, rawMsg), ErrDiscussionNotFound)
+	idx, err = db.GetLastInvoiceIndex()
+	require.NoError(t, err)
+	assert.Equal(t, second.SettleIndex, idx)
+
+	rawMsg.DiscussionID = disc.ID
+	require.NoError(t, db.AddInvoiceMessage(inv, rawMsg))
+	duplicate, _ := generateIncoming(t, disc.Participants[0])
+	duplicate.DiscussionID = disc.ID
+	assert.ErrorIs(t, db.AddInvoiceMessage(inv, duplicate), ErrDuplicateInvoice)
+
+	stored, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, stored, 1)
+	require.NotNil(t, stored[0].Invoice)
+	assert.Equal(t, inv.SettleIndex, stored[0].Invoice.SettleIndex)
+
+	// Invoices carrying message parts are pending
+	// until the message is stored.
+	_, parts := generateIncoming(t, disc.Participants[0])
+	_, other := generateIncoming(t, disc.Participants[0])
+	require.NoError(t, db.AddInvoiceFragment(parts))
+	require.NoError(t, db.AddInvoiceFragment(other))
+	assert.ErrorIs(t, db.AddInvoiceFragment(parts), ErrDuplicateInvoice)
+	pending, err := db.GetInvoiceFragments()
+	require.NoError(t, err)
+	require.Len(t, pending, 2)
+	assert.Equal(t, parts.SettleIndex, pending[0].SettleIndex)
+	assert.Equal(t, other.SettleIndex, pending[1].SettleIndex)
+
+	reassembled, last := generateIncoming(t, disc.Participants[0])
+	reassembled.DiscussionID = disc.ID
+	reassembled.FragmentSettleIndexes = []uint64{parts.SettleIndex}
+	require.NoError(t, db.AddInvoiceMessage(last, reassembled))
+	pending, err = db.GetInvoiceFragments()
+	require.NoError(t, err)
+	require.Len(t, pending, 1)
+	assert.Equal(t, other.SettleIndex, pending[0].SettleIndex)
+
+	require.NoError(t, db.RemoveInvoiceFragments(other.SettleIndex))
+	pending, err = db.GetInvoiceFragments()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+
+	// Messages reassembled from pending invoices are stored by themselves.
+	loaded, firstPart := generateIncoming(t, disc.Participants[0])
+	_, lastPart := generateIncoming(t, disc.Participants[0])
+	require.NoError(t, db.AddInvoiceFragment(firstPart))
+	require.NoError(t, db.AddInvoiceFragment(lastPart))
+	loaded.DiscussionID = disc.ID
+	loaded.InvoiceSettleIndex = lastPart.SettleIndex
+	loaded.FragmentSettleIndexes = []uint64{firstPart.SettleIndex}
+	require.NoError(t, db.AddRawMessage(loaded))
+	pending, err = db.GetInvoiceFragments()
+	require.NoError(t, err)
+	assert.Empty(t, pending)
+}
+
+func testConformanceMessages(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	resetTimestampGetter := overrideTimestampGetterFrom(time.Unix(0, 0), time.Hour)
+	defer resetTimestampGetter()
+
+	disc := f.discussion()
+
+	// Messages require their discussion and invoice or payments.
+	missingDisc, inv := generateIncoming(t, disc.Participants[0])
+	missingDisc.DiscussionID = disc.ID + 100
+	require.NoError(t, db.AddInvoice(inv))
+	assert.ErrorIs(t, db.AddRawMessage(missingDisc), ErrDiscussionNotFound)
+
+	missingInv, _ := generateIncoming(t, disc.Participants[0])
+	missingInv.DiscussionID = disc.ID
+	assert.Error(t, db.AddRawMessage(missingInv))
+
+	missingPayments, _ := generateOutgoing(t, disc.Participants[0])
+	missingPayments.DiscussionID = disc.ID
+	assert.Error(t, db.AddRawMessage(missingPayments))
+
+	incoming := f.incoming(disc, model.MessageContent{Body: "in"}, 1500)
+	outgoing, payments := f.outgoing(disc, 2500)
+	msgs := []*model.RawMessage{incoming, outgoing}
+	foreign := f.text(f.discussion(), "foreign")
+	for i := 0; i < 3; i++ {
+		msgs = append(msgs, f.text(disc, "more"))
+	}
+	assert.EqualValues(t, 1500, incoming.AmtMsat)
+	assert.EqualValues(t, 2500, outgoing.AmtMsat)
+
+	list, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, list, len(msgs))
+	assert.Equal(t, rawIDs(msgs...), aggregateIDs(list))
+
+	assertRawMessage(t, incoming, list[0].RawMessage)
+	require.NotNil(t, list[0].Invoice)
+	assert.Equal(t, incoming.InvoiceSettleIndex, list[0].Invoice.SettleIndex)
+	assert.Empty(t, list[0].Payments)
+
+	assertRawMessage(t, outgoing, list[1].RawMessage)
+	assert.Nil(t, list[1].Invoice)
+	require.Len(t, list[1].Payments, 1)
+	assert.Equal(t, *payments[0], *list[1].Payments[0])
+
+	ids := rawIDs(msgs...)
+	cases := []struct {
+		name     string
+		pageOpts model.PageOptions
+		expected []uint64
+	}{
+		{
+			name:     "page",
+			pageOpts: model.PageOptions{LastID: ids[1], PageSize: 2},
+			expected: ids[1:3],
+		},
+		{
+			name:     "reverse page",
+			pageOpts: model.PageOptions{LastID: ids[3], PageSize: 2, Reverse: true},
+			expected: ids[2:4],
+		},
+		{
+			name:     "latest",
+			pageOpts: model.PageOptions{PageSize: 3, Reverse: true},
+			expected: ids[2:],
+		},
+		{
+			name:     "past last",
+			pageOpts: model.PageOptions{LastID: ids[4] + 1},
+			expected: []uint64{},
+		},
+		{
+			name:     "anchor of another discussion",
+			pageOpts: model.PageOptions{LastID: foreign.ID, PageSize: 2},
+			expected: ids[2:4],
+		},
+		{
+			name:     "reverse anchor of another discussion",
+			pageOpts: model.PageOptions{LastID: foreign.ID, Reverse: true},
+			expected: ids[:2],
+		},
+		{
+			name:     "reverse past last",
+			pageOpts: model.PageOptions{LastID: ids[4] + 1, PageSize: 2, Reverse: true},
+			expected: ids[3:],
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, f.messages(disc, c.pageOpts))
+		})
+	}
+
+	// Pages are ordered and anchored by timestamp,
+	// irrespective of the storage order of messages.
+	resetEarlyTimestampGetter := overrideTimestampGetterFrom(
+		time.Unix(0, 0).Add(-2*time.Hour), time.Hour)
+	defer resetEarlyTimestampGetter()
+
+	early := f.text(disc, "early")
+	assert.Equal(t, append([]uint64{early.ID}, ids...),
+		f.messages(disc, model.PageOptions{}))
+	assert.Equal(t, ids[1:3],
+		f.messages(disc, model.PageOptions{LastID: ids[1], PageSize: 2}))
+	assert.Equal(t, []uint64{early.ID, ids[0], ids[1]},
+		f.messages(disc, model.PageOptions{LastID: ids[1], Reverse: true}))
+	assert.Equal(t, []uint64{early.ID},
+		f.messages(disc, model.PageOptions{LastID: early.ID, Reverse: true}))
+}
+
+func testConformanceMessageReferences(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	disc := f.discussion()
+
+	add := func(messageID string, contentType model.ContentType,
+		replyTo, target string) *model.RawMessage {
+
+		rawMsg, inv := generateIncoming(t, disc.Participants[0])
+		rawMsg.DiscussionID = disc.ID
+		rawMsg.MessageID, rawMsg.ContentType = messageID, contentType
+		rawMsg.ReplyTo, rawMsg.Target = replyTo, target
+
+		require.NoError(t, db.AddInvoice(inv))
+		require.NoError(t, db.AddRawMessage(rawMsg))
+
+		return rawMsg
+	}
+
+	latest := func() uint64 {
+		stored, err := db.GetDiscussion(disc.ID)
+		require.NoError(t, err)
+		return stored.LatestMessageID
+	}
+
+	first := add("first", model.ContentTypeText, "", "")
+	reaction := add("reaction", model.ContentTypeReaction, "", "first")
+	assert.Equal(t, first.ID, latest())
+	assert.True(t, reaction.TargetLinked)
+	assert.Equal(t, first.ID, reaction.TargetID)
+
+	reply := add("reply", model.ContentTypeText, "first", "")
+	assert.True(t, reply.ReplyToLinked)
+	assert.Equal(t, first.ID, reply.ReplyToID)
+
+	earlyReply := add("early reply", model.ContentTypeText, "late", "")
+	earlyEdit := add("early edit", model.ContentTypeEdit, "", "late")
+	assert.Equal(t, earlyEdit.ID, latest())
+	late := add("late", model.ContentTypeText, "", "")
+	assert.Equal(t, late.ID, latest())
+	reactionReply := add("reaction reply", model.ContentTypeText, "reaction", "")
+	assert.False(t, reactionReply.ReplyToLinked)
+	assert.Equal(t, reactionReply.ID, latest())
+
+	msg, err := db.GetMessage(first.ID)
+	require.NoError(t, err)
+	require.Len(t, msg.Annotations, 1)
+	assert.Equal(t, reaction.ID, msg.Annotations[0].RawMessage.ID)
+
+	list, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, rawIDs(first, reply, earlyReply, late, reactionReply),
+		aggregateIDs(list))
+
+	require.Len(t, list[0].Annotations, 1)
+	assert.Equal(t, reaction.ID, list[0].Annotations[0].RawMessage.ID)
+	assert.NotNil(t, list[0].Annotations[0].Invoice)
+
+	assert.True(t, list[2].RawMessage.ReplyToLinked)
+	assert.Equal(t, late.ID, list[2].RawMessage.ReplyToID)
+
+	require.Len(t, list[3].Annotations, 1)
+	assert.Equal(t, earlyEdit.ID, list[3].Annotations[0].RawMessage.ID)
+	assert.Equal(t, late.ID, list[3].Annotations[0].RawMessage.TargetID)
+}
+
+func testConformanceMessageFilters(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	resetTimestampGetter := overrideTimestampGetterFrom(time.Unix(0, 0), time.Hour)
+	defer resetTimestampGetter()
+
+	disc := f.discussion()
+
+	sent := func(amtMsat int64) *model.RawMessage {
+		raw, _ := f.outgoing(disc, amtMsat)
+		return raw
+	}
+	received := func(amtMsat int64) *model.RawMessage {
+		return f.incoming(disc, model.MessageContent{Body: "received"}, amtMsat)
+	}
+	msgs := []*model.RawMessage{
+		received(1000), sent(2000), received(3000), sent(4000), received(5000),
+	}
+
+	cases := []struct {
+		name     string
+		pageOpts model.PageOptions
+		expected []*model.RawMessage
+	}{
+		{
+			name: "time range",
+			pageOpts: model.PageOptions{
+				FromTimeNs: msgs[1].Timestamp.UnixNano(),
+				ToTimeNs:   msgs[3].Timestamp.UnixNano(),
+			},
+			expected: msgs[1:4],
+		},
+		{
+			name:     "amount range",
+			pageOpts: model.PageOptions{MinAmtMsat: 2000, MaxAmtMsat: 4000},
+			expected: msgs[1:4],
+		},
+		{
+			name:     "sent",
+			pageOpts: model.PageOptions{Direction: model.DirectionSent},
+			expected: []*model.RawMessage{msgs[1], msgs[3]},
+		},
+		{
+			name: "received above amount",
+			pageOpts: model.PageOptions{
+				MinAmtMsat: 2000,
+				Direction:  model.DirectionReceived,
+			},
+			expected: []*model.RawMessage{msgs[2], msgs[4]},
+		},
+		{
+			name: "latest since time",
+			pageOpts: model.PageOptions{
+				PageSize:   4,
+				Reverse:    true,
+				FromTimeNs: msgs[2].Timestamp.UnixNano(),
+			},
+			expected: msgs[2:],
+		},
+		{
+			name: "page over amount",
+			pageOpts: model.PageOptions{
+				LastID:     msgs[1].ID,
+				PageSize:   2,
+				MinAmtMsat: 1000,
+			},
+			expected: msgs[1:3],
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, rawIDs(c.expected...), f.messages(disc, c.pageOpts))
+		})
+	}
+}
+
+func testConformanceSearch(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	resetTimestampGetter := overrideTimestampGetterFrom(time.Unix(0, 0), time.Hour)
+	defer resetTimestampGetter()
+
+	discs := []*model.Discussion{f.discussion(), f.discussion()}
+
+	first := f.incoming(discs[0], model.MessageContent{Body: "Hello, world!"}, 1000)
+	second := f.incoming(discs[1], model.MessageContent{Body: "hello there"}, 5000)
+	third := f.incoming(discs[0], model.MessageContent{Body: "Goodbye world"}, 2000)
+
+	// Edits are indexed under their target, even if stored before it.
+	late, err := model.NewRawMessage(discs[1], model.MessageContent{Body: "original"})
+	require.NoError(t, err)
+	f.incoming(discs[1], model.MessageContent{
+		ContentType: model.ContentTypeEdit,
+		Body:        "corrected",
+		Target:      late.MessageID,
+	}, 1000)
+	generated, inv := generateIncoming(t, discs[1].Participants[0])
+	inv.AmtPaid = lnchat.NewAmount(3000)
+	late.Sender, late.Signature = generated.Sender, generated.Signature
+	late.SignatureVerified = generated.SignatureVerified
+	late.InvoiceSettleIndex = generated.InvoiceSettleIndex
+	require.NoError(t, db.AddInvoice(inv))
+	require.NoError(t, db.AddRawMessage(late))
+
+	// Deleted messages and messages of deleted discussions are omitted.
+	deleted := f.incoming(discs[0], model.MessageContent{Body: "deleted world"}, 1000)
+	f.incoming(discs[0], model.MessageContent{
+		ContentType: model.ContentTypeDeleteRequest,
+		Target:      deleted.MessageID,
+	}, 1000)
+	hidden := f.discussion()
+	f.incoming(hidden, model.MessageContent{Body: "hidden world"}, 1000)
+	require.NoError(t, db.SoftDeleteDiscussion(hidden.ID))
+
+	cases := []struct {
+		name     string
+		query    model.SearchQuery
+		pageOpts model.PageOptions
+		expected []uint64
+	}{
+		{
+			name:     "prefix",
+			query:    model.SearchQuery{Text: "HEL"},
+			expected: rawIDs(first, second),
+		},
+		{
+			name:     "all terms",
+			query:    model.SearchQuery{Text: "world hello"},
+			expected: rawIDs(first),
+		},
+		{
+			name:     "no match",
+			query:    model.SearchQuery{Text: "hello moon"},
+			expected: []uint64{},
+		},
+		{
+			name:     "edited",
+			query:    model.SearchQuery{Text: "correct"},
+			expected: rawIDs(late),
+		},
+		{
+			name:     "superseded",
+			query:    model.SearchQuery{Text: "original"},
+			expected: []uint64{},
+		},
+		{
+			name:     "deleted",
+			query:    model.SearchQuery{Text: "world"},
+			expected: rawIDs(first, third),
+		},
+		{
+			name:     "deleted without terms",
+			query:    model.SearchQuery{DiscussionIDs: []uint64{discs[0].ID, hidden.ID}},
+			expected: rawIDs(first, third),
+		},
+		{
+			name: "discussion",
+			query: model.SearchQuery{
+				Text:          "hello",
+				DiscussionIDs: []uint64{discs[1].ID},
+			},
+			expected: rawIDs(second),
+		},
+		{
+			name:     "sender",
+			query:    model.SearchQuery{Sender: discs[0].Participants[0]},
+			expected: rawIDs(first, third),
+		},
+		{
+			name:     "amount range",
+			query:    model.SearchQuery{MinAmtMsat: 2000, MaxAmtMsat: 3000},
+			expected: rawIDs(third, late),
+		},
+		{
+			name:     "page",
+			query:    model.SearchQuery{Text: "world"},
+			pageOpts: model.PageOptions{LastID: first.ID + 1, PageSize: 1},
+			expected: rawIDs(third),
+		},
+		{
+			name:     "latest",
+			query:    model.SearchQuery{Text: "hello"},
+			pageOpts: model.PageOptions{PageSize: 1, Reverse: true},
+			expected: rawIDs(second),
+		},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			assert.Equal(t, c.expected, f.search(c.query, c.pageOpts))
+		})
+	}
+}
+
+func testConformanceReceipts(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	participants := []string{generateHex(t, 33), generateHex(t, 33)}
+	disc := f.discussion(participants...)
+
+	first, _ := f.outgoing(disc, 1000)
+	incoming := f.text(disc, "incoming")
+	second, payments := f.outgoing(disc, 1000)
+
+	receipts := func(id uint64) []model.RecipientReceipts {
+		list, err := db.GetMessages(disc.ID, model.PageOptions{})
+		require.NoError(t, err)
+		for _, msg := range list {
+			if msg.RawMessage.ID == id {
+				return msg.RawMessage.Receipts
+			}
+		}
+		require.FailNow(t, "message not found")
+
+		return nil
+	}
+
+	require.NoError(t, db.AddReceipt(&model.Receipt{
+		Type:        model.ReceiptDELIVERED,
+		PaymentHash: payments[0].Hash,
+		Sender:      payments[0].PayeeAddress,
+		TimeNs:      10,
+	}))
+	assert.Empty(t, receipts(first.ID))
+	assert.Equal(t, []model.RecipientReceipts{
+		{Recipient: payments[0].PayeeAddress, DeliveredTimeNs: 10},
+	}, receipts(second.ID))
+
+	require.NoError(t, db.AddReceipt(&model.Receipt{
+		Type:        model.ReceiptREAD,
+		PaymentHash: payments[1].Hash,
+		Sender:      payments[1].PayeeAddress,
+		TimeNs:      20,
+	}))
+	assert.Equal(t, []model.RecipientReceipts{
+		{Recipient: payments[1].PayeeAddress, DeliveredTimeNs: 20, ReadTimeNs: 20},
+	}, receipts(first.ID))
+	assert.Len(t, receipts(second.ID), 2)
+	assert.Empty(t, receipts(incoming.ID))
+
+	assert.ErrorIs(t, db.AddReceipt(&model.Receipt{
+		Type:        model.ReceiptDELIVERED,
+		PaymentHash: payments[0].Hash,
+		Sender:      payments[1].PayeeAddress,
+	}), ErrMessageNotFound)
+}
+
+func testConformanceRetention(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	resetTimestampGetter := overrideTimestampGetterFrom(time.Unix(0, 0), time.Hour)
+	defer resetTimestampGetter()
+
+	disc := f.discussion()
+	first := f.text(disc, "secret words")
+	f.incoming(disc, model.MessageContent{
+		ContentType: model.ContentTypeEdit,
+		Body:        "secret edit",
+		Target:      first.MessageID,
+	}, 1000)
+	second := f.text(disc, "another secret")
+	third := f.text(disc, "kept secret")
+
+	require.NoError(t, db.RedactMessage(first.ID))
+	assert.ErrorIs(t, db.RedactMessage(third.ID+100), ErrMessageNotFound)
+
+	list, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, list, 3)
+	assert.True(t, list[0].RawMessage.Redacted)
+	require.Len(t, list[0].Annotations, 1)
+	assert.True(t, list[0].Annotations[0].RawMessage.Redacted)
+	assert.Equal(t, rawIDs(second, third),
+		f.search(model.SearchQuery{Text: "secret"}, model.PageOptions{}))
+
+	// Redacted messages are not counted again.
+	policy := model.RetentionPolicy{MaxCount: 1}
+	redacted, err := db.ApplyRetention(policy, time.Unix(0, 0))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, redacted)
+	assert.Equal(t, rawIDs(third),
+		f.search(model.SearchQuery{Text: "secret"}, model.PageOptions{}))
+
+	// Discussion policies override the default policy.
+	require.NoError(t, db.UpdateDiscussionRetention(disc.ID,
+		model.RetentionPolicy{MaxAge: time.Hour}))
+	redacted, err = db.ApplyRetention(policy, third.Timestamp.Add(2*time.Hour))
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, redacted)
+	assert.Empty(t, f.search(model.SearchQuery{Text: "secret"}, model.PageOptions{}))
+}
+
+func testConformanceImport(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	disc := f.discussion()
+	timestamp := time.Unix(1000, 0)
+
+	incoming, inv := generateIncoming(t, disc.Participants[0])
+	inv.Hash = generateHex(t, 32)
+	incoming.DiscussionID, incoming.Timestamp = disc.ID, timestamp
+	incoming.Redacted = true
+
+	imported, err := db.ImportMessage(incoming, []*model.Invoice{inv}, nil)
+	require.NoError(t, err)
+	assert.True(t, imported)
+
+	outgoing, payments := generateOutgoing(t, disc.Participants[0])
+	payments[0].Hash = generateHex(t, 32)
+	outgoing.DiscussionID, outgoing.Timestamp = disc.ID, timestamp
+
+	imported, err = db.ImportMessage(outgoing, nil, payments)
+	require.NoError(t, err)
+	assert.True(t, imported)
+
+	list, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, list, 2)
+	assert.True(t, list[0].RawMessage.Timestamp.Equal(timestamp))
+	assert.True(t, list[0].RawMessage.Redacted)
+	assert.Equal(t, inv.Hash, list[0].Invoice.Hash)
+	assert.True(t, list[1].RawMessage.Timestamp.Equal(timestamp))
+	require.Len(t, list[1].Payments, 1)
+	assert.Equal(t, payments[0].Hash, list[1].Payments[0].Hash)
+
+	// Imported records are stored outside the index keyspaces of the node.
+	invoiceIdx, err := db.GetLastInvoiceIndex()
+	require.NoError(t, err)
+	assert.Zero(t, invoiceIdx)
+	paymentIdx, err := db.GetLastPaymentIndex()
+	require.NoError(t, err)
+	assert.Zero(t, paymentIdx)
+
+	local := *inv
+	local.Hash, local.Preimage, local.Imported = generateHex(t, 32), generateBytes(t, 32), false
+	require.NoError(t, db.AddInvoice(&local))
+	invoiceIdx, err = db.GetLastInvoiceIndex()
+	require.NoError(t, err)
+	assert.Equal(t, inv.SettleIndex, invoiceIdx)
+
+	// Receipts are recorded for imported messages.
+	require.NoError(t, db.AddReceipt(&model.Receipt{
+		Type:        model.ReceiptDELIVERED,
+		PaymentHash: payments[0].Hash,
+		Sender:      payments[0].PayeeAddress,
+		TimeNs:      10,
+	}))
+	list, err = db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	assert.Len(t, list[1].RawMessage.Receipts, 1)
+
+	// Messages carried by stored invoices or payments are skipped.
+	imported, err = db.ImportMessage(incoming, []*model.Invoice{inv}, nil)
+	require.NoError(t, err)
+	assert.False(t, imported)
+
+	moved := *payments[0]
+	moved.PaymentIndex += 100
+	outgoing.PaymentIndexes = []uint64{moved.PaymentIndex}
+	imported, err = db.ImportMessage(outgoing, nil, []*model.Payment{&moved})
+	require.NoError(t, err)
+	assert.False(t, imported)
+
+	// Records with the payment hash of a stored record
+	// but a different preimage or payee are rejected.
+	conflicting := *inv
+	conflicting.Preimage = generateBytes(t, 32)
+	_, err = db.ImportMessage(incoming, []*model.Invoice{&conflicting}, nil)
+	assert.ErrorIs(t, err, ErrImportConflict)
+
+	conflictingPayment := *payments[0]
+	conflictingPayment.PayeeAddress = generateHex(t, 33)
+	_, err = db.ImportMessage(outgoing, nil, []*model.Payment{&conflictingPayment})
+	assert.ErrorIs(t, err, ErrImportConflict)
+
+	assert.Len(t, f.messages(disc, model.PageOptions{}), 2)
+}
+
+func testConformanceOutbox(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	disc := f.discussion()
+	rawMsg, payments := generateOutgoing(t, disc.Participants[0])
+	rawMsg.DiscussionID = disc.ID
+
+	outboxMsg := &model.OutboxMessage{
+		RawMessage: *rawMsg,
+		AmtMsat:    1000,
+		Attempts: []model.OutboxAttempt{
+			{Recipient: disc.Participants[0]},
+		},
+	}
+	require.NoError(t, db.AddOutboxMessage(outboxMsg))
+
+	outboxMsg.WithAttemptHash(disc.Participants[0], 0, "fake payment hash")
+	require.NoError(t, db.UpdateOutboxMessage(outboxMsg))
+
+	msgs, err := db.GetOutboxMessages()
+	require.NoError(t, err)
+	assert.Equal(t, []model.OutboxMessage{*outboxMsg}, msgs)
+
+	stored, err := db.GetOutboxMessage(outboxMsg.ID)
+	require.NoError(t, err)
+	assert.Equal(t, outboxMsg, stored)
+
+	_, err = db.GetSendResult(outboxMsg.ID)
+	assert.ErrorIs(t, err, ErrSendResultNotFound)
+
+	result := model.SendResult{
+		DiscussionID: disc.ID,
+		Status:       model.MessageSUCCEEDED,
+	}
+
+	// The outbox message remains if its raw message cannot be stored.
+	assert.Error(t, db.CompleteOutboxMessage(outboxMsg.ID, result, rawMsg))
+	msgs, err = db.GetOutboxMessages()
+	require.NoError(t, err)
+	assert.Len(t, msgs, 1)
+	_, err = db.GetSendResult(outboxMsg.ID)
+	assert.ErrorIs(t, err, ErrSendResultNotFound)
+
+	require.NoError(t, db.CompleteOutboxMessage(outboxMsg.ID, result,
+		rawMsg, payments...))
+	msgs, err = db.GetOutboxMessages()
+	require.NoError(t, err)
+	assert.Empty(t, msgs)
+	assert.Equal(t, rawIDs(rawMsg), f.messages(disc, model.PageOptions{}))
+
+	_, err = db.GetOutboxMessage(outboxMsg.ID)
+	assert.ErrorIs(t, err, ErrOutboxMessageNotFound)
+
+	// The send result references the stored message.
+	sendResult, err := db.GetSendResult(outboxMsg.ID)
+	require.NoError(t, err)
+	result.SendID, result.MessageID = outboxMsg.ID, rawMsg.ID
+	assert.Equal(t, &result, sendResult)
+
+	msg, err := db.GetMessage(sendResult.MessageID)
+	require.NoError(t, err)
+	assertRawMessage(t, rawMsg, msg.RawMessage)
+	assert.Len(t, msg.Payments, len(payments))
+
+	_, err = db.GetMessage(rawMsg.ID + 100)
+	assert.ErrorIs(t, err, ErrMessageNotFound)
+
+	assert.ErrorIs(t, db.CompleteOutboxMessage(outboxMsg.ID, result, nil),
+		ErrOutboxMessageNotFound)
+	assert.ErrorIs(t, db.UpdateOutboxMessage(outboxMsg), ErrOutboxMessageNotFound)
+}
+
+func testConformanceBackupRestore(t *testing.T, open func(key []byte) Database) {
+	db := open(nil)
+	f := &conformanceFixture{t: t, db: db}
+
+	alice := generateContact("alie", "alice", generateHex(t, 33))
+	_, err := db.AddContact(&alice)
+	require.NoError(t, err)
+	disc := f.discussion(alice.Address)
+	msg := f.text(disc, "backed up")
+
+	full := new(bytes.Buffer)
+	version, err := db.Backup(full, 0)
+	require.NoError(t, err)
+	assert.NotContains(t, full.String(), "alice")
+
+	// Backups without changes retain the version.
+	unchanged, err := db.Backup(new(bytes.Buffer), version)
+	require.NoError(t, err)
+	assert.Equal(t, version, unchanged)
+
+	bob := generateContact("bobby", "bob", generateHex(t, 33))
+	_, err = db.AddContact(&bob)
+	require.NoError(t, err)
+
+	incremental := new(bytes.Buffer)
+	next, err := db.Backup(incremental, version)
+	require.NoError(t, err)
+	assert.Greater(t, next, version)
+
+	restored := open(nil)
+	require.NoError(t, restored.Restore(bytes.NewReader(full.Bytes())))
+
+	contacts, err := restored.GetContacts()
+	require.NoError(t, err)
+	assert.Equal(t, []model.Contact{alice}, contacts)
+	list, err := restored.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, list, 1)
+	assertRawMessage(t, msg, list[0].RawMessage)
+	found, err := restored.SearchMessages(model.SearchQuery{Text: "backed"},
+		model.PageOptions{})
+	require.NoError(t, err)
+	assert.Equal(t, rawIDs(msg), aggregateIDs(found))
+
+	require.NoError(t, restored.Restore(bytes.NewReader(incremental.Bytes())))
+	contacts, err = restored.GetContacts()
+	require.NoError(t, err)
+	assert.Len(t, contacts, 2)
+
+	// Truncated backups are rejected.
+	truncated := full.Bytes()[:full.Len()-1]
+	assert.ErrorIs(t, restored.Restore(bytes.NewReader(truncated)), ErrMalformedBackup)
+
+	// Backups created with a different key are rejected.
+	other := open([]byte("6543210987654321"))
+	assert.ErrorIs(t, other.Restore(bytes.NewReader(full.Bytes())),
+		ErrBackupKeyMismatch)
+	contacts, err = other.GetContacts()
+	require.NoError(t, err)
+	assert.Empty(t, contacts)
+}
@@ -1,8 +1,10 @@
 package store
 
 import (
+	"encoding/binary"
 	"fmt"
 	"sort"
+	"time"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/timshannon/badgerhold/v4"
@@ -19,6 +21,9 @@ var (
 	// ErrDiscussionAlreadyExists is returned in case the discussion
 	// already exists when attempting to insert it.
 	ErrDiscussionAlreadyExists = fmt.Errorf("Discussion already exists")
+	// ErrDiscussionRestored is returned in case a discussion is restored,
+	// or receives a message, while being removed.
+	ErrDiscussionRestored = fmt.Errorf("Discussion restored during removal")
 	// ErrMessageNotFound is returned in case a message id was not found.
 	ErrMessageNotFound = fmt.Errorf("Message not found")
 	// ErrMessageInvalidDisc is returned in case a message does
@@ -31,7 +36,13 @@ func (db *bhDatabase) AddDiscussion(discussion *model.Discussion) (*model.Discus
 	// Sort participant slice for querying by participants.
 	sort.Strings(discussion.Participants)
 
-	err := db.bh.Insert(badgerhold.NextSequence(), discussion)
+	err := db.bh.Badger().Update(func(txn *badger.Txn) error {
+		if err := db.bh.TxInsert(txn, badgerhold.NextSequence(), discussion); err != nil {
+			return err
+		}
+
+		return txIndexDiscussion(txn, discussion)
+	})
 	if err == badgerhold.ErrUniqueExists {
 		return nil, ErrDiscussionAlreadyExists
 	}
@@ -66,20 +77,132 @@ func (db *bhDatabase) GetDiscussionByParticipants(
 	return
 }
 
-// RemoveDiscussion removes a discussion.
-func (db *bhDatabase) RemoveDiscussion(uid uint64) (discussion *model.Discussion, err error) {
-	query := badgerhold.Where(badgerhold.Key).Eq(uid)
+// RemoveDiscussion removes a discussion along with its messages.
+// The invoices and payments of the messages are retained.
+// The discussion is soft deleted before its messages are removed in batches,
+// so that an interrupted removal is resumed by removing the discussion again.
+// The removal is not atomic: a message stored in the discussion during
+// the removal restores the discussion, in which case the removal stops
+// with ErrDiscussionRestored, retaining the messages not yet removed.
+func (db *bhDatabase) RemoveDiscussion(uid uint64) (*model.Discussion, error) {
+	discussion, err := db.GetDiscussion(uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.SoftDeleteDiscussion(uid); err != nil {
+		return nil, err
+	}
 
-	err = db.bh.Badger().Update(func(txn *badger.Txn) error {
-		discussion, err = db.findSingleDiscussion(txn, query)
+	for {
+		var purged int
+		if err := db.bh.Badger().Update(func(txn *badger.Txn) error {
+			if _, err := db.txRemovalPending(txn, uid); err != nil {
+				return err
+			}
+			purged, err = db.txPurgeMessages(txn, uid)
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		if purged == 0 {
+			break
+		}
+	}
+
+	if err := db.bh.Badger().Update(func(txn *badger.Txn) error {
+		disc, err := db.txRemovalPending(txn, uid)
 		if err != nil {
 			return err
 		}
+		if err := txUnindexDiscussion(txn, disc); err != nil {
+			return err
+		}
 
-		return db.bh.TxDelete(txn, uid, discussion)
+		return db.bh.TxDelete(txn, uid, &model.Discussion{})
+	}); err != nil {
+		return nil, err
+	}
+
+	return discussion, nil
+}
+
+// txRemovalPending verifies that a discussion being removed
+// is still deleted, returning ErrDiscussionRestored otherwise.
+// The deleted discussion is returned.
+func (db *bhDatabase) txRemovalPending(txn *badger.Txn, uid uint64) (*model.Discussion, error) {
+	query := badgerhold.Where(badgerhold.Key).Eq(uid)
+	disc, err := db.findSingleDiscussion(txn, query)
+	switch {
+	case err != nil:
+		return nil, err
+	case !disc.Deleted:
+		return nil, ErrDiscussionRestored
+	}
+
+	return disc, nil
+}
+
+// txPurgeMessages removes a batch of standalone messages of a discussion,
+// along with the messages targeting them, and removes them from the search,
+// receipt and message range indexes.
+// The number of removed messages is returned.
+func (db *bhDatabase) txPurgeMessages(txn *badger.Txn, uid uint64) (int, error) {
+	query := badgerhold.Where("DiscussionID").Eq(uid).Index("DiscussionID").
+		And("TargetLinked").Eq(false).Limit(messageIndexBatchSize)
+
+	var raws []model.RawMessage
+	if err := db.bh.TxFind(txn, &raws, query); err != nil {
+		return 0, err
+	}
+
+	purged := 0
+	for i := range raws {
+		raw := &raws[i]
+
+		// The indexed text depends on the targeting messages,
+		// so it is removed before them.
+		text, err := db.txSearchText(txn, raw.ID)
+		if err != nil {
+			return purged, err
+		}
+		if err := db.txUnindexText(txn, raw.ID, text); err != nil {
+			return purged, err
+		}
+		annotations, err := db.txTargetingMessages(txn, raw)
+		if err != nil {
+			return purged, err
+		}
+
+		for _, m := range append([]model.RawMessage{*raw}, annotations...) {
+			if err := db.txUnindexReceipts(txn, &m); err != nil {
+				return purged, err
+			}
+			if err := txUnindexMessageRange(txn, &m); err != nil {
+				return purged, err
+			}
+			if err := db.bh.TxDelete(txn, m.ID, &model.RawMessage{}); err != nil {
+				return purged, err
+			}
+			purged++
+		}
+	}
+
+	return purged, nil
+}
+
+// SoftDeleteDiscussion marks a discussion as deleted,
+// hiding it from discussion listings until restored.
+func (db *bhDatabase) SoftDeleteDiscussion(uid uint64) error {
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Deleted = true
 	})
+}
 
-	return
+// RestoreDiscussion restores a (soft) deleted discussion.
+func (db *bhDatabase) RestoreDiscussion(uid uint64) error {
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Deleted = false
+	})
 }
 
 // UpdateDiscussionLastRead updates a discussion's last read message
@@ -97,27 +220,77 @@ func (db *bhDatabase) UpdateDiscussionLastRead(uid uint64, readMsgID uint64) err
 			return ErrMessageInvalidDisc
 		}
 
-		// Update the stored discussion.
-		err := db.bh.TxUpdateMatching(txn, &model.Discussion{}, query, func(record interface{}) error {
-			disc, ok := record.(*model.Discussion)
-			if !ok {
-				return ErrDiscussionNotFound
-			}
+		disc, err := db.findSingleDiscussion(txn, query)
+		if err != nil {
+			return err
+		}
+		disc.LastReadID = readMsgID
 
-			disc.LastReadID = readMsgID
+		// Count the unread messages, unless the last message is read.
+		disc.UnreadCount = 0
+		if readMsgID != disc.LastMessageID {
+			unreadQuery := badgerhold.Where("DiscussionID").Eq(uid).
+				Index("DiscussionID").And("TargetLinked").Eq(false).
+				And("InvoiceSettleIndex").Ne(uint64(0)).
+				And(badgerhold.Key).Gt(readMsgID)
 
-			return nil
-		})
-		if err != nil {
-			return ErrDiscussionNotFound
+			disc.UnreadCount, err = db.bh.TxCount(txn, &model.RawMessage{}, unreadQuery)
+			if err != nil {
+				return err
+			}
 		}
 
-		return nil
+		// Update the stored discussion.
+		return db.bh.TxUpdate(txn, uid, disc)
 	})
 
 	return err
 }
 
+// UpdateDiscussionMetadata replaces a discussion's metadata.
+func (db *bhDatabase) UpdateDiscussionMetadata(uid uint64,
+	metadata model.DiscussionMetadata) error {
+
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Metadata = metadata
+	})
+}
+
+// UpdateDiscussionOptions replaces a discussion's options.
+func (db *bhDatabase) UpdateDiscussionOptions(uid uint64,
+	options model.MessageOptions) error {
+
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Options = options
+	})
+}
+
+// UpdateDiscussionRetention replaces a discussion's retention policy.
+func (db *bhDatabase) UpdateDiscussionRetention(uid uint64,
+	policy model.RetentionPolicy) error {
+
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Retention = policy
+	})
+}
+
+// updateDiscussion applies an update to the discussion with the provided id.
+func (db *bhDatabase) updateDiscussion(uid uint64, update func(*model.Discussion)) error {
+	query := badgerhold.Where(badgerhold.Key).Eq(uid)
+
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		prev, err := db.findSingleDiscussion(txn, query)
+		if err != nil {
+			return err
+		}
+
+		disc := *prev
+		update(&disc)
+
+		return db.txStoreDiscussion(txn, prev, &disc)
+	})
+}
+
 func (db *bhDatabase) findSingleDiscussion(txn *badger.Txn,
 	query *badgerhold.Query) (*model.Discussion, error) {
 
@@ -137,17 +310,283 @@ func (db *bhDatabase) findSingleDiscussion(txn *badger.Txn,
 }
 
 // GetDiscussions retrieves discussions, respecting pagination.
-// The seekIndex and pageSize parameters control
-// the start and length of the requested range.
-// seekIndex of 0 corresponds to starting from the first discussion, while
-// pageSize of 0 corresponds to no length limit for the result.
-func (db *bhDatabase) GetDiscussions(seekIndex, pageSize uint64) ([]model.Discussion, error) {
-	query := (&badgerhold.Query{}).Skip(int(seekIndex)).Limit(int(pageSize))
-	discussions := make([]model.Discussion, 0)
-
-	if err := db.bh.Find(&discussions, query); err != nil {
+// The order parameter controls the order of the listing
+// and the filter restricts the listed discussions,
+// while pageOpts controls the requested range,
+// starting (or ending, if reverse) with the discussion with id LastID.
+// In last activity order, an unset LastID corresponds to
+// the first (or last, if reverse) discussion of the listing.
+func (db *bhDatabase) GetDiscussions(pageOpts model.PageOptions,
+	order model.DiscussionOrder, filter model.DiscussionFilter) ([]model.Discussion, error) {
+
+	discussions := []model.Discussion{}
+	if err := db.bh.Badger().View(func(txn *badger.Txn) error {
+		prefix := discussionIndexPrefix(order)
+		seek := append(append([]byte{}, prefix...), 0xff)
+		switch {
+		case order == model.DiscussionOrderLastActivity && pageOpts.LastID != 0:
+			// The range is anchored on the position of the anchor
+			// in the listing, even if the anchor is not listed itself.
+			anchor := model.Discussion{}
+			switch err := db.bh.TxGet(txn, pageOpts.LastID, &anchor); {
+			case err == badgerhold.ErrNotFound:
+				return nil
+			case err != nil:
+				return err
+			}
+			seek = discussionIndexKey(prefix, discussionActivityKey(&anchor), anchor.ID)
+		case pageOpts.LastID != 0:
+			seek = discussionIndexKey(prefix, nil, pageOpts.LastID)
+		case !pageOpts.Reverse:
+			seek = prefix
+		}
+
+		opts := badger.DefaultIteratorOptions
+		opts.PrefetchValues = false
+		opts.Prefix = prefix
+		opts.Reverse = pageOpts.Reverse
+
+		it := txn.NewIterator(opts)
+		defer it.Close()
+
+		for it.Seek(seek); it.ValidForPrefix(prefix); it.Next() {
+			key := it.Item().Key()
+			disc := model.Discussion{}
+			switch err := db.bh.TxGet(txn, binary.BigEndian.Uint64(key[len(key)-8:]), &disc); {
+			case err == badgerhold.ErrNotFound:
+				continue
+			case err != nil:
+				return err
+			}
+			if !filter.Matches(&disc) {
+				continue
+			}
+
+			discussions = append(discussions, disc)
+			if pageOpts.PageSize != 0 && uint64(len(discussions)) >= pageOpts.PageSize {
+				break
+			}
+		}
+
+		return nil
+	}); err != nil {
 		return nil, err
 	}
 
+	if pageOpts.Reverse {
+		for i, j := 0, len(discussions)-1; i < j; i, j = i+1, j-1 {
+			discussions[i], discussions[j] = discussions[j], discussions[i]
+		}
+	}
+
 	return discussions, nil
 }
+
+// The discussion listing indexes order the discussions by id
+// and in last activity order, so that listing pages are retrieved
+// by seeking to their anchor instead of sorting all discussions.
+// Each entry key consists of the index prefix, the activity key
+// of the discussion (only in the last activity index)
+// and the big-endian discussion id, and has no value.
+var (
+	discussionIDIndexPrefix          = []byte("_discussion_id:")
+	discussionActivityIndexPrefix    = []byte("_discussion_activity:")
+	discussionListingIndexVersionKey = []byte("_discussion_listing_index_version")
+)
+
+// discussionListingIndexVersion is the version of the discussion listing index format.
+// An index of a different version is rebuilt when the database is opened.
+const discussionListingIndexVersion = 1
+
+func discussionIndexPrefix(order model.DiscussionOrder) []byte {
+	if order == model.DiscussionOrderLastActivity {
+		return discussionActivityIndexPrefix
+	}
+
+	return discussionIDIndexPrefix
+}
+
+func discussionIndexKey(prefix, activityKey []byte, uid uint64) []byte {
+	key := make([]byte, 0, len(prefix)+len(activityKey)+8)
+	key = append(key, prefix...)
+	key = append(key, activityKey...)
+
+	var id [8]byte
+	binary.BigEndian.PutUint64(id[:], uid)
+
+	return append(key, id[:]...)
+}
+
+// discussionActivityKey returns the key ordering a discussion in last
+// activity order, which sorts discussions by descending last activity
+// time and then by id. Pinned discussions are placed first,
+// while discussions without messages are placed last.
+// The key consists of a byte that is zero for pinned discussions,
+// a byte that is zero for discussions with messages and the inverted
+// order-preserving encoding of the last activity time (if any),
+// so that the ids breaking ties are appended to it.
+func discussionActivityKey(disc *model.Discussion) []byte {
+	key := make([]byte, 10)
+	if !disc.Metadata.Pinned {
+		key[0] = 1
+	}
+	if disc.LastActivity.IsZero() {
+		key[1] = 1
+		return key
+	}
+	for i, b := range encodeOrderedInt(disc.LastActivity.UnixNano()) {
+		key[2+i] = ^b
+	}
+
+	return key
+}
+
+// txIndexDiscussion adds a discussion to the listing indexes.
+func txIndexDiscussion(txn *badger.Txn, disc *model.Discussion) error {
+	if err := txn.Set(discussionIndexKey(discussionIDIndexPrefix,
+		nil, disc.ID), nil); err != nil {
+
+		return fmt.Errorf("could not index discussion %d: %w", disc.ID, err)
+	}
+	if err := txn.Set(discussionIndexKey(discussionActivityIndexPrefix,
+		discussionActivityKey(disc), disc.ID), nil); err != nil {
+
+		return fmt.Errorf("could not index discussion %d: %w", disc.ID, err)
+	}
+
+	return nil
+}
+
+// txUnindexDiscussion removes a discussion from the listing indexes.
+func txUnindexDiscussion(txn *badger.Txn, disc *model.Discussion) error {
+	for _, key := range [][]byte{
+		discussionIndexKey(discussionIDIndexPrefix, nil, disc.ID),
+		discussionIndexKey(discussionActivityIndexPrefix,
+			discussionActivityKey(disc), disc.ID),
+	} {
+		if err := txn.Delete(key); err != nil {
+			return fmt.Errorf("could not unindex discussion %d: %w", disc.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// txStoreDiscussion replaces a stored discussion,
+// updating its listing index entries.
+func (db *bhDatabase) txStoreDiscussion(txn *badger.Txn,
+	prev, disc *model.Discussion) error {
+
+	if err := txUnindexDiscussion(txn, prev); err != nil {
+		return err
+	}
+	if err := txIndexDiscussion(txn, disc); err != nil {
+		return err
+	}
+
+	return db.bh.TxUpdate(txn, disc.ID, disc)
+}
+
+// ensureDiscussionListingIndex builds the discussion listing indexes
+// for the stored discussions, unless indexes of the current version exist.
+func (db *bhDatabase) ensureDiscussionListingIndex() error {
+	current, err := db.hasIndexVersion(discussionListingIndexVersionKey,
+		discussionListingIndexVersion)
+	if err != nil || current {
+		return err
+	}
+
+	for _, prefix := range [][]byte{discussionIDIndexPrefix, discussionActivityIndexPrefix} {
+		if err := db.bh.Badger().DropPrefix(prefix); err != nil {
+			return err
+		}
+	}
+
+	var discussions []model.Discussion
+	if err := db.bh.Find(&discussions, nil); err != nil {
+		return err
+	}
+
+	wb := db.bh.Badger().NewWriteBatch()
+	defer wb.Cancel()
+	for i := range discussions {
+		disc := &discussions[i]
+		if err := wb.Set(discussionIndexKey(discussionIDIndexPrefix,
+			nil, disc.ID), nil); err != nil {
+
+			return err
+		}
+		if err := wb.Set(discussionIndexKey(discussionActivityIndexPrefix,
+			discussionActivityKey(disc), disc.ID), nil); err != nil {
+
+			return err
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return err
+	}
+
+	return db.setIndexVersion(discussionListingIndexVersionKey,
+		discussionListingIndexVersion)
+}
+
+// unreadMessage returns whether a raw message is an unread message
+// of a discussion, as a received standalone message
+// stored after the discussion's last read message.
+func unreadMessage(disc *model.Discussion, raw *model.RawMessage) bool {
+	return raw.InvoiceSettleIndex != 0 && !raw.TargetLinked &&
+		raw.ID > disc.LastReadID
+}
+
+// summarizeActivity populates the last activity time, latest message
+// and unread message count of a discussion from all its messages.
+func summarizeActivity(disc *model.Discussion, raws []model.RawMessage) {
+	disc.LastActivity, disc.UnreadCount = time.Time{}, 0
+	var latest messageRangeEntry
+	for i := range raws {
+		if raws[i].Timestamp.After(disc.LastActivity) {
+			disc.LastActivity = raws[i].Timestamp
+		}
+		entry := messageRangeEntry{ts: raws[i].Timestamp.UnixNano(), id: raws[i].ID}
+		if !raws[i].TargetLinked && (latest.id == 0 || latest.before(entry)) {
+			latest = entry
+		}
+		if unreadMessage(disc, &raws[i]) {
+			disc.UnreadCount++
+		}
+	}
+	disc.LatestMessageID = latest.id
+}
+
+// recordMessage updates the last message, last activity time
+// and unread message count of a discussion for a stored message.
+// The linked messages are the previously standalone messages of
+// the discussion that the message linked as its annotations.
+func recordMessage(disc *model.Discussion, rawMsg *model.RawMessage,
+	linked []model.RawMessage) {
+
+	disc.LastMessageID = rawMsg.ID
+	if rawMsg.Timestamp.After(disc.LastActivity) {
+		disc.LastActivity = rawMsg.Timestamp
+	}
+
+	if unreadMessage(disc, rawMsg) {
+		disc.UnreadCount++
+	}
+	for i := range linked {
+		if unreadMessage(disc, &linked[i]) && disc.UnreadCount > 0 {
+			disc.UnreadCount--
+		}
+	}
+}
+
+// GetUnreadCount returns the number of received standalone messages
+// of a discussion stored after its last read message.
+func (db *bhDatabase) GetUnreadCount(discussionUID uint64) (uint64, error) {
+	disc, err := db.GetDiscussion(discussionUID)
+	if err != nil {
+		return 0, err
+	}
+
+	return disc.UnreadCount, nil
+}
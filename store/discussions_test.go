@@ -2,7 +2,9 @@ package store
 
 import (
 	"testing"
+	"time"
 
+	"github.com/dgraph-io/badger/v3"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -220,6 +222,131 @@ func TestRemoveDiscussion(t *testing.T) {
 	assert.Nil(t, notFound)
 }
 
+func TestRemoveDiscussionMessages(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	participant := generateHex(t, 33)
+	discussions := make([]*model.Discussion, 2)
+	for i := range discussions {
+		discussion := generateDiscussion([]string{participant, generateHex(t, 33)})
+		disc, err := db.AddDiscussion(&discussion)
+		require.NoError(t, err)
+		discussions[i] = disc
+	}
+
+	addMessage := func(disc *model.Discussion, body string) {
+		rawMsg, err := model.NewRawMessage(disc, model.MessageContent{Body: body})
+		require.NoError(t, err)
+
+		generated, inv := generateIncoming(t, participant)
+		rawMsg.Sender, rawMsg.Signature = generated.Sender, generated.Signature
+		rawMsg.InvoiceSettleIndex = generated.InvoiceSettleIndex
+		require.NoError(t, db.AddInvoice(inv))
+		require.NoError(t, db.AddRawMessage(rawMsg))
+	}
+	addMessage(discussions[0], "removed message")
+	addMessage(discussions[1], "retained message")
+	addMessage(discussions[0], "another removed message")
+
+	searchCount := func(text string) int {
+		list, err := db.SearchMessages(model.SearchQuery{Text: text},
+			model.PageOptions{})
+		require.NoError(t, err)
+		return len(list)
+	}
+	require.Equal(t, 2, searchCount("removed"))
+
+	_, err := db.RemoveDiscussion(discussions[0].ID)
+	require.NoError(t, err)
+
+	bhdb := db.(*bhDatabase)
+	var raws []model.RawMessage
+	require.NoError(t, bhdb.bh.Find(&raws, nil))
+	require.Len(t, raws, 1)
+	assert.Equal(t, discussions[1].ID, raws[0].DiscussionID)
+
+	assert.Zero(t, searchCount("removed"))
+	assert.Equal(t, 1, searchCount("message"))
+
+	// The search and range index entries of the messages are removed.
+	require.NoError(t, bhdb.bh.Badger().View(func(txn *badger.Txn) error {
+		assert.Empty(t, txSearchTerm(txn, "removed"))
+		assert.Empty(t, txSearchTerm(txn, "another"))
+
+		for _, prefix := range [][]byte{messageTimeIndexPrefix, messageAmtIndexPrefix} {
+			it := txn.NewIterator(badger.DefaultIteratorOptions)
+			prefix = messageRangePrefix(prefix, discussions[0].ID)
+			it.Seek(prefix)
+			assert.False(t, it.ValidForPrefix(prefix))
+			it.Close()
+		}
+		return nil
+	}))
+
+	// The invoices of the messages are retained.
+	var invoices []model.Invoice
+	require.NoError(t, bhdb.bh.Find(&invoices, nil))
+	assert.Len(t, invoices, 3)
+
+	// The removal of discussions restored during it is stopped.
+	err = bhdb.bh.Badger().View(func(txn *badger.Txn) error {
+		_, err := bhdb.txRemovalPending(txn, discussions[1].ID)
+		return err
+	})
+	assert.ErrorIs(t, err, ErrDiscussionRestored)
+}
+
+func TestSoftDeleteDiscussion(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	discussions := make([]*model.Discussion, 2)
+	for i := range discussions {
+		discussion := generateDiscussion([]string{generateHex(t, 33)})
+		disc, err := db.AddDiscussion(&discussion)
+		require.NoError(t, err)
+		discussions[i] = disc
+	}
+
+	ids := func(filter model.DiscussionFilter) []uint64 {
+		list, err := db.GetDiscussions(model.PageOptions{},
+			model.DiscussionOrderID, filter)
+		require.NoError(t, err)
+
+		ids := make([]uint64, len(list))
+		for i := range list {
+			ids[i] = list[i].ID
+		}
+		return ids
+	}
+	deleted := model.DiscussionFilter{Deleted: true}
+
+	require.NoError(t, db.SoftDeleteDiscussion(discussions[0].ID))
+	assert.Equal(t, []uint64{discussions[1].ID}, ids(model.DiscussionFilter{}))
+	assert.Equal(t, []uint64{discussions[0].ID}, ids(deleted))
+
+	disc, err := db.GetDiscussion(discussions[0].ID)
+	require.NoError(t, err)
+	assert.True(t, disc.Deleted)
+
+	require.NoError(t, db.RestoreDiscussion(discussions[0].ID))
+	assert.Equal(t, []uint64{discussions[0].ID, discussions[1].ID},
+		ids(model.DiscussionFilter{}))
+	assert.Empty(t, ids(deleted))
+
+	// A new discussion message restores the discussion.
+	require.NoError(t, db.SoftDeleteDiscussion(discussions[1].ID))
+	raw, inv := generateIncoming(t, discussions[1].Participants[0])
+	raw.DiscussionID = discussions[1].ID
+	require.NoError(t, db.AddInvoice(inv))
+	require.NoError(t, db.AddRawMessage(raw))
+	assert.Empty(t, ids(deleted))
+
+	assert.ErrorIs(t, db.SoftDeleteDiscussion(42), ErrDiscussionNotFound)
+	assert.ErrorIs(t, db.RestoreDiscussion(42), ErrDiscussionNotFound)
+}
+
 func TestUpdateDiscussionLastRead(t *testing.T) {
 	db, cleanup := createInMemoryDB(t)
 	defer cleanup()
@@ -300,6 +427,56 @@ func TestUpdateDiscussionLastRead(t *testing.T) {
 	assert.EqualValues(t, msgs[2].RawMessage.ID, discussion.LastReadID)
 }
 
+func TestUpdateDiscussionMetadata(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	disc := generateDiscussion([]string{generateHex(t, 33)})
+	insertedDisc, err := db.AddDiscussion(&disc)
+	require.NoError(t, err)
+
+	metadata := model.DiscussionMetadata{
+		Title:  "title",
+		Muted:  true,
+		Pinned: true,
+	}
+	err = db.UpdateDiscussionMetadata(insertedDisc.ID, metadata)
+	assert.NoError(t, err)
+
+	discussion, err := db.GetDiscussion(insertedDisc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, metadata, discussion.Metadata)
+	assert.Equal(t, insertedDisc.Participants, discussion.Participants)
+
+	err = db.UpdateDiscussionMetadata(insertedDisc.ID+1, metadata)
+	assert.ErrorIs(t, err, ErrDiscussionNotFound)
+}
+
+func TestUpdateDiscussionOptions(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	disc := generateDiscussion([]string{generateHex(t, 33)})
+	insertedDisc, err := db.AddDiscussion(&disc)
+	require.NoError(t, err)
+
+	options := model.MessageOptions{
+		FeeLimitMsat: 4200,
+		Encrypted:    true,
+		Receipts:     true,
+	}
+	err = db.UpdateDiscussionOptions(insertedDisc.ID, options)
+	assert.NoError(t, err)
+
+	discussion, err := db.GetDiscussion(insertedDisc.ID)
+	require.NoError(t, err)
+	assert.Equal(t, options, discussion.Options)
+	assert.Equal(t, insertedDisc.Participants, discussion.Participants)
+
+	err = db.UpdateDiscussionOptions(insertedDisc.ID+1, options)
+	assert.ErrorIs(t, err, ErrDiscussionNotFound)
+}
+
 func TestUpdateDiscussionLastMessage(t *testing.T) {
 	db, cleanup := createInMemoryDB(t)
 	defer cleanup()
@@ -400,47 +577,200 @@ func TestGetDiscussions(t *testing.T) {
 
 	cases := []struct {
 		name         string
-		seekIdx      uint64
-		pageSz       uint64
+		pageOpts     model.PageOptions
 		expectedList []model.Discussion
 	}{
 		{
 			name:         "all discussions",
-			seekIdx:      0,
-			pageSz:       0,
 			expectedList: discussions[:],
 		},
 		{
 			name:         "specified start and length",
-			seekIdx:      1,
-			pageSz:       3,
+			pageOpts:     model.PageOptions{LastID: discussions[1].ID, PageSize: 3},
 			expectedList: discussions[1 : 1+3],
 		},
 		{
 			name:         "specified length",
-			seekIdx:      0,
-			pageSz:       2,
+			pageOpts:     model.PageOptions{PageSize: 2},
 			expectedList: discussions[:2],
 		},
 		{
 			name:         "specified start",
-			seekIdx:      2,
-			pageSz:       0,
+			pageOpts:     model.PageOptions{LastID: discussions[2].ID},
 			expectedList: discussions[2:],
 		},
 		{
 			name:         "more length than existing discussions",
-			seekIdx:      0,
-			pageSz:       42,
+			pageOpts:     model.PageOptions{PageSize: 42},
 			expectedList: discussions[:],
 		},
+		{
+			name: "reverse with start and length",
+			pageOpts: model.PageOptions{
+				LastID: discussions[3].ID, PageSize: 2, Reverse: true,
+			},
+			expectedList: discussions[2 : 3+1],
+		},
+		{
+			name:         "latest",
+			pageOpts:     model.PageOptions{PageSize: 2, Reverse: true},
+			expectedList: discussions[3:],
+		},
+		{
+			name:         "start past existing discussions",
+			pageOpts:     model.PageOptions{LastID: 42},
+			expectedList: []model.Discussion{},
+		},
 	}
 
 	for _, c := range cases {
 		t.Run(c.name, func(t *testing.T) {
-			list, err := db.GetDiscussions(c.seekIdx, c.pageSz)
+			list, err := db.GetDiscussions(c.pageOpts, model.DiscussionOrderID,
+				model.DiscussionFilter{})
 			assert.NoError(t, err)
 			assert.EqualValues(t, c.expectedList, list)
 		})
 	}
 }
+
+func TestGetDiscussionsLastActivity(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	resetTimestampGetter := overrideTimestampGetter(time.Hour)
+	defer resetTimestampGetter()
+
+	discussions := make([]*model.Discussion, 4)
+	for i := range discussions {
+		discussion := generateDiscussion([]string{generateHex(t, 33)})
+		disc, err := db.AddDiscussion(&discussion)
+		require.NoError(t, err)
+		discussions[i] = disc
+	}
+
+	addMessage := func(disc *model.Discussion) {
+		raw, inv := generateIncoming(t, disc.Participants[0])
+		raw.DiscussionID = disc.ID
+		require.NoError(t, db.AddInvoice(inv))
+		require.NoError(t, db.AddRawMessage(raw))
+	}
+	// The last discussion has no messages.
+	addMessage(discussions[1])
+	addMessage(discussions[0])
+	addMessage(discussions[2])
+	addMessage(discussions[0])
+
+	ids := func(pageOpts model.PageOptions) []uint64 {
+		list, err := db.GetDiscussions(pageOpts, model.DiscussionOrderLastActivity,
+			model.DiscussionFilter{})
+		require.NoError(t, err)
+
+		ids := make([]uint64, len(list))
+		for i := range list {
+			ids[i] = list[i].ID
+		}
+		return ids
+	}
+
+	assert.Equal(t, []uint64{discussions[0].ID, discussions[2].ID,
+		discussions[1].ID, discussions[3].ID}, ids(model.PageOptions{}))
+	assert.Equal(t, []uint64{discussions[2].ID, discussions[1].ID},
+		ids(model.PageOptions{LastID: discussions[2].ID, PageSize: 2}))
+	assert.Equal(t, []uint64{discussions[0].ID, discussions[2].ID},
+		ids(model.PageOptions{LastID: discussions[2].ID, PageSize: 2, Reverse: true}))
+	assert.Equal(t, []uint64{discussions[3].ID},
+		ids(model.PageOptions{PageSize: 1, Reverse: true}))
+	assert.Empty(t, ids(model.PageOptions{LastID: 42}))
+}
+
+func TestGetDiscussionsFilter(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	resetTimestampGetter := overrideTimestampGetter(time.Hour)
+	defer resetTimestampGetter()
+
+	metadata := []model.DiscussionMetadata{
+		{},
+		{Archived: true},
+		{Pinned: true},
+		{Archived: true, Pinned: true},
+	}
+	discussions := make([]*model.Discussion, len(metadata))
+	for i := range discussions {
+		discussion := generateDiscussion([]string{generateHex(t, 33)})
+		disc, err := db.AddDiscussion(&discussion)
+		require.NoError(t, err)
+		require.NoError(t, db.UpdateDiscussionMetadata(disc.ID, metadata[i]))
+		discussions[i] = disc
+
+		raw, inv := generateIncoming(t, disc.Participants[0])
+		raw.DiscussionID = disc.ID
+		require.NoError(t, db.AddInvoice(inv))
+		require.NoError(t, db.AddRawMessage(raw))
+	}
+
+	ids := func(order model.DiscussionOrder, filter model.DiscussionFilter) []uint64 {
+		list, err := db.GetDiscussions(model.PageOptions{}, order, filter)
+		require.NoError(t, err)
+
+		ids := make([]uint64, len(list))
+		for i := range list {
+			ids[i] = list[i].ID
+		}
+		return ids
+	}
+
+	assert.Equal(t, []uint64{discussions[0].ID, discussions[2].ID},
+		ids(model.DiscussionOrderID, model.DiscussionFilter{Archived: model.FlagUnset}))
+	assert.Equal(t, []uint64{discussions[3].ID},
+		ids(model.DiscussionOrderID, model.DiscussionFilter{
+			Archived: model.FlagSet, Pinned: model.FlagSet,
+		}))
+	assert.Equal(t, []uint64{discussions[0].ID, discussions[1].ID},
+		ids(model.DiscussionOrderID, model.DiscussionFilter{Pinned: model.FlagUnset}))
+	// Pinned discussions are placed first in last activity order.
+	assert.Equal(t, []uint64{discussions[3].ID, discussions[2].ID,
+		discussions[1].ID, discussions[0].ID},
+		ids(model.DiscussionOrderLastActivity, model.DiscussionFilter{}))
+}
+
+func TestGetUnreadCount(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	participant := generateHex(t, 33)
+	discussion := generateDiscussion([]string{participant})
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	addIncoming := func() *model.RawMessage {
+		raw, inv := generateIncoming(t, participant)
+		raw.DiscussionID = disc.ID
+		require.NoError(t, db.AddInvoice(inv))
+		require.NoError(t, db.AddRawMessage(raw))
+		return raw
+	}
+
+	first := addIncoming()
+	outgoing, payments := generateOutgoing(t, participant)
+	outgoing.DiscussionID = disc.ID
+	require.NoError(t, db.AddPayments(payments...))
+	require.NoError(t, db.AddRawMessage(outgoing))
+	addIncoming()
+	addIncoming()
+
+	require.NoError(t, db.UpdateDiscussionLastRead(disc.ID, first.ID))
+	count, err := db.GetUnreadCount(disc.ID)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+
+	last := addIncoming()
+	require.NoError(t, db.UpdateDiscussionLastRead(disc.ID, last.ID))
+	count, err = db.GetUnreadCount(disc.ID)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	_, err = db.GetUnreadCount(42)
+	assert.ErrorIs(t, err, ErrDiscussionNotFound)
+}
@@ -0,0 +1,268 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// ErrImportConflict is returned in case an imported invoice or payment
+// has the same payment hash as a stored one, but a different preimage
+// (invoices) or payee (payments).
+var ErrImportConflict = fmt.Errorf("Imported record conflicts with stored record")
+
+// ImportMessage stores an imported raw message, along with the invoices
+// or payments carrying it, retaining its timestamp and redaction status.
+// The invoices and payments are stored by payment hash, outside the
+// settle and payment index keyspaces of the node, and are deduplicated
+// by their payment hash. If any of them is already stored,
+// the message is considered a duplicate and false is returned.
+// All operations are performed atomically.
+func (db *bhDatabase) ImportMessage(rawMsg *model.RawMessage,
+	invoices []*model.Invoice, payments []*model.Payment) (imported bool, err error) {
+
+	err = db.bh.Badger().Update(func(txn *badger.Txn) error {
+		duplicate := false
+		for _, inv := range invoices {
+			stored, err := db.txImportInvoice(txn, inv)
+			if err != nil {
+				return fmt.Errorf("could not import invoice: %w", err)
+			}
+			duplicate = duplicate || !stored
+		}
+		for _, payment := range payments {
+			stored, err := db.txImportPayment(txn, payment)
+			if err != nil {
+				return fmt.Errorf("could not import payment: %w", err)
+			}
+			duplicate = duplicate || !stored
+		}
+		if duplicate {
+			return nil
+		}
+
+		// The message and reference ids are reassigned on insertion.
+		rawMsg.ID = 0
+		rawMsg.ReplyToID, rawMsg.ReplyToLinked = 0, false
+		rawMsg.TargetID, rawMsg.TargetLinked = 0, false
+		if rawMsg.ImportedHashes, err = importedHashes(rawMsg,
+			invoices, payments); err != nil {
+
+			return err
+		}
+
+		if err := db.txInsertRawMessage(txn, rawMsg); err != nil {
+			return err
+		}
+		imported = true
+		return nil
+	})
+
+	return
+}
+
+// importedHashes returns the payment hashes of the imported invoices
+// or payments carrying a raw message, in the order of its settle
+// or payment indexes.
+func importedHashes(rawMsg *model.RawMessage,
+	invoices []*model.Invoice, payments []*model.Payment) ([]string, error) {
+
+	if rawMsg.InvoiceSettleIndex != 0 {
+		invoiceHashes := make(map[uint64]string, len(invoices))
+		for _, inv := range invoices {
+			invoiceHashes[inv.SettleIndex] = inv.Hash
+		}
+
+		settleIdxs := append([]uint64{rawMsg.InvoiceSettleIndex},
+			rawMsg.FragmentSettleIndexes...)
+		hashes := make([]string, len(settleIdxs))
+		for i, idx := range settleIdxs {
+			hash, ok := invoiceHashes[idx]
+			if !ok {
+				return nil, fmt.Errorf("imported invoice "+
+					"with settle index %d missing", idx)
+			}
+			hashes[i] = hash
+		}
+		return hashes, nil
+	}
+
+	paymentHashes := make(map[uint64]string, len(payments))
+	for _, payment := range payments {
+		paymentHashes[payment.PaymentIndex] = payment.Hash
+	}
+
+	hashes := make([]string, len(rawMsg.PaymentIndexes))
+	for i, idx := range rawMsg.PaymentIndexes {
+		hash, ok := paymentHashes[idx]
+		if !ok {
+			return nil, fmt.Errorf("imported payment "+
+				"with payment index %d missing", idx)
+		}
+		hashes[i] = hash
+	}
+	return hashes, nil
+}
+
+// txImportInvoice stores an imported invoice by payment hash,
+// unless an invoice with the same payment hash is already stored.
+func (db *bhDatabase) txImportInvoice(txn *badger.Txn, inv *model.Invoice) (bool, error) {
+	stored := &model.Invoice{}
+	switch found, err := db.txGetByHash(txn, invoiceHashIndexPrefix, inv.Hash, stored); {
+	case err != nil:
+		return false, err
+	case found && !bytes.Equal(stored.Preimage, inv.Preimage):
+		return false, ErrImportConflict
+	case found:
+		return false, nil
+	}
+
+	inv.Imported = true
+	if err := db.bh.TxInsert(txn, inv.Hash, inv); err != nil {
+		return false, err
+	}
+
+	return true, txIndexHash(txn, invoiceHashIndexPrefix, inv.Hash, nil)
+}
+
+// txImportPayment stores an imported payment by payment hash,
+// unless a payment with the same payment hash is already stored.
+func (db *bhDatabase) txImportPayment(txn *badger.Txn, payment *model.Payment) (bool, error) {
+	stored := &model.Payment{}
+	switch found, err := db.txGetByHash(txn, paymentHashIndexPrefix, payment.Hash, stored); {
+	case err != nil:
+		return false, err
+	case found && stored.PayeeAddress != payment.PayeeAddress:
+		return false, ErrImportConflict
+	case found:
+		return false, nil
+	}
+
+	payment.Imported = true
+	if err := db.bh.TxInsert(txn, payment.Hash, payment); err != nil {
+		return false, err
+	}
+
+	return true, txIndexHash(txn, paymentHashIndexPrefix, payment.Hash, nil)
+}
+
+// The hash indexes map the payment hashes of the stored invoices and
+// payments to their keys, so that imported records are deduplicated
+// without scanning the stored invoices and payments.
+// Each entry key consists of invoiceHashIndexPrefix or paymentHashIndexPrefix
+// and the (hex-encoded) payment hash. Its value is the big-endian
+// settle or payment index of the record, or empty for imported records,
+// which are keyed by payment hash.
+var (
+	invoiceHashIndexPrefix = []byte("_invoice_hash:")
+	paymentHashIndexPrefix = []byte("_payment_hash:")
+	hashIndexVersionKey    = []byte("_hash_index_version")
+)
+
+// hashIndexVersion is the version of the hash index format.
+// An index of a different version is rebuilt when the database is opened.
+const hashIndexVersion = 1
+
+func hashIndexKey(prefix []byte, hash string) []byte {
+	key := make([]byte, 0, len(prefix)+len(hash))
+	key = append(key, prefix...)
+
+	return append(key, hash...)
+}
+
+// txIndexHash adds a record to a hash index.
+// The index of imported records is nil.
+func txIndexHash(txn *badger.Txn, prefix []byte, hash string, idx *uint64) error {
+	var value []byte
+	if idx != nil {
+		value = encodeVersion(*idx)
+	}
+
+	return txn.Set(hashIndexKey(prefix, hash), value)
+}
+
+// txGetByHash retrieves the record with the provided payment hash
+// through a hash index, returning whether it was found.
+func (db *bhDatabase) txGetByHash(txn *badger.Txn, prefix []byte,
+	hash string, result interface{}) (bool, error) {
+
+	item, err := txn.Get(hashIndexKey(prefix, hash))
+	switch {
+	case err == badger.ErrKeyNotFound:
+		return false, nil
+	case err != nil:
+		return false, err
+	}
+
+	var key interface{} = hash
+	if err := item.Value(func(v []byte) error {
+		if len(v) == 8 {
+			key = binary.BigEndian.Uint64(v)
+		}
+		return nil
+	}); err != nil {
+		return false, err
+	}
+
+	if err := db.bh.TxGet(txn, key, result); err != nil {
+		return false, fmt.Errorf("could not retrieve record "+
+			"with payment hash %s: %w", hash, err)
+	}
+
+	return true, nil
+}
+
+// ensureHashIndexes builds the hash indexes for the stored invoices
+// and payments, unless indexes of the current version exist.
+func (db *bhDatabase) ensureHashIndexes() error {
+	current, err := db.hasIndexVersion(hashIndexVersionKey, hashIndexVersion)
+	if err != nil || current {
+		return err
+	}
+
+	for _, prefix := range [][]byte{invoiceHashIndexPrefix, paymentHashIndexPrefix} {
+		if err := db.bh.Badger().DropPrefix(prefix); err != nil {
+			return err
+		}
+	}
+
+	var invoices []model.Invoice
+	if err := db.bh.Find(&invoices, nil); err != nil {
+		return err
+	}
+	var payments []model.Payment
+	if err := db.bh.Find(&payments, nil); err != nil {
+		return err
+	}
+
+	wb := db.bh.Badger().NewWriteBatch()
+	defer wb.Cancel()
+	set := func(prefix []byte, hash string, imported bool, idx uint64) error {
+		var value []byte
+		if !imported {
+			value = encodeVersion(idx)
+		}
+		return wb.Set(hashIndexKey(prefix, hash), value)
+	}
+	for _, inv := range invoices {
+		if err := set(invoiceHashIndexPrefix, inv.Hash,
+			inv.Imported, inv.SettleIndex); err != nil {
+			return err
+		}
+	}
+	for _, payment := range payments {
+		if err := set(paymentHashIndexPrefix, payment.Hash,
+			payment.Imported, payment.PaymentIndex); err != nil {
+			return err
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return err
+	}
+
+	return db.setIndexVersion(hashIndexVersionKey, hashIndexVersion)
+}
@@ -0,0 +1,128 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestImportMessage(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	participant := generateHex(t, 33)
+	discussion := generateDiscussion([]string{participant})
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	timestamp := time.Unix(1000, 0).UTC()
+
+	incoming, inv := generateIncoming(t, participant)
+	inv.Hash = generateHex(t, 32)
+	incoming.DiscussionID, incoming.Timestamp = disc.ID, timestamp
+	incoming.ID, incoming.Redacted = 42, true
+
+	imported, err := db.ImportMessage(incoming, []*model.Invoice{inv}, nil)
+	require.NoError(t, err)
+	assert.True(t, imported)
+
+	outgoing, payments := generateOutgoing(t, participant)
+	payments[0].Hash = generateHex(t, 32)
+	outgoing.DiscussionID, outgoing.Timestamp = disc.ID, timestamp
+
+	imported, err = db.ImportMessage(outgoing, nil, payments)
+	require.NoError(t, err)
+	assert.True(t, imported)
+
+	msgs, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.NotEqualValues(t, 42, msgs[0].RawMessage.ID)
+	assert.True(t, msgs[0].RawMessage.Timestamp.Equal(timestamp))
+	assert.True(t, msgs[0].RawMessage.Redacted)
+	assert.Equal(t, inv.SettleIndex, msgs[0].Invoice.SettleIndex)
+	assert.True(t, msgs[1].RawMessage.Timestamp.Equal(timestamp))
+	require.Len(t, msgs[1].Payments, 1)
+	assert.Equal(t, payments[0].Hash, msgs[1].Payments[0].Hash)
+
+	// Messages carried by stored invoices or payments are skipped.
+	imported, err = db.ImportMessage(incoming, []*model.Invoice{inv}, nil)
+	require.NoError(t, err)
+	assert.False(t, imported)
+
+	moved := *payments[0]
+	moved.PaymentIndex += 100
+	outgoing.PaymentIndexes = []uint64{moved.PaymentIndex}
+	imported, err = db.ImportMessage(outgoing, nil, []*model.Payment{&moved})
+	require.NoError(t, err)
+	assert.False(t, imported)
+
+	msgs, err = db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	assert.Len(t, msgs, 2)
+
+	// Imported records are stored by payment hash,
+	// so records of the node with the same indexes are stored.
+	local, localInv := generateIncoming(t, participant)
+	localInv.SettleIndex = inv.SettleIndex
+	local.DiscussionID, local.InvoiceSettleIndex = disc.ID, inv.SettleIndex
+	require.NoError(t, db.AddInvoiceMessage(localInv, local))
+
+	localOutgoing, localPayments := generateOutgoing(t, participant)
+	localPayments[0].PaymentIndex = payments[0].PaymentIndex
+	localOutgoing.DiscussionID = disc.ID
+	localOutgoing.PaymentIndexes = []uint64{payments[0].PaymentIndex}
+	require.NoError(t, db.AddPayments(localPayments...))
+	require.NoError(t, db.AddRawMessage(localOutgoing))
+
+	msgs, err = db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, msgs, 4)
+	var hashes []string
+	for _, msg := range msgs {
+		if msg.Invoice != nil {
+			hashes = append(hashes, msg.Invoice.Hash)
+		}
+		for _, payment := range msg.Payments {
+			hashes = append(hashes, payment.Hash)
+		}
+	}
+	assert.ElementsMatch(t, []string{inv.Hash, payments[0].Hash,
+		localInv.Hash, localPayments[0].Hash}, hashes)
+
+	// Records with the payment hash of a stored record
+	// but a different preimage or payee are rejected.
+	conflicting := *inv
+	conflicting.Preimage = generateBytes(t, 32)
+	_, err = db.ImportMessage(incoming, []*model.Invoice{&conflicting}, nil)
+	assert.ErrorIs(t, err, ErrImportConflict)
+
+	conflictingPayment := *payments[0]
+	conflictingPayment.PayeeAddress = generateHex(t, 33)
+	_, err = db.ImportMessage(outgoing, nil, []*model.Payment{&conflictingPayment})
+	assert.ErrorIs(t, err, ErrImportConflict)
+
+	// The hash indexes are rebuilt if missing, deduplicating
+	// imports against both imported records and records of the node.
+	bhdb := db.(*bhDatabase)
+	require.NoError(t, bhdb.bh.Badger().Update(func(txn *badger.Txn) error {
+		return txn.Delete(hashIndexVersionKey)
+	}))
+	for _, prefix := range [][]byte{invoiceHashIndexPrefix, paymentHashIndexPrefix} {
+		require.NoError(t, bhdb.bh.Badger().DropPrefix(prefix))
+	}
+	require.NoError(t, bhdb.ensureHashIndexes())
+
+	for _, inv := range []*model.Invoice{inv, localInv} {
+		duplicate, _ := generateIncoming(t, participant)
+		duplicate.DiscussionID = disc.ID
+		imported, err = db.ImportMessage(duplicate, []*model.Invoice{inv}, nil)
+		require.NoError(t, err)
+		assert.False(t, imported)
+	}
+}
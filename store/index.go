@@ -0,0 +1,98 @@
+package store
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func encodeVersion(version uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], version)
+
+	return b[:]
+}
+
+// hasIndexVersion returns whether the index version stored under key
+// matches the provided version.
+func (db *bhDatabase) hasIndexVersion(key []byte, version uint64) (bool, error) {
+	current := false
+	err := db.bh.Badger().View(func(txn *badger.Txn) error {
+		item, err := txn.Get(key)
+		switch {
+		case err == badger.ErrKeyNotFound:
+			return nil
+		case err != nil:
+			return err
+		}
+
+		return item.Value(func(v []byte) error {
+			current = bytes.Equal(v, encodeVersion(version))
+			return nil
+		})
+	})
+
+	return current, err
+}
+
+// setIndexVersion stores the provided index version under key.
+func (db *bhDatabase) setIndexVersion(key []byte, version uint64) error {
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		return txn.Set(key, encodeVersion(version))
+	})
+}
+
+var messageIndexVersionKey = []byte("_message_index_version")
+
+// messageIndexVersion is the version of the stored message amounts.
+const messageIndexVersion = 1
+
+// messageIndexBatchSize is the number of messages
+// reindexed in a single transaction.
+const messageIndexBatchSize = 1000
+
+// ensureMessageIndexes populates the amounts
+// of messages stored before their introduction.
+func (db *bhDatabase) ensureMessageIndexes() error {
+	current, err := db.hasIndexVersion(messageIndexVersionKey, messageIndexVersion)
+	if err != nil || current {
+		return err
+	}
+
+	var raws []model.RawMessage
+	if err := db.bh.Find(&raws, nil); err != nil {
+		return err
+	}
+
+	for start := 0; start < len(raws); start += messageIndexBatchSize {
+		end := start + messageIndexBatchSize
+		if end > len(raws) {
+			end = len(raws)
+		}
+
+		if err := db.bh.Badger().Update(func(txn *badger.Txn) error {
+			for i := range raws[start:end] {
+				raw := &raws[start+i]
+
+				amtMsat, err := db.txMessageAmtMsat(txn, raw)
+				if err != nil {
+					return fmt.Errorf("could not reindex message %d: %w", raw.ID, err)
+				}
+				raw.AmtMsat = amtMsat
+
+				if err := db.bh.TxUpdate(txn, raw.ID, raw); err != nil {
+					return err
+				}
+			}
+			return nil
+		}); err != nil {
+			return err
+		}
+	}
+
+	return db.setIndexVersion(messageIndexVersionKey, messageIndexVersion)
+}
@@ -3,6 +3,9 @@ package store
 //go:generate mockery -dir=. -output=./mocks -outpkg=storemock -name=Database
 
 import (
+	"io"
+	"time"
+
 	"github.com/c13n-io/c13n-go/model"
 )
 
@@ -21,16 +24,47 @@ type Database interface {
 	GetDiscussion(uid uint64) (*model.Discussion, error)
 	GetDiscussionByParticipants(participants []string) (*model.Discussion, error)
 	RemoveDiscussion(uid uint64) (*model.Discussion, error)
-	GetDiscussions(seekIndex, pageSize uint64) ([]model.Discussion, error)
+	SoftDeleteDiscussion(uid uint64) error
+	RestoreDiscussion(uid uint64) error
+	GetDiscussions(pageOpts model.PageOptions, order model.DiscussionOrder,
+		filter model.DiscussionFilter) ([]model.Discussion, error)
+	GetUnreadCount(discussionUID uint64) (uint64, error)
 	UpdateDiscussionLastRead(uid uint64, readMsgID uint64) error
+	UpdateDiscussionMetadata(uid uint64, metadata model.DiscussionMetadata) error
+	UpdateDiscussionOptions(uid uint64, options model.MessageOptions) error
+	UpdateDiscussionRetention(uid uint64, policy model.RetentionPolicy) error
 
 	// Invoices-Payments
 	AddInvoice(inv *model.Invoice) error
+	AddInvoiceMessage(inv *model.Invoice, rawMsg *model.RawMessage) error
+	AddInvoiceFragment(inv *model.Invoice) error
+	GetInvoiceFragments() ([]*model.Invoice, error)
+	RemoveInvoiceFragments(settleIndexes ...uint64) error
 	AddPayments(payments ...*model.Payment) error
 	GetLastInvoiceIndex() (invSettleIndex uint64, err error)
 	GetLastPaymentIndex() (paymentIndex uint64, err error)
 	AddRawMessage(*model.RawMessage) error
 	GetMessages(discussionUID uint64, pageOpts model.PageOptions) ([]MessageAggregate, error)
+	GetMessage(uid uint64) (*MessageAggregate, error)
+	SearchMessages(query model.SearchQuery, pageOpts model.PageOptions) ([]MessageAggregate, error)
+	AddReceipt(receipt *model.Receipt) error
+	RedactMessage(uid uint64) error
+	ApplyRetention(defaultPolicy model.RetentionPolicy, now time.Time) (uint64, error)
+	ImportMessage(rawMsg *model.RawMessage, invoices []*model.Invoice,
+		payments []*model.Payment) (imported bool, err error)
+
+	// Outbox
+	AddOutboxMessage(msg *model.OutboxMessage) error
+	UpdateOutboxMessage(msg *model.OutboxMessage) error
+	GetOutboxMessages() ([]model.OutboxMessage, error)
+	GetOutboxMessage(id uint64) (*model.OutboxMessage, error)
+	CompleteOutboxMessage(id uint64, result model.SendResult,
+		rawMsg *model.RawMessage, payments ...*model.Payment) error
+	GetSendResult(sendID uint64) (*model.SendResult, error)
+
+	// Backups
+	Backup(w io.Writer, since uint64) (version uint64, err error)
+	Restore(r io.Reader) error
 
 	// Close closes the database
 	Close() error
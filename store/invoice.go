@@ -1,24 +1,128 @@
 package store
 
 import (
+	"fmt"
+	"sort"
+
 	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
 
 	"github.com/c13n-io/c13n-go/model"
 )
 
+// ErrDuplicateInvoice is returned in case an invoice already exists.
+var ErrDuplicateInvoice = fmt.Errorf("Duplicate invoice")
+
 // AddInvoice stores an invoice.
+// If the invoice already exists, ErrDuplicateInvoice is returned.
 func (db *bhDatabase) AddInvoice(inv *model.Invoice) error {
 	return db.bh.Badger().Update(func(txn *badger.Txn) error {
-		invoiceKey := inv.SettleIndex
-		return db.bh.TxInsert(txn, invoiceKey, inv)
+		return db.txInsertInvoice(txn, inv)
+	})
+}
+
+// AddInvoiceMessage stores an invoice along with the raw message it carries,
+// atomically. If the invoice already exists, ErrDuplicateInvoice
+// is returned and neither is stored.
+// The invoices carrying the other parts of a multi-part message
+// are no longer pending once the message is stored.
+func (db *bhDatabase) AddInvoiceMessage(inv *model.Invoice, rawMsg *model.RawMessage) error {
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		if err := db.txInsertInvoice(txn, inv); err != nil {
+			return err
+		}
+		if err := db.txAddRawMessage(txn, rawMsg); err != nil {
+			return err
+		}
+
+		return db.txRemoveInvoiceFragments(txn, rawMsg.FragmentSettleIndexes...)
+	})
+}
+
+// AddInvoiceFragment stores an invoice carrying a part of a multi-part
+// message, marking it as pending until the message is reassembled.
+// If the invoice already exists, ErrDuplicateInvoice is returned.
+func (db *bhDatabase) AddInvoiceFragment(inv *model.Invoice) error {
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		if err := db.txInsertInvoice(txn, inv); err != nil {
+			return err
+		}
+
+		return db.bh.TxInsert(txn, inv.SettleIndex,
+			&model.InvoiceFragment{SettleIndex: inv.SettleIndex})
 	})
 }
 
+// GetInvoiceFragments retrieves the pending invoices carrying parts
+// of multi-part messages, in settle index order.
+func (db *bhDatabase) GetInvoiceFragments() ([]*model.Invoice, error) {
+	var invoices []*model.Invoice
+	err := db.bh.Badger().View(func(txn *badger.Txn) error {
+		var fragments []model.InvoiceFragment
+		if err := db.bh.TxFind(txn, &fragments, nil); err != nil {
+			return err
+		}
+
+		for _, fragment := range fragments {
+			inv := new(model.Invoice)
+			if err := db.bh.TxGet(txn, fragment.SettleIndex, inv); err != nil {
+				return fmt.Errorf("could not retrieve fragment invoice: %w", err)
+			}
+			invoices = append(invoices, inv)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	sort.Slice(invoices, func(i, j int) bool {
+		return invoices[i].SettleIndex < invoices[j].SettleIndex
+	})
+
+	return invoices, nil
+}
+
+// RemoveInvoiceFragments discards the pending status of the invoices
+// with the provided settle indexes. The invoices are retained.
+func (db *bhDatabase) RemoveInvoiceFragments(settleIndexes ...uint64) error {
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		return db.txRemoveInvoiceFragments(txn, settleIndexes...)
+	})
+}
+
+func (db *bhDatabase) txRemoveInvoiceFragments(txn *badger.Txn, settleIndexes ...uint64) error {
+	for _, idx := range settleIndexes {
+		err := db.bh.TxDelete(txn, idx, &model.InvoiceFragment{})
+		if err != nil && err != badgerhold.ErrNotFound {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *bhDatabase) txInsertInvoice(txn *badger.Txn, inv *model.Invoice) error {
+	invoiceKey := inv.SettleIndex
+	err := db.bh.TxInsert(txn, invoiceKey, inv)
+	switch {
+	case err == badgerhold.ErrKeyExists:
+		return ErrDuplicateInvoice
+	case err != nil:
+		return err
+	}
+
+	return txIndexHash(txn, invoiceHashIndexPrefix, inv.Hash, &invoiceKey)
+}
+
 // GetLastInvoiceIndex retrieves the last invoice index present in the database.
+// Imported invoices are not considered.
 func (db *bhDatabase) GetLastInvoiceIndex() (invoiceSettleIdx uint64, err error) {
 	inv := new(model.Invoice)
 
-	switch result, err := db.bh.FindAggregate(inv, nil); err {
+	query := badgerhold.Where("Imported").Eq(false)
+	switch result, err := db.bh.FindAggregate(inv, query); err {
 	case nil:
 		if result[0].Count() > 0 {
 			result[0].Max("SettleIndex", inv)
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/dgraph-io/badger/v3"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/timshannon/badgerhold/v4"
 
 	"github.com/c13n-io/c13n-go/model"
@@ -12,99 +13,325 @@ import (
 // AddRawMessage stores a raw message under a discussion
 // and updates the last discussion message.
 // An error is returned if its associated invoice or payment indexes are missing.
+// The invoices carrying the message, which are pending
+// if it was reassembled from stored parts, are no longer pending.
 func (db *bhDatabase) AddRawMessage(rawMsg *model.RawMessage) error {
 	return db.bh.Badger().Update(func(txn *badger.Txn) error {
-		// Verify the existence of the associated invoice or payment
-		invIdx := rawMsg.InvoiceSettleIndex
-		paymentIdxs := rawMsg.PaymentIndexes
-		switch {
-		case len(paymentIdxs) == 0 && invIdx == 0:
-			return fmt.Errorf("message not associated with invoice or payment")
-		case invIdx != 0:
-			if _, err := db.findInvoice(txn, invIdx); err != nil {
-				return fmt.Errorf("could not retrieve associated invoice: %w", err)
-			}
-		case len(paymentIdxs) != 0:
-			if _, err := db.findPayments(txn, paymentIdxs...); err != nil {
-				return fmt.Errorf("could not retrieve associated payments: %w", err)
-			}
+		if err := db.txAddRawMessage(txn, rawMsg); err != nil {
+			return err
 		}
 
-		// Verify the existence of the associated discussion
-		discQuery := badgerhold.Where(badgerhold.Key).Eq(rawMsg.DiscussionID)
-		if _, err := db.findSingleDiscussion(txn, discQuery); err != nil {
-			return fmt.Errorf("could not retrieve associated discussion: %w", err)
+		return db.txRemoveInvoiceFragments(txn, messageSettleIndexes(rawMsg)...)
+	})
+}
+
+func (db *bhDatabase) txAddRawMessage(txn *badger.Txn, rawMsg *model.RawMessage) error {
+	rawMsg.WithTimestamp(getCurrentTime())
+
+	return db.txInsertRawMessage(txn, rawMsg)
+}
+
+// txInsertRawMessage stores a raw message, retaining its timestamp.
+func (db *bhDatabase) txInsertRawMessage(txn *badger.Txn, rawMsg *model.RawMessage) error {
+	// Verify the existence of the associated invoice or payment
+	amtMsat, err := db.txMessageAmtMsat(txn, rawMsg)
+	if err != nil {
+		return err
+	}
+	rawMsg.AmtMsat = amtMsat
+
+	// Verify the existence of the associated discussion
+	discQuery := badgerhold.Where(badgerhold.Key).Eq(rawMsg.DiscussionID)
+	prev, err := db.findSingleDiscussion(txn, discQuery)
+	if err != nil {
+		return fmt.Errorf("could not retrieve associated discussion: %w", err)
+	}
+
+	// Resolve the messages referenced by the message
+	if err := db.txResolveReferences(txn, rawMsg); err != nil {
+		return err
+	}
+
+	// Insert the raw message, updating the indexed text
+	// of the message it targets (if any)
+	insert := func() error {
+		return db.bh.TxInsert(txn, badgerhold.NextSequence(), rawMsg)
+	}
+	if rawMsg.TargetLinked {
+		if err := db.txReindexMessage(txn, rawMsg.TargetID, insert); err != nil {
+			return err
 		}
+	} else if err := insert(); err != nil {
+		return err
+	}
+
+	// Add the message to the range indexes of its discussion
+	if err := txIndexMessageRange(txn, rawMsg); err != nil {
+		return err
+	}
+
+	// Add the message payment hashes to the receipt index
+	if err := db.txIndexReceipts(txn, rawMsg); err != nil {
+		return err
+	}
 
-		rawMsg.WithTimestamp(getCurrentTime())
+	// Link previously stored messages referencing the message
+	linked, err := db.txLinkReferencingMessages(txn, rawMsg)
+	if err != nil {
+		return err
+	}
 
-		// Insert the raw message
-		if err := db.bh.TxInsert(txn, badgerhold.NextSequence(), rawMsg); err != nil {
+	// Add the message text (as edited by any linked messages)
+	// to the search index
+	if !rawMsg.TargetLinked {
+		text, err := db.txSearchText(txn, rawMsg.ID)
+		if err != nil {
+			return err
+		}
+		if err := db.txIndexText(txn, rawMsg.ID, text); err != nil {
 			return err
 		}
+	}
 
-		// Update the discussion last message id
-		return db.bh.TxUpdateMatching(txn, &model.Discussion{}, discQuery,
-			func(record interface{}) error {
-				disc, ok := record.(*model.Discussion)
-				if !ok {
-					return ErrDiscussionNotFound
-				}
+	// Update the discussion last and latest message, activity
+	// and unread count, restoring the discussion if deleted
+	disc := *prev
+	recordMessage(&disc, rawMsg, linked)
+	disc.LatestMessageID = txLatestMessageID(txn, rawMsg.DiscussionID)
+	disc.Deleted = false
 
-				disc.LastMessageID = rawMsg.ID
-				return nil
-			})
-	})
+	return db.txStoreDiscussion(txn, prev, &disc)
 }
 
-func (db *bhDatabase) findInvoice(txn *badger.Txn,
-	invoiceIdx uint64) (*model.Invoice, error) {
+// txResolveReferences populates the ids of the messages
+// replied to or targeted by a raw message, if they are stored.
+// Only standalone messages (not targeting another message) can be referenced.
+func (db *bhDatabase) txResolveReferences(txn *badger.Txn,
+	rawMsg *model.RawMessage) error {
+
+	resolve := func(messageID string) (uint64, bool, error) {
+		if messageID == "" {
+			return 0, false, nil
+		}
+		referenced, err := db.findReferencedMessage(txn,
+			rawMsg.DiscussionID, messageID)
+		if err != nil || referenced == nil {
+			return 0, false, err
+		}
+		return referenced.ID, true, nil
+	}
+
+	var err error
+	rawMsg.ReplyToID, rawMsg.ReplyToLinked, err = resolve(rawMsg.ReplyTo)
+	if err != nil {
+		return fmt.Errorf("could not resolve replied message: %w", err)
+	}
+	rawMsg.TargetID, rawMsg.TargetLinked, err = resolve(rawMsg.Target)
+	if err != nil {
+		return fmt.Errorf("could not resolve target message: %w", err)
+	}
+
+	return nil
+}
 
-	invQuery := badgerhold.Where(badgerhold.Key).Eq(invoiceIdx)
+// txLinkReferencingMessages populates the reference ids of the stored
+// messages of the discussion that reply to or target a raw message,
+// in case they were stored before the referenced message.
+// The targeting messages are returned as stored before linking.
+func (db *bhDatabase) txLinkReferencingMessages(txn *badger.Txn,
+	rawMsg *model.RawMessage) ([]model.RawMessage, error) {
 
-	invs := make([]model.Invoice, 0)
-	if err := db.bh.TxFind(txn, &invs, invQuery); err != nil {
+	if rawMsg.MessageID == "" || rawMsg.Target != "" {
+		return nil, nil
+	}
+
+	referencingQuery := func(field string) *badgerhold.Query {
+		return badgerhold.Where("DiscussionID").Eq(rawMsg.DiscussionID).
+			Index("DiscussionID").And(field).Eq(rawMsg.MessageID).
+			And(badgerhold.Key).Ne(rawMsg.ID)
+	}
+
+	if err := db.bh.TxUpdateMatching(txn, &model.RawMessage{},
+		referencingQuery("ReplyTo").And("ReplyToLinked").Eq(false),
+		func(record interface{}) error {
+			msg, ok := record.(*model.RawMessage)
+			if !ok {
+				return fmt.Errorf("record is not a raw message")
+			}
+			msg.ReplyToID, msg.ReplyToLinked = rawMsg.ID, true
+			return nil
+		}); err != nil {
+		return nil, fmt.Errorf("could not link replies: %w", err)
+	}
+
+	// Linked messages are retrieved as annotations of their target,
+	// so they are removed from the message range indexes.
+	var targeting []model.RawMessage
+	if err := db.bh.TxFind(txn, &targeting,
+		referencingQuery("Target").And("TargetLinked").Eq(false)); err != nil {
+
+		return nil, fmt.Errorf("could not link targeting messages: %w", err)
+	}
+	for _, msg := range targeting {
+		if err := txUnindexMessageRange(txn, &msg); err != nil {
+			return nil, err
+		}
+		msg.TargetID, msg.TargetLinked = rawMsg.ID, true
+		if err := db.bh.TxUpdate(txn, msg.ID, &msg); err != nil {
+			return nil, fmt.Errorf("could not link targeting messages: %w", err)
+		}
+	}
+
+	return targeting, nil
+}
+
+// findReferencedMessage retrieves the standalone message of a discussion
+// with the provided sender-assigned identifier, if it is stored.
+func (db *bhDatabase) findReferencedMessage(txn *badger.Txn,
+	discussionID uint64, messageID string) (*model.RawMessage, error) {
+
+	query := badgerhold.Where("DiscussionID").Eq(discussionID).
+		Index("DiscussionID").And("MessageID").Eq(messageID).
+		And("Target").Eq("").Limit(1)
+
+	raws := make([]model.RawMessage, 0)
+	if err := db.bh.TxFind(txn, &raws, query); err != nil {
 		return nil, err
 	}
+	if len(raws) == 0 {
+		return nil, nil
+	}
 
-	switch len(invs) {
-	case 1:
-		return &invs[0], nil
-	case 0:
-		return nil, fmt.Errorf("invoice not found")
+	return &raws[0], nil
+}
+
+// txMessageAmtMsat returns the amount paid over a raw message,
+// as the amount paid to its invoices (incoming) or
+// the amount of its succeeded payment attempts (outgoing).
+// An error is returned if its associated invoice or payment indexes are missing.
+func (db *bhDatabase) txMessageAmtMsat(txn *badger.Txn,
+	rawMsg *model.RawMessage) (int64, error) {
+
+	invIdx := rawMsg.InvoiceSettleIndex
+	paymentIdxs := rawMsg.PaymentIndexes
+
+	var amtMsat int64
+	switch {
+	case len(paymentIdxs) == 0 && invIdx == 0:
+		return 0, fmt.Errorf("message not associated with invoice or payment")
+	case invIdx != 0:
+		keys := invoiceKeys(rawMsg)
+		inv, err := db.findInvoice(txn, keys[0])
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve associated invoice: %w", err)
+		}
+		amtMsat += inv.AmtPaid.Msat()
+		for _, key := range keys[1:] {
+			fragment, err := db.findInvoice(txn, key)
+			if err != nil {
+				return 0, fmt.Errorf("could not retrieve associated "+
+					"fragment invoice: %w", err)
+			}
+			amtMsat += fragment.AmtPaid.Msat()
+		}
 	default:
-		return nil, fmt.Errorf("duplicate invoice found")
+		pays, err := db.findPayments(txn, rawMsg)
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve associated payments: %w", err)
+		}
+		for _, pay := range pays {
+			for _, htlc := range pay.Htlcs {
+				if htlc.Status == lnrpc.HTLCAttempt_SUCCEEDED {
+					amtMsat += htlc.Route.Amt.Msat()
+				}
+			}
+		}
 	}
+
+	return amtMsat, nil
 }
 
-func (db *bhDatabase) findPayments(txn *badger.Txn,
-	paymentIdxs ...uint64) ([]model.Payment, error) {
+// invoiceKeys returns the keys of the invoice carrying an incoming
+// raw message, followed by the keys of its fragment invoices.
+// Imported invoices are keyed by payment hash, instead of settle index.
+func invoiceKeys(raw *model.RawMessage) []interface{} {
+	if raw.Imported() {
+		return hashKeys(raw.ImportedHashes)
+	}
 
-	// Slice type conversion
-	payIdxs := make([]interface{}, len(paymentIdxs))
-	for i := range paymentIdxs {
-		payIdxs[i] = paymentIdxs[i]
+	keys := []interface{}{raw.InvoiceSettleIndex}
+	for _, idx := range raw.FragmentSettleIndexes {
+		keys = append(keys, idx)
 	}
 
-	// NOTE: Use of membership criterion (.In) is not playing nice
-	// with either the use of (.Index) or badgerhold.Key
-	payQuery := badgerhold.Where("PaymentIndex").In(payIdxs...)
+	return keys
+}
 
-	pays := make([]model.Payment, 0)
-	if err := db.bh.TxFind(txn, &pays, payQuery); err != nil {
-		return nil, fmt.Errorf("could not retrieve payment: %w", err)
+// messageSettleIndexes returns the settle indexes of the invoices
+// carrying an incoming raw message, including its fragment invoices.
+func messageSettleIndexes(raw *model.RawMessage) []uint64 {
+	if raw.InvoiceSettleIndex == 0 {
+		return nil
 	}
 
-	resultIdxs := make([]uint64, len(pays))
-	for i, pay := range pays {
-		resultIdxs[i] = pay.PaymentIndex
+	return append([]uint64{raw.InvoiceSettleIndex}, raw.FragmentSettleIndexes...)
+}
+
+// paymentKeys returns the keys of the payments carrying an outgoing
+// raw message. Imported payments are keyed by payment hash,
+// instead of payment index.
+func paymentKeys(raw *model.RawMessage) []interface{} {
+	switch {
+	case raw.InvoiceSettleIndex != 0:
+		return nil
+	case raw.Imported():
+		return hashKeys(raw.ImportedHashes)
 	}
-	switch sameUnorderedIDSlice(paymentIdxs, resultIdxs) {
-	case true:
-		return pays, nil
-	default:
+
+	keys := make([]interface{}, len(raw.PaymentIndexes))
+	for i, idx := range raw.PaymentIndexes {
+		keys[i] = idx
+	}
+
+	return keys
+}
+
+func hashKeys(hashes []string) []interface{} {
+	keys := make([]interface{}, len(hashes))
+	for i, hash := range hashes {
+		keys[i] = hash
+	}
+
+	return keys
+}
+
+func (db *bhDatabase) findInvoice(txn *badger.Txn,
+	key interface{}) (*model.Invoice, error) {
+
+	inv := &model.Invoice{}
+	switch err := db.bh.TxGet(txn, key, inv); {
+	case err == badgerhold.ErrNotFound:
+		return nil, fmt.Errorf("invoice not found")
+	case err != nil:
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// findPayments retrieves the payments carrying an outgoing raw message.
+func (db *bhDatabase) findPayments(txn *badger.Txn,
+	raw *model.RawMessage) ([]model.Payment, error) {
+
+	pays, err := db.txGetPayments(txn, raw)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve payment: %w", err)
+	}
+	if len(pays) != len(raw.PaymentIndexes) {
 		return nil, fmt.Errorf("missing or mismatched payment detected")
 	}
+
+	return pays, nil
 }
 
 func sameUnorderedIDSlice(x, y []uint64) bool {
@@ -1,7 +1,11 @@
 package store
 
 import (
+	"bytes"
+	"encoding/binary"
 	"fmt"
+	"math"
+	"sort"
 
 	"github.com/dgraph-io/badger/v3"
 	"github.com/timshannon/badgerhold/v4"
@@ -16,8 +20,14 @@ type MessageAggregate struct {
 	RawMessage *model.RawMessage
 	// The associated invoice (if any; only valid for incoming messages).
 	Invoice *model.Invoice
+	// The invoices carrying the other parts of
+	// a multi-part message (only valid for incoming messages).
+	Fragments []*model.Invoice
 	// The associated payments (if any; only valid for outgoind messages).
 	Payments []*model.Payment
+	// The stored edits, delete requests and reactions
+	// targeting the message, in storage order.
+	Annotations []MessageAggregate
 }
 
 func newMsgAggregate(raw model.RawMessage,
@@ -41,13 +51,10 @@ func newMsgAggregate(raw model.RawMessage,
 
 // GetMessages retrieves messages belonging to a discussion.
 // The pageOpts parameter controls the requested message range.
+// Reverse pagination without an anchor retrieves the latest messages.
 func (db *bhDatabase) GetMessages(discussionUID uint64,
 	pageOpts model.PageOptions) ([]MessageAggregate, error) {
 
-	if pageOpts.Reverse && pageOpts.LastID == 0 {
-		return nil, fmt.Errorf("reverse pagination without anchor is disallowed")
-	}
-
 	var messages []MessageAggregate
 	if err := db.bh.Badger().View(func(txn *badger.Txn) error {
 		discQuery := badgerhold.Where(badgerhold.Key).Eq(discussionUID)
@@ -55,50 +62,21 @@ func (db *bhDatabase) GetMessages(discussionUID uint64,
 			return err
 		}
 
-		// RawMessage retrieval query
-		query := badgerhold.Where("DiscussionID").Eq(discussionUID).
-			Index("DiscussionID")
-		switch {
-		case pageOpts.Reverse && pageOpts.LastID > 0:
-			query = query.And(badgerhold.Key).Le(pageOpts.LastID).
-				SortBy("Timestamp").Reverse()
-		default:
-			query = query.And(badgerhold.Key).Ge(pageOpts.LastID)
-		}
-		query = query.Limit(int(pageOpts.PageSize))
-
 		// Retrieve the raw messages
-		raws := make([]model.RawMessage, 0)
-		if err := db.bh.TxFind(txn, &raws, query); err != nil {
+		raws, err := db.txMessageRange(txn, discussionUID, pageOpts)
+		if err != nil {
 			return err
 		}
 		messages = make([]MessageAggregate, len(raws))
-
-		for i, raw := range raws {
-			switch {
-			case raw.InvoiceSettleIndex != 0:
-				inv, err := db.findInvoice(txn, raw.InvoiceSettleIndex)
-				if err != nil {
-					return fmt.Errorf("could not retrieve invoice "+
-						"associated to message %d: %w", raw.ID, err)
-				}
-
-				messages[i] = newMsgAggregate(raws[i], inv, nil)
-			case raw.PaymentIndexes != nil:
-				pays, err := db.findPayments(txn, raw.PaymentIndexes...)
-				if err != nil {
-					return fmt.Errorf("could not retrieve payments "+
-						"associated with message %d: %w", raw.ID, err)
-				}
-
-				messages[i] = newMsgAggregate(raws[i], nil, pays)
-			default:
-				return fmt.Errorf("stored message not " +
-					"associated with invoice or payments")
+		for i := range raws {
+			msg, err := db.txMessageAggregate(txn, raws[i])
+			if err != nil {
+				return err
 			}
+			messages[i] = *msg
 		}
 
-		return nil
+		return db.txAttachAnnotations(txn, messages)
 	}); err != nil {
 		return nil, err
 	}
@@ -114,3 +92,505 @@ func (db *bhDatabase) GetMessages(discussionUID uint64,
 
 	return messages, nil
 }
+
+// GetMessage retrieves a message along with
+// the invoice or payments associated with it
+// and, for standalone messages, its annotations.
+func (db *bhDatabase) GetMessage(uid uint64) (*MessageAggregate, error) {
+	var msg *MessageAggregate
+	if err := db.bh.Badger().View(func(txn *badger.Txn) error {
+		raw := model.RawMessage{}
+		switch err := db.bh.TxGet(txn, uid, &raw); {
+		case err == badgerhold.ErrNotFound:
+			return ErrMessageNotFound
+		case err != nil:
+			return err
+		}
+
+		var err error
+		if msg, err = db.txMessageAggregate(txn, raw); err != nil || raw.TargetLinked {
+			return err
+		}
+
+		messages := []MessageAggregate{*msg}
+		if err := db.txAttachAnnotations(txn, messages); err != nil {
+			return err
+		}
+		msg = &messages[0]
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// The message range indexes order the standalone messages of each
+// discussion by timestamp and by amount, so that pages are retrieved
+// by seeking to their anchor instead of scanning all discussion messages.
+// Each entry key consists of the index prefix, the big-endian discussion id,
+// the order-preserving encoding of the timestamp (or amount)
+// and the big-endian message id.
+// Amount index entries hold the encoded message timestamp as their value.
+var (
+	messageTimeIndexPrefix      = []byte("_message_time:")
+	messageAmtIndexPrefix       = []byte("_message_amt:")
+	messageRangeIndexVersionKey = []byte("_message_range_index_version")
+)
+
+// messageRangeIndexVersion is the version of the message range index format.
+// An index of a different version is rebuilt when the database is opened.
+const messageRangeIndexVersion = 1
+
+// encodeOrderedInt encodes a signed integer so that
+// its byte-wise order matches its numeric order.
+func encodeOrderedInt(v int64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], uint64(v)^(1<<63))
+
+	return b[:]
+}
+
+func decodeOrderedInt(b []byte) int64 {
+	return int64(binary.BigEndian.Uint64(b) ^ (1 << 63))
+}
+
+func messageRangePrefix(prefix []byte, discussionUID uint64) []byte {
+	key := make([]byte, 0, len(prefix)+24)
+	key = append(key, prefix...)
+
+	var disc [8]byte
+	binary.BigEndian.PutUint64(disc[:], discussionUID)
+
+	return append(key, disc[:]...)
+}
+
+func messageRangeKey(prefix []byte, discussionUID uint64,
+	value int64, msgID uint64) []byte {
+
+	key := messageRangePrefix(prefix, discussionUID)
+	key = append(key, encodeOrderedInt(value)...)
+
+	var id [8]byte
+	binary.BigEndian.PutUint64(id[:], msgID)
+
+	return append(key, id[:]...)
+}
+
+// txIndexMessageRange adds a standalone message to the message range indexes.
+func txIndexMessageRange(txn *badger.Txn, raw *model.RawMessage) error {
+	if raw.TargetLinked {
+		return nil
+	}
+
+	ts := raw.Timestamp.UnixNano()
+	if err := txn.Set(messageRangeKey(messageTimeIndexPrefix,
+		raw.DiscussionID, ts, raw.ID), nil); err != nil {
+
+		return fmt.Errorf("could not index message %d: %w", raw.ID, err)
+	}
+	if err := txn.Set(messageRangeKey(messageAmtIndexPrefix,
+		raw.DiscussionID, raw.AmtMsat, raw.ID), encodeOrderedInt(ts)); err != nil {
+
+		return fmt.Errorf("could not index message %d: %w", raw.ID, err)
+	}
+
+	return nil
+}
+
+// txUnindexMessageRange removes a message from the message range indexes.
+func txUnindexMessageRange(txn *badger.Txn, raw *model.RawMessage) error {
+	keys := [][]byte{
+		messageRangeKey(messageTimeIndexPrefix,
+			raw.DiscussionID, raw.Timestamp.UnixNano(), raw.ID),
+		messageRangeKey(messageAmtIndexPrefix,
+			raw.DiscussionID, raw.AmtMsat, raw.ID),
+	}
+	for _, key := range keys {
+		if err := txn.Delete(key); err != nil {
+			return fmt.Errorf("could not unindex message %d: %w", raw.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// ensureMessageRangeIndex builds the message range indexes
+// for the stored messages, unless indexes of the current version exist.
+func (db *bhDatabase) ensureMessageRangeIndex() error {
+	current, err := db.hasIndexVersion(messageRangeIndexVersionKey,
+		messageRangeIndexVersion)
+	if err != nil || current {
+		return err
+	}
+
+	for _, prefix := range [][]byte{messageTimeIndexPrefix, messageAmtIndexPrefix} {
+		if err := db.bh.Badger().DropPrefix(prefix); err != nil {
+			return err
+		}
+	}
+
+	var raws []model.RawMessage
+	if err := db.bh.Find(&raws, nil); err != nil {
+		return err
+	}
+
+	wb := db.bh.Badger().NewWriteBatch()
+	defer wb.Cancel()
+	for _, raw := range raws {
+		if raw.TargetLinked {
+			continue
+		}
+
+		ts := raw.Timestamp.UnixNano()
+		if err := wb.Set(messageRangeKey(messageTimeIndexPrefix,
+			raw.DiscussionID, ts, raw.ID), nil); err != nil {
+
+			return err
+		}
+		if err := wb.Set(messageRangeKey(messageAmtIndexPrefix,
+			raw.DiscussionID, raw.AmtMsat, raw.ID), encodeOrderedInt(ts)); err != nil {
+
+			return err
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return err
+	}
+
+	return db.setIndexVersion(messageRangeIndexVersionKey, messageRangeIndexVersion)
+}
+
+// messageRangeEntry is a message range index entry,
+// identifying a message by its timestamp and id.
+type messageRangeEntry struct {
+	ts int64
+	id uint64
+}
+
+func (e messageRangeEntry) before(other messageRangeEntry) bool {
+	return e.ts < other.ts || (e.ts == other.ts && e.id < other.id)
+}
+
+// txMessageRange retrieves the requested range of standalone messages
+// of a discussion, in (timestamp, id) order (reversed for reverse pages).
+// Messages targeting another stored message are
+// not retrieved on their own, but as its annotations.
+// Pages are anchored on the timestamp and id of the LastID message,
+// or, if it is not a message of the discussion, of the message
+// following it by id (preceding it, for reverse pages).
+// Amount filters are evaluated over the amount index
+// in the absence of time range filters.
+func (db *bhDatabase) txMessageRange(txn *badger.Txn, discussionUID uint64,
+	pageOpts model.PageOptions) ([]model.RawMessage, error) {
+
+	lo := messageRangeEntry{ts: math.MinInt64}
+	hi := messageRangeEntry{ts: math.MaxInt64, id: math.MaxUint64}
+	if pageOpts.FromTimeNs != 0 {
+		lo.ts = pageOpts.FromTimeNs
+	}
+	if pageOpts.ToTimeNs != 0 {
+		hi.ts = pageOpts.ToTimeNs
+	}
+
+	if pageOpts.LastID != 0 {
+		entry, ok, err := db.txMessageRangeAnchor(txn, discussionUID, pageOpts)
+		if err != nil || !ok {
+			return nil, err
+		}
+
+		switch {
+		case pageOpts.Reverse && entry.before(hi):
+			hi = entry
+		case !pageOpts.Reverse && lo.before(entry):
+			lo = entry
+		}
+	}
+
+	var raws []model.RawMessage
+	// add appends a message of the range, if it matches the page filters,
+	// and returns whether the page is complete.
+	add := func(id uint64) (bool, error) {
+		raw := model.RawMessage{}
+		switch err := db.bh.TxGet(txn, id, &raw); {
+		case err == badgerhold.ErrNotFound:
+			return false, nil
+		case err != nil:
+			return false, err
+		}
+		if !raw.TargetLinked && matchesPageFilters(pageOpts, &raw) {
+			raws = append(raws, raw)
+		}
+
+		return pageOpts.PageSize != 0 && uint64(len(raws)) >= pageOpts.PageSize, nil
+	}
+
+	amtFiltered := pageOpts.MinAmtMsat != 0 || pageOpts.MaxAmtMsat != 0
+	timeFiltered := pageOpts.FromTimeNs != 0 || pageOpts.ToTimeNs != 0
+	if amtFiltered && !timeFiltered {
+		entries, err := txMessageAmtRange(txn, discussionUID, pageOpts, lo, hi)
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range entries {
+			if done, err := add(entry.id); err != nil || done {
+				return raws, err
+			}
+		}
+
+		return raws, nil
+	}
+
+	prefix := messageRangePrefix(messageTimeIndexPrefix, discussionUID)
+	loKey := messageRangeKey(messageTimeIndexPrefix, discussionUID, lo.ts, lo.id)
+	hiKey := messageRangeKey(messageTimeIndexPrefix, discussionUID, hi.ts, hi.id)
+
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = prefix
+	opts.Reverse = pageOpts.Reverse
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	seek := loKey
+	if pageOpts.Reverse {
+		seek = hiKey
+	}
+	for it.Seek(seek); it.ValidForPrefix(prefix); it.Next() {
+		key := it.Item().Key()
+		if bytes.Compare(key, loKey) < 0 || bytes.Compare(key, hiKey) > 0 {
+			break
+		}
+
+		id := binary.BigEndian.Uint64(key[len(key)-8:])
+		if done, err := add(id); err != nil || done {
+			return raws, err
+		}
+	}
+
+	return raws, nil
+}
+
+// txMessageRangeAnchor returns the range index entry of the LastID message.
+// If it is not a message of the discussion, the entry of the standalone
+// message of the discussion following it by id (preceding it, for reverse
+// pages) is returned, as pages were anchored when ordered by id.
+// No entry is returned if no such message exists.
+func (db *bhDatabase) txMessageRangeAnchor(txn *badger.Txn, discussionUID uint64,
+	pageOpts model.PageOptions) (messageRangeEntry, bool, error) {
+
+	anchor := model.RawMessage{}
+	switch err := db.bh.TxGet(txn, pageOpts.LastID, &anchor); {
+	case err == nil && anchor.DiscussionID == discussionUID:
+		return messageRangeEntry{ts: anchor.Timestamp.UnixNano(), id: anchor.ID}, true, nil
+	case err != nil && err != badgerhold.ErrNotFound:
+		return messageRangeEntry{}, false, err
+	}
+
+	prefix := messageRangePrefix(messageTimeIndexPrefix, discussionUID)
+
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = prefix
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	var next messageRangeEntry
+	found := false
+	for it.Rewind(); it.ValidForPrefix(prefix); it.Next() {
+		key := it.Item().Key()
+		entry := messageRangeEntry{
+			ts: decodeOrderedInt(key[len(prefix) : len(prefix)+8]),
+			id: binary.BigEndian.Uint64(key[len(key)-8:]),
+		}
+
+		switch {
+		case pageOpts.Reverse && entry.id < pageOpts.LastID:
+			if !found || entry.id > next.id {
+				next, found = entry, true
+			}
+		case !pageOpts.Reverse && entry.id > pageOpts.LastID:
+			if !found || entry.id < next.id {
+				next, found = entry, true
+			}
+		}
+	}
+
+	return next, found, nil
+}
+
+// txLatestMessageID returns the id of the latest standalone message
+// of a discussion in (timestamp, id) order, or zero if it has none.
+func txLatestMessageID(txn *badger.Txn, discussionUID uint64) uint64 {
+	prefix := messageRangePrefix(messageTimeIndexPrefix, discussionUID)
+
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = prefix
+	opts.Reverse = true
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	it.Seek(append(append([]byte{}, prefix...), 0xff))
+	if !it.ValidForPrefix(prefix) {
+		return 0
+	}
+	key := it.Item().Key()
+
+	return binary.BigEndian.Uint64(key[len(key)-8:])
+}
+
+// txMessageAmtRange returns the amount index entries of a discussion
+// within the amount range of the page options and the provided
+// (timestamp, id) bounds, in page order.
+func txMessageAmtRange(txn *badger.Txn, discussionUID uint64,
+	pageOpts model.PageOptions, lo, hi messageRangeEntry) ([]messageRangeEntry, error) {
+
+	minAmt, maxAmt := int64(math.MinInt64), int64(math.MaxInt64)
+	if pageOpts.MinAmtMsat != 0 {
+		minAmt = pageOpts.MinAmtMsat
+	}
+	if pageOpts.MaxAmtMsat != 0 {
+		maxAmt = pageOpts.MaxAmtMsat
+	}
+
+	prefix := messageRangePrefix(messageAmtIndexPrefix, discussionUID)
+	hiKey := messageRangeKey(messageAmtIndexPrefix, discussionUID,
+		maxAmt, math.MaxUint64)
+
+	opts := badger.DefaultIteratorOptions
+	opts.Prefix = prefix
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	var entries []messageRangeEntry
+	seek := messageRangeKey(messageAmtIndexPrefix, discussionUID, minAmt, 0)
+	for it.Seek(seek); it.ValidForPrefix(prefix); it.Next() {
+		item := it.Item()
+		key := item.Key()
+		if bytes.Compare(key, hiKey) > 0 {
+			break
+		}
+
+		entry := messageRangeEntry{id: binary.BigEndian.Uint64(key[len(key)-8:])}
+		if err := item.Value(func(v []byte) error {
+			entry.ts = decodeOrderedInt(v)
+			return nil
+		}); err != nil {
+			return nil, err
+		}
+		if entry.before(lo) || hi.before(entry) {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	sort.Slice(entries, func(i, j int) bool {
+		return entries[i].before(entries[j]) != pageOpts.Reverse
+	})
+
+	return entries, nil
+}
+
+// matchesPageFilters returns whether a raw message
+// satisfies the filters of the provided page options.
+func matchesPageFilters(pageOpts model.PageOptions, raw *model.RawMessage) bool {
+	ts := raw.Timestamp.UnixNano()
+	switch {
+	case pageOpts.FromTimeNs != 0 && ts < pageOpts.FromTimeNs:
+		return false
+	case pageOpts.ToTimeNs != 0 && ts > pageOpts.ToTimeNs:
+		return false
+	case pageOpts.MinAmtMsat != 0 && raw.AmtMsat < pageOpts.MinAmtMsat:
+		return false
+	case pageOpts.MaxAmtMsat != 0 && raw.AmtMsat > pageOpts.MaxAmtMsat:
+		return false
+	case pageOpts.Direction == model.DirectionSent && raw.InvoiceSettleIndex != 0:
+		return false
+	case pageOpts.Direction == model.DirectionReceived && raw.InvoiceSettleIndex == 0:
+		return false
+	}
+
+	return true
+}
+
+// txAttachAnnotations retrieves the annotations of standalone messages,
+// grouped by discussion in order to use the discussion index.
+func (db *bhDatabase) txAttachAnnotations(txn *badger.Txn,
+	messages []MessageAggregate) error {
+
+	msgIdx := make(map[uint64]int, len(messages))
+	targetIDs := make(map[uint64][]interface{})
+	for i := range messages {
+		raw := messages[i].RawMessage
+		msgIdx[raw.ID] = i
+		targetIDs[raw.DiscussionID] = append(targetIDs[raw.DiscussionID], raw.ID)
+	}
+
+	for discussionUID, ids := range targetIDs {
+		annotationQuery := badgerhold.Where("DiscussionID").Eq(discussionUID).
+			Index("DiscussionID").And("TargetLinked").Eq(true).
+			And("TargetID").In(ids...)
+		annotations := make([]model.RawMessage, 0)
+		if err := db.bh.TxFind(txn, &annotations, annotationQuery); err != nil {
+			return err
+		}
+		for _, raw := range annotations {
+			annotation, err := db.txMessageAggregate(txn, raw)
+			if err != nil {
+				return err
+			}
+			i := msgIdx[raw.TargetID]
+			messages[i].Annotations = append(messages[i].Annotations, *annotation)
+		}
+	}
+
+	return nil
+}
+
+// txMessageAggregate retrieves the invoices or payments
+// associated with a raw message.
+func (db *bhDatabase) txMessageAggregate(txn *badger.Txn,
+	raw model.RawMessage) (*MessageAggregate, error) {
+
+	switch {
+	case raw.InvoiceSettleIndex != 0:
+		keys := invoiceKeys(&raw)
+		inv, err := db.findInvoice(txn, keys[0])
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve invoice "+
+				"associated to message %d: %w", raw.ID, err)
+		}
+
+		msg := newMsgAggregate(raw, inv, nil)
+
+		for _, key := range keys[1:] {
+			fragment, err := db.findInvoice(txn, key)
+			if err != nil {
+				return nil, fmt.Errorf("could not retrieve fragment invoice "+
+					"associated to message %d: %w", raw.ID, err)
+			}
+			msg.Fragments = append(msg.Fragments, fragment)
+		}
+
+		return &msg, nil
+	case raw.PaymentIndexes != nil:
+		pays, err := db.findPayments(txn, &raw)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve payments "+
+				"associated with message %d: %w", raw.ID, err)
+		}
+
+		msg := newMsgAggregate(raw, nil, pays)
+
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("stored message not " +
+			"associated with invoice or payments")
+	}
+}
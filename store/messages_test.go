@@ -8,6 +8,8 @@ import (
 	"testing"
 	"time"
 
+	"github.com/dgraph-io/badger/v3"
+	"github.com/lightningnetwork/lnd/lnrpc"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
@@ -46,6 +48,13 @@ func generateHex(t *testing.T, n uint) string {
 	return hex.EncodeToString(bs)
 }
 
+func generateNode(t *testing.T, address string) lnchat.NodeID {
+	node, err := lnchat.NewNodeFromString(address)
+	require.NoError(t, err)
+
+	return node
+}
+
 func generateIncoming(t *testing.T, sender string) (
 	*model.RawMessage, *model.Invoice) {
 
@@ -81,7 +90,7 @@ func generateIncoming(t *testing.T, sender string) (
 
 	rawMsg := &model.RawMessage{
 		RawPayload:         payloadBytes,
-		Sender:             sender,
+		Sender:             generateNode(t, sender),
 		Signature:          sig,
 		SignatureVerified:  true,
 		InvoiceSettleIndex: invSettleIdx,
@@ -132,7 +141,7 @@ func generateOutgoing(t *testing.T, receivers ...string) (
 
 	rawMsg := &model.RawMessage{
 		RawPayload:        payloadBytes,
-		Sender:            sender,
+		Sender:            generateNode(t, sender),
 		Signature:         sig,
 		SignatureVerified: true,
 		PaymentIndexes:    paymentIdxs,
@@ -160,6 +169,9 @@ func TestAddPayments(t *testing.T) {
 
 	err := db.AddPayments(payments...)
 	assert.NoError(t, err)
+
+	err = db.AddPayments(payments...)
+	assert.ErrorIs(t, err, ErrDuplicatePayment)
 }
 
 func TestAddRawMessage(t *testing.T) {
@@ -464,6 +476,72 @@ func TestGetMessages(t *testing.T) {
 	assert.EqualValues(t, msgs, list)
 }
 
+func TestGetMessagesReferences(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	discussion := generateDiscussion([]string{generateHex(t, 33)})
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	addMessage := func(messageID string, contentType model.ContentType,
+		replyTo, target string) *model.RawMessage {
+
+		rawMsg, inv := generateIncoming(t, disc.Participants[0])
+		rawMsg.DiscussionID = disc.ID
+		rawMsg.MessageID, rawMsg.ContentType = messageID, contentType
+		rawMsg.ReplyTo, rawMsg.Target = replyTo, target
+
+		require.NoError(t, db.AddInvoice(inv))
+		require.NoError(t, db.AddRawMessage(rawMsg))
+
+		return rawMsg
+	}
+
+	first := addMessage("first", model.ContentTypeText, "", "")
+	reaction := addMessage("reaction", model.ContentTypeReaction, "", "first")
+	assert.True(t, reaction.TargetLinked)
+	assert.Equal(t, first.ID, reaction.TargetID)
+
+	reply := addMessage("reply", model.ContentTypeText, "first", "")
+	assert.True(t, reply.ReplyToLinked)
+	assert.Equal(t, first.ID, reply.ReplyToID)
+
+	// References to messages not (yet) stored are linked
+	// once the referenced message is stored.
+	earlyReply := addMessage("early reply", model.ContentTypeText, "late", "")
+	earlyEdit := addMessage("early edit", model.ContentTypeEdit, "", "late")
+	assert.False(t, earlyReply.ReplyToLinked)
+	assert.False(t, earlyEdit.TargetLinked)
+	late := addMessage("late", model.ContentTypeText, "", "")
+
+	// Messages targeting other messages cannot be referenced.
+	reactionReply := addMessage("reaction reply", model.ContentTypeText, "reaction", "")
+	assert.False(t, reactionReply.ReplyToLinked)
+
+	list, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+
+	ids := make([]uint64, len(list))
+	for i, msg := range list {
+		ids[i] = msg.RawMessage.ID
+	}
+	assert.Equal(t, []uint64{first.ID, reply.ID,
+		earlyReply.ID, late.ID, reactionReply.ID}, ids)
+
+	require.Len(t, list[0].Annotations, 1)
+	assert.Equal(t, reaction.ID, list[0].Annotations[0].RawMessage.ID)
+	assert.NotNil(t, list[0].Annotations[0].Invoice)
+
+	assert.True(t, list[2].RawMessage.ReplyToLinked)
+	assert.Equal(t, late.ID, list[2].RawMessage.ReplyToID)
+
+	require.Len(t, list[3].Annotations, 1)
+	assert.Equal(t, earlyEdit.ID, list[3].Annotations[0].RawMessage.ID)
+	assert.True(t, list[3].Annotations[0].RawMessage.TargetLinked)
+	assert.Equal(t, late.ID, list[3].Annotations[0].RawMessage.TargetID)
+}
+
 func TestGetMessagesMissingDiscussion(t *testing.T) {
 	db, cleanup := createInMemoryDB(t)
 	defer cleanup()
@@ -682,7 +760,7 @@ func TestGetMessagesRange(t *testing.T) {
 				PageSize: 5,
 				Reverse:  true,
 			},
-			expectedErr: fmt.Errorf("reverse pagination without anchor is disallowed"),
+			expectedList: msgs[len(msgs)-5:],
 		},
 		{
 			name: "reverse with start",
@@ -724,7 +802,7 @@ func TestGetMessagesRange(t *testing.T) {
 			pageOpts: model.PageOptions{
 				Reverse: true,
 			},
-			expectedErr: fmt.Errorf("reverse pagination without anchor is disallowed"),
+			expectedList: msgs,
 		},
 	}
 
@@ -753,3 +831,174 @@ func TestGetMessagesRange(t *testing.T) {
 		})
 	}
 }
+
+func TestGetMessagesFilters(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	resetTimestampGetter := overrideTimestampGetterFrom(time.Unix(0, 0), time.Hour)
+	defer resetTimestampGetter()
+
+	participant := generateHex(t, 33)
+	discussion := generateDiscussion([]string{participant})
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	addIncoming := func(amtMsat int64) *model.RawMessage {
+		raw, inv := generateIncoming(t, participant)
+		raw.DiscussionID = disc.ID
+		inv.AmtPaid = lnchat.NewAmount(amtMsat)
+
+		require.NoError(t, db.AddInvoice(inv))
+		require.NoError(t, db.AddRawMessage(raw))
+
+		return raw
+	}
+	addOutgoing := func(amtMsat int64) *model.RawMessage {
+		raw, payments := generateOutgoing(t, participant)
+		raw.DiscussionID = disc.ID
+		payments[0].Htlcs = []lnchat.HTLCAttempt{
+			{
+				Route:  lnchat.Route{Amt: lnchat.NewAmount(amtMsat)},
+				Status: lnrpc.HTLCAttempt_SUCCEEDED,
+			},
+			{
+				Route:  lnchat.Route{Amt: lnchat.NewAmount(amtMsat)},
+				Status: lnrpc.HTLCAttempt_FAILED,
+			},
+		}
+
+		require.NoError(t, db.AddPayments(payments...))
+		require.NoError(t, db.AddRawMessage(raw))
+
+		return raw
+	}
+
+	msgs := []*model.RawMessage{
+		addIncoming(1000),
+		addOutgoing(2000),
+		addIncoming(3000),
+		addOutgoing(4000),
+		addIncoming(5000),
+	}
+	for i, amtMsat := range []int64{1000, 2000, 3000, 4000, 5000} {
+		require.Equal(t, amtMsat, msgs[i].AmtMsat)
+	}
+
+	cases := []struct {
+		name     string
+		pageOpts model.PageOptions
+		expected []*model.RawMessage
+	}{
+		{
+			name: "time range",
+			pageOpts: model.PageOptions{
+				FromTimeNs: msgs[1].Timestamp.UnixNano(),
+				ToTimeNs:   msgs[3].Timestamp.UnixNano(),
+			},
+			expected: msgs[1:4],
+		},
+		{
+			name: "amount range",
+			pageOpts: model.PageOptions{
+				MinAmtMsat: 2000,
+				MaxAmtMsat: 4000,
+			},
+			expected: msgs[1:4],
+		},
+		{
+			name: "sent",
+			pageOpts: model.PageOptions{
+				Direction: model.DirectionSent,
+			},
+			expected: []*model.RawMessage{msgs[1], msgs[3]},
+		},
+		{
+			name: "received above amount",
+			pageOpts: model.PageOptions{
+				MinAmtMsat: 2000,
+				Direction:  model.DirectionReceived,
+			},
+			expected: []*model.RawMessage{msgs[2], msgs[4]},
+		},
+		{
+			name: "latest",
+			pageOpts: model.PageOptions{
+				PageSize: 2,
+				Reverse:  true,
+			},
+			expected: msgs[3:],
+		},
+		{
+			name: "latest since time",
+			pageOpts: model.PageOptions{
+				PageSize:   4,
+				Reverse:    true,
+				FromTimeNs: msgs[2].Timestamp.UnixNano(),
+			},
+			expected: msgs[2:],
+		},
+		{
+			name: "page over amount index",
+			pageOpts: model.PageOptions{
+				LastID:     msgs[1].ID,
+				PageSize:   2,
+				MinAmtMsat: 1000,
+			},
+			expected: msgs[1:3],
+		},
+	}
+
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			list, err := db.GetMessages(disc.ID, c.pageOpts)
+			require.NoError(t, err)
+
+			ids := make([]uint64, len(list))
+			for i, m := range list {
+				ids[i] = m.RawMessage.ID
+			}
+			expectedIDs := make([]uint64, len(c.expected))
+			for i, raw := range c.expected {
+				expectedIDs[i] = raw.ID
+			}
+			assert.Equal(t, expectedIDs, ids)
+		})
+	}
+	t.Run("reindex", func(t *testing.T) {
+		bhdb := db.(*bhDatabase)
+		require.NoError(t, bhdb.bh.UpdateMatching(&model.RawMessage{}, nil,
+			func(record interface{}) error {
+				record.(*model.RawMessage).AmtMsat = 0
+				return nil
+			}))
+		require.NoError(t, bhdb.bh.Badger().Update(func(txn *badger.Txn) error {
+			if err := txn.Delete(messageIndexVersionKey); err != nil {
+				return err
+			}
+			return txn.Delete(messageRangeIndexVersionKey)
+		}))
+		require.NoError(t, bhdb.bh.Badger().DropPrefix(messageTimeIndexPrefix))
+		require.NoError(t, bhdb.bh.Badger().DropPrefix(messageAmtIndexPrefix))
+
+		list, err := db.GetMessages(disc.ID, model.PageOptions{})
+		require.NoError(t, err)
+		assert.Empty(t, list)
+
+		require.NoError(t, bhdb.ensureMessageIndexes())
+		require.NoError(t, bhdb.ensureMessageRangeIndex())
+		list, err = db.GetMessages(disc.ID, model.PageOptions{MinAmtMsat: 5000})
+		require.NoError(t, err)
+		require.Len(t, list, 1)
+		assert.Equal(t, msgs[4].ID, list[0].RawMessage.ID)
+
+		list, err = db.GetMessages(disc.ID, model.PageOptions{
+			PageSize: 2,
+			Reverse:  true,
+		})
+		require.NoError(t, err)
+		require.Len(t, list, 2)
+		assert.Equal(t, msgs[3].ID, list[0].RawMessage.ID)
+		assert.Equal(t, msgs[4].ID, list[1].RawMessage.ID)
+	})
+}
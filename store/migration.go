@@ -0,0 +1,264 @@
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
+
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+// schemaVersionKey is the key the database schema version is stored under.
+var schemaVersionKey = []byte("_schema_version")
+
+var (
+	// ErrUnsupportedSchema is returned in case the database schema version
+	// is newer than the latest version supported.
+	ErrUnsupportedSchema = fmt.Errorf("Unsupported database schema version")
+	// ErrMigrationDryRun is returned by New in migration dry-run mode,
+	// after the pending migrations are verified.
+	ErrMigrationDryRun = fmt.Errorf("Migration dry run")
+)
+
+// A migration converts the stored entries of the previous schema version
+// to the migration schema version.
+// A migration that fails after committing part of its changes is applied
+// again on the next database open, so migrations must be idempotent.
+type migration struct {
+	version     uint64
+	description string
+	// migrate applies the migration, returning the number of migrated entries.
+	migrate func(db *bhDatabase) (int, error)
+}
+
+// migrations contains the database migrations, in schema version order.
+var migrations = []migration{
+	{
+		version:     1,
+		description: "store message senders as node identifiers",
+		migrate:     migrateRawMessageSender,
+	},
+	{
+		version:     2,
+		description: "store the last activity time and unread count of discussions",
+		migrate:     migrateDiscussionActivity,
+	},
+	{
+		version:     3,
+		description: "store the latest message of discussions",
+		migrate:     migrateDiscussionActivity,
+	},
+}
+
+// latestSchemaVersion returns the schema version of the stored types.
+func latestSchemaVersion() uint64 {
+	return migrations[len(migrations)-1].version
+}
+
+// WithMigrationDryRun sets New to apply any pending migrations
+// to an in-memory copy of the database, leaving the database unchanged.
+// New then returns ErrMigrationDryRun instead of the database.
+func WithMigrationDryRun() func(Database) {
+	return func(db Database) {
+		switch db := db.(type) {
+		case *bhDatabase:
+			db.migrationDryRun = true
+		case *sqlDatabase:
+			db.migrationDryRun = true
+		}
+	}
+}
+
+// WithMigrationBackupDir sets the directory a full backup of the database
+// is written to before any pending migrations are applied.
+func WithMigrationBackupDir(dir string) func(Database) {
+	return func(db Database) {
+		switch db := db.(type) {
+		case *bhDatabase:
+			db.migrationBackupDir = dir
+		case *sqlDatabase:
+			db.migrationBackupDir = dir
+		}
+	}
+}
+
+// migrate applies the pending migrations of the database, in order.
+// Databases without entries are initialized with the latest schema version.
+func (db *bhDatabase) migrate() error {
+	current, empty, err := db.schemaVersion()
+	if err != nil {
+		return err
+	}
+	if empty {
+		current = latestSchemaVersion()
+	}
+	if current > latestSchemaVersion() {
+		return fmt.Errorf("%w: %d (latest supported version is %d)",
+			ErrUnsupportedSchema, current, latestSchemaVersion())
+	}
+
+	var pending []migration
+	for _, m := range migrations {
+		if m.version > current {
+			pending = append(pending, m)
+		}
+	}
+
+	switch {
+	case db.migrationDryRun:
+		return db.dryRunMigrations(current, pending)
+	case empty:
+		return db.setSchemaVersion(current)
+	case len(pending) == 0:
+		return nil
+	}
+
+	if db.migrationBackupDir != "" {
+		if err := backupBeforeMigration(db, db.logger,
+			db.migrationBackupDir, current, latestSchemaVersion()); err != nil {
+
+			return fmt.Errorf("could not back up database before migration: %w", err)
+		}
+	}
+
+	return db.applyMigrations(pending)
+}
+
+// schemaVersion returns the schema version of the database,
+// along with whether the database contains no entries.
+// Databases created before schema versioning have schema version 0.
+func (db *bhDatabase) schemaVersion() (version uint64, empty bool, err error) {
+	err = db.bh.Badger().View(func(txn *badger.Txn) error {
+		item, err := txn.Get(schemaVersionKey)
+		switch {
+		case err == badger.ErrKeyNotFound:
+			it := txn.NewIterator(badger.IteratorOptions{})
+			defer it.Close()
+			it.Rewind()
+			empty = !it.Valid()
+			return nil
+		case err != nil:
+			return err
+		}
+
+		return item.Value(func(v []byte) error {
+			if len(v) != 8 {
+				return fmt.Errorf("invalid schema version encoding")
+			}
+			version = binary.BigEndian.Uint64(v)
+			return nil
+		})
+	})
+
+	return version, empty, err
+}
+
+// setSchemaVersion stores the schema version of the database.
+func (db *bhDatabase) setSchemaVersion(version uint64) error {
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		return txn.Set(schemaVersionKey, encodeVersion(version))
+	})
+}
+
+// applyMigrations applies the provided migrations in order,
+// storing the schema version after each migration completes.
+func (db *bhDatabase) applyMigrations(pending []migration) error {
+	for _, m := range pending {
+		db.logger.Infof("Applying database migration %d (%s)",
+			m.version, m.description)
+
+		count, err := m.migrate(db)
+		if err != nil {
+			return fmt.Errorf("migration %d failed: %w", m.version, err)
+		}
+		if err := db.setSchemaVersion(m.version); err != nil {
+			return err
+		}
+
+		db.logger.Infof("Applied database migration %d to %d entries",
+			m.version, count)
+	}
+
+	return nil
+}
+
+// dryRunMigrations applies the provided migrations
+// to an in-memory copy of the database.
+func (db *bhDatabase) dryRunMigrations(current uint64, pending []migration) error {
+	if len(pending) == 0 {
+		db.logger.Infof("Database schema version %d is up to date", current)
+		return ErrMigrationDryRun
+	}
+
+	opts := db.bhOptions
+	opts.Options = opts.Options.WithDir("").WithValueDir("").WithInMemory(true)
+	bh, err := badgerhold.Open(opts)
+	if err != nil {
+		return fmt.Errorf("could not open in-memory database: %w", err)
+	}
+	dbCopy := &bhDatabase{
+		logger:    db.logger,
+		bhOptions: opts,
+		bh:        bh,
+	}
+	defer dbCopy.Close()
+
+	pr, pw := io.Pipe()
+	backupErr := make(chan error, 1)
+	go func() {
+		_, err := db.Backup(pw, 0)
+		pw.CloseWithError(err)
+		backupErr <- err
+	}()
+	err = dbCopy.Restore(pr)
+	pr.CloseWithError(err)
+	if backupErr := <-backupErr; err == nil {
+		err = backupErr
+	}
+	if err != nil {
+		return fmt.Errorf("could not copy database: %w", err)
+	}
+
+	db.logger.Infof("Dry run of %d database migrations "+
+		"from schema version %d", len(pending), current)
+	if err := dbCopy.applyMigrations(pending); err != nil {
+		return err
+	}
+
+	return ErrMigrationDryRun
+}
+
+// backupBeforeMigration writes a full backup of the database
+// to the migration backup directory, before migrating
+// from schema version from to schema version to.
+func backupBeforeMigration(db Database, logger *slog.Logger, dir string, from, to uint64) error {
+	name := filepath.Join(dir,
+		fmt.Sprintf("c13n-premigration-%d-%d-%s.bak", from,
+			to, time.Now().UTC().Format("20060102T150405Z")))
+
+	f, err := os.OpenFile(name, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+
+	_, err = db.Backup(f, 0)
+	if err == nil {
+		err = f.Sync()
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		os.Remove(name)
+		return err
+	}
+	logger.Infof("Database backup written to %s", name)
+
+	return nil
+}
@@ -0,0 +1,39 @@
+package store
+
+import (
+	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// migrateDiscussionActivity stores the last activity time, latest message
+// and unread message count of the stored discussions,
+// which are otherwise updated as messages are stored and read.
+func migrateDiscussionActivity(db *bhDatabase) (int, error) {
+	var discussions []model.Discussion
+	if err := db.bh.Find(&discussions, nil); err != nil {
+		return 0, err
+	}
+
+	for i := range discussions {
+		disc := &discussions[i]
+		if err := db.bh.Badger().Update(func(txn *badger.Txn) error {
+			query := badgerhold.Where("DiscussionID").Eq(disc.ID).
+				Index("DiscussionID")
+
+			var raws []model.RawMessage
+			if err := db.bh.TxFind(txn, &raws, query); err != nil {
+				return err
+			}
+			prev := *disc
+			summarizeActivity(disc, raws)
+
+			return db.txStoreDiscussion(txn, &prev, disc)
+		}); err != nil {
+			return i, err
+		}
+	}
+
+	return len(discussions), nil
+}
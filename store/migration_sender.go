@@ -0,0 +1,161 @@
+package store
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// rawMessagePrefix is the key prefix of the stored raw messages.
+var rawMessagePrefix = []byte("bh_RawMessage:")
+
+// migrationBatchSize is the number of entries
+// migrated in a single transaction.
+const migrationBatchSize = 1000
+
+// rawMessageV0 is the raw message stored by schema version 0,
+// with the sender stored as a hex-encoded address.
+type rawMessageV0 struct {
+	ID                    uint64
+	DiscussionID          uint64
+	RawPayload            []byte
+	Sender                string
+	Signature             []byte
+	SignatureVerified     bool
+	Encrypted             bool
+	MessageID             string
+	ContentType           model.ContentType
+	ReplyTo               string
+	Target                string
+	ReplyToID             uint64
+	ReplyToLinked         bool
+	TargetID              uint64
+	TargetLinked          bool
+	InvoiceSettleIndex    uint64
+	FragmentSettleIndexes []uint64
+	PaymentIndexes        []uint64
+	Receipts              []model.RecipientReceipts
+	AmtMsat               int64
+	Timestamp             time.Time
+	Redacted              bool
+}
+
+// migrateRawMessageSender converts the sender address
+// of the stored raw messages to a node identifier.
+// Messages with an invalid sender address retain their other fields,
+// while the sender is dropped and the signature marked unverified.
+func migrateRawMessageSender(db *bhDatabase) (int, error) {
+	type entry struct {
+		key, value []byte
+	}
+
+	var entries []entry
+	if err := db.bh.Badger().View(func(txn *badger.Txn) error {
+		it := txn.NewIterator(badger.IteratorOptions{Prefix: rawMessagePrefix})
+		defer it.Close()
+
+		for it.Rewind(); it.Valid(); it.Next() {
+			value, err := it.Item().ValueCopy(nil)
+			if err != nil {
+				return err
+			}
+			entries = append(entries, entry{
+				key:   it.Item().KeyCopy(nil),
+				value: value,
+			})
+		}
+		return nil
+	}); err != nil {
+		return 0, err
+	}
+
+	migrated := 0
+	for start := 0; start < len(entries); start += migrationBatchSize {
+		end := start + migrationBatchSize
+		if end > len(entries) {
+			end = len(entries)
+		}
+
+		if err := db.bh.Badger().Update(func(txn *badger.Txn) error {
+			for _, e := range entries[start:end] {
+				raw, ok, err := db.decodeRawMessageV0(e.value)
+				if err != nil {
+					return err
+				}
+				if !ok {
+					continue
+				}
+
+				value, err := db.bhOptions.Encoder(raw)
+				if err != nil {
+					return fmt.Errorf("could not encode message %d: %w", raw.ID, err)
+				}
+				if err := txn.Set(e.key, value); err != nil {
+					return err
+				}
+				migrated++
+			}
+			return nil
+		}); err != nil {
+			return migrated, err
+		}
+	}
+
+	return migrated, nil
+}
+
+// decodeRawMessageV0 decodes a stored raw message of schema version 0,
+// and converts it to the current raw message.
+// Messages already converted are not returned.
+func (db *bhDatabase) decodeRawMessageV0(value []byte) (*model.RawMessage, bool, error) {
+	var v0 rawMessageV0
+	if err := db.bhOptions.Decoder(value, &v0); err != nil {
+		var current model.RawMessage
+		if db.bhOptions.Decoder(value, &current) == nil {
+			return nil, false, nil
+		}
+		return nil, false, fmt.Errorf("could not decode message: %w", err)
+	}
+
+	raw := &model.RawMessage{
+		ID:                    v0.ID,
+		DiscussionID:          v0.DiscussionID,
+		RawPayload:            v0.RawPayload,
+		Signature:             v0.Signature,
+		SignatureVerified:     v0.SignatureVerified,
+		Encrypted:             v0.Encrypted,
+		MessageID:             v0.MessageID,
+		ContentType:           v0.ContentType,
+		ReplyTo:               v0.ReplyTo,
+		Target:                v0.Target,
+		ReplyToID:             v0.ReplyToID,
+		ReplyToLinked:         v0.ReplyToLinked,
+		TargetID:              v0.TargetID,
+		TargetLinked:          v0.TargetLinked,
+		InvoiceSettleIndex:    v0.InvoiceSettleIndex,
+		FragmentSettleIndexes: v0.FragmentSettleIndexes,
+		PaymentIndexes:        v0.PaymentIndexes,
+		Receipts:              v0.Receipts,
+		AmtMsat:               v0.AmtMsat,
+		Timestamp:             v0.Timestamp,
+		Redacted:              v0.Redacted,
+	}
+
+	if v0.Sender != "" {
+		sender, err := lnchat.NewNodeFromString(v0.Sender)
+		switch {
+		case err != nil:
+			db.logger.WithError(err).Warnf("Dropping invalid sender "+
+				"address of message %d", v0.ID)
+			raw.SignatureVerified = false
+		default:
+			raw.Sender = sender
+		}
+	}
+
+	return raw, true, nil
+}
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// downgradeRawMessages stores the raw messages of the database
+// in their schema version 0 encoding, with an invalid sender
+// for the message with the provided id.
+func downgradeRawMessages(t *testing.T, db *bhDatabase, invalidID uint64) {
+	var raws []model.RawMessage
+	require.NoError(t, db.bh.Find(&raws, nil))
+
+	err := db.bh.Badger().Update(func(txn *badger.Txn) error {
+		for _, raw := range raws {
+			v0 := rawMessageV0{
+				ID:                 raw.ID,
+				DiscussionID:       raw.DiscussionID,
+				RawPayload:         raw.RawPayload,
+				Sender:             raw.SenderAddress(),
+				Signature:          raw.Signature,
+				SignatureVerified:  raw.SignatureVerified,
+				InvoiceSettleIndex: raw.InvoiceSettleIndex,
+				PaymentIndexes:     raw.PaymentIndexes,
+				AmtMsat:            raw.AmtMsat,
+				Timestamp:          raw.Timestamp,
+			}
+			if raw.ID == invalidID {
+				v0.Sender = "invalid"
+			}
+
+			id, err := db.bhOptions.Encoder(raw.ID)
+			require.NoError(t, err)
+			key := append(append([]byte(nil), rawMessagePrefix...), id...)
+			value, err := db.bhOptions.Encoder(&v0)
+			require.NoError(t, err)
+			require.NoError(t, txn.Set(key, value))
+		}
+
+		return txn.Delete(schemaVersionKey)
+	})
+	require.NoError(t, err)
+}
+
+func TestMigrations(t *testing.T) {
+	dir, backupDir := t.TempDir(), t.TempDir()
+	open := func(options ...func(Database)) (Database, error) {
+		return New(dir, append(options, WithBadgerOption(
+			func(o badger.Options) badger.Options {
+				return o.WithEncryptionKey([]byte("1234567890123456")).
+					WithIndexCacheSize(1 << 20)
+			}),
+		)...)
+	}
+
+	db, err := open()
+	require.NoError(t, err)
+
+	// New databases are created with the latest schema version.
+	version, _, err := db.(*bhDatabase).schemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+
+	participant := generateHex(t, 33)
+	discussion := generateDiscussion([]string{participant})
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	incoming, inv := generateIncoming(t, participant)
+	incoming.DiscussionID = disc.ID
+	require.NoError(t, db.AddInvoice(inv))
+	require.NoError(t, db.AddRawMessage(incoming))
+
+	outgoing, payments := generateOutgoing(t, participant)
+	outgoing.DiscussionID = disc.ID
+	for _, p := range payments {
+		require.NoError(t, db.AddPayments(p))
+	}
+	require.NoError(t, db.AddRawMessage(outgoing))
+
+	downgradeRawMessages(t, db.(*bhDatabase), outgoing.ID)
+	require.NoError(t, db.(*bhDatabase).bh.UpdateMatching(&model.Discussion{}, nil,
+		func(record interface{}) error {
+			record.(*model.Discussion).LastActivity = time.Time{}
+			record.(*model.Discussion).LatestMessageID = 0
+			return nil
+		}))
+	// Databases created before the indexes have no index versions.
+	require.NoError(t, db.(*bhDatabase).bh.Badger().Update(func(txn *badger.Txn) error {
+		for _, key := range [][]byte{messageIndexVersionKey, messageRangeIndexVersionKey,
+			receiptIndexVersionKey, searchIndexVersionKey, hashIndexVersionKey,
+			discussionListingIndexVersionKey} {
+
+			if err := txn.Delete(key); err != nil {
+				return err
+			}
+		}
+		return nil
+	}))
+	require.NoError(t, db.Close())
+
+	// Dry runs leave the database unchanged.
+	_, err = open(WithMigrationDryRun())
+	assert.ErrorIs(t, err, ErrMigrationDryRun)
+
+	db, err = open(WithMigrationBackupDir(backupDir))
+	require.NoError(t, err)
+
+	msgs, err := db.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, participant, msgs[0].RawMessage.SenderAddress())
+	assert.True(t, msgs[0].RawMessage.SignatureVerified)
+	assert.True(t, msgs[1].RawMessage.Sender.IsZero())
+	assert.False(t, msgs[1].RawMessage.SignatureVerified)
+
+	migrated, err := db.GetDiscussion(disc.ID)
+	require.NoError(t, err)
+	assert.True(t, outgoing.Timestamp.Equal(migrated.LastActivity))
+	assert.Equal(t, outgoing.ID, migrated.LatestMessageID)
+
+	listed, err := db.GetDiscussions(model.PageOptions{},
+		model.DiscussionOrderLastActivity, model.DiscussionFilter{})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{disc.ID}, discussionIDs(listed))
+
+	// The pre-migration backup is written only if migrations were pending.
+	files, err := ioutil.ReadDir(backupDir)
+	require.NoError(t, err)
+	assert.Len(t, files, 1)
+
+	// Restored backups of earlier schema versions are migrated and indexed.
+	backup, err := os.Open(filepath.Join(backupDir, files[0].Name()))
+	require.NoError(t, err)
+	defer backup.Close()
+	restored, err := New(t.TempDir(), WithRestore(backup), WithBadgerOption(
+		func(o badger.Options) badger.Options {
+			return o.WithEncryptionKey([]byte("1234567890123456")).
+				WithIndexCacheSize(1 << 20)
+		}),
+	)
+	require.NoError(t, err)
+	defer restored.Close()
+
+	version, _, err = restored.(*bhDatabase).schemaVersion()
+	require.NoError(t, err)
+	assert.Equal(t, latestSchemaVersion(), version)
+
+	msgs, err = restored.GetMessages(disc.ID, model.PageOptions{})
+	require.NoError(t, err)
+	require.Len(t, msgs, 2)
+	assert.Equal(t, participant, msgs[0].RawMessage.SenderAddress())
+	assert.True(t, msgs[1].RawMessage.Sender.IsZero())
+
+	// Databases with newer schema versions are rejected.
+	require.NoError(t, db.(*bhDatabase).setSchemaVersion(latestSchemaVersion()+1))
+	require.NoError(t, db.Close())
+
+	_, err = open()
+	assert.ErrorIs(t, err, ErrUnsupportedSchema)
+}
@@ -3,11 +3,15 @@
 package storemock
 
 import (
+	io "io"
+
 	mock "github.com/stretchr/testify/mock"
 
 	model "github.com/c13n-io/c13n-go/model"
 
 	store "github.com/c13n-io/c13n-go/store"
+
+	time "time"
 )
 
 // Database is an autogenerated mock type for the Database type
@@ -75,6 +79,48 @@ func (_m *Database) AddInvoice(inv *model.Invoice) error {
 	return r0
 }
 
+// AddInvoiceFragment provides a mock function with given fields: inv
+func (_m *Database) AddInvoiceFragment(inv *model.Invoice) error {
+	ret := _m.Called(inv)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Invoice) error); ok {
+		r0 = rf(inv)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddInvoiceMessage provides a mock function with given fields: inv, rawMsg
+func (_m *Database) AddInvoiceMessage(inv *model.Invoice, rawMsg *model.RawMessage) error {
+	ret := _m.Called(inv, rawMsg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Invoice, *model.RawMessage) error); ok {
+		r0 = rf(inv, rawMsg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// AddOutboxMessage provides a mock function with given fields: msg
+func (_m *Database) AddOutboxMessage(msg *model.OutboxMessage) error {
+	ret := _m.Called(msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.OutboxMessage) error); ok {
+		r0 = rf(msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // AddPayments provides a mock function with given fields: payments
 func (_m *Database) AddPayments(payments ...*model.Payment) error {
 	_va := make([]interface{}, len(payments))
@@ -109,6 +155,62 @@ func (_m *Database) AddRawMessage(_a0 *model.RawMessage) error {
 	return r0
 }
 
+// AddReceipt provides a mock function with given fields: receipt
+func (_m *Database) AddReceipt(receipt *model.Receipt) error {
+	ret := _m.Called(receipt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.Receipt) error); ok {
+		r0 = rf(receipt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ApplyRetention provides a mock function with given fields: defaultPolicy, now
+func (_m *Database) ApplyRetention(defaultPolicy model.RetentionPolicy, now time.Time) (uint64, error) {
+	ret := _m.Called(defaultPolicy, now)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(model.RetentionPolicy, time.Time) uint64); ok {
+		r0 = rf(defaultPolicy, now)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(model.RetentionPolicy, time.Time) error); ok {
+		r1 = rf(defaultPolicy, now)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// Backup provides a mock function with given fields: w, since
+func (_m *Database) Backup(w io.Writer, since uint64) (uint64, error) {
+	ret := _m.Called(w, since)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(io.Writer, uint64) uint64); ok {
+		r0 = rf(w, since)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(io.Writer, uint64) error); ok {
+		r1 = rf(w, since)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // Close provides a mock function with given fields:
 func (_m *Database) Close() error {
 	ret := _m.Called()
@@ -123,6 +225,27 @@ func (_m *Database) Close() error {
 	return r0
 }
 
+// CompleteOutboxMessage provides a mock function with given fields: id, result, rawMsg, payments
+func (_m *Database) CompleteOutboxMessage(id uint64, result model.SendResult, rawMsg *model.RawMessage, payments ...*model.Payment) error {
+	_va := make([]interface{}, len(payments))
+	for _i := range payments {
+		_va[_i] = payments[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, result, rawMsg)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, model.SendResult, *model.RawMessage, ...*model.Payment) error); ok {
+		r0 = rf(id, result, rawMsg, payments...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // GetContact provides a mock function with given fields: address
 func (_m *Database) GetContact(address string) (*model.Contact, error) {
 	ret := _m.Called(address)
@@ -238,13 +361,13 @@ func (_m *Database) GetDiscussionByParticipants(participants []string) (*model.D
 	return r0, r1
 }
 
-// GetDiscussions provides a mock function with given fields: seekIndex, pageSize
-func (_m *Database) GetDiscussions(seekIndex uint64, pageSize uint64) ([]model.Discussion, error) {
-	ret := _m.Called(seekIndex, pageSize)
+// GetDiscussions provides a mock function with given fields: pageOpts, order, filter
+func (_m *Database) GetDiscussions(pageOpts model.PageOptions, order model.DiscussionOrder, filter model.DiscussionFilter) ([]model.Discussion, error) {
+	ret := _m.Called(pageOpts, order, filter)
 
 	var r0 []model.Discussion
-	if rf, ok := ret.Get(0).(func(uint64, uint64) []model.Discussion); ok {
-		r0 = rf(seekIndex, pageSize)
+	if rf, ok := ret.Get(0).(func(model.PageOptions, model.DiscussionOrder, model.DiscussionFilter) []model.Discussion); ok {
+		r0 = rf(pageOpts, order, filter)
 	} else {
 		if ret.Get(0) != nil {
 			r0 = ret.Get(0).([]model.Discussion)
@@ -252,8 +375,31 @@ func (_m *Database) GetDiscussions(seekIndex uint64, pageSize uint64) ([]model.D
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(uint64, uint64) error); ok {
-		r1 = rf(seekIndex, pageSize)
+	if rf, ok := ret.Get(1).(func(model.PageOptions, model.DiscussionOrder, model.DiscussionFilter) error); ok {
+		r1 = rf(pageOpts, order, filter)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetInvoiceFragments provides a mock function with given fields:
+func (_m *Database) GetInvoiceFragments() ([]*model.Invoice, error) {
+	ret := _m.Called()
+
+	var r0 []*model.Invoice
+	if rf, ok := ret.Get(0).(func() []*model.Invoice); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]*model.Invoice)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -303,6 +449,29 @@ func (_m *Database) GetLastPaymentIndex() (uint64, error) {
 	return r0, r1
 }
 
+// GetMessage provides a mock function with given fields: uid
+func (_m *Database) GetMessage(uid uint64) (*store.MessageAggregate, error) {
+	ret := _m.Called(uid)
+
+	var r0 *store.MessageAggregate
+	if rf, ok := ret.Get(0).(func(uint64) *store.MessageAggregate); ok {
+		r0 = rf(uid)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*store.MessageAggregate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(uid)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetMessages provides a mock function with given fields: discussionUID, pageOpts
 func (_m *Database) GetMessages(discussionUID uint64, pageOpts model.PageOptions) ([]store.MessageAggregate, error) {
 	ret := _m.Called(discussionUID, pageOpts)
@@ -326,6 +495,131 @@ func (_m *Database) GetMessages(discussionUID uint64, pageOpts model.PageOptions
 	return r0, r1
 }
 
+// GetOutboxMessage provides a mock function with given fields: id
+func (_m *Database) GetOutboxMessage(id uint64) (*model.OutboxMessage, error) {
+	ret := _m.Called(id)
+
+	var r0 *model.OutboxMessage
+	if rf, ok := ret.Get(0).(func(uint64) *model.OutboxMessage); ok {
+		r0 = rf(id)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.OutboxMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(id)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetOutboxMessages provides a mock function with given fields:
+func (_m *Database) GetOutboxMessages() ([]model.OutboxMessage, error) {
+	ret := _m.Called()
+
+	var r0 []model.OutboxMessage
+	if rf, ok := ret.Get(0).(func() []model.OutboxMessage); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.OutboxMessage)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetSendResult provides a mock function with given fields: sendID
+func (_m *Database) GetSendResult(sendID uint64) (*model.SendResult, error) {
+	ret := _m.Called(sendID)
+
+	var r0 *model.SendResult
+	if rf, ok := ret.Get(0).(func(uint64) *model.SendResult); ok {
+		r0 = rf(sendID)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*model.SendResult)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(sendID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetUnreadCount provides a mock function with given fields: discussionUID
+func (_m *Database) GetUnreadCount(discussionUID uint64) (uint64, error) {
+	ret := _m.Called(discussionUID)
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func(uint64) uint64); ok {
+		r0 = rf(discussionUID)
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(uint64) error); ok {
+		r1 = rf(discussionUID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ImportMessage provides a mock function with given fields: rawMsg, invoices, payments
+func (_m *Database) ImportMessage(rawMsg *model.RawMessage, invoices []*model.Invoice, payments []*model.Payment) (bool, error) {
+	ret := _m.Called(rawMsg, invoices, payments)
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func(*model.RawMessage, []*model.Invoice, []*model.Payment) bool); ok {
+		r0 = rf(rawMsg, invoices, payments)
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(*model.RawMessage, []*model.Invoice, []*model.Payment) error); ok {
+		r1 = rf(rawMsg, invoices, payments)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RedactMessage provides a mock function with given fields: uid
+func (_m *Database) RedactMessage(uid uint64) error {
+	ret := _m.Called(uid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RemoveContact provides a mock function with given fields: address
 func (_m *Database) RemoveContact(address string) (*model.Contact, error) {
 	ret := _m.Called(address)
@@ -395,6 +689,91 @@ func (_m *Database) RemoveDiscussion(uid uint64) (*model.Discussion, error) {
 	return r0, r1
 }
 
+// RemoveInvoiceFragments provides a mock function with given fields: settleIndexes
+func (_m *Database) RemoveInvoiceFragments(settleIndexes ...uint64) error {
+	_va := make([]interface{}, len(settleIndexes))
+	for _i := range settleIndexes {
+		_va[_i] = settleIndexes[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(...uint64) error); ok {
+		r0 = rf(settleIndexes...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// Restore provides a mock function with given fields: r
+func (_m *Database) Restore(r io.Reader) error {
+	ret := _m.Called(r)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(io.Reader) error); ok {
+		r0 = rf(r)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreDiscussion provides a mock function with given fields: uid
+func (_m *Database) RestoreDiscussion(uid uint64) error {
+	ret := _m.Called(uid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchMessages provides a mock function with given fields: query, pageOpts
+func (_m *Database) SearchMessages(query model.SearchQuery, pageOpts model.PageOptions) ([]store.MessageAggregate, error) {
+	ret := _m.Called(query, pageOpts)
+
+	var r0 []store.MessageAggregate
+	if rf, ok := ret.Get(0).(func(model.SearchQuery, model.PageOptions) []store.MessageAggregate); ok {
+		r0 = rf(query, pageOpts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]store.MessageAggregate)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(model.SearchQuery, model.PageOptions) error); ok {
+		r1 = rf(query, pageOpts)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// SoftDeleteDiscussion provides a mock function with given fields: uid
+func (_m *Database) SoftDeleteDiscussion(uid uint64) error {
+	ret := _m.Called(uid)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64) error); ok {
+		r0 = rf(uid)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateDiscussionLastRead provides a mock function with given fields: uid, readMsgID
 func (_m *Database) UpdateDiscussionLastRead(uid uint64, readMsgID uint64) error {
 	ret := _m.Called(uid, readMsgID)
@@ -408,3 +787,59 @@ func (_m *Database) UpdateDiscussionLastRead(uid uint64, readMsgID uint64) error
 
 	return r0
 }
+
+// UpdateDiscussionMetadata provides a mock function with given fields: uid, metadata
+func (_m *Database) UpdateDiscussionMetadata(uid uint64, metadata model.DiscussionMetadata) error {
+	ret := _m.Called(uid, metadata)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, model.DiscussionMetadata) error); ok {
+		r0 = rf(uid, metadata)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDiscussionOptions provides a mock function with given fields: uid, options
+func (_m *Database) UpdateDiscussionOptions(uid uint64, options model.MessageOptions) error {
+	ret := _m.Called(uid, options)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, model.MessageOptions) error); ok {
+		r0 = rf(uid, options)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateDiscussionRetention provides a mock function with given fields: uid, policy
+func (_m *Database) UpdateDiscussionRetention(uid uint64, policy model.RetentionPolicy) error {
+	ret := _m.Called(uid, policy)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(uint64, model.RetentionPolicy) error); ok {
+		r0 = rf(uid, policy)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// UpdateOutboxMessage provides a mock function with given fields: msg
+func (_m *Database) UpdateOutboxMessage(msg *model.OutboxMessage) error {
+	ret := _m.Called(msg)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*model.OutboxMessage) error); ok {
+		r0 = rf(msg)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -0,0 +1,102 @@
+package store
+
+import (
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+var (
+	// ErrOutboxMessageNotFound is returned in case an outbox message was not found.
+	ErrOutboxMessageNotFound = fmt.Errorf("Outbox message not found")
+	// ErrSendResultNotFound is returned in case a send result was not found.
+	ErrSendResultNotFound = fmt.Errorf("Send result not found")
+)
+
+// AddOutboxMessage stores an outgoing message in the outbox.
+func (db *bhDatabase) AddOutboxMessage(msg *model.OutboxMessage) error {
+	msg.CreatedAt = getCurrentTime()
+
+	return db.bh.Insert(badgerhold.NextSequence(), msg)
+}
+
+// UpdateOutboxMessage updates an outbox message.
+func (db *bhDatabase) UpdateOutboxMessage(msg *model.OutboxMessage) error {
+	err := db.bh.Update(msg.ID, msg)
+	if err == badgerhold.ErrNotFound {
+		return ErrOutboxMessageNotFound
+	}
+
+	return err
+}
+
+// GetOutboxMessages retrieves all outbox messages.
+func (db *bhDatabase) GetOutboxMessages() ([]model.OutboxMessage, error) {
+	var msgs []model.OutboxMessage
+	if err := db.bh.Find(&msgs, nil); err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
+
+// GetOutboxMessage retrieves an outbox message.
+func (db *bhDatabase) GetOutboxMessage(id uint64) (*model.OutboxMessage, error) {
+	msg := &model.OutboxMessage{}
+	switch err := db.bh.Get(id, msg); {
+	case err == badgerhold.ErrNotFound:
+		return nil, ErrOutboxMessageNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// GetSendResult retrieves the result of a completed send operation.
+func (db *bhDatabase) GetSendResult(sendID uint64) (*model.SendResult, error) {
+	result := &model.SendResult{}
+	switch err := db.bh.Get(sendID, result); {
+	case err == badgerhold.ErrNotFound:
+		return nil, ErrSendResultNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	return result, nil
+}
+
+// CompleteOutboxMessage removes a message from the outbox,
+// storing its payments, the resulting raw message (if not nil)
+// and the send result, which references the stored raw message.
+// All operations are performed atomically.
+func (db *bhDatabase) CompleteOutboxMessage(id uint64, result model.SendResult,
+	rawMsg *model.RawMessage, payments ...*model.Payment) error {
+
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		err := db.bh.TxDelete(txn, id, &model.OutboxMessage{})
+		switch {
+		case err == badgerhold.ErrNotFound:
+			return ErrOutboxMessageNotFound
+		case err != nil:
+			return err
+		}
+
+		if err := db.txAddPayments(txn, payments...); err != nil {
+			return fmt.Errorf("could not store payments: %w", err)
+		}
+
+		result.SendID, result.MessageID = id, 0
+		if rawMsg != nil {
+			if err := db.txAddRawMessage(txn, rawMsg); err != nil {
+				return err
+			}
+			result.MessageID = rawMsg.ID
+		}
+
+		return db.bh.TxUpsert(txn, id, &result)
+	})
+}
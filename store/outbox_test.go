@@ -0,0 +1,86 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestOutboxMessage(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	receivers := []string{generateHex(t, 33), generateHex(t, 33)}
+
+	discussion := generateDiscussion(receivers)
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	rawMsg, payments := generateOutgoing(t, receivers...)
+	rawMsg.DiscussionID = disc.ID
+
+	outboxMsg := &model.OutboxMessage{
+		RawMessage: *rawMsg,
+		AmtMsat:    1000,
+	}
+	for _, receiver := range receivers {
+		outboxMsg.Attempts = append(outboxMsg.Attempts,
+			model.OutboxAttempt{Recipient: receiver})
+	}
+
+	err = db.AddOutboxMessage(outboxMsg)
+	require.NoError(t, err)
+
+	outboxMsg.WithAttemptHash(receivers[0], 0, "fake payment hash")
+	err = db.UpdateOutboxMessage(outboxMsg)
+	require.NoError(t, err)
+
+	outboxMsgs, err := db.GetOutboxMessages()
+	require.NoError(t, err)
+	assert.Equal(t, []model.OutboxMessage{*outboxMsg}, outboxMsgs)
+
+	err = db.CompleteOutboxMessage(outboxMsg.ID, model.SendResult{
+		DiscussionID: disc.ID,
+		Status:       model.MessageSUCCEEDED,
+	}, rawMsg, payments...)
+	require.NoError(t, err)
+
+	outboxMsgs, err = db.GetOutboxMessages()
+	require.NoError(t, err)
+	assert.Empty(t, outboxMsgs)
+
+	err = db.CompleteOutboxMessage(outboxMsg.ID, model.SendResult{}, nil)
+	assert.ErrorIs(t, err, ErrOutboxMessageNotFound)
+}
+
+func TestCompleteOutboxMessageMissingPayments(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	receiver := generateHex(t, 33)
+
+	discussion := generateDiscussion([]string{receiver})
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	rawMsg, _ := generateOutgoing(t, receiver)
+	rawMsg.DiscussionID = disc.ID
+
+	outboxMsg := &model.OutboxMessage{
+		RawMessage: *rawMsg,
+	}
+	err = db.AddOutboxMessage(outboxMsg)
+	require.NoError(t, err)
+
+	// The raw message cannot be stored without its payments,
+	// in which case the outbox message must remain.
+	err = db.CompleteOutboxMessage(outboxMsg.ID, model.SendResult{}, rawMsg)
+	assert.Error(t, err)
+
+	outboxMsgs, err := db.GetOutboxMessages()
+	require.NoError(t, err)
+	assert.Len(t, outboxMsgs, 1)
+}
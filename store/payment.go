@@ -1,33 +1,55 @@
 package store
 
 import (
+	"fmt"
+
 	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
 
 	"github.com/c13n-io/c13n-go/model"
 )
 
+// ErrDuplicatePayment is returned in case a payment already exists.
+var ErrDuplicatePayment = fmt.Errorf("Duplicate payment")
+
 // AddPayments stores a list of payments.
+// If any of the payments already exists, ErrDuplicatePayment is returned
+// and none of the payments is stored.
 func (db *bhDatabase) AddPayments(payments ...*model.Payment) error {
 	if len(payments) <= 0 {
 		return nil
 	}
 
 	return db.bh.Badger().Update(func(txn *badger.Txn) error {
-		for _, payment := range payments {
-			paymentKey := payment.PaymentIndex
-			if err := db.bh.TxInsert(txn, paymentKey, payment); err != nil {
-				return err
-			}
-		}
-		return nil
+		return db.txAddPayments(txn, payments...)
 	})
 }
 
+func (db *bhDatabase) txAddPayments(txn *badger.Txn, payments ...*model.Payment) error {
+	for _, payment := range payments {
+		paymentKey := payment.PaymentIndex
+		err := db.bh.TxInsert(txn, paymentKey, payment)
+		switch {
+		case err == badgerhold.ErrKeyExists:
+			return ErrDuplicatePayment
+		case err != nil:
+			return err
+		}
+		if err := txIndexHash(txn, paymentHashIndexPrefix,
+			payment.Hash, &paymentKey); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // GetLastPaymentIndex retrieves the last payment index present in the database.
+// Imported payments are not considered.
 func (db *bhDatabase) GetLastPaymentIndex() (paymentIdx uint64, err error) {
 	p := new(model.Payment)
 
-	switch result, err := db.bh.FindAggregate(p, nil); err {
+	query := badgerhold.Where("Imported").Eq(false)
+	switch result, err := db.bh.FindAggregate(p, query); err {
 	case nil:
 		if result[0].Count() > 0 {
 			result[0].Max("PaymentIndex", p)
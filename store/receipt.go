@@ -0,0 +1,241 @@
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// The receipt index maps the hashes of the payments carrying
+// outgoing messages to the ids of the messages, so that receipts
+// are recorded without scanning the stored payments and messages.
+// Each entry key consists of receiptIndexPrefix, the (hex-encoded)
+// payment hash, a zero byte and the big-endian message id, and has no value.
+var (
+	receiptIndexPrefix     = []byte("_receipt_index:")
+	receiptIndexVersionKey = []byte("_receipt_index_version")
+)
+
+// receiptIndexVersion is the version of the receipt index format.
+// An index of a different version is rebuilt when the database is opened.
+const receiptIndexVersion = 1
+
+func receiptIndexKey(paymentHash string, msgID uint64) []byte {
+	key := make([]byte, 0, len(receiptIndexPrefix)+len(paymentHash)+9)
+	key = append(key, receiptIndexPrefix...)
+	key = append(key, paymentHash...)
+	key = append(key, 0)
+
+	var id [8]byte
+	binary.BigEndian.PutUint64(id[:], msgID)
+
+	return append(key, id[:]...)
+}
+
+// txGetPayments retrieves the stored payments carrying
+// an outgoing raw message, skipping any missing payments.
+func (db *bhDatabase) txGetPayments(txn *badger.Txn,
+	raw *model.RawMessage) ([]model.Payment, error) {
+
+	keys := paymentKeys(raw)
+	pays := make([]model.Payment, 0, len(keys))
+	for _, key := range keys {
+		var pay model.Payment
+		switch err := db.bh.TxGet(txn, key, &pay); {
+		case err == badgerhold.ErrNotFound:
+			continue
+		case err != nil:
+			return nil, err
+		}
+		pays = append(pays, pay)
+	}
+
+	return pays, nil
+}
+
+// txIndexReceipts adds the payment hashes of an outgoing message
+// to the receipt index.
+func (db *bhDatabase) txIndexReceipts(txn *badger.Txn, raw *model.RawMessage) error {
+	pays, err := db.txGetPayments(txn, raw)
+	if err != nil {
+		return err
+	}
+
+	for _, pay := range pays {
+		if err := txn.Set(receiptIndexKey(pay.Hash, raw.ID), nil); err != nil {
+			return fmt.Errorf("could not index message %d payments: %w", raw.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// txUnindexReceipts removes the payment hashes of an outgoing message
+// from the receipt index.
+func (db *bhDatabase) txUnindexReceipts(txn *badger.Txn, raw *model.RawMessage) error {
+	pays, err := db.txGetPayments(txn, raw)
+	if err != nil {
+		return err
+	}
+
+	for _, pay := range pays {
+		if err := txn.Delete(receiptIndexKey(pay.Hash, raw.ID)); err != nil {
+			return fmt.Errorf("could not unindex message %d payments: %w", raw.ID, err)
+		}
+	}
+
+	return nil
+}
+
+// txReceiptMessageIDs returns the ids of the messages
+// carried by payments with the provided hash.
+func txReceiptMessageIDs(txn *badger.Txn, paymentHash string) []uint64 {
+	prefix := append(append([]byte{}, receiptIndexPrefix...), paymentHash...)
+	prefix = append(prefix, 0)
+
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = prefix
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	var ids []uint64
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		key := it.Item().Key()
+		if len(key) != len(prefix)+8 {
+			continue
+		}
+		ids = append(ids, binary.BigEndian.Uint64(key[len(prefix):]))
+	}
+
+	return ids
+}
+
+// ensureReceiptIndex builds the receipt index for the stored messages,
+// unless an index of the current version exists.
+func (db *bhDatabase) ensureReceiptIndex() error {
+	current, err := db.hasIndexVersion(receiptIndexVersionKey, receiptIndexVersion)
+	if err != nil || current {
+		return err
+	}
+
+	if err := db.bh.Badger().DropPrefix(receiptIndexPrefix); err != nil {
+		return err
+	}
+
+	var pays []model.Payment
+	query := badgerhold.Where("Imported").Eq(false)
+	if err := db.bh.Find(&pays, query); err != nil {
+		return err
+	}
+	hashes := make(map[uint64]string, len(pays))
+	for _, pay := range pays {
+		hashes[pay.PaymentIndex] = pay.Hash
+	}
+
+	var raws []model.RawMessage
+	if err := db.bh.Find(&raws, nil); err != nil {
+		return err
+	}
+
+	wb := db.bh.Badger().NewWriteBatch()
+	defer wb.Cancel()
+	for _, raw := range raws {
+		// The payments of imported messages are stored by hash.
+		if raw.Imported() {
+			if raw.InvoiceSettleIndex != 0 {
+				continue
+			}
+			for _, hash := range raw.ImportedHashes {
+				if err := wb.Set(receiptIndexKey(hash, raw.ID), nil); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+
+		for _, idx := range raw.PaymentIndexes {
+			hash, ok := hashes[idx]
+			if !ok {
+				continue
+			}
+			if err := wb.Set(receiptIndexKey(hash, raw.ID), nil); err != nil {
+				return err
+			}
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return err
+	}
+
+	return db.setIndexVersion(receiptIndexVersionKey, receiptIndexVersion)
+}
+
+// txFindReceiptMessage retrieves the outgoing message acknowledged
+// by a receipt, carried by a payment with the receipt payment hash
+// addressed to the receipt sender.
+func (db *bhDatabase) txFindReceiptMessage(txn *badger.Txn,
+	receipt *model.Receipt) (*model.RawMessage, error) {
+
+	for _, id := range txReceiptMessageIDs(txn, receipt.PaymentHash) {
+		raw := &model.RawMessage{}
+		switch err := db.bh.TxGet(txn, id, raw); {
+		case err == badgerhold.ErrNotFound:
+			continue
+		case err != nil:
+			return nil, err
+		}
+
+		pays, err := db.txGetPayments(txn, raw)
+		if err != nil {
+			return nil, err
+		}
+		for _, pay := range pays {
+			if pay.Hash == receipt.PaymentHash &&
+				pay.PayeeAddress == receipt.Sender {
+
+				return raw, nil
+			}
+		}
+	}
+
+	return nil, ErrMessageNotFound
+}
+
+// AddReceipt records a receipt for the outgoing message carried by
+// the payment with the receipt payment hash, which must have been
+// addressed to the receipt sender.
+// A read receipt is recorded for all previous outgoing messages
+// of the discussion as well.
+// If no such message exists, ErrMessageNotFound is returned.
+func (db *bhDatabase) AddReceipt(receipt *model.Receipt) error {
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		raw, err := db.txFindReceiptMessage(txn, receipt)
+		if err != nil {
+			return err
+		}
+
+		query := badgerhold.Where(badgerhold.Key).Eq(raw.ID)
+		if receipt.Type == model.ReceiptREAD {
+			query = badgerhold.Where("DiscussionID").Eq(raw.DiscussionID).
+				Index("DiscussionID").And(badgerhold.Key).Le(raw.ID).
+				And("InvoiceSettleIndex").Eq(uint64(0))
+		}
+
+		return db.bh.TxUpdateMatching(txn, &model.RawMessage{}, query,
+			func(record interface{}) error {
+				msg, ok := record.(*model.RawMessage)
+				if !ok {
+					return ErrMessageNotFound
+				}
+
+				msg.WithReceipt(receipt)
+				return nil
+			})
+	})
+}
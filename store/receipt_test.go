@@ -0,0 +1,117 @@
+package store
+
+import (
+	"testing"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestAddReceipt(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	participants := []string{generateHex(t, 33), generateHex(t, 33)}
+	discussion := generateDiscussion(participants)
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	addOutgoing := func() (*model.RawMessage, []*model.Payment) {
+		raw, payments := generateOutgoing(t, participants...)
+		raw.DiscussionID = disc.ID
+		for _, p := range payments {
+			p.Hash = generateHex(t, 32)
+		}
+
+		require.NoError(t, db.AddPayments(payments...))
+		require.NoError(t, db.AddRawMessage(raw))
+
+		return raw, payments
+	}
+	getReceipts := func(id uint64) []model.RecipientReceipts {
+		list, err := db.GetMessages(disc.ID, model.PageOptions{})
+		require.NoError(t, err)
+		for _, msg := range list {
+			if msg.RawMessage.ID == id {
+				return msg.RawMessage.Receipts
+			}
+		}
+		require.FailNow(t, "message not found")
+
+		return nil
+	}
+
+	first, _ := addOutgoing()
+	incoming, inv := generateIncoming(t, participants[0])
+	incoming.DiscussionID = disc.ID
+	require.NoError(t, db.AddInvoice(inv))
+	require.NoError(t, db.AddRawMessage(incoming))
+	second, payments := addOutgoing()
+
+	// A delivery receipt is recorded only for the acknowledged message.
+	err = db.AddReceipt(&model.Receipt{
+		Type:        model.ReceiptDELIVERED,
+		PaymentHash: payments[0].Hash,
+		Sender:      participants[0],
+		TimeNs:      10,
+	})
+	require.NoError(t, err)
+	assert.Empty(t, getReceipts(first.ID))
+	assert.Equal(t, []model.RecipientReceipts{
+		{Recipient: participants[0], DeliveredTimeNs: 10},
+	}, getReceipts(second.ID))
+
+	// A read receipt is recorded for all previous outgoing messages.
+	err = db.AddReceipt(&model.Receipt{
+		Type:        model.ReceiptREAD,
+		PaymentHash: payments[1].Hash,
+		Sender:      participants[1],
+		TimeNs:      20,
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []model.RecipientReceipts{
+		{Recipient: participants[1], DeliveredTimeNs: 20, ReadTimeNs: 20},
+	}, getReceipts(first.ID))
+	assert.Equal(t, []model.RecipientReceipts{
+		{Recipient: participants[0], DeliveredTimeNs: 10},
+		{Recipient: participants[1], DeliveredTimeNs: 20, ReadTimeNs: 20},
+	}, getReceipts(second.ID))
+	assert.Empty(t, getReceipts(incoming.ID))
+
+	// Receipts must be sent by the payment recipient.
+	err = db.AddReceipt(&model.Receipt{
+		Type:        model.ReceiptDELIVERED,
+		PaymentHash: payments[0].Hash,
+		Sender:      participants[1],
+		TimeNs:      30,
+	})
+	assert.ErrorIs(t, err, ErrMessageNotFound)
+
+	err = db.AddReceipt(&model.Receipt{
+		Type:        model.ReceiptDELIVERED,
+		PaymentHash: generateHex(t, 32),
+		Sender:      participants[0],
+		TimeNs:      30,
+	})
+	assert.ErrorIs(t, err, ErrMessageNotFound)
+
+	// The receipt index is rebuilt for messages stored before its introduction.
+	bhdb := db.(*bhDatabase)
+	require.NoError(t, bhdb.bh.Badger().Update(func(txn *badger.Txn) error {
+		return txn.Delete(receiptIndexVersionKey)
+	}))
+	require.NoError(t, bhdb.bh.Badger().DropPrefix(receiptIndexPrefix))
+	receipt := &model.Receipt{
+		Type:        model.ReceiptDELIVERED,
+		PaymentHash: payments[1].Hash,
+		Sender:      participants[1],
+		TimeNs:      40,
+	}
+	assert.ErrorIs(t, db.AddReceipt(receipt), ErrMessageNotFound)
+
+	require.NoError(t, bhdb.ensureReceiptIndex())
+	assert.NoError(t, db.AddReceipt(receipt))
+}
@@ -0,0 +1,193 @@
+package store
+
+import (
+	"sort"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// RedactMessage redacts the payload of a message, along with the payloads
+// of the edits, delete requests and reactions targeting it.
+// The invoice or payments associated with the messages are retained.
+func (db *bhDatabase) RedactMessage(uid uint64) error {
+	return db.bh.Badger().Update(func(txn *badger.Txn) error {
+		raw := &model.RawMessage{}
+		switch err := db.bh.TxGet(txn, uid, raw); {
+		case err == badgerhold.ErrNotFound:
+			return ErrMessageNotFound
+		case err != nil:
+			return err
+		}
+
+		_, err := db.txRedactMessage(txn, raw)
+		return err
+	})
+}
+
+// ApplyRetention redacts the messages exceeding the retention policy
+// of their discussion, which overrides the provided default policy.
+// The message age is measured up to the provided time.
+// The number of redacted messages is returned.
+func (db *bhDatabase) ApplyRetention(defaultPolicy model.RetentionPolicy,
+	now time.Time) (uint64, error) {
+
+	var discussions []model.Discussion
+	if err := db.bh.Find(&discussions, nil); err != nil {
+		return 0, err
+	}
+
+	var redacted uint64
+	for _, disc := range discussions {
+		policy := defaultPolicy.Override(disc.Retention)
+		if policy.IsZero() {
+			continue
+		}
+
+		expired, err := db.expiredMessages(disc.ID, policy, now)
+		if err != nil {
+			return redacted, err
+		}
+
+		for start := 0; start < len(expired); start += messageIndexBatchSize {
+			end := start + messageIndexBatchSize
+			if end > len(expired) {
+				end = len(expired)
+			}
+
+			if err := db.bh.Badger().Update(func(txn *badger.Txn) error {
+				for _, id := range expired[start:end] {
+					raw := &model.RawMessage{}
+					if err := db.bh.TxGet(txn, id, raw); err != nil {
+						return err
+					}
+					n, err := db.txRedactMessage(txn, raw)
+					if err != nil {
+						return err
+					}
+					redacted += n
+				}
+				return nil
+			}); err != nil {
+				return redacted, err
+			}
+		}
+	}
+
+	if redacted != 0 {
+		db.collectGarbage()
+	}
+
+	return redacted, nil
+}
+
+// expiredMessages returns the ids of the unredacted messages
+// of a discussion exceeding the provided retention policy.
+func (db *bhDatabase) expiredMessages(discussionUID uint64,
+	policy model.RetentionPolicy, now time.Time) ([]uint64, error) {
+
+	query := badgerhold.Where("DiscussionID").Eq(discussionUID).
+		Index("DiscussionID").And("Redacted").Eq(false)
+
+	var raws []model.RawMessage
+	if err := db.bh.Find(&raws, query); err != nil {
+		return nil, err
+	}
+
+	return expiredMessageIDs(raws, policy, now), nil
+}
+
+// expiredMessageIDs returns the ids of the provided unredacted messages
+// of a discussion exceeding the provided retention policy.
+func expiredMessageIDs(raws []model.RawMessage, policy model.RetentionPolicy,
+	now time.Time) []uint64 {
+
+	sort.SliceStable(raws, func(i, j int) bool {
+		return raws[i].Timestamp.Before(raws[j].Timestamp)
+	})
+
+	var standalone int
+	for i := range raws {
+		if !raws[i].TargetLinked {
+			standalone++
+		}
+	}
+
+	var expired []uint64
+	for i := range raws {
+		raw := &raws[i]
+
+		exceedsCount := false
+		if !raw.TargetLinked && policy.MaxCount != 0 &&
+			uint64(standalone) > policy.MaxCount {
+
+			exceedsCount = true
+			standalone--
+		}
+		exceedsAge := policy.MaxAge != 0 &&
+			raw.Timestamp.Before(now.Add(-policy.MaxAge))
+
+		if exceedsCount || exceedsAge {
+			expired = append(expired, raw.ID)
+		}
+	}
+
+	return expired
+}
+
+// txRedactMessage redacts the payload of a raw message and removes it
+// from the search index. The edits, delete requests and reactions
+// targeting a standalone message are also redacted.
+// The number of redacted messages is returned.
+func (db *bhDatabase) txRedactMessage(txn *badger.Txn,
+	raw *model.RawMessage) (uint64, error) {
+
+	group := []model.RawMessage{*raw}
+	indexedID := raw.ID
+	if raw.TargetLinked {
+		indexedID = raw.TargetID
+	} else {
+		annotations, err := db.txTargetingMessages(txn, raw)
+		if err != nil {
+			return 0, err
+		}
+		group = append(group, annotations...)
+	}
+
+	// Redacting an edit also updates the indexed text of its target.
+	var redacted uint64
+	err := db.txReindexMessage(txn, indexedID, func() error {
+		for i := range group {
+			m := &group[i]
+			if m.Redacted {
+				continue
+			}
+
+			m.Redact()
+			if err := db.bh.TxUpdate(txn, m.ID, m); err != nil {
+				return err
+			}
+			redacted++
+		}
+		return nil
+	})
+
+	return redacted, err
+}
+
+// collectGarbage rewrites the badger value log, in order to discard
+// the previous versions of updated records (e.g. redacted payloads).
+func (db *bhDatabase) collectGarbage() {
+	const discardRatio = 0.5
+	for {
+		if err := db.bh.Badger().RunValueLogGC(discardRatio); err != nil {
+			if err != badger.ErrNoRewrite {
+				db.logger.WithError(err).Debug("value log garbage collection failed")
+			}
+			return
+		}
+	}
+}
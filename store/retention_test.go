@@ -0,0 +1,204 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+type retentionFixture struct {
+	t           *testing.T
+	db          Database
+	participant string
+	discussion  *model.Discussion
+}
+
+func newRetentionFixture(t *testing.T, db Database) *retentionFixture {
+	participant := generateHex(t, 33)
+	discussion := generateDiscussion([]string{participant})
+	disc, err := db.AddDiscussion(&discussion)
+	require.NoError(t, err)
+
+	return &retentionFixture{
+		t:           t,
+		db:          db,
+		participant: participant,
+		discussion:  disc,
+	}
+}
+
+func (f *retentionFixture) add(content model.MessageContent) *model.RawMessage {
+	rawMsg, err := model.NewRawMessage(f.discussion, content)
+	require.NoError(f.t, err)
+
+	generated, inv := generateIncoming(f.t, f.participant)
+	rawMsg.Sender, rawMsg.Signature = generated.Sender, generated.Signature
+	rawMsg.SignatureVerified = generated.SignatureVerified
+	rawMsg.InvoiceSettleIndex = generated.InvoiceSettleIndex
+	require.NoError(f.t, f.db.AddInvoice(inv))
+	require.NoError(f.t, f.db.AddRawMessage(rawMsg))
+
+	return rawMsg
+}
+
+func (f *retentionFixture) text(body string) *model.RawMessage {
+	return f.add(model.MessageContent{Body: body})
+}
+
+func (f *retentionFixture) search(text string) []uint64 {
+	list, err := f.db.SearchMessages(model.SearchQuery{Text: text},
+		model.PageOptions{})
+	require.NoError(f.t, err)
+
+	ids := make([]uint64, len(list))
+	for i, msg := range list {
+		ids[i] = msg.RawMessage.ID
+	}
+	return ids
+}
+
+func (f *retentionFixture) messages() []MessageAggregate {
+	msgs, err := f.db.GetMessages(f.discussion.ID, model.PageOptions{})
+	require.NoError(f.t, err)
+
+	return msgs
+}
+
+func TestRedactMessage(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	f := newRetentionFixture(t, db)
+
+	first := f.text("secret words")
+	f.add(model.MessageContent{
+		ContentType: model.ContentTypeEdit,
+		Body:        "secret edit",
+		Target:      first.MessageID,
+	})
+	f.add(model.MessageContent{
+		ContentType: model.ContentTypeReaction,
+		Body:        "👍",
+		Target:      first.MessageID,
+	})
+	second := f.text("another secret")
+	require.Equal(t, []uint64{first.ID, second.ID}, f.search("secret"))
+
+	require.NoError(t, db.RedactMessage(first.ID))
+
+	msgs := f.messages()
+	require.Len(t, msgs, 2)
+	redacted := msgs[0]
+	assert.True(t, redacted.RawMessage.Redacted)
+	assert.Nil(t, redacted.RawMessage.Signature)
+	assert.Equal(t, first.MessageID, redacted.RawMessage.MessageID)
+	assert.Equal(t, first.AmtMsat, redacted.RawMessage.AmtMsat)
+	require.NotNil(t, redacted.Invoice)
+
+	text, participants, err := redacted.RawMessage.UnmarshalPayload()
+	require.NoError(t, err)
+	assert.Empty(t, text)
+	assert.Equal(t, f.discussion.Participants, participants)
+
+	require.Len(t, redacted.Annotations, 2)
+	for _, annotation := range redacted.Annotations {
+		assert.True(t, annotation.RawMessage.Redacted)
+	}
+	assert.False(t, msgs[1].RawMessage.Redacted)
+
+	assert.Equal(t, []uint64{second.ID}, f.search("secret"))
+	assert.Empty(t, f.search("words"))
+
+	assert.ErrorIs(t, db.RedactMessage(42), ErrMessageNotFound)
+}
+
+func TestRedactMessageEdit(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	f := newRetentionFixture(t, db)
+
+	target := f.text("alpha beta")
+	edit := f.add(model.MessageContent{
+		ContentType: model.ContentTypeEdit,
+		Body:        "alpha gamma",
+		Target:      target.MessageID,
+	})
+	require.Equal(t, []uint64{target.ID}, f.search("gamma"))
+
+	require.NoError(t, db.RedactMessage(edit.ID))
+
+	// The target is displayed redacted, so its terms are removed.
+	assert.Empty(t, f.search("alpha"))
+	assert.Empty(t, f.search("beta"))
+	assert.Empty(t, f.search("gamma"))
+
+	msgs := f.messages()
+	require.Len(t, msgs, 1)
+	assert.False(t, msgs[0].RawMessage.Redacted)
+	require.Len(t, msgs[0].Annotations, 1)
+	assert.True(t, msgs[0].Annotations[0].RawMessage.Redacted)
+}
+
+func TestApplyRetention(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	start := time.Unix(0, 0)
+	resetTimestampGetter := overrideTimestampGetterFrom(start, time.Hour)
+	defer resetTimestampGetter()
+
+	f := newRetentionFixture(t, db)
+	other := newRetentionFixture(t, db)
+
+	// Messages are stored an hour apart, starting an hour after start.
+	raws := make([]*model.RawMessage, 5)
+	for i := range raws {
+		raws[i] = f.text("message")
+	}
+	otherRaw := other.text("message")
+
+	redactedIDs := func() []uint64 {
+		var ids []uint64
+		for _, msg := range append(f.messages(), other.messages()...) {
+			if msg.RawMessage.Redacted {
+				ids = append(ids, msg.RawMessage.ID)
+			}
+		}
+		return ids
+	}
+
+	now := start.Add(7 * time.Hour)
+
+	// No policy results in no redactions.
+	count, err := db.ApplyRetention(model.RetentionPolicy{}, now)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+
+	// The default policy applies to all discussions.
+	count, err = db.ApplyRetention(model.RetentionPolicy{
+		MaxAge: 5*time.Hour + time.Minute,
+	}, now)
+	require.NoError(t, err)
+	assert.EqualValues(t, 1, count)
+	assert.Equal(t, []uint64{raws[0].ID}, redactedIDs())
+
+	// The discussion policy overrides the default policy.
+	require.NoError(t, db.UpdateDiscussionRetention(f.discussion.ID,
+		model.RetentionPolicy{MaxCount: 2}))
+	count, err = db.ApplyRetention(model.RetentionPolicy{
+		MaxAge: 24 * time.Hour,
+	}, now)
+	require.NoError(t, err)
+	assert.EqualValues(t, 2, count)
+	assert.Equal(t, []uint64{raws[0].ID, raws[1].ID, raws[2].ID}, redactedIDs())
+
+	count, err = db.ApplyRetention(model.RetentionPolicy{MaxCount: 1}, now)
+	require.NoError(t, err)
+	assert.Zero(t, count)
+	assert.NotContains(t, redactedIDs(), otherRaw.ID)
+}
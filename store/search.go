@@ -0,0 +1,441 @@
+package store
+
+import (
+	"encoding/binary"
+	"fmt"
+	"sort"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/timshannon/badgerhold/v4"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// The search index is an inverted index of message payload terms,
+// stored directly in badger alongside the badgerhold records
+// (and thus encrypted along with them, if database encryption is enabled).
+// Each entry key consists of searchIndexPrefix, the term,
+// a zero byte and the big-endian message id, and has no value.
+var (
+	searchIndexPrefix     = []byte("_search_index:")
+	searchIndexVersionKey = []byte("_search_index_version")
+)
+
+// searchIndexVersion is the version of the search index format.
+// An index of a different version is rebuilt when the database is opened.
+// Version 2 indexes only the displayed text of messages.
+const searchIndexVersion = 2
+
+func searchIndexKey(term string, msgID uint64) []byte {
+	key := make([]byte, 0, len(searchIndexPrefix)+len(term)+9)
+	key = append(key, searchIndexPrefix...)
+	key = append(key, term...)
+	key = append(key, 0)
+
+	var id [8]byte
+	binary.BigEndian.PutUint64(id[:], msgID)
+
+	return append(key, id[:]...)
+}
+
+// annotatedBySender returns whether an annotation targeting a message
+// was sent by the verified sender of the message, which is required
+// for edits and delete requests to apply to the message.
+func annotatedBySender(raw, annotation *model.RawMessage) bool {
+	sender := raw.SenderAddress()
+
+	return sender != "" && raw.SignatureVerified &&
+		annotation.SignatureVerified && annotation.SenderAddress() == sender
+}
+
+// displayedText returns the text of a standalone message as displayed,
+// given the messages targeting it in storage order, along with
+// whether the message was deleted by its sender.
+// The text of a message is replaced by its latest edit,
+// while deleted messages have no text.
+func displayedText(raw *model.RawMessage, annotations []model.RawMessage) (string, bool) {
+	var text string
+	if raw.ContentType == model.ContentTypeText {
+		text, _, _ = raw.UnmarshalPayload()
+	}
+
+	for i := range annotations {
+		a := &annotations[i]
+		if !annotatedBySender(raw, a) {
+			continue
+		}
+
+		switch a.ContentType {
+		case model.ContentTypeEdit:
+			text, _, _ = a.UnmarshalPayload()
+		case model.ContentTypeDeleteRequest:
+			return "", true
+		}
+	}
+
+	return text, false
+}
+
+// searchTexts returns the indexed text of the standalone messages
+// among the provided ones, which must include the messages targeting them.
+// Only the text of standalone text messages is indexed,
+// as displayed, so that superseded edits are not matched.
+func searchTexts(raws []model.RawMessage) map[uint64]string {
+	sort.Slice(raws, func(i, j int) bool {
+		return raws[i].ID < raws[j].ID
+	})
+
+	annotations := make(map[uint64][]model.RawMessage)
+	for _, raw := range raws {
+		if raw.TargetLinked {
+			annotations[raw.TargetID] = append(annotations[raw.TargetID], raw)
+		}
+	}
+
+	texts := make(map[uint64]string)
+	for i := range raws {
+		raw := &raws[i]
+		if raw.TargetLinked || raw.ContentType != model.ContentTypeText {
+			continue
+		}
+		if text, _ := displayedText(raw, annotations[raw.ID]); text != "" {
+			texts[raw.ID] = text
+		}
+	}
+
+	return texts
+}
+
+// txTargetingMessages retrieves the messages targeting
+// a standalone message, in storage order.
+func (db *bhDatabase) txTargetingMessages(txn *badger.Txn,
+	raw *model.RawMessage) ([]model.RawMessage, error) {
+
+	query := badgerhold.Where("DiscussionID").Eq(raw.DiscussionID).
+		Index("DiscussionID").And("TargetID").Eq(raw.ID).
+		And("TargetLinked").Eq(true)
+
+	var annotations []model.RawMessage
+	if err := db.bh.TxFind(txn, &annotations, query); err != nil {
+		return nil, err
+	}
+	sort.Slice(annotations, func(i, j int) bool {
+		return annotations[i].ID < annotations[j].ID
+	})
+
+	return annotations, nil
+}
+
+// txSearchText returns the indexed text of the message with the provided id.
+func (db *bhDatabase) txSearchText(txn *badger.Txn, id uint64) (string, error) {
+	raw := &model.RawMessage{}
+	switch err := db.bh.TxGet(txn, id, raw); {
+	case err == badgerhold.ErrNotFound:
+		return "", nil
+	case err != nil:
+		return "", err
+	}
+	raw.ID = id
+	if raw.TargetLinked || raw.ContentType != model.ContentTypeText {
+		return "", nil
+	}
+
+	annotations, err := db.txTargetingMessages(txn, raw)
+	if err != nil {
+		return "", err
+	}
+	text, _ := displayedText(raw, annotations)
+
+	return text, nil
+}
+
+// txIndexText adds the terms of a message text to the search index.
+func (db *bhDatabase) txIndexText(txn *badger.Txn, id uint64, text string) error {
+	for _, term := range model.SearchTerms(text) {
+		if err := txn.Set(searchIndexKey(term, id), nil); err != nil {
+			return fmt.Errorf("could not index message %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// txUnindexText removes the terms of a message text from the search index.
+func (db *bhDatabase) txUnindexText(txn *badger.Txn, id uint64, text string) error {
+	for _, term := range model.SearchTerms(text) {
+		if err := txn.Delete(searchIndexKey(term, id)); err != nil {
+			return fmt.Errorf("could not unindex message %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// txReindexMessage applies an update affecting the indexed text
+// of the message with the provided id (such as storing or redacting
+// a message targeting it), replacing its indexed terms.
+func (db *bhDatabase) txReindexMessage(txn *badger.Txn, id uint64,
+	update func() error) error {
+
+	previous, err := db.txSearchText(txn, id)
+	if err != nil {
+		return err
+	}
+	if err := update(); err != nil {
+		return err
+	}
+	current, err := db.txSearchText(txn, id)
+	if err != nil || current == previous {
+		return err
+	}
+
+	if err := db.txUnindexText(txn, id, previous); err != nil {
+		return err
+	}
+
+	return db.txIndexText(txn, id, current)
+}
+
+// txSearchTerm returns the ids of the messages
+// containing a word prefixed by the provided term.
+func txSearchTerm(txn *badger.Txn, term string) map[uint64]struct{} {
+	prefix := append(append([]byte{}, searchIndexPrefix...), term...)
+
+	opts := badger.DefaultIteratorOptions
+	opts.PrefetchValues = false
+	opts.Prefix = prefix
+
+	it := txn.NewIterator(opts)
+	defer it.Close()
+
+	ids := make(map[uint64]struct{})
+	for it.Seek(prefix); it.ValidForPrefix(prefix); it.Next() {
+		key := it.Item().Key()
+		if len(key) < len(prefix)+9 || key[len(key)-9] != 0 {
+			continue
+		}
+		ids[binary.BigEndian.Uint64(key[len(key)-8:])] = struct{}{}
+	}
+
+	return ids
+}
+
+// ensureSearchIndex builds the search index for the stored messages,
+// unless an index of the current version exists.
+func (db *bhDatabase) ensureSearchIndex() error {
+	current, err := db.hasIndexVersion(searchIndexVersionKey, searchIndexVersion)
+	if err != nil || current {
+		return err
+	}
+
+	if err := db.bh.Badger().DropPrefix(searchIndexPrefix); err != nil {
+		return err
+	}
+
+	var raws []model.RawMessage
+	if err := db.bh.Find(&raws, nil); err != nil {
+		return err
+	}
+
+	wb := db.bh.Badger().NewWriteBatch()
+	defer wb.Cancel()
+	for id, text := range searchTexts(raws) {
+		for _, term := range model.SearchTerms(text) {
+			if err := wb.Set(searchIndexKey(term, id), nil); err != nil {
+				return err
+			}
+		}
+	}
+	if err := wb.Flush(); err != nil {
+		return err
+	}
+
+	return db.setIndexVersion(searchIndexVersionKey, searchIndexVersion)
+}
+
+// SearchMessages retrieves the standalone messages matching a search query,
+// along with their annotations.
+// Messages deleted by their sender and messages of deleted discussions
+// are omitted, while text terms are matched against the displayed
+// (latest edited) text of messages.
+// The pageOpts parameter controls the requested range of matching messages,
+// in message id order, and may further filter them.
+func (db *bhDatabase) SearchMessages(query model.SearchQuery,
+	pageOpts model.PageOptions) ([]MessageAggregate, error) {
+
+	var messages []MessageAggregate
+	if err := db.bh.Badger().View(func(txn *badger.Txn) error {
+		ids, loaded, err := db.txSearchCandidates(txn, query)
+		if err != nil {
+			return err
+		}
+
+		sort.Slice(ids, func(i, j int) bool {
+			return (ids[i] < ids[j]) != pageOpts.Reverse
+		})
+
+		deletedDiscs := make(map[uint64]bool)
+		for _, id := range ids {
+			if pageOpts.PageSize != 0 && uint64(len(messages)) >= pageOpts.PageSize {
+				break
+			}
+			switch {
+			case pageOpts.Latest():
+			case pageOpts.Reverse && id > pageOpts.LastID:
+				continue
+			case !pageOpts.Reverse && id < pageOpts.LastID:
+				continue
+			}
+
+			raw, ok := loaded[id]
+			if !ok {
+				raw = model.RawMessage{}
+				switch err := db.bh.TxGet(txn, id, &raw); {
+				case err == badgerhold.ErrNotFound:
+					continue
+				case err != nil:
+					return err
+				}
+			}
+			raw.ID = id
+			if !searchMatchesRaw(&query, pageOpts, &raw) {
+				continue
+			}
+
+			deleted, ok := deletedDiscs[raw.DiscussionID]
+			if !ok {
+				discQuery := badgerhold.Where(badgerhold.Key).Eq(raw.DiscussionID)
+				disc, err := db.findSingleDiscussion(txn, discQuery)
+				if err != nil {
+					return err
+				}
+				deleted = disc.Deleted
+				deletedDiscs[raw.DiscussionID] = deleted
+			}
+			if deleted {
+				continue
+			}
+
+			annotations, err := db.txTargetingMessages(txn, &raw)
+			if err != nil {
+				return err
+			}
+			if _, deleted := displayedText(&raw, annotations); deleted {
+				continue
+			}
+
+			msg, err := db.txMessageAggregate(txn, raw)
+			if err != nil {
+				return err
+			}
+			messages = append(messages, *msg)
+		}
+
+		return db.txAttachAnnotations(txn, messages)
+	}); err != nil {
+		return nil, err
+	}
+
+	if pageOpts.Reverse {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+
+	return messages, nil
+}
+
+// txSearchCandidates returns the ids of the messages containing
+// all the terms of a search query. If the query contains no terms,
+// the ids of all standalone messages (of the query discussions, if any)
+// are returned, along with the messages themselves.
+func (db *bhDatabase) txSearchCandidates(txn *badger.Txn,
+	query model.SearchQuery) ([]uint64, map[uint64]model.RawMessage, error) {
+
+	terms := model.SearchTerms(query.Text)
+	if len(terms) == 0 {
+		queries := []*badgerhold.Query{
+			badgerhold.Where("TargetLinked").Eq(false),
+		}
+		if len(query.DiscussionIDs) != 0 {
+			queries = queries[:0]
+			for _, discID := range query.DiscussionIDs {
+				queries = append(queries, badgerhold.Where("DiscussionID").Eq(discID).
+					Index("DiscussionID").And("TargetLinked").Eq(false))
+			}
+		}
+
+		var ids []uint64
+		loaded := make(map[uint64]model.RawMessage)
+		for _, q := range queries {
+			raws := make([]model.RawMessage, 0)
+			if err := db.bh.TxFind(txn, &raws, q); err != nil {
+				return nil, nil, err
+			}
+			for _, raw := range raws {
+				if _, ok := loaded[raw.ID]; ok {
+					continue
+				}
+				ids = append(ids, raw.ID)
+				loaded[raw.ID] = raw
+			}
+		}
+		return ids, loaded, nil
+	}
+
+	matches := txSearchTerm(txn, terms[0])
+	for _, term := range terms[1:] {
+		termMatches := txSearchTerm(txn, term)
+		for id := range matches {
+			if _, ok := termMatches[id]; !ok {
+				delete(matches, id)
+			}
+		}
+	}
+
+	ids := make([]uint64, 0, len(matches))
+	for id := range matches {
+		ids = append(ids, id)
+	}
+
+	return ids, nil, nil
+}
+
+// searchMatchesRaw returns whether a raw message satisfies
+// the message filters of a search query and page options.
+func searchMatchesRaw(query *model.SearchQuery, pageOpts model.PageOptions,
+	raw *model.RawMessage) bool {
+
+	if raw.TargetLinked {
+		return false
+	}
+
+	if len(query.DiscussionIDs) != 0 {
+		found := false
+		for _, id := range query.DiscussionIDs {
+			if id == raw.DiscussionID {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+
+	ts := raw.Timestamp.UnixNano()
+	switch {
+	case query.Sender != "" && raw.SenderAddress() != query.Sender:
+		return false
+	case query.FromTimeNs != 0 && ts < query.FromTimeNs:
+		return false
+	case query.ToTimeNs != 0 && ts > query.ToTimeNs:
+		return false
+	case query.MinAmtMsat != 0 && raw.AmtMsat < query.MinAmtMsat:
+		return false
+	case query.MaxAmtMsat != 0 && raw.AmtMsat > query.MaxAmtMsat:
+		return false
+	}
+
+	return matchesPageFilters(pageOpts, raw)
+}
@@ -0,0 +1,171 @@
+package store
+
+import (
+	"testing"
+	"time"
+
+	"github.com/dgraph-io/badger/v3"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/lnchat"
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestSearchMessages(t *testing.T) {
+	db, cleanup := createInMemoryDB(t)
+	defer cleanup()
+
+	resetTimestampGetter := overrideTimestampGetterFrom(time.Unix(0, 0), time.Hour)
+	defer resetTimestampGetter()
+
+	participants := []string{generateHex(t, 33), generateHex(t, 33)}
+	discussions := make([]*model.Discussion, len(participants))
+	for i, p := range participants {
+		discussion := generateDiscussion([]string{p})
+		disc, err := db.AddDiscussion(&discussion)
+		require.NoError(t, err)
+		discussions[i] = disc
+	}
+
+	newMessage := func(disc int, content model.MessageContent) *model.RawMessage {
+		rawMsg, err := model.NewRawMessage(discussions[disc], content)
+		require.NoError(t, err)
+
+		return rawMsg
+	}
+	addMessage := func(disc int, rawMsg *model.RawMessage, amtMsat int64) *model.RawMessage {
+		generated, inv := generateIncoming(t, participants[disc])
+		rawMsg.Sender, rawMsg.Signature = generated.Sender, generated.Signature
+		rawMsg.SignatureVerified = generated.SignatureVerified
+		rawMsg.InvoiceSettleIndex = generated.InvoiceSettleIndex
+		inv.AmtPaid = lnchat.NewAmount(amtMsat)
+
+		require.NoError(t, db.AddInvoice(inv))
+		require.NoError(t, db.AddRawMessage(rawMsg))
+
+		return rawMsg
+	}
+	text := func(disc int, body string) *model.RawMessage {
+		return newMessage(disc, model.MessageContent{Body: body})
+	}
+
+	first := addMessage(0, text(0, "Hello, world!"), 1000)
+	second := addMessage(1, text(1, "hello there"), 5000)
+	third := addMessage(0, text(0, "Goodbye world"), 2000)
+	// Edits are indexed under their target, even if stored before it.
+	late := text(1, "original")
+	addMessage(1, newMessage(1, model.MessageContent{
+		ContentType: model.ContentTypeEdit,
+		Body:        "corrected",
+		Target:      late.MessageID,
+	}), 1000)
+	addMessage(1, late, 3000)
+
+	search := func(query model.SearchQuery, pageOpts model.PageOptions) []uint64 {
+		list, err := db.SearchMessages(query, pageOpts)
+		require.NoError(t, err)
+
+		ids := make([]uint64, len(list))
+		for i, msg := range list {
+			ids[i] = msg.RawMessage.ID
+		}
+		return ids
+	}
+
+	testCases := []struct {
+		name     string
+		query    model.SearchQuery
+		pageOpts model.PageOptions
+		expected []uint64
+	}{
+		{
+			name:     "Prefix",
+			query:    model.SearchQuery{Text: "HEL"},
+			expected: []uint64{first.ID, second.ID},
+		},
+		{
+			name:     "All terms",
+			query:    model.SearchQuery{Text: "world hello"},
+			expected: []uint64{first.ID},
+		},
+		{
+			name:     "No match",
+			query:    model.SearchQuery{Text: "hello moon"},
+			expected: []uint64{},
+		},
+		{
+			name:     "Edited",
+			query:    model.SearchQuery{Text: "correct"},
+			expected: []uint64{late.ID},
+		},
+		{
+			name: "Discussion",
+			query: model.SearchQuery{
+				Text:          "hello",
+				DiscussionIDs: []uint64{discussions[1].ID},
+			},
+			expected: []uint64{second.ID},
+		},
+		{
+			name:     "Sender",
+			query:    model.SearchQuery{Sender: participants[0]},
+			expected: []uint64{first.ID, third.ID},
+		},
+		{
+			name: "Date range",
+			query: model.SearchQuery{
+				FromTimeNs: second.Timestamp.UnixNano(),
+				ToTimeNs:   third.Timestamp.UnixNano(),
+			},
+			expected: []uint64{second.ID, third.ID},
+		},
+		{
+			name:     "Amount range",
+			query:    model.SearchQuery{MinAmtMsat: 2000, MaxAmtMsat: 3000},
+			expected: []uint64{third.ID, late.ID},
+		},
+		{
+			name:     "Page",
+			query:    model.SearchQuery{Text: "world"},
+			pageOpts: model.PageOptions{LastID: first.ID + 1, PageSize: 1},
+			expected: []uint64{third.ID},
+		},
+		{
+			name:  "Reverse page",
+			query: model.SearchQuery{},
+			pageOpts: model.PageOptions{
+				LastID: late.ID, PageSize: 2, Reverse: true,
+			},
+			expected: []uint64{third.ID, late.ID},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			assert.Equal(t, tc.expected, search(tc.query, tc.pageOpts))
+		})
+	}
+
+	t.Run("Latest", func(t *testing.T) {
+		assert.Equal(t, []uint64{second.ID},
+			search(model.SearchQuery{Text: "hello"},
+				model.PageOptions{PageSize: 1, Reverse: true}))
+	})
+
+	t.Run("Rebuild", func(t *testing.T) {
+		bhdb := db.(*bhDatabase)
+		require.NoError(t, bhdb.bh.Badger().Update(func(txn *badger.Txn) error {
+			return txn.Delete(searchIndexVersionKey)
+		}))
+		require.NoError(t, bhdb.bh.Badger().DropPrefix(searchIndexPrefix))
+		assert.Empty(t, search(model.SearchQuery{Text: "hello"}, model.PageOptions{}))
+
+		require.NoError(t, bhdb.ensureSearchIndex())
+		assert.Equal(t, []uint64{first.ID, second.ID},
+			search(model.SearchQuery{Text: "hello"}, model.PageOptions{}))
+		assert.Equal(t, []uint64{late.ID},
+			search(model.SearchQuery{Text: "corrected"}, model.PageOptions{}))
+		assert.Empty(t, search(model.SearchQuery{Text: "original"}, model.PageOptions{}))
+	})
+}
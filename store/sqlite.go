@@ -0,0 +1,193 @@
+package store
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/url"
+
+	"github.com/pkg/errors"
+
+	// Register the pure-Go SQLite driver.
+	_ "modernc.org/sqlite"
+
+	"github.com/c13n-io/c13n-go/slog"
+)
+
+// The SQLite database stores each record as JSON in a data column,
+// along with the record fields used for querying in separate columns.
+// Unlike badger databases, SQLite databases are not encrypted at rest,
+// with the encryption key used only for encrypting backups.
+type sqlDatabase struct {
+	logger *slog.Logger
+
+	path      string
+	backupKey []byte
+	db        *sql.DB
+
+	migrationDryRun    bool
+	migrationBackupDir string
+
+	restore []io.Reader
+}
+
+// sqlitePragmas are the pragmas applied to SQLite database connections.
+// Deleted content is overwritten, so that redacted payloads
+// are not retained in the database file.
+var sqlitePragmas = []string{
+	"foreign_keys(1)",
+	"secure_delete(1)",
+	"busy_timeout(5000)",
+	"locking_mode(EXCLUSIVE)",
+	"journal_mode(WAL)",
+}
+
+// WithBackupEncryptionKey sets the key SQLite database backups
+// are encrypted with. Badger database backups are encrypted
+// with the database encryption key instead.
+func WithBackupEncryptionKey(key []byte) func(Database) {
+	return func(db Database) {
+		if sqldb, ok := db.(*sqlDatabase); ok {
+			sqldb.backupKey = key
+		}
+	}
+}
+
+// NewSQLite opens and returns an SQLite database object,
+// stored in the file with the provided path (":memory:" for
+// an in-memory database).
+// The database file is locked while the database is open.
+func NewSQLite(path string, options ...func(Database)) (Database, error) {
+	db := &sqlDatabase{
+		path: path,
+	}
+
+	// Apply all database options.
+	for _, option := range options {
+		option(db)
+	}
+
+	// Set the logger instance, if unset.
+	if db.logger == nil {
+		db.logger = slog.NewLogger("database")
+	}
+
+	// The pragmas are applied to each opened connection.
+	dsn := path + "?" + url.Values{"_pragma": sqlitePragmas}.Encode()
+
+	var err error
+	if db.db, err = sql.Open("sqlite", dsn); err != nil {
+		return nil, errors.Wrap(err, "Could not open database")
+	}
+	// A single connection is used, since an in-memory database
+	// is private to its connection and the database file is locked.
+	db.db.SetMaxOpenConns(1)
+	if err := db.db.Ping(); err != nil {
+		db.db.Close()
+		return nil, errors.Wrap(err, "Could not open database")
+	}
+
+	// Apply any pending schema migrations.
+	if err := db.migrate(); err != nil {
+		db.db.Close()
+		if errors.Is(err, ErrMigrationDryRun) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "Could not migrate database")
+	}
+
+	// Load the restored backups, whose snapshots are migrated on restore.
+	for i, r := range db.restore {
+		if err := db.Restore(r); err != nil {
+			db.db.Close()
+			return nil, errors.Wrapf(err, "Could not restore backup %d", i+1)
+		}
+	}
+
+	return db, nil
+}
+
+// Close closes the database and returns any encountered error.
+func (db *sqlDatabase) Close() error {
+	return db.db.Close()
+}
+
+// view executes a read-only function within a transaction.
+func (db *sqlDatabase) view(fn func(tx *sql.Tx) error) error {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	return fn(tx)
+}
+
+// update executes a function within a transaction,
+// which is committed if the function succeeds.
+// The database change version is incremented along with the changes.
+func (db *sqlDatabase) update(fn func(tx *sql.Tx) error) error {
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if err := fn(tx); err != nil {
+		return err
+	}
+	if _, err := tx.Exec(`UPDATE meta SET value = value + 1
+		WHERE key = 'change_version'`); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// encodeRecord encodes a record as stored in a data column.
+func encodeRecord(record interface{}) (string, error) {
+	data, err := json.Marshal(record)
+	if err != nil {
+		return "", fmt.Errorf("could not encode %T: %w", record, err)
+	}
+
+	return string(data), nil
+}
+
+// decodeRecord decodes a record stored in a data column.
+func decodeRecord(data string, record interface{}) error {
+	if err := json.Unmarshal([]byte(data), record); err != nil {
+		return fmt.Errorf("could not decode %T: %w", record, err)
+	}
+
+	return nil
+}
+
+// boolInt returns the integer representation of a boolean column.
+func boolInt(b bool) int {
+	if b {
+		return 1
+	}
+
+	return 0
+}
+
+// scanRecords calls fn with the id and data columns of each returned row.
+// The rows are closed once scanned.
+func scanRecords(rows *sql.Rows, fn func(id uint64, data string) error) error {
+	defer rows.Close()
+
+	for rows.Next() {
+		var id uint64
+		var data string
+		if err := rows.Scan(&id, &data); err != nil {
+			return err
+		}
+		if err := fn(id, data); err != nil {
+			return err
+		}
+	}
+
+	return rows.Err()
+}
@@ -0,0 +1,228 @@
+package store
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+)
+
+// sqliteHeader is the header of SQLite database files.
+const sqliteHeader = "SQLite format 3\x00"
+
+// sqliteTables are the tables restored from SQLite backups,
+// in an order satisfying their foreign key constraints.
+var sqliteTables = []string{
+	"contacts",
+	"discussions",
+	"invoices",
+	"invoice_fragments",
+	"payments",
+	"imported_invoices",
+	"imported_payments",
+	"messages",
+	"message_payments",
+	"message_imported_payments",
+	"message_terms",
+	"outbox",
+	"send_results",
+}
+
+// Backup writes a backup of the database to a writer, if the database
+// changed after the provided version (0 for an unconditional backup).
+// SQLite database backups always contain a complete database snapshot,
+// encrypted with the backup encryption key (if set).
+// The returned version is the one a subsequent backup should be
+// conditioned on, and equals the provided one if the database is unchanged,
+// in which case the backup contains no database snapshot.
+func (db *sqlDatabase) Backup(w io.Writer, since uint64) (uint64, error) {
+	bw, err := newBackupWriter(w, db.backupKey)
+	if err != nil {
+		return 0, err
+	}
+
+	var version uint64
+	if err := db.view(func(tx *sql.Tx) error {
+		return tx.QueryRow(`SELECT value FROM meta
+			WHERE key = 'change_version'`).Scan(&version)
+	}); err != nil {
+		return 0, err
+	}
+	// Changes are counted from 0, so the version
+	// of a backup must be positive.
+	version++
+
+	if since != 0 && version <= since {
+		return since, bw.Close()
+	}
+
+	dir, err := ioutil.TempDir("", "c13n-backup")
+	if err != nil {
+		return 0, err
+	}
+	defer os.RemoveAll(dir)
+
+	snapshot := filepath.Join(dir, "snapshot.db")
+	if _, err := db.db.Exec(`VACUUM INTO ?`, snapshot); err != nil {
+		return 0, fmt.Errorf("could not create database snapshot: %w", err)
+	}
+
+	f, err := os.Open(snapshot)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	if _, err := io.Copy(bw, f); err != nil {
+		return 0, err
+	}
+
+	return version, bw.Close()
+}
+
+// Restore loads a backup created by Backup into the database,
+// replacing its contents with the backup database snapshot.
+// The backup is verified to have been created with the same
+// backup encryption key before the database is modified.
+func (db *sqlDatabase) Restore(r io.Reader) error {
+	br, err := newBackupReader(r, db.backupKey)
+	if err != nil {
+		return err
+	}
+
+	dir, err := ioutil.TempDir("", "c13n-restore")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(dir)
+
+	snapshot := filepath.Join(dir, "snapshot.db")
+	f, err := os.OpenFile(snapshot, os.O_WRONLY|os.O_CREATE|os.O_EXCL, 0600)
+	if err != nil {
+		return err
+	}
+	n, err := io.Copy(f, br)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		// Backups of unchanged databases contain no snapshot.
+		return nil
+	}
+
+	if err := verifySQLiteFile(snapshot); err != nil {
+		return err
+	}
+	if err := db.migrateSnapshot(snapshot); err != nil {
+		return err
+	}
+
+	return db.restoreSnapshot(snapshot)
+}
+
+// migrateSnapshot applies the pending schema migrations to a backup
+// database snapshot, so that backups of earlier schema versions,
+// such as the ones written before migrations, are restored.
+func (db *sqlDatabase) migrateSnapshot(snapshot string) error {
+	dsn := snapshot + "?" + url.Values{"_pragma": {"foreign_keys(1)"}}.Encode()
+	sdb, err := sql.Open("sqlite", dsn)
+	if err != nil {
+		return err
+	}
+	defer sdb.Close()
+	sdb.SetMaxOpenConns(1)
+
+	snapshotDB := &sqlDatabase{
+		logger: db.logger,
+		path:   snapshot,
+		db:     sdb,
+	}
+	switch version, err := snapshotDB.schemaVersion(); {
+	case err != nil:
+		return fmt.Errorf("%w: could not open database snapshot: %v",
+			ErrMalformedBackup, err)
+	case version == 0:
+		return fmt.Errorf("%w: missing schema version", ErrMalformedBackup)
+	}
+
+	return snapshotDB.migrate()
+}
+
+// verifySQLiteFile verifies that a backup snapshot is an SQLite database.
+func verifySQLiteFile(path string) error {
+	f, err := os.Open(path)
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+
+	header := make([]byte, len(sqliteHeader))
+	if _, err := io.ReadFull(f, header); err != nil || string(header) != sqliteHeader {
+		return fmt.Errorf("%w: invalid database snapshot", ErrMalformedBackup)
+	}
+
+	return nil
+}
+
+// restoreSnapshot replaces the database contents with
+// the contents of a database snapshot.
+func (db *sqlDatabase) restoreSnapshot(snapshot string) error {
+	ctx := context.Background()
+
+	// The snapshot is attached to the connection used
+	// for the restore, for the duration of the restore.
+	conn, err := db.db.Conn(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, `ATTACH DATABASE ? AS snapshot`,
+		snapshot); err != nil {
+		return fmt.Errorf("%w: could not open database snapshot: %v",
+			ErrMalformedBackup, err)
+	}
+	defer conn.ExecContext(ctx, `DETACH DATABASE snapshot`)
+
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	var version uint64
+	if err := tx.QueryRow(`SELECT value FROM snapshot.meta
+		WHERE key = 'schema_version'`).Scan(&version); err != nil {
+		return fmt.Errorf("%w: missing schema version", ErrMalformedBackup)
+	}
+	if latest := uint64(len(sqliteMigrations)); version != latest {
+		return fmt.Errorf("%w: backup schema version %d "+
+			"differs from database schema version %d",
+			ErrUnsupportedSchema, version, latest)
+	}
+
+	for i := len(sqliteTables) - 1; i >= 0; i-- {
+		if _, err := tx.Exec(`DELETE FROM main.` + sqliteTables[i]); err != nil {
+			return err
+		}
+	}
+	for _, table := range sqliteTables {
+		if _, err := tx.Exec(`INSERT INTO main.` + table +
+			` SELECT * FROM snapshot.` + table); err != nil {
+			return fmt.Errorf("could not restore %s: %w", table, err)
+		}
+	}
+	if _, err := tx.Exec(`UPDATE main.meta SET value = value + 1
+		WHERE key = 'change_version'`); err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
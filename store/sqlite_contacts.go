@@ -0,0 +1,136 @@
+package store
+
+import (
+	"database/sql"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// AddContact stores a contact.
+func (db *sqlDatabase) AddContact(contact *model.Contact) (*model.Contact, error) {
+	if err := db.update(func(tx *sql.Tx) error {
+		switch _, err := txFindContact(tx, "address = ?", contact.Node.Address); err {
+		case ErrContactNotFound:
+		case nil:
+			return ErrContactAlreadyExists
+		default:
+			return err
+		}
+
+		data, err := encodeRecord(contact)
+		if err != nil {
+			return err
+		}
+		res, err := tx.Exec(`INSERT INTO contacts (address, data) VALUES (?, ?)`,
+			contact.Node.Address, data)
+		if err != nil {
+			return err
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		contact.ID = uint64(id)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return contact, nil
+}
+
+// GetContact retrieves a contact.
+func (db *sqlDatabase) GetContact(address string) (contact *model.Contact, err error) {
+	err = db.view(func(tx *sql.Tx) error {
+		contact, err = txFindContact(tx, "address = ?", address)
+		return err
+	})
+
+	return
+}
+
+// GetContactByID retrieves a contact by its id.
+func (db *sqlDatabase) GetContactByID(uid uint64) (contact *model.Contact, err error) {
+	err = db.view(func(tx *sql.Tx) error {
+		contact, err = txFindContact(tx, "id = ?", uid)
+		return err
+	})
+
+	return
+}
+
+// RemoveContact removes a contact.
+func (db *sqlDatabase) RemoveContact(address string) (*model.Contact, error) {
+	return db.removeContact("address = ?", address)
+}
+
+// RemoveContactByID removes a contact by its id.
+func (db *sqlDatabase) RemoveContactByID(uid uint64) (*model.Contact, error) {
+	return db.removeContact("id = ?", uid)
+}
+
+func (db *sqlDatabase) removeContact(cond string, arg interface{}) (
+	contact *model.Contact, err error) {
+
+	err = db.update(func(tx *sql.Tx) error {
+		contact, err = txFindContact(tx, cond, arg)
+		if err != nil {
+			return err
+		}
+
+		_, err = tx.Exec(`DELETE FROM contacts WHERE id = ?`, contact.ID)
+		return err
+	})
+	if err != nil {
+		contact = nil
+	}
+
+	return
+}
+
+// txFindContact retrieves the contact satisfying the provided condition.
+func txFindContact(tx *sql.Tx, cond string, arg interface{}) (*model.Contact, error) {
+	var id uint64
+	var data string
+	switch err := tx.QueryRow(`SELECT id, data FROM contacts WHERE `+cond,
+		arg).Scan(&id, &data); {
+	case err == sql.ErrNoRows:
+		return nil, ErrContactNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	contact := &model.Contact{}
+	if err := decodeRecord(data, contact); err != nil {
+		return nil, err
+	}
+	contact.ID = id
+
+	return contact, nil
+}
+
+// GetContacts retrieves all contacts.
+func (db *sqlDatabase) GetContacts() ([]model.Contact, error) {
+	contacts := make([]model.Contact, 0)
+	if err := db.view(func(tx *sql.Tx) error {
+		rows, err := tx.Query(`SELECT id, data FROM contacts ORDER BY id`)
+		if err != nil {
+			return err
+		}
+
+		return scanRecords(rows, func(id uint64, data string) error {
+			var contact model.Contact
+			if err := decodeRecord(data, &contact); err != nil {
+				return err
+			}
+			contact.ID = id
+			contacts = append(contacts, contact)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return contacts, nil
+}
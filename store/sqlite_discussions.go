@@ -0,0 +1,393 @@
+package store
+
+import (
+	"database/sql"
+	"sort"
+	"strings"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// participantsKey returns the unique key of a participant set.
+func participantsKey(participants []string) string {
+	sort.Strings(participants)
+
+	return strings.Join(participants, ",")
+}
+
+// AddDiscussion stores a discussion.
+func (db *sqlDatabase) AddDiscussion(discussion *model.Discussion) (*model.Discussion, error) {
+	key := participantsKey(discussion.Participants)
+
+	if err := db.update(func(tx *sql.Tx) error {
+		switch _, err := txFindDiscussion(tx, "participants = ?", key); err {
+		case ErrDiscussionNotFound:
+		case nil:
+			return ErrDiscussionAlreadyExists
+		default:
+			return err
+		}
+
+		data, err := encodeRecord(discussion)
+		if err != nil {
+			return err
+		}
+		res, err := tx.Exec(`INSERT INTO discussions
+			(participants, last_message_id, deleted, archived, pinned, activity_key, data)
+			VALUES (?, ?, ?, ?, ?, ?, ?)`, key, discussion.LastMessageID,
+			boolInt(discussion.Deleted), boolInt(discussion.Metadata.Archived),
+			boolInt(discussion.Metadata.Pinned), discussionActivityKey(discussion), data)
+		if err != nil {
+			return err
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		discussion.ID = uint64(id)
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return discussion, nil
+}
+
+// GetDiscussion retrieves a discussion.
+func (db *sqlDatabase) GetDiscussion(uid uint64) (discussion *model.Discussion, err error) {
+	err = db.view(func(tx *sql.Tx) error {
+		discussion, err = txFindDiscussion(tx, "id = ?", uid)
+		return err
+	})
+
+	return
+}
+
+// GetDiscussionByParticipants retrieves a discussion based on its participant set.
+func (db *sqlDatabase) GetDiscussionByParticipants(
+	participants []string) (discussion *model.Discussion, err error) {
+
+	key := participantsKey(participants)
+
+	err = db.view(func(tx *sql.Tx) error {
+		discussion, err = txFindDiscussion(tx, "participants = ?", key)
+		return err
+	})
+
+	return
+}
+
+// RemoveDiscussion removes a discussion along with its messages.
+// The invoices and payments of the messages are retained.
+// The discussion is soft deleted before its messages are removed in batches,
+// so that an interrupted removal is resumed by removing the discussion again.
+// The removal is not atomic: a message stored in the discussion during
+// the removal restores the discussion, in which case the removal stops
+// with ErrDiscussionRestored, retaining the messages not yet removed.
+func (db *sqlDatabase) RemoveDiscussion(uid uint64) (*model.Discussion, error) {
+	discussion, err := db.GetDiscussion(uid)
+	if err != nil {
+		return nil, err
+	}
+	if err := db.SoftDeleteDiscussion(uid); err != nil {
+		return nil, err
+	}
+
+	for {
+		var purged int64
+		if err := db.update(func(tx *sql.Tx) error {
+			if err := txRemovalPending(tx, uid); err != nil {
+				return err
+			}
+			// The search terms and payments of the messages
+			// are removed along with them.
+			res, err := tx.Exec(`DELETE FROM messages WHERE id IN (
+				SELECT id FROM messages WHERE discussion_id = ? LIMIT ?)`,
+				uid, messageIndexBatchSize)
+			if err != nil {
+				return err
+			}
+			purged, err = res.RowsAffected()
+			return err
+		}); err != nil {
+			return nil, err
+		}
+		if purged == 0 {
+			break
+		}
+	}
+
+	if err := db.update(func(tx *sql.Tx) error {
+		if err := txRemovalPending(tx, uid); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(`DELETE FROM discussions WHERE id = ?`, uid)
+		return err
+	}); err != nil {
+		return nil, err
+	}
+
+	return discussion, nil
+}
+
+// txRemovalPending verifies that a discussion being removed
+// is still deleted, returning ErrDiscussionRestored otherwise.
+func txRemovalPending(tx *sql.Tx, uid uint64) error {
+	disc, err := txFindDiscussion(tx, "id = ?", uid)
+	switch {
+	case err != nil:
+		return err
+	case !disc.Deleted:
+		return ErrDiscussionRestored
+	}
+
+	return nil
+}
+
+// SoftDeleteDiscussion marks a discussion as deleted,
+// hiding it from discussion listings until restored.
+func (db *sqlDatabase) SoftDeleteDiscussion(uid uint64) error {
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Deleted = true
+	})
+}
+
+// RestoreDiscussion restores a (soft) deleted discussion.
+func (db *sqlDatabase) RestoreDiscussion(uid uint64) error {
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Deleted = false
+	})
+}
+
+// UpdateDiscussionLastRead updates a discussion's last read message
+// with the provided messsage id, if the message id belongs to the discussion.
+func (db *sqlDatabase) UpdateDiscussionLastRead(uid uint64, readMsgID uint64) error {
+	return db.update(func(tx *sql.Tx) error {
+		// Verify that the message belongs to the discussion.
+		var discussionID uint64
+		switch err := tx.QueryRow(`SELECT discussion_id FROM messages WHERE id = ?`,
+			readMsgID).Scan(&discussionID); {
+		case err == sql.ErrNoRows:
+			return ErrMessageNotFound
+		case err != nil:
+			return err
+		case discussionID != uid:
+			return ErrMessageInvalidDisc
+		}
+
+		disc, err := txFindDiscussion(tx, "id = ?", uid)
+		if err != nil {
+			return err
+		}
+		disc.LastReadID = readMsgID
+
+		// Count the unread messages, unless the last message is read.
+		disc.UnreadCount = 0
+		if readMsgID != disc.LastMessageID {
+			if err := tx.QueryRow(`SELECT count(*) FROM messages
+				WHERE discussion_id = ? AND NOT target_linked
+				AND invoice_settle_index != 0 AND id > ?`,
+				uid, readMsgID).Scan(&disc.UnreadCount); err != nil {
+
+				return err
+			}
+		}
+
+		return txStoreDiscussion(tx, disc)
+	})
+}
+
+// UpdateDiscussionMetadata replaces a discussion's metadata.
+func (db *sqlDatabase) UpdateDiscussionMetadata(uid uint64,
+	metadata model.DiscussionMetadata) error {
+
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Metadata = metadata
+	})
+}
+
+// UpdateDiscussionOptions replaces a discussion's options.
+func (db *sqlDatabase) UpdateDiscussionOptions(uid uint64,
+	options model.MessageOptions) error {
+
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Options = options
+	})
+}
+
+// UpdateDiscussionRetention replaces a discussion's retention policy.
+func (db *sqlDatabase) UpdateDiscussionRetention(uid uint64,
+	policy model.RetentionPolicy) error {
+
+	return db.updateDiscussion(uid, func(disc *model.Discussion) {
+		disc.Retention = policy
+	})
+}
+
+// updateDiscussion applies an update to the discussion with the provided id.
+func (db *sqlDatabase) updateDiscussion(uid uint64, update func(*model.Discussion)) error {
+	return db.update(func(tx *sql.Tx) error {
+		return txUpdateDiscussion(tx, uid, update)
+	})
+}
+
+// txUpdateDiscussion applies an update to the discussion with the provided id.
+func txUpdateDiscussion(tx *sql.Tx, uid uint64, update func(*model.Discussion)) error {
+	disc, err := txFindDiscussion(tx, "id = ?", uid)
+	if err != nil {
+		return err
+	}
+
+	update(disc)
+
+	return txStoreDiscussion(tx, disc)
+}
+
+// txStoreDiscussion replaces a stored discussion.
+func txStoreDiscussion(tx *sql.Tx, disc *model.Discussion) error {
+	data, err := encodeRecord(disc)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`UPDATE discussions SET last_message_id = ?, deleted = ?,
+		archived = ?, pinned = ?, activity_key = ?, data = ? WHERE id = ?`,
+		disc.LastMessageID, boolInt(disc.Deleted), boolInt(disc.Metadata.Archived),
+		boolInt(disc.Metadata.Pinned), discussionActivityKey(disc), data, disc.ID)
+
+	return err
+}
+
+// txFindDiscussion retrieves the discussion satisfying the provided condition.
+func txFindDiscussion(tx *sql.Tx, cond string, arg interface{}) (*model.Discussion, error) {
+	var id uint64
+	var data string
+	switch err := tx.QueryRow(`SELECT id, data FROM discussions WHERE `+cond,
+		arg).Scan(&id, &data); {
+	case err == sql.ErrNoRows:
+		return nil, ErrDiscussionNotFound
+	case err != nil:
+		return nil, err
+	}
+
+	disc := &model.Discussion{}
+	if err := decodeRecord(data, disc); err != nil {
+		return nil, err
+	}
+	disc.ID = id
+
+	return disc, nil
+}
+
+// GetDiscussions retrieves discussions, respecting pagination.
+// The order parameter controls the order of the listing
+// and the filter restricts the listed discussions,
+// while pageOpts controls the requested range,
+// starting (or ending, if reverse) with the discussion with id LastID.
+// In last activity order, an unset LastID corresponds to
+// the first (or last, if reverse) discussion of the listing.
+func (db *sqlDatabase) GetDiscussions(pageOpts model.PageOptions,
+	order model.DiscussionOrder, filter model.DiscussionFilter) ([]model.Discussion, error) {
+
+	discussions := []model.Discussion{}
+	if err := db.view(func(tx *sql.Tx) error {
+		var anchor *model.Discussion
+		if order == model.DiscussionOrderLastActivity && pageOpts.LastID != 0 {
+			disc, err := txFindDiscussion(tx, "id = ?", pageOpts.LastID)
+			switch {
+			case err == ErrDiscussionNotFound:
+				return nil
+			case err != nil:
+				return err
+			}
+			anchor = disc
+		}
+		cond, args := discussionPageCondition(pageOpts, order, filter, anchor)
+
+		rows, err := tx.Query(`SELECT id, data FROM discussions WHERE `+cond, args...)
+		if err != nil {
+			return err
+		}
+		return scanRecords(rows, func(id uint64, data string) error {
+			var disc model.Discussion
+			if err := decodeRecord(data, &disc); err != nil {
+				return err
+			}
+			disc.ID = id
+			discussions = append(discussions, disc)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	if pageOpts.Reverse {
+		for i, j := 0, len(discussions)-1; i < j; i, j = i+1, j-1 {
+			discussions[i], discussions[j] = discussions[j], discussions[i]
+		}
+	}
+
+	return discussions, nil
+}
+
+// discussionPageCondition constructs the condition selecting the requested
+// range of a discussion listing, along with its arguments.
+// In last activity order, the range is anchored on the position
+// of the provided anchor in the listing, even if the anchor is not listed itself.
+func discussionPageCondition(pageOpts model.PageOptions, order model.DiscussionOrder,
+	filter model.DiscussionFilter, anchor *model.Discussion) (string, []interface{}) {
+
+	conds := []string{"deleted = ?"}
+	args := []interface{}{boolInt(filter.Deleted)}
+	flag := func(column string, f model.FlagFilter) {
+		switch f {
+		case model.FlagSet:
+			conds = append(conds, column)
+		case model.FlagUnset:
+			conds = append(conds, "NOT "+column)
+		}
+	}
+	flag("archived", filter.Archived)
+	flag("pinned", filter.Pinned)
+
+	columns, bound := []string{"id"}, []interface{}{pageOpts.LastID}
+	if order == model.DiscussionOrderLastActivity {
+		columns, bound = []string{"activity_key", "id"}, nil
+		if anchor != nil {
+			bound = []interface{}{discussionActivityKey(anchor), anchor.ID}
+		}
+	}
+
+	cmp, direction := ">=", ""
+	if pageOpts.Reverse {
+		cmp, direction = "<=", " DESC"
+	}
+	if bound != nil && !pageOpts.Latest() {
+		placeholders := strings.TrimSuffix(strings.Repeat("?, ", len(bound)), ", ")
+		conds = append(conds, "("+strings.Join(columns, ", ")+") "+
+			cmp+" ("+placeholders+")")
+		args = append(args, bound...)
+	}
+	for i := range columns {
+		columns[i] += direction
+	}
+
+	cond := strings.Join(conds, " AND ") + " ORDER BY " + strings.Join(columns, ", ")
+	if pageOpts.PageSize != 0 {
+		cond, args = cond+" LIMIT ?", append(args, pageOpts.PageSize)
+	}
+
+	return cond, args
+}
+
+// GetUnreadCount returns the number of received standalone messages
+// of a discussion stored after its last read message.
+func (db *sqlDatabase) GetUnreadCount(discussionUID uint64) (uint64, error) {
+	disc, err := db.GetDiscussion(discussionUID)
+	if err != nil {
+		return 0, err
+	}
+
+	return disc.UnreadCount, nil
+}
@@ -0,0 +1,139 @@
+package store
+
+import (
+	"bytes"
+	"database/sql"
+	"fmt"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// ImportMessage stores an imported raw message, along with the invoices
+// or payments carrying it, retaining its timestamp and redaction status.
+// The invoices and payments are stored by payment hash, outside the
+// settle and payment index keyspaces of the node, and are deduplicated
+// by their payment hash. If any of them is already stored,
+// the message is considered a duplicate and false is returned.
+// All operations are performed atomically.
+func (db *sqlDatabase) ImportMessage(rawMsg *model.RawMessage,
+	invoices []*model.Invoice, payments []*model.Payment) (imported bool, err error) {
+
+	err = db.update(func(tx *sql.Tx) error {
+		duplicate := false
+		for _, inv := range invoices {
+			stored, err := txImportInvoice(tx, inv)
+			if err != nil {
+				return fmt.Errorf("could not import invoice: %w", err)
+			}
+			duplicate = duplicate || !stored
+		}
+		for _, payment := range payments {
+			stored, err := txImportPayment(tx, payment)
+			if err != nil {
+				return fmt.Errorf("could not import payment: %w", err)
+			}
+			duplicate = duplicate || !stored
+		}
+		if duplicate {
+			return nil
+		}
+
+		// The message and reference ids are reassigned on insertion.
+		rawMsg.ID = 0
+		rawMsg.ReplyToID, rawMsg.ReplyToLinked = 0, false
+		rawMsg.TargetID, rawMsg.TargetLinked = 0, false
+		if rawMsg.ImportedHashes, err = importedHashes(rawMsg,
+			invoices, payments); err != nil {
+
+			return err
+		}
+
+		if err := txInsertRawMessage(tx, rawMsg); err != nil {
+			return err
+		}
+		imported = true
+		return nil
+	})
+	if err != nil {
+		imported = false
+	}
+
+	return
+}
+
+// txImportInvoice stores an imported invoice by payment hash,
+// unless an invoice with the same payment hash is already stored.
+func txImportInvoice(tx *sql.Tx, inv *model.Invoice) (bool, error) {
+	var data string
+	switch err := tx.QueryRow(`SELECT data FROM invoices WHERE hash = ?
+		UNION ALL SELECT data FROM imported_invoices WHERE hash = ?
+		LIMIT 1`, inv.Hash, inv.Hash).Scan(&data); {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return false, err
+	default:
+		stored := &model.Invoice{}
+		if err := decodeRecord(data, stored); err != nil {
+			return false, err
+		}
+		if !bytes.Equal(stored.Preimage, inv.Preimage) {
+			return false, ErrImportConflict
+		}
+		return false, nil
+	}
+
+	inv.Imported = true
+	data, err := encodeRecord(inv)
+	if err != nil {
+		return false, err
+	}
+	_, err = tx.Exec(`INSERT INTO imported_invoices (hash, data) VALUES (?, ?)`,
+		inv.Hash, data)
+
+	return err == nil, err
+}
+
+// txImportPayment stores an imported payment by payment hash,
+// unless a payment with the same payment hash is already stored.
+func txImportPayment(tx *sql.Tx, payment *model.Payment) (bool, error) {
+	var payee string
+	switch err := tx.QueryRow(`SELECT payee_address FROM payments WHERE hash = ?
+		UNION ALL SELECT payee_address FROM imported_payments WHERE hash = ?
+		LIMIT 1`, payment.Hash, payment.Hash).Scan(&payee); {
+	case err == sql.ErrNoRows:
+	case err != nil:
+		return false, err
+	case payee != payment.PayeeAddress:
+		return false, ErrImportConflict
+	default:
+		return false, nil
+	}
+
+	payment.Imported = true
+	data, err := encodeRecord(payment)
+	if err != nil {
+		return false, err
+	}
+	_, err = tx.Exec(`INSERT INTO imported_payments (hash, payee_address, data)
+		VALUES (?, ?, ?)`, payment.Hash, payment.PayeeAddress, data)
+
+	return err == nil, err
+}
+
+// txInsertImportedMessagePayments associates an imported outgoing message
+// with the payment hashes of the imported payments carrying it.
+func txInsertImportedMessagePayments(tx *sql.Tx, rawMsg *model.RawMessage) error {
+	if rawMsg.InvoiceSettleIndex != 0 {
+		return nil
+	}
+
+	for i, hash := range rawMsg.ImportedHashes {
+		if _, err := tx.Exec(`INSERT INTO message_imported_payments
+			(message_id, position, hash) VALUES (?, ?, ?)`,
+			rawMsg.ID, i, hash); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,564 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"strings"
+
+	"github.com/lightningnetwork/lnd/lnrpc"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// sqlParamBatchSize is the maximum number of values
+// bound to a single membership condition.
+const sqlParamBatchSize = 500
+
+// messageColumns are the columns of the messages table
+// populated from the raw message fields, in messageColumnValues order.
+const messageColumns = `discussion_id, message_id, reply_to, reply_to_linked,
+	target, target_id, target_linked, invoice_settle_index,
+	amt_msat, timestamp, redacted, data`
+
+// messageColumnValues returns the values of the raw message columns.
+func messageColumnValues(raw *model.RawMessage) ([]interface{}, error) {
+	data, err := encodeRecord(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return []interface{}{
+		raw.DiscussionID, raw.MessageID, raw.ReplyTo, boolInt(raw.ReplyToLinked),
+		raw.Target, raw.TargetID, boolInt(raw.TargetLinked), raw.InvoiceSettleIndex,
+		raw.AmtMsat, raw.Timestamp.UnixNano(), boolInt(raw.Redacted), data,
+	}, nil
+}
+
+// AddRawMessage stores a raw message under a discussion
+// and updates the last discussion message.
+// An error is returned if its associated invoice or payment indexes are missing.
+// The invoices carrying the message, which are pending
+// if it was reassembled from stored parts, are no longer pending.
+func (db *sqlDatabase) AddRawMessage(rawMsg *model.RawMessage) error {
+	return db.update(func(tx *sql.Tx) error {
+		if err := txAddRawMessage(tx, rawMsg); err != nil {
+			return err
+		}
+
+		return txRemoveInvoiceFragments(tx, messageSettleIndexes(rawMsg)...)
+	})
+}
+
+func txAddRawMessage(tx *sql.Tx, rawMsg *model.RawMessage) error {
+	rawMsg.WithTimestamp(getCurrentTime())
+
+	return txInsertRawMessage(tx, rawMsg)
+}
+
+// txInsertRawMessage stores a raw message, retaining its timestamp.
+func txInsertRawMessage(tx *sql.Tx, rawMsg *model.RawMessage) error {
+	// Verify the existence of the associated invoice or payment
+	amtMsat, err := txMessageAmtMsat(tx, rawMsg)
+	if err != nil {
+		return err
+	}
+	rawMsg.AmtMsat = amtMsat
+
+	// Verify the existence of the associated discussion
+	if _, err := txFindDiscussion(tx, "id = ?", rawMsg.DiscussionID); err != nil {
+		return fmt.Errorf("could not retrieve associated discussion: %w", err)
+	}
+
+	// Resolve the messages referenced by the message
+	if err := txResolveReferences(tx, rawMsg); err != nil {
+		return err
+	}
+
+	// Insert the raw message, along with its payment indexes,
+	// updating the indexed text of the message it targets (if any)
+	insert := func() error {
+		values, err := messageColumnValues(rawMsg)
+		if err != nil {
+			return err
+		}
+		res, err := tx.Exec(`INSERT INTO messages (`+messageColumns+`)
+			VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?, ?)`, values...)
+		if err != nil {
+			return err
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		rawMsg.ID = uint64(id)
+
+		if rawMsg.Imported() {
+			return txInsertImportedMessagePayments(tx, rawMsg)
+		}
+		for i, idx := range rawMsg.PaymentIndexes {
+			if _, err := tx.Exec(`INSERT INTO message_payments
+				(message_id, position, payment_index) VALUES (?, ?, ?)`,
+				rawMsg.ID, i, idx); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if rawMsg.TargetLinked {
+		if err := txReindexMessage(tx, rawMsg.TargetID, insert); err != nil {
+			return err
+		}
+	} else if err := insert(); err != nil {
+		return err
+	}
+
+	// Link previously stored messages referencing the message
+	linked, err := txLinkReferencingMessages(tx, rawMsg)
+	if err != nil {
+		return err
+	}
+
+	// Add the message text (as edited by any linked messages)
+	// to the search index
+	if !rawMsg.TargetLinked {
+		text, err := txSearchText(tx, rawMsg.ID)
+		if err != nil {
+			return err
+		}
+		if err := txIndexText(tx, rawMsg.ID, text); err != nil {
+			return err
+		}
+	}
+
+	// Update the discussion last and latest message, activity
+	// and unread count, restoring the discussion if deleted
+	var latest uint64
+	if err := tx.QueryRow(`SELECT id FROM messages
+		WHERE discussion_id = ? AND NOT target_linked
+		ORDER BY timestamp DESC, id DESC LIMIT 1`,
+		rawMsg.DiscussionID).Scan(&latest); err != nil && err != sql.ErrNoRows {
+
+		return err
+	}
+
+	return txUpdateDiscussion(tx, rawMsg.DiscussionID, func(disc *model.Discussion) {
+		recordMessage(disc, rawMsg, linked)
+		disc.LatestMessageID = latest
+		disc.Deleted = false
+	})
+}
+
+// txUpdateMessage replaces a stored raw message.
+func txUpdateMessage(tx *sql.Tx, raw *model.RawMessage) error {
+	values, err := messageColumnValues(raw)
+	if err != nil {
+		return err
+	}
+
+	columns := strings.Split(messageColumns, ",")
+	for i := range columns {
+		columns[i] = strings.TrimSpace(columns[i]) + " = ?"
+	}
+	_, err = tx.Exec(`UPDATE messages SET `+strings.Join(columns, ", ")+
+		` WHERE id = ?`, append(values, raw.ID)...)
+
+	return err
+}
+
+// txFindMessage retrieves the raw message with the provided id.
+func txFindMessage(tx *sql.Tx, uid uint64) (*model.RawMessage, error) {
+	raws, err := txQueryMessages(tx, `id = ?`, uid)
+	switch {
+	case err != nil:
+		return nil, err
+	case len(raws) == 0:
+		return nil, ErrMessageNotFound
+	}
+
+	return &raws[0], nil
+}
+
+// txQueryMessages retrieves the raw messages satisfying the provided
+// condition, which may be followed by ordering and limit clauses.
+func txQueryMessages(tx *sql.Tx, cond string, args ...interface{}) (
+	[]model.RawMessage, error) {
+
+	rows, err := tx.Query(`SELECT id, data FROM messages WHERE `+cond, args...)
+	if err != nil {
+		return nil, err
+	}
+
+	raws := make([]model.RawMessage, 0)
+	if err := scanRecords(rows, func(id uint64, data string) error {
+		var raw model.RawMessage
+		if err := decodeRecord(data, &raw); err != nil {
+			return err
+		}
+		raw.ID = id
+		raws = append(raws, raw)
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return raws, nil
+}
+
+// txResolveReferences populates the ids of the messages
+// replied to or targeted by a raw message, if they are stored.
+// Only standalone messages (not targeting another message) can be referenced.
+func txResolveReferences(tx *sql.Tx, rawMsg *model.RawMessage) error {
+	resolve := func(messageID string) (uint64, bool, error) {
+		if messageID == "" {
+			return 0, false, nil
+		}
+		referenced, err := txQueryMessages(tx, `discussion_id = ?
+			AND message_id = ? AND target = '' ORDER BY id LIMIT 1`,
+			rawMsg.DiscussionID, messageID)
+		if err != nil || len(referenced) == 0 {
+			return 0, false, err
+		}
+		return referenced[0].ID, true, nil
+	}
+
+	var err error
+	rawMsg.ReplyToID, rawMsg.ReplyToLinked, err = resolve(rawMsg.ReplyTo)
+	if err != nil {
+		return fmt.Errorf("could not resolve replied message: %w", err)
+	}
+	rawMsg.TargetID, rawMsg.TargetLinked, err = resolve(rawMsg.Target)
+	if err != nil {
+		return fmt.Errorf("could not resolve target message: %w", err)
+	}
+
+	return nil
+}
+
+// txLinkReferencingMessages populates the reference ids of the stored
+// messages of the discussion that reply to or target a raw message,
+// in case they were stored before the referenced message.
+// The targeting messages are returned as stored before linking.
+func txLinkReferencingMessages(tx *sql.Tx, rawMsg *model.RawMessage) (
+	[]model.RawMessage, error) {
+
+	if rawMsg.MessageID == "" || rawMsg.Target != "" {
+		return nil, nil
+	}
+
+	replies, err := txQueryMessages(tx, `discussion_id = ? AND reply_to = ?
+		AND reply_to != '' AND NOT reply_to_linked AND id != ?`,
+		rawMsg.DiscussionID, rawMsg.MessageID, rawMsg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not link replies: %w", err)
+	}
+	for i := range replies {
+		msg := &replies[i]
+		msg.ReplyToID, msg.ReplyToLinked = rawMsg.ID, true
+		if err := txUpdateMessage(tx, msg); err != nil {
+			return nil, fmt.Errorf("could not link replies: %w", err)
+		}
+	}
+
+	linked, err := txQueryMessages(tx, `discussion_id = ? AND target = ?
+		AND target != '' AND NOT target_linked AND id != ?`,
+		rawMsg.DiscussionID, rawMsg.MessageID, rawMsg.ID)
+	if err != nil {
+		return nil, fmt.Errorf("could not link targeting messages: %w", err)
+	}
+	for _, msg := range linked {
+		msg.TargetID, msg.TargetLinked = rawMsg.ID, true
+		if err := txUpdateMessage(tx, &msg); err != nil {
+			return nil, fmt.Errorf("could not link targeting messages: %w", err)
+		}
+	}
+
+	return linked, nil
+}
+
+// txMessageAmtMsat returns the amount paid over a raw message,
+// as the amount paid to its invoices (incoming) or
+// the amount of its succeeded payment attempts (outgoing).
+// An error is returned if its associated invoice or payment indexes are missing.
+func txMessageAmtMsat(tx *sql.Tx, rawMsg *model.RawMessage) (int64, error) {
+	invIdx := rawMsg.InvoiceSettleIndex
+	paymentIdxs := rawMsg.PaymentIndexes
+
+	var amtMsat int64
+	switch {
+	case len(paymentIdxs) == 0 && invIdx == 0:
+		return 0, fmt.Errorf("message not associated with invoice or payment")
+	case invIdx != 0:
+		keys := invoiceKeys(rawMsg)
+		inv, err := txFindInvoice(tx, keys[0])
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve associated invoice: %w", err)
+		}
+		amtMsat += inv.AmtPaid.Msat()
+		for _, key := range keys[1:] {
+			fragment, err := txFindInvoice(tx, key)
+			if err != nil {
+				return 0, fmt.Errorf("could not retrieve associated "+
+					"fragment invoice: %w", err)
+			}
+			amtMsat += fragment.AmtPaid.Msat()
+		}
+	default:
+		pays, err := txFindPayments(tx, rawMsg)
+		if err != nil {
+			return 0, fmt.Errorf("could not retrieve associated payments: %w", err)
+		}
+		for _, pay := range pays {
+			for _, htlc := range pay.Htlcs {
+				if htlc.Status == lnrpc.HTLCAttempt_SUCCEEDED {
+					amtMsat += htlc.Route.Amt.Msat()
+				}
+			}
+		}
+	}
+
+	return amtMsat, nil
+}
+
+// GetMessages retrieves messages belonging to a discussion.
+// The pageOpts parameter controls the requested message range.
+// Reverse pagination without an anchor retrieves the latest messages.
+func (db *sqlDatabase) GetMessages(discussionUID uint64,
+	pageOpts model.PageOptions) ([]MessageAggregate, error) {
+
+	var messages []MessageAggregate
+	if err := db.view(func(tx *sql.Tx) error {
+		if _, err := txFindDiscussion(tx, "id = ?", discussionUID); err != nil {
+			return err
+		}
+
+		var anchor *messageRangeEntry
+		if pageOpts.LastID != 0 {
+			entry, ok, err := txMessageRangeAnchor(tx, discussionUID, pageOpts)
+			switch {
+			case err != nil:
+				return err
+			case !ok:
+				messages = []MessageAggregate{}
+				return nil
+			}
+			anchor = &entry
+		}
+		cond, args := messageRangeCondition(discussionUID, pageOpts, anchor)
+
+		// Retrieve the raw messages
+		raws, err := txQueryMessages(tx, cond, args...)
+		if err != nil {
+			return err
+		}
+		messages = make([]MessageAggregate, len(raws))
+		for i := range raws {
+			msg, err := txMessageAggregate(tx, raws[i])
+			if err != nil {
+				return err
+			}
+			messages[i] = *msg
+		}
+
+		return txAttachAnnotations(tx, messages)
+	}); err != nil {
+		return nil, err
+	}
+
+	if pageOpts.Reverse {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+
+	return messages, nil
+}
+
+// GetMessage retrieves a message along with
+// the invoice or payments associated with it
+// and, for standalone messages, its annotations.
+func (db *sqlDatabase) GetMessage(uid uint64) (*MessageAggregate, error) {
+	var msg *MessageAggregate
+	if err := db.view(func(tx *sql.Tx) error {
+		raw, err := txFindMessage(tx, uid)
+		if err != nil {
+			return err
+		}
+
+		if msg, err = txMessageAggregate(tx, *raw); err != nil || raw.TargetLinked {
+			return err
+		}
+
+		messages := []MessageAggregate{*msg}
+		if err := txAttachAnnotations(tx, messages); err != nil {
+			return err
+		}
+		msg = &messages[0]
+
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	return msg, nil
+}
+
+// txMessageRangeAnchor returns the timestamp and id of the LastID message.
+// If it is not a message of the discussion, those of the standalone
+// message of the discussion following it by id (preceding it, for reverse
+// pages) are returned, as pages were anchored when ordered by id.
+// No anchor is returned if no such message exists.
+func txMessageRangeAnchor(tx *sql.Tx, discussionUID uint64,
+	pageOpts model.PageOptions) (messageRangeEntry, bool, error) {
+
+	next := `id > ? ORDER BY id`
+	if pageOpts.Reverse {
+		next = `id < ? ORDER BY id DESC`
+	}
+
+	var anchor messageRangeEntry
+	err := tx.QueryRow(`SELECT timestamp, id FROM messages
+		WHERE discussion_id = ? AND id = ?`,
+		discussionUID, pageOpts.LastID).Scan(&anchor.ts, &anchor.id)
+	if err == sql.ErrNoRows {
+		err = tx.QueryRow(`SELECT timestamp, id FROM messages
+			WHERE discussion_id = ? AND NOT target_linked AND `+next+` LIMIT 1`,
+			discussionUID, pageOpts.LastID).Scan(&anchor.ts, &anchor.id)
+	}
+	switch {
+	case err == sql.ErrNoRows:
+		return messageRangeEntry{}, false, nil
+	case err != nil:
+		return messageRangeEntry{}, false, err
+	}
+
+	return anchor, true, nil
+}
+
+// messageRangeCondition constructs the condition selecting the requested
+// range of standalone messages of a discussion, along with its arguments.
+// Messages targeting another stored message are
+// not retrieved on their own, but as its annotations.
+// Pages are anchored on the provided anchor, if any.
+func messageRangeCondition(discussionUID uint64, pageOpts model.PageOptions,
+	anchor *messageRangeEntry) (string, []interface{}) {
+
+	conds := []string{"discussion_id = ?", "NOT target_linked"}
+	args := []interface{}{discussionUID}
+	filter := func(cond string, arg interface{}) {
+		conds, args = append(conds, cond), append(args, arg)
+	}
+	if pageOpts.MinAmtMsat != 0 {
+		filter("amt_msat >= ?", pageOpts.MinAmtMsat)
+	}
+	if pageOpts.MaxAmtMsat != 0 {
+		filter("amt_msat <= ?", pageOpts.MaxAmtMsat)
+	}
+	if pageOpts.FromTimeNs != 0 {
+		filter("timestamp >= ?", pageOpts.FromTimeNs)
+	}
+	if pageOpts.ToTimeNs != 0 {
+		filter("timestamp <= ?", pageOpts.ToTimeNs)
+	}
+
+	switch pageOpts.Direction {
+	case model.DirectionSent:
+		conds = append(conds, "invoice_settle_index = 0")
+	case model.DirectionReceived:
+		conds = append(conds, "invoice_settle_index != 0")
+	}
+
+	order := "timestamp, id"
+	switch {
+	case pageOpts.Latest():
+		order = "timestamp DESC, id DESC"
+	case pageOpts.Reverse && anchor != nil:
+		conds = append(conds, "(timestamp, id) <= (?, ?)")
+		args = append(args, anchor.ts, anchor.id)
+		order = "timestamp DESC, id DESC"
+	case anchor != nil:
+		conds = append(conds, "(timestamp, id) >= (?, ?)")
+		args = append(args, anchor.ts, anchor.id)
+	}
+
+	cond := strings.Join(conds, " AND ") + " ORDER BY " + order
+	if pageOpts.PageSize != 0 {
+		cond, args = cond+" LIMIT ?", append(args, pageOpts.PageSize)
+	}
+
+	return cond, args
+}
+
+// txAttachAnnotations retrieves the annotations of standalone messages.
+func txAttachAnnotations(tx *sql.Tx, messages []MessageAggregate) error {
+	msgIdx := make(map[uint64]int, len(messages))
+	targetIDs := make([]interface{}, len(messages))
+	for i := range messages {
+		msgIdx[messages[i].RawMessage.ID] = i
+		targetIDs[i] = messages[i].RawMessage.ID
+	}
+
+	for start := 0; start < len(targetIDs); start += sqlParamBatchSize {
+		end := start + sqlParamBatchSize
+		if end > len(targetIDs) {
+			end = len(targetIDs)
+		}
+		ids := targetIDs[start:end]
+
+		annotations, err := txQueryMessages(tx, `target_linked AND target_id IN (`+
+			strings.TrimSuffix(strings.Repeat("?,", len(ids)), ",")+
+			`) ORDER BY id`, ids...)
+		if err != nil {
+			return err
+		}
+		for _, raw := range annotations {
+			annotation, err := txMessageAggregate(tx, raw)
+			if err != nil {
+				return err
+			}
+			i := msgIdx[raw.TargetID]
+			messages[i].Annotations = append(messages[i].Annotations, *annotation)
+		}
+	}
+
+	return nil
+}
+
+// txMessageAggregate retrieves the invoices or payments
+// associated with a raw message.
+func txMessageAggregate(tx *sql.Tx, raw model.RawMessage) (*MessageAggregate, error) {
+	switch {
+	case raw.InvoiceSettleIndex != 0:
+		keys := invoiceKeys(&raw)
+		inv, err := txFindInvoice(tx, keys[0])
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve invoice "+
+				"associated to message %d: %w", raw.ID, err)
+		}
+
+		msg := newMsgAggregate(raw, inv, nil)
+
+		for _, key := range keys[1:] {
+			fragment, err := txFindInvoice(tx, key)
+			if err != nil {
+				return nil, fmt.Errorf("could not retrieve fragment invoice "+
+					"associated to message %d: %w", raw.ID, err)
+			}
+			msg.Fragments = append(msg.Fragments, fragment)
+		}
+
+		return &msg, nil
+	case raw.PaymentIndexes != nil:
+		pays, err := txFindPayments(tx, &raw)
+		if err != nil {
+			return nil, fmt.Errorf("could not retrieve payments "+
+				"associated with message %d: %w", raw.ID, err)
+		}
+
+		msg := newMsgAggregate(raw, nil, pays)
+
+		return &msg, nil
+	default:
+		return nil, fmt.Errorf("stored message not " +
+			"associated with invoice or payments")
+	}
+}
@@ -0,0 +1,170 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// AddOutboxMessage stores an outgoing message in the outbox.
+func (db *sqlDatabase) AddOutboxMessage(msg *model.OutboxMessage) error {
+	msg.CreatedAt = getCurrentTime()
+
+	return db.update(func(tx *sql.Tx) error {
+		data, err := encodeRecord(msg)
+		if err != nil {
+			return err
+		}
+		res, err := tx.Exec(`INSERT INTO outbox (data) VALUES (?)`, data)
+		if err != nil {
+			return err
+		}
+		id, err := res.LastInsertId()
+		if err != nil {
+			return err
+		}
+		msg.ID = uint64(id)
+
+		return nil
+	})
+}
+
+// UpdateOutboxMessage updates an outbox message.
+func (db *sqlDatabase) UpdateOutboxMessage(msg *model.OutboxMessage) error {
+	return db.update(func(tx *sql.Tx) error {
+		data, err := encodeRecord(msg)
+		if err != nil {
+			return err
+		}
+		res, err := tx.Exec(`UPDATE outbox SET data = ? WHERE id = ?`, data, msg.ID)
+		if err != nil {
+			return err
+		}
+
+		return outboxRowAffected(res)
+	})
+}
+
+// GetOutboxMessages retrieves all outbox messages.
+func (db *sqlDatabase) GetOutboxMessages() ([]model.OutboxMessage, error) {
+	var msgs []model.OutboxMessage
+	if err := db.view(func(tx *sql.Tx) error {
+		rows, err := tx.Query(`SELECT id, data FROM outbox ORDER BY id`)
+		if err != nil {
+			return err
+		}
+
+		return scanRecords(rows, func(id uint64, data string) error {
+			var msg model.OutboxMessage
+			if err := decodeRecord(data, &msg); err != nil {
+				return err
+			}
+			msg.ID = id
+			msgs = append(msgs, msg)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return msgs, nil
+}
+
+// GetOutboxMessage retrieves an outbox message.
+func (db *sqlDatabase) GetOutboxMessage(id uint64) (*model.OutboxMessage, error) {
+	msg := &model.OutboxMessage{}
+	if err := db.view(func(tx *sql.Tx) error {
+		var data string
+		err := tx.QueryRow(`SELECT data FROM outbox WHERE id = ?`, id).Scan(&data)
+		switch {
+		case err == sql.ErrNoRows:
+			return ErrOutboxMessageNotFound
+		case err != nil:
+			return err
+		}
+
+		return decodeRecord(data, msg)
+	}); err != nil {
+		return nil, err
+	}
+	msg.ID = id
+
+	return msg, nil
+}
+
+// GetSendResult retrieves the result of a completed send operation.
+func (db *sqlDatabase) GetSendResult(sendID uint64) (*model.SendResult, error) {
+	result := &model.SendResult{}
+	if err := db.view(func(tx *sql.Tx) error {
+		var data string
+		err := tx.QueryRow(`SELECT data FROM send_results WHERE send_id = ?`,
+			sendID).Scan(&data)
+		switch {
+		case err == sql.ErrNoRows:
+			return ErrSendResultNotFound
+		case err != nil:
+			return err
+		}
+
+		return decodeRecord(data, result)
+	}); err != nil {
+		return nil, err
+	}
+	result.SendID = sendID
+
+	return result, nil
+}
+
+// CompleteOutboxMessage removes a message from the outbox,
+// storing its payments, the resulting raw message (if not nil)
+// and the send result, which references the stored raw message.
+// All operations are performed atomically.
+func (db *sqlDatabase) CompleteOutboxMessage(id uint64, result model.SendResult,
+	rawMsg *model.RawMessage, payments ...*model.Payment) error {
+
+	return db.update(func(tx *sql.Tx) error {
+		res, err := tx.Exec(`DELETE FROM outbox WHERE id = ?`, id)
+		if err != nil {
+			return err
+		}
+		if err := outboxRowAffected(res); err != nil {
+			return err
+		}
+
+		if err := txAddPayments(tx, payments...); err != nil {
+			return fmt.Errorf("could not store payments: %w", err)
+		}
+
+		result.SendID, result.MessageID = id, 0
+		if rawMsg != nil {
+			if err := txAddRawMessage(tx, rawMsg); err != nil {
+				return err
+			}
+			result.MessageID = rawMsg.ID
+		}
+
+		data, err := encodeRecord(&result)
+		if err != nil {
+			return err
+		}
+		_, err = tx.Exec(`INSERT OR REPLACE INTO send_results (send_id, data)
+			VALUES (?, ?)`, id, data)
+
+		return err
+	})
+}
+
+// outboxRowAffected returns ErrOutboxMessageNotFound
+// in case an outbox statement affected no rows.
+func outboxRowAffected(res sql.Result) error {
+	n, err := res.RowsAffected()
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return ErrOutboxMessageNotFound
+	}
+
+	return nil
+}
@@ -0,0 +1,249 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// AddInvoice stores an invoice.
+// If the invoice already exists, ErrDuplicateInvoice is returned.
+func (db *sqlDatabase) AddInvoice(inv *model.Invoice) error {
+	return db.update(func(tx *sql.Tx) error {
+		return txInsertInvoice(tx, inv)
+	})
+}
+
+// AddInvoiceMessage stores an invoice along with the raw message it carries,
+// atomically. If the invoice already exists, ErrDuplicateInvoice
+// is returned and neither is stored.
+// The invoices carrying the other parts of a multi-part message
+// are no longer pending once the message is stored.
+func (db *sqlDatabase) AddInvoiceMessage(inv *model.Invoice, rawMsg *model.RawMessage) error {
+	return db.update(func(tx *sql.Tx) error {
+		if err := txInsertInvoice(tx, inv); err != nil {
+			return err
+		}
+		if err := txAddRawMessage(tx, rawMsg); err != nil {
+			return err
+		}
+
+		return txRemoveInvoiceFragments(tx, rawMsg.FragmentSettleIndexes...)
+	})
+}
+
+// AddInvoiceFragment stores an invoice carrying a part of a multi-part
+// message, marking it as pending until the message is reassembled.
+// If the invoice already exists, ErrDuplicateInvoice is returned.
+func (db *sqlDatabase) AddInvoiceFragment(inv *model.Invoice) error {
+	return db.update(func(tx *sql.Tx) error {
+		if err := txInsertInvoice(tx, inv); err != nil {
+			return err
+		}
+
+		_, err := tx.Exec(`INSERT INTO invoice_fragments (settle_index)
+			VALUES (?)`, inv.SettleIndex)
+		return err
+	})
+}
+
+// GetInvoiceFragments retrieves the pending invoices carrying parts
+// of multi-part messages, in settle index order.
+func (db *sqlDatabase) GetInvoiceFragments() ([]*model.Invoice, error) {
+	var invoices []*model.Invoice
+	if err := db.view(func(tx *sql.Tx) error {
+		rows, err := tx.Query(`SELECT i.settle_index, i.data
+			FROM invoice_fragments f JOIN invoices i USING (settle_index)
+			ORDER BY i.settle_index`)
+		if err != nil {
+			return err
+		}
+
+		return scanRecords(rows, func(_ uint64, data string) error {
+			inv := new(model.Invoice)
+			if err := decodeRecord(data, inv); err != nil {
+				return err
+			}
+			invoices = append(invoices, inv)
+			return nil
+		})
+	}); err != nil {
+		return nil, err
+	}
+
+	return invoices, nil
+}
+
+// RemoveInvoiceFragments discards the pending status of the invoices
+// with the provided settle indexes. The invoices are retained.
+func (db *sqlDatabase) RemoveInvoiceFragments(settleIndexes ...uint64) error {
+	return db.update(func(tx *sql.Tx) error {
+		return txRemoveInvoiceFragments(tx, settleIndexes...)
+	})
+}
+
+func txRemoveInvoiceFragments(tx *sql.Tx, settleIndexes ...uint64) error {
+	for _, idx := range settleIndexes {
+		if _, err := tx.Exec(`DELETE FROM invoice_fragments
+			WHERE settle_index = ?`, idx); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func txInsertInvoice(tx *sql.Tx, inv *model.Invoice) error {
+	var exists int
+	if err := tx.QueryRow(`SELECT count(*) FROM invoices WHERE settle_index = ?`,
+		inv.SettleIndex).Scan(&exists); err != nil {
+		return err
+	}
+	if exists != 0 {
+		return ErrDuplicateInvoice
+	}
+
+	data, err := encodeRecord(inv)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`INSERT INTO invoices (settle_index, hash, data)
+		VALUES (?, ?, ?)`, inv.SettleIndex, inv.Hash, data)
+
+	return err
+}
+
+// GetLastInvoiceIndex retrieves the last invoice index present in the database.
+func (db *sqlDatabase) GetLastInvoiceIndex() (invoiceSettleIdx uint64, err error) {
+	err = db.view(func(tx *sql.Tx) error {
+		return tx.QueryRow(`SELECT coalesce(max(settle_index), 0)
+			FROM invoices`).Scan(&invoiceSettleIdx)
+	})
+
+	return
+}
+
+// AddPayments stores a list of payments.
+// If any of the payments already exists, ErrDuplicatePayment is returned
+// and none of the payments is stored.
+func (db *sqlDatabase) AddPayments(payments ...*model.Payment) error {
+	if len(payments) <= 0 {
+		return nil
+	}
+
+	return db.update(func(tx *sql.Tx) error {
+		return txAddPayments(tx, payments...)
+	})
+}
+
+func txAddPayments(tx *sql.Tx, payments ...*model.Payment) error {
+	for _, payment := range payments {
+		var exists int
+		if err := tx.QueryRow(`SELECT count(*) FROM payments WHERE payment_index = ?`,
+			payment.PaymentIndex).Scan(&exists); err != nil {
+			return err
+		}
+		if exists != 0 {
+			return ErrDuplicatePayment
+		}
+
+		if err := txInsertPayment(tx, payment); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func txInsertPayment(tx *sql.Tx, payment *model.Payment) error {
+	data, err := encodeRecord(payment)
+	if err != nil {
+		return err
+	}
+	_, err = tx.Exec(`INSERT INTO payments (payment_index, hash, payee_address, data)
+		VALUES (?, ?, ?, ?)`,
+		payment.PaymentIndex, payment.Hash, payment.PayeeAddress, data)
+
+	return err
+}
+
+// GetLastPaymentIndex retrieves the last payment index present in the database.
+func (db *sqlDatabase) GetLastPaymentIndex() (paymentIdx uint64, err error) {
+	err = db.view(func(tx *sql.Tx) error {
+		return tx.QueryRow(`SELECT coalesce(max(payment_index), 0)
+			FROM payments`).Scan(&paymentIdx)
+	})
+
+	return
+}
+
+// txFindInvoice retrieves an invoice by settle index or,
+// in case of imported invoices, by payment hash.
+func txFindInvoice(tx *sql.Tx, key interface{}) (*model.Invoice, error) {
+	query := `SELECT data FROM invoices WHERE settle_index = ?`
+	if _, imported := key.(string); imported {
+		query = `SELECT data FROM imported_invoices WHERE hash = ?`
+	}
+
+	var data string
+	switch err := tx.QueryRow(query, key).Scan(&data); {
+	case err == sql.ErrNoRows:
+		return nil, fmt.Errorf("invoice not found")
+	case err != nil:
+		return nil, err
+	}
+
+	inv := &model.Invoice{}
+	if err := decodeRecord(data, inv); err != nil {
+		return nil, err
+	}
+
+	return inv, nil
+}
+
+// txFindPayments retrieves the payments carrying an outgoing raw message,
+// in payment index order. Imported payments are retrieved by payment hash.
+func txFindPayments(tx *sql.Tx, raw *model.RawMessage) ([]model.Payment, error) {
+	query := `SELECT payment_index, data FROM payments
+		WHERE payment_index IN (%s)`
+	if raw.Imported() {
+		query = `SELECT rowid, data FROM imported_payments WHERE hash IN (%s)`
+	}
+	args := paymentKeys(raw)
+	placeholders := strings.TrimSuffix(strings.Repeat("?,", len(args)), ",")
+
+	rows, err := tx.Query(fmt.Sprintf(query, placeholders), args...)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve payment: %w", err)
+	}
+
+	pays := make([]model.Payment, 0)
+	if err := scanRecords(rows, func(_ uint64, data string) error {
+		var pay model.Payment
+		if err := decodeRecord(data, &pay); err != nil {
+			return err
+		}
+		pays = append(pays, pay)
+		return nil
+	}); err != nil {
+		return nil, fmt.Errorf("could not retrieve payment: %w", err)
+	}
+
+	sort.Slice(pays, func(i, j int) bool {
+		return pays[i].PaymentIndex < pays[j].PaymentIndex
+	})
+
+	resultIdxs := make([]uint64, len(pays))
+	for i, pay := range pays {
+		resultIdxs[i] = pay.PaymentIndex
+	}
+	switch sameUnorderedIDSlice(raw.PaymentIndexes, resultIdxs) {
+	case true:
+		return pays, nil
+	default:
+		return nil, fmt.Errorf("missing or mismatched payment detected")
+	}
+}
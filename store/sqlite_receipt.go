@@ -0,0 +1,58 @@
+package store
+
+import (
+	"database/sql"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// AddReceipt records a receipt for the outgoing message carried by
+// the payment with the receipt payment hash, which must have been
+// addressed to the receipt sender.
+// A read receipt is recorded for all previous outgoing messages
+// of the discussion as well.
+// If no such message exists, ErrMessageNotFound is returned.
+func (db *sqlDatabase) AddReceipt(receipt *model.Receipt) error {
+	return db.update(func(tx *sql.Tx) error {
+		var msgID uint64
+		switch err := tx.QueryRow(`SELECT m.message_id
+			FROM message_payments m JOIN payments p USING (payment_index)
+			WHERE p.hash = ? AND p.payee_address = ?
+			UNION ALL SELECT m.message_id
+			FROM message_imported_payments m JOIN imported_payments p USING (hash)
+			WHERE p.hash = ? AND p.payee_address = ?
+			ORDER BY 1 LIMIT 1`,
+			receipt.PaymentHash, receipt.Sender,
+			receipt.PaymentHash, receipt.Sender).Scan(&msgID); {
+		case err == sql.ErrNoRows:
+			return ErrMessageNotFound
+		case err != nil:
+			return err
+		}
+
+		raw, err := txFindMessage(tx, msgID)
+		if err != nil {
+			return err
+		}
+
+		raws := []model.RawMessage{*raw}
+		if receipt.Type == model.ReceiptREAD {
+			raws, err = txQueryMessages(tx, `discussion_id = ? AND id <= ?
+				AND invoice_settle_index = 0`, raw.DiscussionID, raw.ID)
+			if err != nil {
+				return err
+			}
+		}
+
+		for i := range raws {
+			if !raws[i].WithReceipt(receipt) {
+				continue
+			}
+			if err := txUpdateMessage(tx, &raws[i]); err != nil {
+				return err
+			}
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,133 @@
+package store
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// RedactMessage redacts the payload of a message, along with the payloads
+// of the edits, delete requests and reactions targeting it.
+// The invoice or payments associated with the messages are retained.
+func (db *sqlDatabase) RedactMessage(uid uint64) error {
+	return db.update(func(tx *sql.Tx) error {
+		raw, err := txFindMessage(tx, uid)
+		if err != nil {
+			return err
+		}
+
+		_, err = txRedactMessage(tx, raw)
+		return err
+	})
+}
+
+// ApplyRetention redacts the messages exceeding the retention policy
+// of their discussion, which overrides the provided default policy.
+// The message age is measured up to the provided time.
+// The number of redacted messages is returned.
+func (db *sqlDatabase) ApplyRetention(defaultPolicy model.RetentionPolicy,
+	now time.Time) (uint64, error) {
+
+	var discussions []model.Discussion
+	if err := db.view(func(tx *sql.Tx) error {
+		rows, err := tx.Query(`SELECT id, data FROM discussions ORDER BY id`)
+		if err != nil {
+			return err
+		}
+
+		return scanRecords(rows, func(id uint64, data string) error {
+			var disc model.Discussion
+			if err := decodeRecord(data, &disc); err != nil {
+				return err
+			}
+			disc.ID = id
+			discussions = append(discussions, disc)
+			return nil
+		})
+	}); err != nil {
+		return 0, err
+	}
+
+	var redacted uint64
+	for _, disc := range discussions {
+		policy := defaultPolicy.Override(disc.Retention)
+		if policy.IsZero() {
+			continue
+		}
+
+		var expired []uint64
+		if err := db.view(func(tx *sql.Tx) error {
+			raws, err := txQueryMessages(tx, `discussion_id = ? AND NOT redacted
+				ORDER BY id`, disc.ID)
+			expired = expiredMessageIDs(raws, policy, now)
+			return err
+		}); err != nil {
+			return redacted, err
+		}
+
+		for start := 0; start < len(expired); start += messageIndexBatchSize {
+			end := start + messageIndexBatchSize
+			if end > len(expired) {
+				end = len(expired)
+			}
+
+			if err := db.update(func(tx *sql.Tx) error {
+				for _, id := range expired[start:end] {
+					raw, err := txFindMessage(tx, id)
+					if err != nil {
+						return err
+					}
+					n, err := txRedactMessage(tx, raw)
+					if err != nil {
+						return err
+					}
+					redacted += n
+				}
+				return nil
+			}); err != nil {
+				return redacted, err
+			}
+		}
+	}
+
+	return redacted, nil
+}
+
+// txRedactMessage redacts the payload of a raw message and removes it
+// from the search index. The edits, delete requests and reactions
+// targeting a standalone message are also redacted.
+// The number of redacted messages is returned.
+func txRedactMessage(tx *sql.Tx, raw *model.RawMessage) (uint64, error) {
+	group := []model.RawMessage{*raw}
+	indexedID := raw.ID
+	if raw.TargetLinked {
+		indexedID = raw.TargetID
+	} else {
+		annotations, err := txTargetingMessages(tx, raw)
+		if err != nil {
+			return 0, err
+		}
+		group = append(group, annotations...)
+	}
+
+	// Redacting an edit also updates the indexed text of its target.
+	var redacted uint64
+	err := txReindexMessage(tx, indexedID, func() error {
+		for i := range group {
+			m := &group[i]
+			if m.Redacted {
+				continue
+			}
+
+			m.Redact()
+			if err := txUpdateMessage(tx, m); err != nil {
+				return err
+			}
+			redacted++
+		}
+		return nil
+	})
+
+	return redacted, err
+}
@@ -0,0 +1,233 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+)
+
+// sqliteMigration is a schema migration of SQLite databases,
+// applied within a single transaction.
+// Data migrations are applied after the migration statements, if present.
+type sqliteMigration struct {
+	description string
+	statements  string
+	migrate     func(tx *sql.Tx) error
+}
+
+// sqliteMigrations contains the schema migrations of SQLite databases,
+// in schema version order (starting from version 1),
+// the first of which creates the database schema.
+var sqliteMigrations = []sqliteMigration{
+	{
+		description: "create schema",
+		statements: `
+CREATE TABLE contacts (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	address TEXT NOT NULL UNIQUE,
+	data TEXT NOT NULL
+);
+
+CREATE TABLE discussions (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	participants TEXT NOT NULL UNIQUE,
+	last_message_id INTEGER NOT NULL,
+	deleted INTEGER NOT NULL,
+	archived INTEGER NOT NULL,
+	pinned INTEGER NOT NULL,
+	activity_key BLOB NOT NULL,
+	data TEXT NOT NULL
+);
+CREATE INDEX discussions_activity ON discussions(deleted, activity_key, id);
+
+CREATE TABLE invoices (
+	settle_index INTEGER PRIMARY KEY,
+	hash TEXT NOT NULL,
+	data TEXT NOT NULL
+);
+CREATE INDEX invoices_hash ON invoices(hash);
+
+CREATE TABLE payments (
+	payment_index INTEGER PRIMARY KEY,
+	hash TEXT NOT NULL,
+	payee_address TEXT NOT NULL,
+	data TEXT NOT NULL
+);
+CREATE INDEX payments_hash ON payments(hash, payee_address);
+
+CREATE TABLE messages (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	discussion_id INTEGER NOT NULL REFERENCES discussions(id) ON DELETE CASCADE,
+	message_id TEXT NOT NULL,
+	reply_to TEXT NOT NULL,
+	reply_to_linked INTEGER NOT NULL,
+	target TEXT NOT NULL,
+	target_id INTEGER NOT NULL,
+	target_linked INTEGER NOT NULL,
+	invoice_settle_index INTEGER NOT NULL,
+	amt_msat INTEGER NOT NULL,
+	timestamp INTEGER NOT NULL,
+	redacted INTEGER NOT NULL,
+	data TEXT NOT NULL
+);
+CREATE INDEX messages_discussion ON messages(discussion_id, target_linked, id);
+CREATE INDEX messages_discussion_timestamp ON messages(discussion_id, timestamp);
+CREATE INDEX messages_discussion_amt ON messages(discussion_id, amt_msat);
+CREATE INDEX messages_discussion_message_id ON messages(discussion_id, message_id)
+	WHERE message_id != '';
+CREATE INDEX messages_unlinked_reply ON messages(discussion_id, reply_to)
+	WHERE reply_to != '' AND NOT reply_to_linked;
+CREATE INDEX messages_unlinked_target ON messages(discussion_id, target)
+	WHERE target != '' AND NOT target_linked;
+CREATE INDEX messages_target ON messages(target_id) WHERE target_linked;
+CREATE INDEX messages_settle_index ON messages(invoice_settle_index)
+	WHERE invoice_settle_index != 0;
+
+CREATE TABLE message_payments (
+	message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	payment_index INTEGER NOT NULL,
+	PRIMARY KEY (message_id, position)
+) WITHOUT ROWID;
+CREATE INDEX message_payments_payment_index ON message_payments(payment_index);
+
+CREATE TABLE message_terms (
+	term BLOB NOT NULL,
+	message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+	PRIMARY KEY (term, message_id)
+) WITHOUT ROWID;
+CREATE INDEX message_terms_message ON message_terms(message_id);
+
+CREATE TABLE outbox (
+	id INTEGER PRIMARY KEY AUTOINCREMENT,
+	data TEXT NOT NULL
+);
+
+CREATE TABLE send_results (
+	send_id INTEGER PRIMARY KEY,
+	data TEXT NOT NULL
+);
+
+CREATE TABLE invoice_fragments (
+	settle_index INTEGER PRIMARY KEY REFERENCES invoices(settle_index)
+);
+
+CREATE TABLE imported_invoices (
+	hash TEXT PRIMARY KEY,
+	data TEXT NOT NULL
+);
+
+CREATE TABLE imported_payments (
+	hash TEXT PRIMARY KEY,
+	payee_address TEXT NOT NULL,
+	data TEXT NOT NULL
+);
+
+CREATE TABLE message_imported_payments (
+	message_id INTEGER NOT NULL REFERENCES messages(id) ON DELETE CASCADE,
+	position INTEGER NOT NULL,
+	hash TEXT NOT NULL,
+	PRIMARY KEY (message_id, position)
+) WITHOUT ROWID;
+CREATE INDEX message_imported_payments_hash ON message_imported_payments(hash);
+
+INSERT INTO meta (key, value) VALUES ('change_version', 0);
+`,
+	},
+}
+
+// sqliteMetaSchema creates the table holding the
+// schema and change versions of the database.
+const sqliteMetaSchema = `CREATE TABLE IF NOT EXISTS meta (
+	key TEXT PRIMARY KEY,
+	value INTEGER NOT NULL
+)`
+
+// migrate applies the pending schema migrations of the database, in order.
+func (db *sqlDatabase) migrate() error {
+	current, err := db.schemaVersion()
+	if err != nil {
+		return err
+	}
+	latest := uint64(len(sqliteMigrations))
+	if current > latest {
+		return fmt.Errorf("%w: %d (latest supported version is %d)",
+			ErrUnsupportedSchema, current, latest)
+	}
+
+	switch {
+	case db.migrationDryRun && current == latest:
+		db.logger.Infof("Database schema version %d is up to date", current)
+		return ErrMigrationDryRun
+	case current == latest:
+		return nil
+	case current != 0 && !db.migrationDryRun && db.migrationBackupDir != "":
+		if err := backupBeforeMigration(db, db.logger,
+			db.migrationBackupDir, current, latest); err != nil {
+
+			return fmt.Errorf("could not back up database before migration: %w", err)
+		}
+	}
+
+	tx, err := db.db.Begin()
+	if err != nil {
+		return err
+	}
+	defer tx.Rollback()
+
+	if _, err := tx.Exec(sqliteMetaSchema); err != nil {
+		return err
+	}
+	for version := current + 1; version <= latest; version++ {
+		m := sqliteMigrations[version-1]
+		db.logger.Infof("Applying database migration %d (%s)",
+			version, m.description)
+
+		if _, err := tx.Exec(m.statements); err != nil {
+			return fmt.Errorf("migration %d failed: %w", version, err)
+		}
+		if m.migrate == nil {
+			continue
+		}
+		if err := m.migrate(tx); err != nil {
+			return fmt.Errorf("migration %d failed: %w", version, err)
+		}
+	}
+	if _, err := tx.Exec(`INSERT INTO meta (key, value) VALUES ('schema_version', ?)
+		ON CONFLICT (key) DO UPDATE SET value = excluded.value`, latest); err != nil {
+		return err
+	}
+
+	// Schema changes are transactional, so dry runs are rolled back.
+	if db.migrationDryRun {
+		db.logger.Infof("Dry run of database migrations "+
+			"from schema version %d succeeded", current)
+		return ErrMigrationDryRun
+	}
+
+	return tx.Commit()
+}
+
+// schemaVersion returns the schema version of the database,
+// which is 0 for databases without a schema.
+func (db *sqlDatabase) schemaVersion() (uint64, error) {
+	var version uint64
+	err := db.view(func(tx *sql.Tx) error {
+		var tables int
+		if err := tx.QueryRow(`SELECT count(*) FROM sqlite_master
+			WHERE type = 'table' AND name = 'meta'`).Scan(&tables); err != nil {
+			return err
+		}
+		if tables == 0 {
+			return nil
+		}
+
+		err := tx.QueryRow(`SELECT value FROM meta
+			WHERE key = 'schema_version'`).Scan(&version)
+		if err == sql.ErrNoRows {
+			return nil
+		}
+		return err
+	})
+
+	return version, err
+}
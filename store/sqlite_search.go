@@ -0,0 +1,245 @@
+package store
+
+import (
+	"database/sql"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+// The search index of SQLite databases is stored in the message_terms table,
+// containing a row for each term of an indexed message.
+// Terms are stored as blobs, so that prefix matches correspond to
+// the byte range between the prefix and the prefix followed by 0xff,
+// which cannot appear in UTF-8 text.
+
+// txTargetingMessages retrieves the messages targeting
+// a standalone message, in storage order.
+func txTargetingMessages(tx *sql.Tx, raw *model.RawMessage) ([]model.RawMessage, error) {
+	return txQueryMessages(tx, `target_linked AND target_id = ? ORDER BY id`, raw.ID)
+}
+
+// txSearchText returns the indexed text of the message with the provided id.
+func txSearchText(tx *sql.Tx, id uint64) (string, error) {
+	raw, err := txFindMessage(tx, id)
+	switch {
+	case err == ErrMessageNotFound:
+		return "", nil
+	case err != nil:
+		return "", err
+	case raw.TargetLinked || raw.ContentType != model.ContentTypeText:
+		return "", nil
+	}
+
+	annotations, err := txTargetingMessages(tx, raw)
+	if err != nil {
+		return "", err
+	}
+	text, _ := displayedText(raw, annotations)
+
+	return text, nil
+}
+
+// txIndexText adds the terms of a message text to the search index.
+func txIndexText(tx *sql.Tx, id uint64, text string) error {
+	for _, term := range model.SearchTerms(text) {
+		if _, err := tx.Exec(`INSERT OR IGNORE INTO message_terms
+			(term, message_id) VALUES (?, ?)`, []byte(term), id); err != nil {
+			return fmt.Errorf("could not index message %d: %w", id, err)
+		}
+	}
+
+	return nil
+}
+
+// txReindexMessage applies an update affecting the indexed text
+// of the message with the provided id (such as storing or redacting
+// a message targeting it), replacing its indexed terms.
+func txReindexMessage(tx *sql.Tx, id uint64, update func() error) error {
+	previous, err := txSearchText(tx, id)
+	if err != nil {
+		return err
+	}
+	if err := update(); err != nil {
+		return err
+	}
+	current, err := txSearchText(tx, id)
+	if err != nil || current == previous {
+		return err
+	}
+
+	if _, err := tx.Exec(`DELETE FROM message_terms WHERE message_id = ?`, id); err != nil {
+		return fmt.Errorf("could not unindex message %d: %w", id, err)
+	}
+
+	return txIndexText(tx, id, current)
+}
+
+// sqlSearchTerm returns the ids of the messages
+// containing a word prefixed by the provided term.
+func sqlSearchTerm(tx *sql.Tx, term string) (map[uint64]struct{}, error) {
+	rows, err := tx.Query(`SELECT DISTINCT message_id FROM message_terms
+		WHERE term >= ? AND term < ?`,
+		[]byte(term), append([]byte(term), 0xff))
+	if err != nil {
+		return nil, err
+	}
+
+	return scanIDSet(rows)
+}
+
+// scanIDSet returns the set of ids returned by a single column query.
+func scanIDSet(rows *sql.Rows) (map[uint64]struct{}, error) {
+	defer rows.Close()
+
+	ids := make(map[uint64]struct{})
+	for rows.Next() {
+		var id uint64
+		if err := rows.Scan(&id); err != nil {
+			return nil, err
+		}
+		ids[id] = struct{}{}
+	}
+
+	return ids, rows.Err()
+}
+
+// SearchMessages retrieves the standalone messages matching a search query,
+// along with their annotations.
+// Messages deleted by their sender and messages of deleted discussions
+// are omitted, while text terms are matched against the displayed
+// (latest edited) text of messages.
+// The pageOpts parameter controls the requested range of matching messages,
+// in message id order, and may further filter them.
+func (db *sqlDatabase) SearchMessages(query model.SearchQuery,
+	pageOpts model.PageOptions) ([]MessageAggregate, error) {
+
+	var messages []MessageAggregate
+	if err := db.view(func(tx *sql.Tx) error {
+		ids, err := txSearchCandidates(tx, query)
+		if err != nil {
+			return err
+		}
+
+		sort.Slice(ids, func(i, j int) bool {
+			return (ids[i] < ids[j]) != pageOpts.Reverse
+		})
+
+		deletedDiscs := make(map[uint64]bool)
+		for _, id := range ids {
+			if pageOpts.PageSize != 0 && uint64(len(messages)) >= pageOpts.PageSize {
+				break
+			}
+			switch {
+			case pageOpts.Latest():
+			case pageOpts.Reverse && id > pageOpts.LastID:
+				continue
+			case !pageOpts.Reverse && id < pageOpts.LastID:
+				continue
+			}
+
+			raw, err := txFindMessage(tx, id)
+			switch {
+			case err == ErrMessageNotFound:
+				continue
+			case err != nil:
+				return err
+			}
+			if !searchMatchesRaw(&query, pageOpts, raw) {
+				continue
+			}
+
+			deleted, ok := deletedDiscs[raw.DiscussionID]
+			if !ok {
+				disc, err := txFindDiscussion(tx, "id = ?", raw.DiscussionID)
+				if err != nil {
+					return err
+				}
+				deleted = disc.Deleted
+				deletedDiscs[raw.DiscussionID] = deleted
+			}
+			if deleted {
+				continue
+			}
+
+			annotations, err := txTargetingMessages(tx, raw)
+			if err != nil {
+				return err
+			}
+			if _, deleted := displayedText(raw, annotations); deleted {
+				continue
+			}
+
+			msg, err := txMessageAggregate(tx, *raw)
+			if err != nil {
+				return err
+			}
+			messages = append(messages, *msg)
+		}
+
+		return txAttachAnnotations(tx, messages)
+	}); err != nil {
+		return nil, err
+	}
+
+	if pageOpts.Reverse {
+		for i, j := 0, len(messages)-1; i < j; i, j = i+1, j-1 {
+			messages[i], messages[j] = messages[j], messages[i]
+		}
+	}
+
+	return messages, nil
+}
+
+// txSearchCandidates returns the ids of the messages containing
+// all the terms of a search query. If the query contains no terms,
+// the ids of all standalone messages (of the query discussions, if any)
+// are returned.
+func txSearchCandidates(tx *sql.Tx, query model.SearchQuery) ([]uint64, error) {
+	var matches map[uint64]struct{}
+
+	terms := model.SearchTerms(query.Text)
+	if len(terms) == 0 {
+		cond, args := `NOT target_linked`, []interface{}{}
+		if len(query.DiscussionIDs) != 0 {
+			cond = `discussion_id IN (?` +
+				strings.Repeat(`, ?`, len(query.DiscussionIDs)-1) +
+				`) AND NOT target_linked`
+			for _, id := range query.DiscussionIDs {
+				args = append(args, id)
+			}
+		}
+		rows, err := tx.Query(`SELECT id FROM messages WHERE `+cond, args...)
+		if err != nil {
+			return nil, err
+		}
+		if matches, err = scanIDSet(rows); err != nil {
+			return nil, err
+		}
+	}
+
+	for i, term := range terms {
+		termMatches, err := sqlSearchTerm(tx, term)
+		if err != nil {
+			return nil, err
+		}
+		if i == 0 {
+			matches = termMatches
+			continue
+		}
+		for id := range matches {
+			if _, ok := termMatches[id]; !ok {
+				delete(matches, id)
+			}
+		}
+	}
+
+	ids := make([]uint64, 0, len(matches))
+	for id := range matches {
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
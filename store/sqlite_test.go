@@ -0,0 +1,82 @@
+package store
+
+import (
+	"bytes"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/c13n-io/c13n-go/model"
+)
+
+func TestSQLiteSchema(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "c13n.db")
+
+	db, err := NewSQLite(path)
+	require.NoError(t, err)
+
+	// New databases are created with the latest schema version.
+	version, err := db.(*sqlDatabase).schemaVersion()
+	require.NoError(t, err)
+	assert.EqualValues(t, len(sqliteMigrations), version)
+
+	contact := generateContact("alice", "alice", generateHex(t, 33))
+	_, err = db.AddContact(&contact)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Dry runs report no pending migrations as well.
+	_, err = NewSQLite(path, WithMigrationDryRun())
+	assert.ErrorIs(t, err, ErrMigrationDryRun)
+
+	// Database contents persist across reopens.
+	db, err = NewSQLite(path)
+	require.NoError(t, err)
+	contacts, err := db.GetContacts()
+	require.NoError(t, err)
+	assert.Equal(t, []model.Contact{contact}, contacts)
+
+	// Databases with newer schema versions are rejected.
+	_, err = db.(*sqlDatabase).db.Exec(`UPDATE meta SET value = ?
+		WHERE key = 'schema_version'`, len(sqliteMigrations)+1)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	_, err = NewSQLite(path)
+	assert.ErrorIs(t, err, ErrUnsupportedSchema)
+}
+
+func TestSQLiteRestoreEarlierSchema(t *testing.T) {
+	dir := t.TempDir()
+
+	db, err := NewSQLite(filepath.Join(dir, "old.db"))
+	require.NoError(t, err)
+	contact := generateContact("alice", "alice", generateHex(t, 33))
+	_, err = db.AddContact(&contact)
+	require.NoError(t, err)
+
+	backup := new(bytes.Buffer)
+	_, err = db.Backup(backup, 0)
+	require.NoError(t, err)
+	require.NoError(t, db.Close())
+
+	// Backups of earlier schema versions are migrated before being restored.
+	migrations := sqliteMigrations
+	defer func() { sqliteMigrations = migrations }()
+	sqliteMigrations = append(sqliteMigrations[:len(migrations):len(migrations)],
+		sqliteMigration{
+			description: "add contact notes",
+			statements:  `ALTER TABLE contacts ADD COLUMN notes TEXT NOT NULL DEFAULT ''`,
+		})
+
+	db, err = NewSQLite(filepath.Join(dir, "new.db"))
+	require.NoError(t, err)
+	defer db.Close()
+
+	require.NoError(t, db.Restore(bytes.NewReader(backup.Bytes())))
+	contacts, err := db.GetContacts()
+	require.NoError(t, err)
+	assert.Equal(t, []model.Contact{contact}, contacts)
+}
@@ -1,6 +1,8 @@
 package store
 
 import (
+	"io"
+
 	"github.com/dgraph-io/badger/v3"
 	"github.com/pkg/errors"
 	"github.com/timshannon/badgerhold/v4"
@@ -13,13 +15,38 @@ type bhDatabase struct {
 
 	bhOptions badgerhold.Options
 	bh        *badgerhold.Store
+
+	migrationDryRun    bool
+	migrationBackupDir string
+
+	restore []io.Reader
 }
 
 // WithLogger sets the database logger.
 func WithLogger(logger *slog.Logger) func(Database) {
 	return func(db Database) {
-		if bhdb, ok := db.(*bhDatabase); ok {
-			bhdb.logger = logger
+		switch db := db.(type) {
+		case *bhDatabase:
+			db.logger = logger
+		case *sqlDatabase:
+			db.logger = logger
+		}
+	}
+}
+
+// WithRestore loads backups into the database when it is opened,
+// in the order provided: a full backup followed by the incremental
+// backups created after it.
+// The backups are loaded before pending migrations are applied
+// and indexes are built, so that backups of earlier schema versions,
+// such as the ones written before migrations, are migrated and indexed.
+func WithRestore(backups ...io.Reader) func(Database) {
+	return func(db Database) {
+		switch db := db.(type) {
+		case *bhDatabase:
+			db.restore = backups
+		case *sqlDatabase:
+			db.restore = backups
 		}
 	}
 }
@@ -60,9 +87,106 @@ func New(dbDir string, options ...func(Database)) (Database, error) {
 		return nil, errors.Wrap(err, "Could not open database")
 	}
 
+	// Load the restored backups, before migrating and indexing them.
+	for i, r := range db.restore {
+		if err := db.Restore(r); err != nil {
+			db.bh.Close()
+			return nil, errors.Wrapf(err, "Could not restore backup %d", i+1)
+		}
+	}
+
+	// Apply any pending schema migrations.
+	if err := db.migrate(); err != nil {
+		db.bh.Close()
+		if errors.Is(err, ErrMigrationDryRun) {
+			return nil, err
+		}
+		return nil, errors.Wrap(err, "Could not migrate database")
+	}
+
+	// Populate the message amounts, if missing.
+	if err := db.ensureMessageIndexes(); err != nil {
+		db.bh.Close()
+		return nil, errors.Wrap(err, "Could not build message indexes")
+	}
+
+	// Build the message range indexes, if missing.
+	if err := db.ensureMessageRangeIndex(); err != nil {
+		db.bh.Close()
+		return nil, errors.Wrap(err, "Could not build message range indexes")
+	}
+
+	// Build the discussion listing indexes, if missing.
+	if err := db.ensureDiscussionListingIndex(); err != nil {
+		db.bh.Close()
+		return nil, errors.Wrap(err, "Could not build discussion listing indexes")
+	}
+
+	// Build the message search index, if missing.
+	if err := db.ensureSearchIndex(); err != nil {
+		db.bh.Close()
+		return nil, errors.Wrap(err, "Could not build search index")
+	}
+
+	// Build the message receipt index, if missing.
+	if err := db.ensureReceiptIndex(); err != nil {
+		db.bh.Close()
+		return nil, errors.Wrap(err, "Could not build receipt index")
+	}
+
+	// Build the invoice and payment hash indexes, if missing.
+	if err := db.ensureHashIndexes(); err != nil {
+		db.bh.Close()
+		return nil, errors.Wrap(err, "Could not build hash indexes")
+	}
+
 	return db, nil
 }
 
+// RotateEncryptionKey re-encrypts the database in the provided directory
+// under a new encryption key.
+// The database must not be open while its encryption key is rotated.
+// The data keys encrypting the database entries are re-encrypted
+// under the new key, which is required for opening the database afterwards.
+func RotateEncryptionKey(dbDir string, oldKey, newKey []byte) error {
+	switch len(newKey) {
+	case 16, 24, 32:
+	default:
+		return badger.ErrInvalidEncryptionKey
+	}
+
+	// Opening the database verifies the current key,
+	// and fails if the database is in use.
+	// It is opened read-only, so that no migrations are applied.
+	db, err := badger.Open(badger.DefaultOptions(dbDir).
+		WithReadOnly(true).WithLogger(nil).
+		WithEncryptionKey(oldKey).WithIndexCacheSize(1 << 20))
+	if err != nil {
+		return err
+	}
+	if err := db.Close(); err != nil {
+		return errors.Wrap(err, "Could not close database")
+	}
+
+	opt := badger.KeyRegistryOptions{
+		Dir:                           dbDir,
+		ReadOnly:                      true,
+		EncryptionKey:                 oldKey,
+		EncryptionKeyRotationDuration: badger.DefaultOptions(dbDir).EncryptionKeyRotationDuration,
+	}
+	registry, err := badger.OpenKeyRegistry(opt)
+	if err != nil {
+		return errors.Wrap(err, "Could not open key registry")
+	}
+
+	opt.EncryptionKey = newKey
+	if err := badger.WriteKeyRegistry(registry, opt); err != nil {
+		return errors.Wrap(err, "Could not write key registry")
+	}
+
+	return nil
+}
+
 // Close closees the database and returns any encountered error.
 func (db *bhDatabase) Close() error {
 	return db.bh.Close()
@@ -53,9 +53,15 @@ func createInMemoryDB(t *testing.T) (Database, func()) {
 }
 
 func overrideTimestampGetter(step time.Duration) (resetTimestampGetter func()) {
+	return overrideTimestampGetterFrom(time.Time{}, step)
+}
+
+func overrideTimestampGetterFrom(start time.Time,
+	step time.Duration) (resetTimestampGetter func()) {
+
 	prevTimestamp := getCurrentTime
 
-	nextCurTime := time.Time{}
+	nextCurTime := start
 	getCurrentTime = func() time.Time {
 		nextCurTime = nextCurTime.Add(step)
 		return nextCurTime
@@ -79,3 +85,60 @@ func TestNew(t *testing.T) {
 	err = db.Close()
 	assert.NoError(t, err)
 }
+
+func TestRotateEncryptionKey(t *testing.T) {
+	dir := t.TempDir()
+	oldKey := []byte("1234567890123456")
+	newKey := []byte("abcdefghijklmnopqrstuvwxyz012345")
+
+	open := func(key []byte) (Database, error) {
+		return New(dir, WithBadgerOption(
+			func(o badger.Options) badger.Options {
+				return o.WithEncryptionKey(key).WithIndexCacheSize(1 << 20)
+			}),
+		)
+	}
+
+	db, err := open(oldKey)
+	require.NoError(t, err)
+	contact := generateContact("alie", "alice", generateHex(t, 33))
+	_, err = db.AddContact(&contact)
+	require.NoError(t, err)
+
+	// The key cannot be rotated while the database is in use.
+	assert.Error(t, RotateEncryptionKey(dir, oldKey, newKey))
+
+	// Pending migrations are not applied by key rotation.
+	require.NoError(t, db.(*bhDatabase).bh.Badger().Update(func(txn *badger.Txn) error {
+		return txn.Delete(schemaVersionKey)
+	}))
+	require.NoError(t, db.Close())
+
+	assert.Error(t, RotateEncryptionKey(dir, newKey, oldKey))
+	assert.ErrorIs(t, RotateEncryptionKey(dir, oldKey, []byte("short")),
+		badger.ErrInvalidEncryptionKey)
+
+	require.NoError(t, RotateEncryptionKey(dir, oldKey, newKey))
+
+	raw, err := badger.Open(badger.DefaultOptions(dir).WithReadOnly(true).
+		WithLogger(nil).WithEncryptionKey(newKey).WithIndexCacheSize(1 << 20))
+	require.NoError(t, err)
+	require.NoError(t, raw.View(func(txn *badger.Txn) error {
+		_, err := txn.Get(schemaVersionKey)
+		assert.ErrorIs(t, err, badger.ErrKeyNotFound)
+		return nil
+	}))
+	require.NoError(t, raw.Close())
+
+	_, err = open(oldKey)
+	assert.Error(t, err)
+
+	db, err = open(newKey)
+	require.NoError(t, err)
+	defer db.Close()
+
+	contacts, err := db.GetContacts()
+	require.NoError(t, err)
+	require.Len(t, contacts, 1)
+	assert.Equal(t, contact.Address, contacts[0].Address)
+}